@@ -10,10 +10,14 @@ import (
 	"time"
 
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils"
 )
 
 const HTTPClientTimeout = time.Second * 300
 
+// AuthorizationToken is sent as a bearer token with every REST API call, if it is set
+var AuthorizationToken string
+
 func InvokeRESTAPI(method, url string, requestBody []byte) (*http.Response, []byte, error) {
 	var request *http.Request
 	var err error
@@ -28,6 +32,9 @@ func InvokeRESTAPI(method, url string, requestBody []byte) (*http.Response, []by
 	}
 
 	request.Header.Set("Content-Type", "application/json")
+	if AuthorizationToken != "" {
+		request.Header.Set("Authorization", "Bearer "+AuthorizationToken)
+	}
 
 	LogHTTPRequest(request, requestBody)
 
@@ -53,11 +60,51 @@ func InvokeRESTAPI(method, url string, requestBody []byte) (*http.Response, []by
 	return response, responseBody, err
 }
 
+// OpenRESTStream calls a REST API that streams its response.  If the call succeeds, the response body is left
+// open for the caller to read and close; otherwise it is read and returned.
+func OpenRESTStream(url string) (*http.Response, []byte, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request.Header.Set("Accept", "text/event-stream")
+	if AuthorizationToken != "" {
+		request.Header.Set("Authorization", "Bearer "+AuthorizationToken)
+	}
+
+	LogHTTPRequest(request, nil)
+
+	client := &http.Client{Timeout: HTTPClientTimeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error communicating with Trident REST API; %v", err)
+	}
+
+	if response.StatusCode == http.StatusOK {
+		return response, nil, nil
+	}
+
+	defer func() { _ = response.Body.Close() }()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response, responseBody, fmt.Errorf("error reading response body; %v", err)
+	}
+
+	LogHTTPResponse(response, responseBody)
+
+	return response, responseBody, nil
+}
+
 func LogHTTPRequest(request *http.Request, requestBody []byte) {
 	Log().Debug("--------------------------------------------------------------------------------\n")
 	Log().Debugf("Request Method: %s\n", request.Method)
 	Log().Debugf("Request URL: %v\n", request.URL)
-	Log().Debugf("Request headers: %v\n", request.Header)
+	headers := request.Header.Clone()
+	if headers.Get("Authorization") != "" {
+		headers.Set("Authorization", utils.REDACTED)
+	}
+	Log().Debugf("Request headers: %v\n", headers)
 	if requestBody == nil {
 		requestBody = []byte{}
 	}
@@ -56,6 +56,10 @@ type MultipleSnapshotResponse struct {
 	Items []storage.SnapshotExternal `json:"items"`
 }
 
+type MultipleVolumeMigrationResponse struct {
+	Items []storage.VolumeMigrationExternal `json:"items"`
+}
+
 type Version struct {
 	Version       string `json:"version"`
 	MajorVersion  uint   `json:"majorVersion"`
@@ -0,0 +1,14 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func init() {
+	RootCmd.AddCommand(backupCmd)
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up a resource from Trident",
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	k8sclient "github.com/netapp/trident/cli/k8s_client"
+	persistentstore "github.com/netapp/trident/persistent_store"
+)
+
+var backupStateFilename string
+
+func init() {
+	backupCmd.AddCommand(backupStateCmd)
+	backupStateCmd.Flags().StringVarP(&backupStateFilename, "filename", "f", "",
+		"Path of the state archive to write, or - for stdout")
+}
+
+var backupStateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Back up Trident's state to an archive",
+	Long: `Back up Trident's state to an archive, from which a new Trident installation may be restored.
+
+The archive holds backends with their credentials, storage classes, volumes, snapshots, volume publications and
+mirror relationships.  It is read directly from Trident's custom resources using the Kubernetes config, so it is
+written to the local machine.  Keep the archive secure, because it contains the credentials of every backend.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return stateBackup(backupStateFilename)
+	},
+}
+
+// initStateStoreClient returns a client for the persistent store of the Trident installation in the selected
+// namespace, along with the Kubernetes clients it uses.
+func initStateStoreClient() (*k8sclient.Clients, *persistentstore.CRDClientV1, error) {
+	clients, err := k8sclient.CreateK8SClients("", KubeConfigPath, TridentPodNamespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	return clients, persistentstore.NewCRDClientV1FromClients(clients), nil
+}
+
+func stateBackup(filename string) error {
+	if filename == "" {
+		return errors.New("no output file was specified")
+	}
+
+	clients, storeClient, err := initStateStoreClient()
+	if err != nil {
+		return err
+	}
+
+	archive, err := persistentstore.NewStateArchive(ctx(), storeClient)
+	if err != nil {
+		return err
+	}
+
+	relationships, err := clients.TridentClient.TridentV1().TridentMirrorRelationships(allNamespaces).List(ctx(),
+		listOpts)
+	if err != nil {
+		return fmt.Errorf("could not list mirror relationships; %v", err)
+	}
+	archive.MirrorRelationships = append(archive.MirrorRelationships, relationships.Items...)
+
+	if filename == "-" {
+		return archive.Write(os.Stdout)
+	}
+	if err = writeStateArchive(filename, archive); err != nil {
+		return err
+	}
+
+	fmt.Printf("Backed up %d backends, %d storage classes, %d volumes, %d snapshots, %d volume publications "+
+		"and %d mirror relationships to %s.\n", len(archive.Backends), len(archive.StorageClasses),
+		len(archive.Volumes), len(archive.Snapshots), len(archive.VolumePublications),
+		len(archive.MirrorRelationships), filename)
+
+	return nil
+}
+
+// writeStateArchive writes an archive to a temporary file that is only readable by the user, and renames it into
+// place, so that an existing archive is not lost if the backup fails.
+func writeStateArchive(filename string, archive *persistentstore.StateArchive) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tempFile.Name()) }()
+
+	writeErr := archive.Write(tempFile)
+	if closeErr := tempFile.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		return fmt.Errorf("could not write the state archive; %v", writeErr)
+	}
+
+	return os.Rename(tempFile.Name(), filename)
+}
+
+// readStateArchive reads an archive from a file, or from stdin if the filename is -.
+func readStateArchive(filename string) (*persistentstore.StateArchive, error) {
+	if filename == "" {
+		return nil, errors.New("no input file was specified")
+	}
+
+	var reader io.Reader = os.Stdin
+	if filename != "-" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = file.Close() }()
+		reader = file
+	}
+
+	return persistentstore.ReadStateArchive(reader)
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var checkRepair bool
+
+func init() {
+	RootCmd.AddCommand(checkCmd)
+	checkCmd.Flags().BoolVar(&checkRepair, "repair", false,
+		"Apply safe fixes, such as adopting volumes, deleting stale publications and deleting leftover "+
+			"create transactions")
+}
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check Trident's state against its backends and Kubernetes",
+	Long: "Compare the volumes, publications and transactions in Trident's persistent store with the volumes " +
+		"on its backends and the PVs and volume attachments in Kubernetes, and report where they have drifted " +
+		"apart.",
+	Args: cobra.ExactArgs(0),
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		err := discoverOperatingMode(cmd)
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"check"}
+			if checkRepair {
+				command = append(command, "--repair")
+			}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return consistencyCheck(checkRepair)
+		}
+	},
+}
+
+func consistencyCheck(repair bool) error {
+	report, err := GetConsistencyReport(repair)
+	if err != nil {
+		return err
+	}
+
+	WriteConsistencyReport(report)
+
+	return nil
+}
+
+// GetConsistencyReport asks the Trident controller to check its consistency, and to repair it if requested.
+func GetConsistencyReport(repair bool) (*storage.ConsistencyReport, error) {
+	url := BaseURL() + "/consistency"
+	method := "GET"
+	if repair {
+		url += "/repair"
+		method = "POST"
+	}
+
+	response, responseBody, err := api.InvokeRESTAPI(method, url, nil)
+	if err != nil {
+		return nil, err
+	} else if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not check consistency: %v", GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var consistencyResponse rest.ConsistencyResponse
+	if err = json.Unmarshal(responseBody, &consistencyResponse); err != nil {
+		return nil, err
+	}
+	if consistencyResponse.Report == nil {
+		return nil, fmt.Errorf("could not check consistency: no report returned")
+	}
+
+	return consistencyResponse.Report, nil
+}
+
+func WriteConsistencyReport(report *storage.ConsistencyReport) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(report)
+	case FormatYAML:
+		WriteYAML(report)
+	case FormatName:
+		for _, issue := range report.Issues {
+			fmt.Println(issue.Object)
+		}
+	default:
+		writeConsistencyIssueTable(report)
+		for _, skipped := range report.Skipped {
+			fmt.Println("Skipped " + skipped)
+		}
+	}
+}
+
+func writeConsistencyIssueTable(report *storage.ConsistencyReport) {
+	table := tablewriter.NewWriter(os.Stdout)
+	header := []string{"Type", "Object", "Backend", "Node", "Message", "Repair"}
+	if report.Repair {
+		header = append(header, "Repaired")
+	}
+	table.SetHeader(header)
+
+	for _, issue := range report.Issues {
+		row := []string{
+			string(issue.Type),
+			issue.Object,
+			issue.Backend,
+			issue.Node,
+			issue.Message,
+			issue.Repair,
+		}
+		if report.Repair {
+			repaired := strconv.FormatBool(issue.Repaired)
+			if issue.RepairError != "" {
+				repaired = issue.RepairError
+			}
+			row = append(row, repaired)
+		}
+		table.Append(row)
+	}
+
+	table.Render()
+}
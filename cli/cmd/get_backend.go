@@ -22,6 +22,7 @@ import (
 
 func init() {
 	getCmd.AddCommand(getBackendCmd)
+	addWatchFlag(getBackendCmd)
 }
 
 var getBackendCmd = &cobra.Command{
@@ -31,8 +32,14 @@ var getBackendCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if OperatingMode == ModeTunnel {
 			command := []string{"get", "backend"}
+			if getWatch {
+				TunnelCommandStream(append(append(command, "--watch"), args...))
+				return nil
+			}
 			TunnelCommand(append(command, args...))
 			return nil
+		} else if getWatch {
+			return watchChanges(storage.ChangeObjectBackend, matchNames(args), func() error { return backendList(args) })
 		} else {
 			return backendList(args)
 		}
@@ -0,0 +1,191 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+func init() {
+	getCmd.AddCommand(getMigrationCmd)
+}
+
+var getMigrationCmd = &cobra.Command{
+	Use:     "migration [<volume name>...]",
+	Short:   "Get the progress of one or more volume migrations from Trident",
+	Aliases: []string{"m", "migrations"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"get", "migration"}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return volumeMigrationList(args)
+		}
+	},
+}
+
+func volumeMigrationList(volumeNames []string) error {
+	var err error
+
+	// If no volumes were specified, we'll get all migrations
+	getAll := false
+	if len(volumeNames) == 0 {
+		getAll = true
+		volumeNames, err = GetVolumeMigrations()
+		if err != nil {
+			return err
+		}
+	}
+
+	migrations := make([]storage.VolumeMigrationExternal, 0, len(volumeNames))
+
+	for _, volumeName := range volumeNames {
+		migration, err := GetVolumeMigration(volumeName)
+		if err != nil {
+			// A migration may complete between listing and getting it
+			if getAll && utils.IsNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		migrations = append(migrations, migration)
+	}
+
+	WriteVolumeMigrations(migrations)
+
+	return nil
+}
+
+func GetVolumeMigrations() ([]string, error) {
+	url := BaseURL() + "/migration"
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
+	if err != nil {
+		return nil, err
+	} else if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get volume migrations: %v",
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var listMigrationsResponse rest.ListVolumeMigrationsResponse
+	err = json.Unmarshal(responseBody, &listMigrationsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return listMigrationsResponse.Volumes, nil
+}
+
+func GetVolumeMigration(volumeName string) (storage.VolumeMigrationExternal, error) {
+	url := BaseURL() + "/migration/" + volumeName
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
+	if err != nil {
+		return storage.VolumeMigrationExternal{}, err
+	} else if response.StatusCode != http.StatusOK {
+		errorMessage := fmt.Sprintf("could not get migration of volume %s: %v", volumeName,
+			GetErrorFromHTTPResponse(response, responseBody))
+		switch response.StatusCode {
+		case http.StatusNotFound:
+			return storage.VolumeMigrationExternal{}, utils.NotFoundError(errorMessage)
+		default:
+			return storage.VolumeMigrationExternal{}, errors.New(errorMessage)
+		}
+	}
+
+	var getMigrationResponse rest.GetVolumeMigrationResponse
+	err = json.Unmarshal(responseBody, &getMigrationResponse)
+	if err != nil {
+		return storage.VolumeMigrationExternal{}, err
+	}
+	if getMigrationResponse.Migration == nil {
+		return storage.VolumeMigrationExternal{}, fmt.Errorf("could not get migration of volume %s: "+
+			"no migration returned", volumeName)
+	}
+
+	return *getMigrationResponse.Migration, nil
+}
+
+func WriteVolumeMigrations(migrations []storage.VolumeMigrationExternal) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(api.MultipleVolumeMigrationResponse{Items: migrations})
+	case FormatYAML:
+		WriteYAML(api.MultipleVolumeMigrationResponse{Items: migrations})
+	case FormatName:
+		writeVolumeMigrationNames(migrations)
+	case FormatWide:
+		writeWideVolumeMigrationTable(migrations)
+	default:
+		writeVolumeMigrationTable(migrations)
+	}
+}
+
+func writeVolumeMigrationTable(migrations []storage.VolumeMigrationExternal) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Volume", "Target Backend", "Target Pool", "State", "Progress"})
+
+	for _, migration := range migrations {
+		table.Append([]string{
+			migration.Volume,
+			migration.TargetBackend,
+			migration.TargetPool,
+			string(migration.State),
+			strconv.Itoa(migration.PercentComplete) + "%",
+		})
+	}
+
+	table.Render()
+}
+
+func writeWideVolumeMigrationTable(migrations []storage.VolumeMigrationExternal) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{
+		"Volume",
+		"Source Backend",
+		"Source Pool",
+		"Target Backend",
+		"Target Pool",
+		"State",
+		"Progress",
+		"Started",
+		"Message",
+	})
+
+	for _, migration := range migrations {
+		table.Append([]string{
+			migration.Volume,
+			migration.SourceBackend,
+			migration.SourcePool,
+			migration.TargetBackend,
+			migration.TargetPool,
+			string(migration.State),
+			strconv.Itoa(migration.PercentComplete) + "%",
+			migration.StartTime.Format(time.RFC3339),
+			migration.Message,
+		})
+	}
+
+	table.Render()
+}
+
+func writeVolumeMigrationNames(migrations []storage.VolumeMigrationExternal) {
+	for _, migration := range migrations {
+		fmt.Println(migration.Volume)
+	}
+}
@@ -15,11 +15,13 @@ import (
 
 	"github.com/netapp/trident/cli/api"
 	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
 func init() {
 	getCmd.AddCommand(getNodeCmd)
+	addWatchFlag(getNodeCmd)
 }
 
 var getNodeCmd = &cobra.Command{
@@ -30,8 +32,14 @@ var getNodeCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if OperatingMode == ModeTunnel {
 			command := []string{"get", "node"}
+			if getWatch {
+				TunnelCommandStream(append(append(command, "--watch"), args...))
+				return nil
+			}
 			TunnelCommand(append(command, args...))
 			return nil
+		} else if getWatch {
+			return watchChanges(storage.ChangeObjectNode, matchNames(args), func() error { return nodeList(args) })
 		} else {
 			return nodeList(args)
 		}
@@ -151,6 +159,8 @@ func writeWideNodeTable(nodes []utils.NodeExternal) {
 	header := []string{
 		"Name",
 		"IQN",
+		"NQN",
+		"WWPNs",
 		"IPs",
 		"Services",
 		"State",
@@ -165,6 +175,8 @@ func writeWideNodeTable(nodes []utils.NodeExternal) {
 		table.Append([]string{
 			node.Name,
 			node.IQN,
+			node.NQN,
+			strings.Join(node.WWPNs, "\n"),
 			strings.Join(node.IPs, "\n"),
 			strings.Join(services, "\n"),
 			string(node.PublicationState),
@@ -0,0 +1,186 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var (
+	getPoolBackend string
+	getPoolUsage   bool
+)
+
+func init() {
+	getCmd.AddCommand(getPoolCmd)
+	getPoolCmd.Flags().StringVar(&getPoolBackend, "backend", "", "Limit query to backend")
+	getPoolCmd.Flags().BoolVar(&getPoolUsage, "usage", false,
+		"Show how full each pool is, and which volumes could be migrated to even out their usage")
+}
+
+var getPoolCmd = &cobra.Command{
+	Use:     "pool",
+	Short:   "Get the storage pools of the backends in Trident",
+	Aliases: []string{"p", "pools"},
+	Args:    cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"get", "pool"}
+			if getPoolBackend != "" {
+				command = append(command, "--backend", getPoolBackend)
+			}
+			if getPoolUsage {
+				command = append(command, "--usage")
+			}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return poolList(getPoolBackend, getPoolUsage)
+		}
+	},
+}
+
+func poolList(backendName string, showUsage bool) error {
+	report, err := GetPoolUsage()
+	if err != nil {
+		return err
+	}
+
+	if backendName != "" {
+		pools := make([]*storage.PoolUsage, 0)
+		for _, pool := range report.Pools {
+			if pool.Backend == backendName {
+				pools = append(pools, pool)
+			}
+		}
+		suggestions := make([]*storage.PoolRebalanceSuggestion, 0)
+		for _, suggestion := range report.Suggestions {
+			if suggestion.SourceBackend == backendName || suggestion.TargetBackend == backendName {
+				suggestions = append(suggestions, suggestion)
+			}
+		}
+		report = &storage.PoolUsageReport{Pools: pools, Suggestions: suggestions}
+	}
+
+	WritePools(report, showUsage)
+
+	return nil
+}
+
+func GetPoolUsage() (*storage.PoolUsageReport, error) {
+	url := BaseURL() + "/pool/usage"
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
+	if err != nil {
+		return nil, err
+	} else if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get pool usage: %v", GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var getPoolUsageResponse rest.GetPoolUsageResponse
+	err = json.Unmarshal(responseBody, &getPoolUsageResponse)
+	if err != nil {
+		return nil, err
+	}
+	if getPoolUsageResponse.Report == nil {
+		return nil, fmt.Errorf("could not get pool usage: no report returned")
+	}
+
+	return getPoolUsageResponse.Report, nil
+}
+
+func WritePools(report *storage.PoolUsageReport, showUsage bool) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(report)
+	case FormatYAML:
+		WriteYAML(report)
+	case FormatName:
+		writePoolNames(report.Pools)
+	default:
+		if showUsage {
+			writePoolUsageTable(report.Pools)
+			if len(report.Suggestions) > 0 {
+				fmt.Println("\nSuggested volume migrations:")
+				writePoolRebalanceTable(report.Suggestions)
+			}
+		} else {
+			writePoolTable(report.Pools)
+		}
+	}
+}
+
+func writePoolTable(pools []*storage.PoolUsage) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Backend", "Pool", "Volumes"})
+
+	for _, pool := range pools {
+		table.Append([]string{
+			pool.Backend,
+			pool.Pool,
+			strconv.Itoa(pool.Volumes),
+		})
+	}
+
+	table.Render()
+}
+
+func writePoolUsageTable(pools []*storage.PoolUsage) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Backend", "Pool", "Volumes", "Size", "Free", "Used"})
+
+	for _, pool := range pools {
+		size, free, used := "", "", ""
+		if pool.HasCapacity() {
+			size = humanize.IBytes(pool.TotalBytes)
+			free = humanize.IBytes(pool.FreeBytes)
+			used = strconv.Itoa(int(pool.UsedFraction()*100+0.5)) + "%"
+		}
+		table.Append([]string{
+			pool.Backend,
+			pool.Pool,
+			strconv.Itoa(pool.Volumes),
+			size,
+			free,
+			used,
+		})
+	}
+
+	table.Render()
+}
+
+func writePoolRebalanceTable(suggestions []*storage.PoolRebalanceSuggestion) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Volume", "Size", "Source Backend", "Source Pool", "Target Backend", "Target Pool"})
+
+	for _, suggestion := range suggestions {
+		table.Append([]string{
+			suggestion.Volume,
+			humanize.IBytes(suggestion.SizeBytes),
+			suggestion.SourceBackend,
+			suggestion.SourcePool,
+			suggestion.TargetBackend,
+			suggestion.TargetPool,
+		})
+	}
+
+	table.Render()
+}
+
+func writePoolNames(pools []*storage.PoolUsage) {
+	for _, pool := range pools {
+		fmt.Println(pool.Backend + "/" + pool.Pool)
+	}
+}
@@ -25,6 +25,7 @@ var getSnapshotVolume string
 
 func init() {
 	getCmd.AddCommand(getSnapshotCmd)
+	addWatchFlag(getSnapshotCmd)
 	getSnapshotCmd.Flags().StringVar(&getSnapshotVolume, "volume", "", "Limit query to volume "+
 		"(unless additional arguments are provided)")
 }
@@ -39,8 +40,14 @@ var getSnapshotCmd = &cobra.Command{
 			if getSnapshotVolume != "" {
 				command = append(command, "--volume", getSnapshotVolume)
 			}
+			if getWatch {
+				TunnelCommandStream(append(append(command, "--watch"), args...))
+				return nil
+			}
 			TunnelCommand(append(command, args...))
 			return nil
+		} else if getWatch {
+			return watchChanges(storage.ChangeObjectSnapshot, matchSnapshots(args), func() error { return snapshotList(args) })
 		} else {
 			return snapshotList(args)
 		}
@@ -196,3 +203,14 @@ func writeSnapshotIDs(snapshots []storage.SnapshotExternal) {
 		fmt.Println(storage.MakeSnapshotID(s.Config.VolumeName, s.Config.Name))
 	}
 }
+
+// matchSnapshots returns a filter that matches the given snapshot IDs, or else the snapshots of the volume the
+// query is limited to, if any.
+func matchSnapshots(snapshotIDs []string) func(string) bool {
+	if len(snapshotIDs) == 0 && getSnapshotVolume != "" {
+		return func(snapshotID string) bool {
+			return strings.HasPrefix(snapshotID, getSnapshotVolume+"/")
+		}
+	}
+	return matchNames(snapshotIDs)
+}
@@ -24,15 +24,18 @@ var (
 	getSourceVolume      string
 	getSubordinateVolume string
 	backendsByUUID       map[string]*storage.BackendExternal
+	volumeHealthByName   map[string]*storage.VolumeHealth
 )
 
 func init() {
 	getCmd.AddCommand(getVolumeCmd)
+	addWatchFlag(getVolumeCmd)
 	getVolumeCmd.Flags().StringVar(&getSourceVolume, "subordinateOf", "", "Limit query to subordinates of volume")
 	getVolumeCmd.Flags().StringVar(&getSubordinateVolume, "parentOfSubordinate", "",
 		"Limit query to subordinate source volume")
 	getVolumeCmd.MarkFlagsMutuallyExclusive("subordinateOf", "parentOfSubordinate")
 	backendsByUUID = make(map[string]*storage.BackendExternal)
+	volumeHealthByName = make(map[string]*storage.VolumeHealth)
 }
 
 var getVolumeCmd = &cobra.Command{
@@ -48,8 +51,14 @@ var getVolumeCmd = &cobra.Command{
 			if getSubordinateVolume != "" {
 				command = append(command, "--parentOfSubordinate", getSubordinateVolume)
 			}
+			if getWatch {
+				TunnelCommandStream(append(append(command, "--watch"), args...))
+				return nil
+			}
 			TunnelCommand(append(command, args...))
 			return nil
+		} else if getWatch {
+			return watchChanges(storage.ChangeObjectVolume, matchNames(args), func() error { return volumeList(args) })
 		} else {
 			return volumeList(args)
 		}
@@ -91,6 +100,11 @@ func volumeList(volumeNames []string) error {
 				}
 				backendsByUUID[volume.BackendUUID] = &backend
 			}
+
+			// Volume health is informational, so don't fail if it cannot be determined
+			if health, err := GetVolumeHealth(volumeName); err == nil {
+				volumeHealthByName[volumeName] = health
+			}
 		}
 
 		volumes = append(volumes, volume)
@@ -156,6 +170,35 @@ func GetVolume(volumeName string) (storage.VolumeExternal, error) {
 	return *getVolumeResponse.Volume, nil
 }
 
+func GetVolumeHealth(volumeName string) (*storage.VolumeHealth, error) {
+	url := BaseURL() + "/volume/" + volumeName + "/health"
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
+	if err != nil {
+		return nil, err
+	} else if response.StatusCode != http.StatusOK {
+		errorMessage := fmt.Sprintf("could not get health of volume %s: %v", volumeName,
+			GetErrorFromHTTPResponse(response, responseBody))
+		switch response.StatusCode {
+		case http.StatusNotFound:
+			return nil, utils.NotFoundError(errorMessage)
+		default:
+			return nil, errors.New(errorMessage)
+		}
+	}
+
+	var getVolumeHealthResponse rest.GetVolumeHealthResponse
+	err = json.Unmarshal(responseBody, &getVolumeHealthResponse)
+	if err != nil {
+		return nil, err
+	}
+	if getVolumeHealthResponse.Health == nil {
+		return nil, fmt.Errorf("could not get health of volume %s: no health returned", volumeName)
+	}
+
+	return getVolumeHealthResponse.Health, nil
+}
+
 func WriteVolumes(volumes []storage.VolumeExternal) {
 	switch OutputFormat {
 	case FormatJSON:
@@ -206,6 +249,7 @@ func writeWideVolumeTable(volumes []storage.VolumeExternal) {
 		"State",
 		"Managed",
 		"Access Mode",
+		"Health",
 	}
 	table.SetHeader(header)
 
@@ -218,6 +262,17 @@ func writeWideVolumeTable(volumes []storage.VolumeExternal) {
 			backendName = backend.Name
 		}
 
+		health := "unknown"
+		if volumeHealth := volumeHealthByName[volume.Config.Name]; volumeHealth != nil {
+			if !volumeHealth.Abnormal {
+				health = "healthy"
+			} else if volumeHealth.Message != "" {
+				health = "abnormal: " + volumeHealth.Message
+			} else {
+				health = "abnormal"
+			}
+		}
+
 		table.Append([]string{
 			volume.Config.Name,
 			volume.Config.InternalName,
@@ -229,6 +284,7 @@ func writeWideVolumeTable(volumes []storage.VolumeExternal) {
 			string(volume.State),
 			strconv.FormatBool(!volume.Config.ImportNotManaged),
 			string(volume.Config.AccessMode),
+			health,
 		})
 	}
 
@@ -40,18 +40,21 @@ const (
 	DefaultPVName      = tridentconfig.OrchestratorName
 
 	// CRD names
-	BackendConfigCRDName      = "tridentbackendconfigs.trident.netapp.io"
-	BackendCRDName            = "tridentbackends.trident.netapp.io"
-	MirrorRelationshipCRDName = "tridentmirrorrelationships.trident.netapp.io"
-	NodeCRDName               = "tridentnodes.trident.netapp.io"
-	SnapshotCRDName           = "tridentsnapshots.trident.netapp.io"
-	SnapshotInfoCRDName       = "tridentsnapshotinfos.trident.netapp.io"
-	StorageClassCRDName       = "tridentstorageclasses.trident.netapp.io"
-	TransactionCRDName        = "tridenttransactions.trident.netapp.io"
-	VersionCRDName            = "tridentversions.trident.netapp.io"
-	VolumeCRDName             = "tridentvolumes.trident.netapp.io"
-	VolumePublicationCRDName  = "tridentvolumepublications.trident.netapp.io"
-	VolumeReferenceCRDName    = "tridentvolumereferences.trident.netapp.io"
+	ActionSnapshotRestoreCRDName = "tridentactionsnapshotrestores.trident.netapp.io"
+	BackendConfigCRDName         = "tridentbackendconfigs.trident.netapp.io"
+	BackendCRDName               = "tridentbackends.trident.netapp.io"
+	GroupSnapshotCRDName         = "tridentgroupsnapshots.trident.netapp.io"
+	MirrorRelationshipCRDName    = "tridentmirrorrelationships.trident.netapp.io"
+	NodeCRDName                  = "tridentnodes.trident.netapp.io"
+	SnapshotCRDName              = "tridentsnapshots.trident.netapp.io"
+	SnapshotPolicyCRDName        = "tridentsnapshotpolicies.trident.netapp.io"
+	SnapshotInfoCRDName          = "tridentsnapshotinfos.trident.netapp.io"
+	StorageClassCRDName          = "tridentstorageclasses.trident.netapp.io"
+	TransactionCRDName           = "tridenttransactions.trident.netapp.io"
+	VersionCRDName               = "tridentversions.trident.netapp.io"
+	VolumeCRDName                = "tridentvolumes.trident.netapp.io"
+	VolumePublicationCRDName     = "tridentvolumepublications.trident.netapp.io"
+	VolumeReferenceCRDName       = "tridentvolumereferences.trident.netapp.io"
 
 	ControllerRoleFilename               = "trident-controller-role.yaml"
 	ControllerClusterRoleFilename        = "trident-controller-clusterrole.yaml"
@@ -122,6 +125,8 @@ var (
 	logWorkflows            string
 	logLayers               string
 	probePort               int64
+	controllerReplicas      int
+	restAuthorizationSecret string
 	k8sTimeout              time.Duration
 	httpRequestTimeout      time.Duration
 
@@ -165,8 +170,11 @@ var (
 	dns1123DomainRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
 
 	CRDnames = []string{
+		ActionSnapshotRestoreCRDName,
 		BackendConfigCRDName,
 		BackendCRDName,
+		GroupSnapshotCRDName,
+		SnapshotPolicyCRDName,
 		MirrorRelationshipCRDName,
 		NodeCRDName,
 		VolumeReferenceCRDName,
@@ -216,6 +224,11 @@ func init() {
 		"log layers for which to enable trace logging.")
 	installCmd.Flags().Int64Var(&probePort, "probe-port", 17546,
 		"The port used by the node pods for liveness/readiness probes. Must not already be in use on the worker hosts.")
+	installCmd.Flags().IntVar(&controllerReplicas, "controller-replicas", 1,
+		"The number of Trident controllers. Controllers beyond the first stand by to take over from the leader.")
+	installCmd.Flags().StringVar(&restAuthorizationSecret, "rest-authorization-policy-secret", "",
+		"The name of a secret in the Trident namespace whose policy.yaml key holds the authorization policy of "+
+			"the Trident REST interface.")
 	installCmd.Flags().StringVar(&kubeletDir, "kubelet-dir", "/var/lib/kubelet",
 		"The host location of kubelet's internal state.")
 	installCmd.Flags().StringVar(&imageRegistry, "image-registry", "",
@@ -441,6 +454,10 @@ func validateInstallationArguments() error {
 		return fmt.Errorf("'%s' is not a valid log format", logFormat)
 	}
 
+	if controllerReplicas < 1 {
+		return fmt.Errorf("'%d' is not a valid number of controller replicas; must be at least 1", controllerReplicas)
+	}
+
 	switch v1.PullPolicy(imagePullPolicy) {
 	// If the value of imagePullPolicy is either of PullIfNotPresent, PullAlways or PullNever then the imagePullPolicy
 	// is valid and no action is required.
@@ -634,6 +651,8 @@ func prepareYAMLFiles() error {
 		ServiceAccountName:      getControllerRBACResourceName(true),
 		ImagePullPolicy:         imagePullPolicy,
 		EnableForceDetach:       enableForceDetach,
+		Replicas:                controllerReplicas,
+		RESTAuthorizationSecret: restAuthorizationSecret,
 	}
 	deploymentYAML := k8sclient.GetCSIDeploymentYAML(deploymentArgs)
 	if err = writeFile(deploymentPath, deploymentYAML); err != nil {
@@ -1160,6 +1179,8 @@ func installTrident() (returnError error) {
 			ServiceAccountName:      getControllerRBACResourceName(true),
 			ImagePullPolicy:         imagePullPolicy,
 			EnableForceDetach:       enableForceDetach,
+			Replicas:                controllerReplicas,
+			RESTAuthorizationSecret: restAuthorizationSecret,
 		}
 		returnError = client.CreateObjectByYAML(
 			k8sclient.GetCSIDeploymentYAML(deploymentArgs))
@@ -2142,9 +2163,14 @@ func waitForTridentPod() (*v1.Pod, error) {
 			return err
 		}
 
-		// If Trident was just uninstalled, there could be multiple pods for a brief time.  Calling GetPodByLabel
-		// ensures only one is running.
-		pod, err = client.GetPodByLabel(appLabel, false)
+		// If Trident was just uninstalled, there could be multiple pods for a brief time, and several
+		// controllers may be deployed.  SelectControllerPod ignores terminating pods and picks the leader.
+		var pods []v1.Pod
+		pods, err = client.GetPodsByLabel(appLabel, false)
+		if err != nil {
+			return err
+		}
+		pod, err = k8sclient.SelectControllerPod(pods)
 		if err != nil {
 			return err
 		}
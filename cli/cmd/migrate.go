@@ -0,0 +1,19 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func init() {
+	RootCmd.AddCommand(migrateCmd)
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate a resource in Trident",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		err := discoverOperatingMode(cmd)
+		return err
+	},
+}
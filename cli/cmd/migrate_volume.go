@@ -0,0 +1,82 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var (
+	migrateBackend string
+	migratePool    string
+)
+
+func init() {
+	migrateCmd.AddCommand(migrateVolumeCmd)
+	migrateVolumeCmd.Flags().StringVarP(&migrateBackend, "backend", "b", "", "Backend to which to migrate the volume")
+	migrateVolumeCmd.Flags().StringVar(&migratePool, "pool", "", "Pool of the backend to which to migrate the volume")
+	_ = migrateVolumeCmd.MarkFlagRequired("backend")
+	_ = migrateVolumeCmd.MarkFlagRequired("pool")
+}
+
+var migrateVolumeCmd = &cobra.Command{
+	Use:     "volume <name> --backend <backend> --pool <pool>",
+	Short:   "Migrate a volume to another backend or pool",
+	Aliases: []string{"v"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"migrate", "volume", "--backend", migrateBackend, "--pool", migratePool}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return volumeMigrate(args, migrateBackend, migratePool)
+		}
+	},
+}
+
+func volumeMigrate(volumeNames []string, backendName, poolName string) error {
+	switch len(volumeNames) {
+	case 0:
+		return errors.New("volume name not specified")
+	case 1:
+	default:
+		return errors.New("only one volume may be migrated at a time")
+	}
+
+	request := storage.VolumeMigrateRequest{Backend: backendName, Pool: poolName}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	url := BaseURL() + "/migration/" + volumeNames[0]
+
+	response, responseBody, err := api.InvokeRESTAPI("POST", url, requestBytes)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not migrate volume %s: %v", volumeNames[0],
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var migrateVolumeResponse rest.MigrateVolumeResponse
+	if err = json.Unmarshal(responseBody, &migrateVolumeResponse); err != nil {
+		return err
+	}
+	if migrateVolumeResponse.Migration == nil {
+		return fmt.Errorf("no migration returned for %s", volumeNames[0])
+	}
+
+	WriteVolumeMigrations([]storage.VolumeMigrationExternal{*migrateVolumeResponse.Migration})
+
+	return nil
+}
@@ -108,6 +108,10 @@ func deleteCRs() error {
 		return err
 	}
 
+	if err := deleteTridentActionSnapshotRestores(); err != nil {
+		return err
+	}
+
 	// deleting backend config before backends is desirable, do not want backend deletion without
 	// the backendconfig deletion to trigger another backend creation
 	if err := deleteBackendConfigs(); err != nil {
@@ -138,6 +142,14 @@ func deleteCRs() error {
 		return err
 	}
 
+	if err := deleteGroupSnapshots(); err != nil {
+		return err
+	}
+
+	if err := deleteSnapshotPolicies(); err != nil {
+		return err
+	}
+
 	if err := deleteVolumePublications(); err != nil {
 		return err
 	}
@@ -377,6 +389,52 @@ func deleteTridentSnapshotInfos() error {
 	return nil
 }
 
+func deleteTridentActionSnapshotRestores() error {
+	crd := "tridentactionsnapshotrestores.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithFields(logFields).Debug("CRD not present.")
+		return nil
+	}
+
+	actions, err := crdClientset.TridentV1().TridentActionSnapshotRestores(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(actions.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, action := range actions.Items {
+		if action.HasTridentFinalizers() {
+			crCopy := action.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentActionSnapshotRestores(action.Namespace).Update(ctx(), crCopy,
+				updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentActionSnapshotRestores(action.Namespace).Delete
+		if err := deleteWithRetry(deleteFunc, ctx(), action.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
 func deleteBackendConfigs() error {
 	crd := "tridentbackendconfigs.trident.netapp.io"
 	logFields := LogFields{"CRD": crd}
@@ -773,6 +831,98 @@ func deleteSnapshots() error {
 	return nil
 }
 
+func deleteGroupSnapshots() error {
+	crd := "tridentgroupsnapshots.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	groupSnapshots, err := crdClientset.TridentV1().TridentGroupSnapshots(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(groupSnapshots.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, groupSnapshot := range groupSnapshots.Items {
+		if groupSnapshot.HasTridentFinalizers() {
+			crCopy := groupSnapshot.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentGroupSnapshots(groupSnapshot.Namespace).Update(ctx(), crCopy,
+				updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentGroupSnapshots(groupSnapshot.Namespace).Delete
+		if err := deleteWithRetry(deleteFunc, ctx(), groupSnapshot.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
+func deleteSnapshotPolicies() error {
+	crd := "tridentsnapshotpolicies.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	policies, err := crdClientset.TridentV1().TridentSnapshotPolicies(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(policies.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, policy := range policies.Items {
+		if policy.HasTridentFinalizers() {
+			crCopy := policy.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Update(ctx(), crCopy,
+				updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Delete
+		if err := deleteWithRetry(deleteFunc, ctx(), policy.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
 func deleteVolumeReferences() error {
 	crd := "tridentvolumereferences.trident.netapp.io"
 	logFields := LogFields{"CRD": crd}
@@ -837,10 +987,13 @@ func deleteCRDs() error {
 		"tridentstorageclasses.trident.netapp.io",
 		"tridentmirrorrelationships.trident.netapp.io",
 		"tridentsnapshotinfos.trident.netapp.io",
+		"tridentactionsnapshotrestores.trident.netapp.io",
 		"tridentvolumes.trident.netapp.io",
 		"tridentnodes.trident.netapp.io",
 		"tridenttransactions.trident.netapp.io",
 		"tridentsnapshots.trident.netapp.io",
+		"tridentgroupsnapshots.trident.netapp.io",
+		"tridentsnapshotpolicies.trident.netapp.io",
 		"tridentvolumepublications.trident.netapp.io",
 		"tridentvolumereferences.trident.netapp.io",
 	}
@@ -0,0 +1,19 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func init() {
+	RootCmd.AddCommand(restoreCmd)
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore a resource in Trident",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		err := discoverOperatingMode(cmd)
+		return err
+	},
+}
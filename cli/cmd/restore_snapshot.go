@@ -0,0 +1,62 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/utils"
+)
+
+func init() {
+	restoreCmd.AddCommand(restoreSnapshotCmd)
+}
+
+var restoreSnapshotCmd = &cobra.Command{
+	Use:     "snapshot <volume/snapshot>",
+	Short:   "Restore a volume in place from one of its snapshots",
+	Aliases: []string{"s", "snap"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"restore", "snapshot"}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return snapshotRestore(args)
+		}
+	},
+}
+
+func snapshotRestore(snapshotIDs []string) error {
+	switch len(snapshotIDs) {
+	case 0:
+		return errors.New("volume/snapshot not specified")
+	case 1:
+	default:
+		return errors.New("only one snapshot may be restored at a time")
+	}
+
+	snapshotID := snapshotIDs[0]
+	if !strings.ContainsRune(snapshotID, '/') {
+		return utils.InvalidInputError(fmt.Sprintf("invalid snapshot ID: %s; Please use the format "+
+			"<volume name>/<snapshot name>", snapshotID))
+	}
+
+	url := BaseURL() + "/snapshot/" + snapshotID + "/restore"
+
+	response, responseBody, err := api.InvokeRESTAPI("POST", url, nil)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not restore snapshot %s: %v", snapshotID,
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	persistentstore "github.com/netapp/trident/persistent_store"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	crdclient "github.com/netapp/trident/persistent_store/crd/client/clientset/versioned"
+	"github.com/netapp/trident/storage"
+)
+
+var (
+	restoreStateFilename string
+	restoreStateDryRun   bool
+)
+
+func init() {
+	restoreCmd.AddCommand(restoreStateCmd)
+	restoreStateCmd.Flags().StringVarP(&restoreStateFilename, "filename", "f", "",
+		"Path of the state archive to restore, or - for stdin")
+	restoreStateCmd.Flags().BoolVar(&restoreStateDryRun, "dry-run", false,
+		"Validate the archive and report what would be restored, without changing anything")
+}
+
+var restoreStateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Restore Trident's state from an archive",
+	Long: `Restore Trident's state from an archive written by 'tridentctl backup state'.
+
+The archive is validated against the backends of the live installation.  An archived backend with the same name as
+a live backend is not restored; instead, its volumes are restored to the live backend, under the live backend's UUID.
+The volumes restored to live backends are checked against them with the Trident controller's consistency check.
+Volumes the live backend no longer has are restored as orphaned, and volumes that could not be checked against their
+backends are reported.  Volume publications are restored only for nodes in the live installation.  No object that
+already exists in the live installation is overwritten.  Once the state is restored, restart the Trident controller
+so that it loads the restored state.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return stateRestore(restoreStateFilename, restoreStateDryRun)
+	},
+}
+
+func stateRestore(filename string, dryRun bool) error {
+	archive, err := readStateArchive(filename)
+	if err != nil {
+		return err
+	}
+
+	clients, storeClient, err := initStateStoreClient()
+	if err != nil {
+		return err
+	}
+
+	liveBackendVolumes, err := getLiveBackendVolumes()
+	if err != nil {
+		fmt.Printf("Could not list the volumes on the live backends, so they cannot be checked; %v\n", err)
+	}
+
+	plan, err := archive.PlanRestore(ctx(), storeClient, liveBackendVolumes)
+	if err != nil {
+		return fmt.Errorf("the state archive cannot be restored; %v", err)
+	}
+	for archivedUUID, liveUUID := range plan.BackendUUIDs {
+		fmt.Printf("Volumes of archived backend %s will be restored to live backend %s.\n", archivedUUID, liveUUID)
+	}
+	for _, name := range plan.MissingVolumes {
+		fmt.Printf("Volume %s is missing from its live backend, so it will be restored as orphaned.\n", name)
+	}
+	if len(plan.UnverifiedVolumes) > 0 {
+		fmt.Printf("Volumes %s could not be checked against their backends; check them with 'tridentctl check' "+
+			"once the Trident controller is restarted.\n", strings.Join(plan.UnverifiedVolumes, ", "))
+	}
+	for _, name := range plan.SkippedPublications {
+		fmt.Printf("Volume publication %s will not be restored, because its node is not in the live "+
+			"installation.\n", name)
+	}
+
+	// The migrator refuses to overwrite any object that already exists, so a dry run validates the restore
+	if err = persistentstore.NewDataMigrator(plan.Source, storeClient, dryRun).Run(); err != nil {
+		return fmt.Errorf("could not restore the state archive; %v", err)
+	}
+
+	restored, skipped, err := restoreMirrorRelationships(clients.TridentClient, archive.MirrorRelationships, dryRun)
+	if err != nil {
+		return err
+	}
+
+	action := "Restored"
+	if dryRun {
+		action = "Would restore"
+	}
+	fmt.Printf("%s %d backends, %d storage classes, %d volumes, %d snapshots, %d volume publications and %d "+
+		"mirror relationships.\n", action, len(archive.Backends)-len(plan.BackendUUIDs), len(archive.StorageClasses),
+		len(archive.Volumes), len(archive.Snapshots), len(archive.VolumePublications)-len(plan.SkippedPublications),
+		restored)
+	if skipped > 0 {
+		fmt.Printf("Skipped %d mirror relationships that already exist.\n", skipped)
+	}
+	if !dryRun {
+		fmt.Println("Restart the Trident controller so that it loads the restored state.")
+	}
+
+	return nil
+}
+
+// getLiveBackendVolumes asks the Trident controller for a consistency report, and returns the volumes it found on
+// each backend that it doesn't track, by backend name and internal volume name.  Backends the controller could not
+// list are left out.
+func getLiveBackendVolumes() (map[string]map[string]bool, error) {
+	if err := discoverOperatingMode(nil); err != nil {
+		return nil, err
+	}
+
+	var report *storage.ConsistencyReport
+	if OperatingMode == ModeTunnel {
+		// Run the check in the Trident pod without TunnelCommandRaw, so a failure doesn't set the exit code
+		var outBuff, errBuff bytes.Buffer
+		cmd := getTunnelCommandRaw([]string{"check", "--output", FormatJSON})
+		cmd.Stdout = &outBuff
+		cmd.Stderr = &errBuff
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("%v; %s", err, errBuff.String())
+		}
+		report = &storage.ConsistencyReport{}
+		if err := json.Unmarshal(outBuff.Bytes(), report); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if report, err = GetConsistencyReport(false); err != nil {
+			return nil, err
+		}
+	}
+
+	backendVolumes := make(map[string]map[string]bool, len(report.ListedBackends))
+	for _, name := range report.ListedBackends {
+		backendVolumes[name] = make(map[string]bool)
+	}
+	for _, issue := range report.Issues {
+		if issue.Type != storage.UntrackedBackendVolumeIssue {
+			continue
+		}
+		if names, ok := backendVolumes[issue.Backend]; ok {
+			names[issue.Object] = true
+		}
+	}
+	return backendVolumes, nil
+}
+
+// restoreMirrorRelationships creates the archived mirror relationships that do not already exist, and returns how
+// many were (or in a dry run, would be) created and how many were skipped.  Each relationship is created afresh, so
+// that its controller establishes its status again.
+func restoreMirrorRelationships(
+	crdClient crdclient.Interface, relationships []*netappv1.TridentMirrorRelationship, dryRun bool,
+) (int, int, error) {
+	restored, skipped := 0, 0
+
+	for _, relationship := range relationships {
+		_, err := crdClient.TridentV1().TridentMirrorRelationships(relationship.Namespace).Get(ctx(),
+			relationship.Name, getOpts)
+		if err == nil {
+			skipped++
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return restored, skipped, fmt.Errorf("could not check for mirror relationship %s/%s; %v",
+				relationship.Namespace, relationship.Name, err)
+		}
+
+		restored++
+		if dryRun {
+			continue
+		}
+
+		newRelationship := &netappv1.TridentMirrorRelationship{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        relationship.Name,
+				Namespace:   relationship.Namespace,
+				Labels:      relationship.Labels,
+				Annotations: relationship.Annotations,
+			},
+			Spec: relationship.Spec,
+		}
+		if _, err = crdClient.TridentV1().TridentMirrorRelationships(relationship.Namespace).Create(ctx(),
+			newRelationship, createOpts); err != nil {
+			return restored, skipped, fmt.Errorf("could not restore mirror relationship %s/%s; %v",
+				relationship.Namespace, relationship.Name, err)
+		}
+	}
+
+	return restored, skipped, nil
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/exec"
@@ -21,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/netapp/trident/cli/api"
+	k8sclient "github.com/netapp/trident/cli/k8s_client"
 	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
 
@@ -89,12 +91,15 @@ var (
 
 	Debug                bool
 	useDebug             bool
+	tokenFromStdin       bool
 	LogLevel             string
 	Server               string
 	AutosupportCollector string
 	OutputFormat         string
 
 	listOpts   = metav1.ListOptions{}
+	getOpts    = metav1.GetOptions{}
+	createOpts = metav1.CreateOptions{}
 	updateOpts = metav1.UpdateOptions{}
 	deleteOpts = metav1.DeleteOptions{}
 
@@ -111,6 +116,11 @@ func init() {
 		"Output format. One of json|yaml|name|wide|ps (default)")
 	RootCmd.PersistentFlags().StringVarP(&TridentPodNamespace, "namespace", "n", "", "Namespace of Trident deployment")
 	RootCmd.PersistentFlags().StringVarP(&KubeConfigPath, "kubeconfig", "k", "", "Kubernetes config path")
+	RootCmd.PersistentFlags().StringVarP(&api.AuthorizationToken, "token", "", "",
+		"Bearer token with which to authenticate to the Trident REST interface (default $TRIDENT_TOKEN)")
+	RootCmd.PersistentFlags().BoolVarP(&tokenFromStdin, "token-stdin", "", false,
+		"Read the bearer token from the first line of standard input")
+	_ = RootCmd.PersistentFlags().MarkHidden("token-stdin")
 }
 
 var RootCmd = &cobra.Command{
@@ -141,6 +151,8 @@ func discoverOperatingMode(_ *cobra.Command) error {
 
 	var err error
 
+	discoverAuthorizationToken()
+
 	envServer := os.Getenv("TRIDENT_SERVER")
 
 	if Server != "" {
@@ -182,6 +194,22 @@ func discoverOperatingMode(_ *cobra.Command) error {
 	return nil
 }
 
+// discoverAuthorizationToken reads the REST API token from standard input when tunneled, or else from the
+// environment if it is not given on the command line.
+func discoverAuthorizationToken() {
+	if tokenFromStdin {
+		token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && err != io.EOF {
+			Log().WithError(err).Error("Could not read the bearer token from standard input.")
+		}
+		api.AuthorizationToken = strings.TrimSpace(token)
+		return
+	}
+	if api.AuthorizationToken == "" {
+		api.AuthorizationToken = os.Getenv("TRIDENT_TOKEN")
+	}
+}
+
 func discoverJustOperatingMode(_ *cobra.Command) error {
 	defer func() {
 		if !Debug {
@@ -202,6 +230,8 @@ func discoverJustOperatingMode(_ *cobra.Command) error {
 
 	var err error
 
+	discoverAuthorizationToken()
+
 	envServer := os.Getenv("TRIDENT_SERVER")
 
 	if Server != "" {
@@ -326,15 +356,18 @@ func getTridentPod(namespace, appLabel string) (string, error) {
 		return "", err
 	}
 
-	if len(tridentPod.Items) != 1 {
+	if len(tridentPod.Items) == 0 {
 		return "", fmt.Errorf("could not find a Trident pod in the %s namespace. "+
 			"You may need to use the -n option to specify the correct namespace", namespace)
 	}
 
-	// Get Trident pod name & namespace
-	name := tridentPod.Items[0].ObjectMeta.Name
+	// When several controllers are deployed, talk to the elected leader
+	pod, err := k8sclient.SelectControllerPod(tridentPod.Items)
+	if err != nil {
+		return "", fmt.Errorf("could not find the Trident pod in the %s namespace; %v", namespace, err)
+	}
 
-	return name, nil
+	return pod.ObjectMeta.Name, nil
 }
 
 // getTridentOperatorPod returns the name and namespace of the Trident pod
@@ -506,11 +539,10 @@ func BaseAutosupportURL() string {
 	return url
 }
 
-func TunnelCommand(commandArgs []string) {
-	// Build tunnel command to exec command in container
-	execCommand := []string{"exec", TridentPodName, "-n", TridentPodNamespace, "-c", config.ContainerTrident, "--"}
+// getTunnelCommand returns the Kubernetes CLI command that runs tridentctl in the Trident pod.
+func getTunnelCommand(commandArgs []string) *exec.Cmd {
 	// Build CLI command
-	cliCommand := []string{"tridentctl"}
+	cliCommand := make([]string, 0)
 	if Debug {
 		cliCommand = append(cliCommand, "--debug")
 	}
@@ -520,15 +552,40 @@ func TunnelCommand(commandArgs []string) {
 	}
 	cliCommand = append(cliCommand, commandArgs...)
 
+	return getTunnelCommandRaw(cliCommand)
+}
+
+// getTunnelCommandRaw returns the Kubernetes CLI command that runs tridentctl in the Trident pod with exactly the
+// given arguments.  The bearer token, if any, is written to the standard input of tridentctl rather than passed
+// as an argument, so that it cannot be read from the process list of either host.
+func getTunnelCommandRaw(commandArgs []string) *exec.Cmd {
+	// Build tunnel command to exec command in container
+	execCommand := []string{"exec", TridentPodName, "-n", TridentPodNamespace, "-c", config.ContainerTrident}
+	cliCommand := []string{"tridentctl"}
+	if api.AuthorizationToken != "" {
+		execCommand = append(execCommand, "-i")
+		cliCommand = append(cliCommand, "--token-stdin")
+	}
+	cliCommand = append(cliCommand, commandArgs...)
+
 	// Combine tunnel and CLI commands
+	execCommand = append(execCommand, "--")
 	execCommand = append(execCommand, cliCommand...)
 
 	if Debug {
 		fmt.Printf("Invoking tunneled command: %s %v\n", KubernetesCLI, strings.Join(execCommand, " "))
 	}
 
+	cmd := execKubernetesCLI(execCommand...)
+	if api.AuthorizationToken != "" {
+		cmd.Stdin = strings.NewReader(api.AuthorizationToken + "\n")
+	}
+	return cmd
+}
+
+func TunnelCommand(commandArgs []string) {
 	// Invoke tridentctl inside the Trident pod
-	out, err := execKubernetesCLI(execCommand...).CombinedOutput()
+	out, err := getTunnelCommand(commandArgs).CombinedOutput()
 
 	SetExitCodeFromError(err)
 	if err != nil {
@@ -538,25 +595,20 @@ func TunnelCommand(commandArgs []string) {
 	}
 }
 
-func TunnelCommandRaw(commandArgs []string) ([]byte, []byte, error) {
-	// Build tunnel command to exec command in container
-	execCommand := []string{"exec", TridentPodName, "-n", TridentPodNamespace, "-c", config.ContainerTrident, "--"}
-
-	// Build CLI command
-	cliCommand := []string{"tridentctl"}
-	cliCommand = append(cliCommand, commandArgs...)
-
-	// Combine tunnel and CLI commands
-	execCommand = append(execCommand, cliCommand...)
-
-	if Debug {
-		fmt.Printf("Invoking tunneled command: %s %v\n", KubernetesCLI, strings.Join(execCommand, " "))
-	}
+// TunnelCommandStream invokes tridentctl inside the Trident pod, and copies its output as it is written, for
+// commands such as watches that run until interrupted.
+func TunnelCommandStream(commandArgs []string) {
+	cmd := getTunnelCommand(commandArgs)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	SetExitCodeFromError(cmd.Run())
+}
 
+func TunnelCommandRaw(commandArgs []string) ([]byte, []byte, error) {
 	// Invoke tridentctl inside the Trident pod and get Stdout and Stderr separately in two buffers
 	// Capture the Stdout for the command in outbuff which will later be unmarshalled and
 	// capture the Stderr for the command in os.Stderr
-	cmd := execKubernetesCLI(execCommand...)
+	cmd := getTunnelCommandRaw(commandArgs)
 	var outbuff, stderrBuff bytes.Buffer
 	cmd.Stdout = &outbuff
 	cmd.Stderr = &stderrBuff
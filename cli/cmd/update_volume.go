@@ -0,0 +1,95 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var volumeParameters map[string]string
+
+func init() {
+	updateCmd.AddCommand(updateVolumeCmd)
+	updateVolumeCmd.Flags().StringToStringVarP(&volumeParameters, "parameter", "p", nil,
+		"Volume attribute to modify, as name=value; one of "+
+			strings.Join(storage.ModifiableVolumeAttributes(), ", "))
+}
+
+var updateVolumeCmd = &cobra.Command{
+	Use:     "volume <name> --parameter <name=value>...",
+	Short:   "Modify the attributes of a volume in Trident",
+	Aliases: []string{"v"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"update", "volume"}
+			for _, name := range sortedParameterNames(volumeParameters) {
+				command = append(command, "--parameter", name+"="+volumeParameters[name])
+			}
+			TunnelCommand(append(command, args...))
+			return nil
+		} else {
+			return volumeModify(args, volumeParameters)
+		}
+	},
+}
+
+func sortedParameterNames(parameters map[string]string) []string {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func volumeModify(volumeNames []string, parameters map[string]string) error {
+	switch len(volumeNames) {
+	case 0:
+		return errors.New("volume name not specified")
+	case 1:
+	default:
+		return errors.New("multiple volume names specified")
+	}
+	if len(parameters) == 0 {
+		return errors.New("no volume attributes specified")
+	}
+
+	request := storage.VolumeModifyRequest{Parameters: parameters}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	url := BaseURL() + "/volume/" + volumeNames[0]
+
+	response, responseBody, err := api.InvokeRESTAPI("PATCH", url, requestBytes)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not modify volume %s: %v", volumeNames[0],
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var updateVolumeResponse rest.UpdateVolumeResponse
+	if err = json.Unmarshal(responseBody, &updateVolumeResponse); err != nil {
+		return err
+	}
+	if updateVolumeResponse.Volume == nil {
+		return fmt.Errorf("no volume returned for %s", volumeNames[0])
+	}
+
+	WriteVolumes([]storage.VolumeExternal{*updateVolumeResponse.Volume})
+
+	return nil
+}
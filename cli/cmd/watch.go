@@ -0,0 +1,133 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+var getWatch bool
+
+// addWatchFlag adds the flag that makes a get command watch for changes after listing its objects.
+func addWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&getWatch, "watch", "w", false, "After listing, watch for changes")
+}
+
+// matchNames returns a filter that matches the given object names, or every object if no names are given.
+func matchNames(names []string) func(string) bool {
+	return func(name string) bool {
+		return len(names) == 0 || utils.SliceContainsString(names, name)
+	}
+}
+
+// watchChanges lists objects of one type, then writes each change to them until interrupted.  The objects are
+// listed once the change stream is open, so that no change made while listing is missed.  If the stream cannot be
+// resumed, for instance because Trident restarted, the objects are listed again.
+func watchChanges(objectType storage.ChangeObjectType, match func(string) bool, list func() error) error {
+	resumeToken := ""
+	for {
+		query := url.Values{}
+		query.Set("objectType", string(objectType))
+		if resumeToken != "" {
+			query.Set("resumeToken", resumeToken)
+		}
+
+		response, responseBody, err := api.OpenRESTStream(BaseURL() + "/changes?" + query.Encode())
+		if err != nil {
+			return err
+		}
+
+		switch response.StatusCode {
+		case http.StatusOK:
+		case http.StatusGone:
+			_, _ = fmt.Fprintln(os.Stderr, "Could not resume watching changes; listing again.")
+			resumeToken = ""
+			continue
+		default:
+			return fmt.Errorf("could not watch changes: %v", GetErrorFromHTTPResponse(response, responseBody))
+		}
+
+		listed := resumeToken != ""
+		err = readChangeEvents(response, func(event *storage.ChangeEvent) error {
+			if event.ResumeToken != "" {
+				resumeToken = event.ResumeToken
+			}
+			if event.Type == storage.ChangeBookmark {
+				if !listed {
+					listed = true
+					return list()
+				}
+				return nil
+			}
+			if !match(event.Name) {
+				return nil
+			}
+			WriteChangeEvent(event)
+			return nil
+		})
+		_ = response.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readChangeEvents reads Server-Sent Events from a change stream until it ends, passing each change event to a
+// handler.  A stream that is cut off ends without an error, so that the caller may resume it.
+func readChangeEvents(response *http.Response, handle func(*storage.ChangeEvent) error) error {
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	data := make([]string, 0)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			if strings.HasPrefix(line, "data:") {
+				data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+			}
+			continue
+		}
+
+		// A blank line ends an event
+		if len(data) == 0 {
+			continue
+		}
+		var event storage.ChangeEvent
+		if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &event); err != nil {
+			return fmt.Errorf("could not parse change event; %v", err)
+		}
+		data = data[:0]
+		if err := handle(&event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func WriteChangeEvent(event *storage.ChangeEvent) {
+	switch OutputFormat {
+	case FormatJSON:
+		jsonBytes, _ := json.Marshal(event)
+		fmt.Println(string(jsonBytes))
+	case FormatYAML:
+		fmt.Println("---")
+		WriteYAML(event)
+	case FormatName:
+		fmt.Println(event.Name)
+	default:
+		fmt.Printf("%-32s %-8s %-12s %s\n", event.Time, event.Type, event.ObjectType, event.Name)
+	}
+}
@@ -3377,3 +3377,39 @@ func GenericPatch(original interface{}, modifiedYAML []byte) ([]byte, error) {
 	// JSON Merge patch
 	return jsonMergePatch(originalJSON, modifiedJSON)
 }
+
+// SelectControllerPod returns the Trident controller pod to which requests should be sent.  When several
+// controllers are deployed, only the elected leader is ready, so the ready pod is chosen; pods that are
+// terminating, such as those of a controller that was just uninstalled, are ignored.
+func SelectControllerPod(pods []v1.Pod) (*v1.Pod, error) {
+	if len(pods) == 0 {
+		return nil, utils.NotFoundError("no Trident controller pods found")
+	} else if len(pods) == 1 {
+		return &pods[0], nil
+	}
+
+	livePods := make([]*v1.Pod, 0, len(pods))
+	for i := range pods {
+		if pods[i].DeletionTimestamp == nil {
+			livePods = append(livePods, &pods[i])
+		}
+	}
+	if len(livePods) == 1 {
+		return livePods[0], nil
+	}
+
+	readyPods := make([]*v1.Pod, 0, len(livePods))
+	for _, pod := range livePods {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+				readyPods = append(readyPods, pod)
+			}
+		}
+	}
+	if len(readyPods) == 1 {
+		return readyPods[0], nil
+	}
+
+	return nil, fmt.Errorf("found %d Trident controller pods, of which %d are ready; expected a single ready "+
+		"leader", len(livePods), len(readyPods))
+}
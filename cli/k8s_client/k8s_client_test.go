@@ -12,7 +12,9 @@ import (
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/ghodss/yaml"
+	v1 "k8s.io/api/core/v1"
 	apiextensionv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/stretchr/testify/assert"
@@ -321,3 +323,62 @@ spec:
 		)
 	}
 }
+
+func TestSelectControllerPod(t *testing.T) {
+	newPod := func(name string, ready, terminating bool) v1.Pod {
+		pod := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		status := v1.ConditionFalse
+		if ready {
+			status = v1.ConditionTrue
+		}
+		pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+		if terminating {
+			pod.DeletionTimestamp = &metav1.Time{}
+		}
+		return pod
+	}
+
+	tests := map[string]struct {
+		pods          []v1.Pod
+		expected      string
+		errorExpected bool
+	}{
+		"no pods": {
+			pods:          []v1.Pod{},
+			errorExpected: true,
+		},
+		"single pod that is not ready": {
+			pods:     []v1.Pod{newPod("a", false, false)},
+			expected: "a",
+		},
+		"new pod replacing a terminating pod": {
+			pods:     []v1.Pod{newPod("a", true, true), newPod("b", false, false)},
+			expected: "b",
+		},
+		"ready leader among standby pods": {
+			pods:     []v1.Pod{newPod("a", false, false), newPod("b", true, false), newPod("c", false, false)},
+			expected: "b",
+		},
+		"no leader elected": {
+			pods:          []v1.Pod{newPod("a", false, false), newPod("b", false, false)},
+			errorExpected: true,
+		},
+		"several ready pods": {
+			pods:          []v1.Pod{newPod("a", true, false), newPod("b", true, false)},
+			errorExpected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod, err := SelectControllerPod(test.pods)
+			if test.errorExpected {
+				assert.Error(t, err)
+				assert.Nil(t, pod)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, pod.Name)
+			}
+		})
+	}
+}
@@ -190,6 +190,8 @@ type DeploymentYAMLArguments struct {
 	ServiceAccountName      string                `json:"serviceAccountName"`
 	ImagePullPolicy         string                `json:"imagePullPolicy"`
 	EnableForceDetach       bool                  `json:"enableForceDetach"`
+	Replicas                int                   `json:"replicas"`
+	RESTAuthorizationSecret string                `json:"restAuthorizationSecret"`
 }
 
 type DaemonsetYAMLArguments struct {
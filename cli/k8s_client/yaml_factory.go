@@ -148,7 +148,9 @@ rules:
     resources: ["tridentversions", "tridentbackends", "tridentstorageclasses", "tridentvolumes","tridentnodes",
 "tridenttransactions", "tridentsnapshots", "tridentbackendconfigs", "tridentbackendconfigs/status",
 "tridentmirrorrelationships", "tridentmirrorrelationships/status", "tridentsnapshotinfos",
-"tridentsnapshotinfos/status", "tridentvolumepublications", "tridentvolumereferences"]
+"tridentsnapshotinfos/status", "tridentvolumepublications", "tridentvolumereferences",
+"tridentactionsnapshotrestores", "tridentactionsnapshotrestores/status", "tridentgroupsnapshots",
+"tridentsnapshotpolicies"]
     verbs: ["get", "list", "watch", "create", "delete", "update", "patch"]
   - apiGroups: ["policy"]
     resources: ["podsecuritypolicies"]
@@ -218,7 +220,9 @@ rules:
     resources: ["tridentversions", "tridentbackends", "tridentstorageclasses", "tridentvolumes","tridentnodes",
 "tridenttransactions", "tridentsnapshots", "tridentbackendconfigs", "tridentbackendconfigs/status",
 "tridentmirrorrelationships", "tridentmirrorrelationships/status", "tridentsnapshotinfos",
-"tridentsnapshotinfos/status", "tridentvolumepublications", "tridentvolumereferences"]
+"tridentsnapshotinfos/status", "tridentvolumepublications", "tridentvolumereferences",
+"tridentactionsnapshotrestores", "tridentactionsnapshotrestores/status", "tridentgroupsnapshots",
+"tridentsnapshotpolicies"]
     verbs: ["get", "list", "watch", "create", "delete", "update", "patch"]
   - apiGroups: ["policy"]
     resources: ["podsecuritypolicies"]
@@ -263,6 +267,18 @@ rules:
   - apiGroups: [""]
     resources: ["secrets"]
     verbs: ["get", "list", "watch", "create", "delete", "update", "patch"]
+  - apiGroups: ["storage.k8s.io"]
+    resources: ["csistoragecapacities"]
+    verbs: ["get", "list", "watch", "create", "delete", "update", "patch"]
+  - apiGroups: [""]
+    resources: ["pods"]
+    verbs: ["get"]
+  - apiGroups: ["apps"]
+    resources: ["replicasets"]
+    verbs: ["get"]
+  - apiGroups: ["coordination.k8s.io"]
+    resources: ["leases"]
+    verbs: ["get", "watch", "list", "create", "delete", "update", "patch"]
 `
 
 const nodeRoleCSIYAMLTemplate = `---
@@ -526,6 +542,25 @@ func GetCSIDeploymentYAML(args *DeploymentYAMLArguments) string {
 		autosupportDebugLine = "#" + autosupportDebugLine
 	}
 
+	// Several controllers elect a leader, and only the leader is ready to serve the REST interface
+	if args.Replicas < 1 {
+		args.Replicas = 1
+	}
+	leaderElectionLine, sidecarLeaderElectionLine, readinessProbe, podAntiAffinity := "", "", "", ""
+	if args.Replicas > 1 {
+		leaderElectionLine = "- \"--leader_election\""
+		sidecarLeaderElectionLine = "- \"--leader-election\""
+		readinessProbe = constructControllerReadinessProbe(ipLocalhost)
+		podAntiAffinity = constructControllerPodAntiAffinity(args.Labels[TridentAppLabelKey])
+	}
+
+	// The REST authorization policy is read from a secret projected into the certificates volume
+	restAuthorizationLine, restAuthorizationSecret := "", ""
+	if args.RESTAuthorizationSecret != "" {
+		restAuthorizationLine = fmt.Sprintf("- \"--rest_authorization_policy=%s\"", commonconfig.RESTAuthorizationPolicyPath)
+		restAuthorizationSecret = constructRESTAuthorizationSecret(args.RESTAuthorizationSecret)
+	}
+
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{TRIDENT_IMAGE}", args.TridentImage)
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{DEPLOYMENT_NAME}", args.DeploymentName)
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{CSI_SIDECAR_REGISTRY}", args.ImageRegistry)
@@ -556,6 +591,13 @@ func GetCSIDeploymentYAML(args *DeploymentYAMLArguments) string {
 	deploymentYAML = replaceMultilineYAMLTag(deploymentYAML, "NODE_SELECTOR", constructNodeSelector(args.NodeSelector))
 	deploymentYAML = replaceMultilineYAMLTag(deploymentYAML, "NODE_TOLERATIONS", constructTolerations(args.Tolerations))
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{ENABLE_FORCE_DETACH}", strconv.FormatBool(args.EnableForceDetach))
+	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{REPLICAS}", strconv.Itoa(args.Replicas))
+	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{LEADER_ELECTION}", leaderElectionLine)
+	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{SIDECAR_LEADER_ELECTION}", sidecarLeaderElectionLine)
+	deploymentYAML = replaceMultilineYAMLTag(deploymentYAML, "READINESS_PROBE", readinessProbe)
+	deploymentYAML = replaceMultilineYAMLTag(deploymentYAML, "POD_ANTI_AFFINITY", podAntiAffinity)
+	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{REST_AUTHORIZATION_POLICY}", restAuthorizationLine)
+	deploymentYAML = replaceMultilineYAMLTag(deploymentYAML, "REST_AUTHORIZATION_SECRET", restAuthorizationSecret)
 
 	// Log before secrets are inserted into YAML.
 	Log().WithField("yaml", deploymentYAML).Trace("CSI Deployment YAML.")
@@ -573,7 +615,7 @@ metadata:
   {LABELS}
   {OWNER_REF}
 spec:
-  replicas: 1
+  replicas: {REPLICAS}
   strategy:
     type: Recreate
   selector:
@@ -611,6 +653,8 @@ spec:
         - "--http_request_timeout={HTTP_REQUEST_TIMEOUT}"
         - "--enable_force_detach={ENABLE_FORCE_DETACH}"
         - "--metrics"
+        {LEADER_ELECTION}
+        {REST_AUTHORIZATION_POLICY}
         {DEBUG}
         livenessProbe:
           exec:
@@ -623,6 +667,7 @@ spec:
           initialDelaySeconds: 120
           periodSeconds: 120
           timeoutSeconds: 90
+        {READINESS_PROBE}
         env:
         - name: KUBE_NODE_NAME
           valueFrom:
@@ -668,10 +713,21 @@ spec:
         - "--csi-address=$(ADDRESS)"
         - "--retry-interval-start=8s"
         - "--retry-interval-max=30s"
+        - "--enable-capacity"
+        - "--capacity-ownerref-level=2"
+        {SIDECAR_LEADER_ELECTION}
         {PROVISIONER_FEATURE_GATES}
         env:
         - name: ADDRESS
           value: /var/lib/csi/sockets/pluginproxy/csi.sock
+        - name: NAMESPACE
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.namespace
+        - name: POD_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.name
         volumeMounts:
         - name: socket-dir
           mountPath: /var/lib/csi/sockets/pluginproxy/
@@ -682,6 +738,7 @@ spec:
         - "--v={SIDECAR_LOG_LEVEL}"
         - "--timeout=60s"
         - "--retry-interval-start=10s"
+        {SIDECAR_LEADER_ELECTION}
         - "--csi-address=$(ADDRESS)"
         env:
         - name: ADDRESS
@@ -696,6 +753,7 @@ spec:
         - "--v={SIDECAR_LOG_LEVEL}"
         - "--timeout=300s"
         - "--csi-address=$(ADDRESS)"
+        {SIDECAR_LEADER_ELECTION}
         env:
         - name: ADDRESS
           value: /var/lib/csi/sockets/pluginproxy/csi.sock
@@ -709,6 +767,7 @@ spec:
         - "--v={SIDECAR_LOG_LEVEL}"
         - "--timeout=300s"
         - "--csi-address=$(ADDRESS)"
+        {SIDECAR_LEADER_ELECTION}
         env:
         - name: ADDRESS
           value: /var/lib/csi/sockets/pluginproxy/csi.sock
@@ -731,6 +790,7 @@ spec:
                     values:
                     - linux
                   {NODE_SELECTOR}
+        {POD_ANTI_AFFINITY}
       {NODE_TOLERATIONS}
       volumes:
       - name: socket-dir
@@ -742,6 +802,7 @@ spec:
               name: trident-csi
           - secret:
               name: trident-encryption-keys
+          {REST_AUTHORIZATION_SECRET}
       - name: asup-dir
         emptyDir:
           medium: ""
@@ -1577,6 +1638,12 @@ func GetSnapshotInfoCRDYAML() string {
 	return tridentSnapshotInfoCRDYAMLv1
 }
 
+func GetActionSnapshotRestoreCRDYAML() string {
+	Log().Trace(">>>> GetActionSnapshotRestoreCRDYAML")
+	defer func() { Log().Trace("<<<< GetActionSnapshotRestoreCRDYAML") }()
+	return tridentActionSnapshotRestoreCRDYAMLv1
+}
+
 func GetStorageClassCRDYAML() string {
 	Log().Trace(">>>> GetStorageClassCRDYAML")
 	defer func() { Log().Trace("<<<< GetStorageClassCRDYAML") }()
@@ -1613,6 +1680,18 @@ func GetSnapshotCRDYAML() string {
 	return tridentSnapshotCRDYAMLv1
 }
 
+func GetGroupSnapshotCRDYAML() string {
+	Log().Trace(">>>> GetGroupSnapshotCRDYAML")
+	defer func() { Log().Trace("<<<< GetGroupSnapshotCRDYAML") }()
+	return tridentGroupSnapshotCRDYAMLv1
+}
+
+func GetSnapshotPolicyCRDYAML() string {
+	Log().Trace(">>>> GetSnapshotPolicyCRDYAML")
+	defer func() { Log().Trace("<<<< GetSnapshotPolicyCRDYAML") }()
+	return tridentSnapshotPolicyCRDYAMLv1
+}
+
 func GetVolumeReferenceCRDYAML() string {
 	Log().Trace(">>>> GetVolumeReferenceCRDYAML")
 	defer func() { Log().Trace("<<<< GetVolumeReferenceCRDYAML") }()
@@ -1636,6 +1715,8 @@ kubectl delete crd tridentvolumepublications.trident.netapp.io --wait=false
 kubectl delete crd tridentnodes.trident.netapp.io --wait=false
 kubectl delete crd tridenttransactions.trident.netapp.io --wait=false
 kubectl delete crd tridentsnapshots.trident.netapp.io --wait=false
+kubectl delete crd tridentgroupsnapshots.trident.netapp.io --wait=false
+kubectl delete crd tridentsnapshotpolicies.trident.netapp.io --wait=false
 kubectl delete crd tridentvolumereferences.trident.netapp.io --wait=false
 
 kubectl patch crd tridentversions.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
@@ -1648,6 +1729,8 @@ kubectl patch crd tridentvolumepublications.trident.netapp.io -p '{"metadata":{"
 kubectl patch crd tridentnodes.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridenttransactions.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentsnapshots.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentgroupsnapshots.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentsnapshotpolicies.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentvolumereferences.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 
 kubectl delete crd tridentversions.trident.netapp.io
@@ -1660,6 +1743,8 @@ kubectl delete crd tridentvolumepublications.trident.netapp.io
 kubectl delete crd tridentnodes.trident.netapp.io
 kubectl delete crd tridenttransactions.trident.netapp.io
 kubectl delete crd tridentsnapshots.trident.netapp.io
+kubectl delete crd tridentgroupsnapshots.trident.netapp.io
+kubectl delete crd tridentsnapshotpolicies.trident.netapp.io
 kubectl delete crd tridentvolumereferences.trident.netapp.io
 */
 
@@ -1893,6 +1978,79 @@ spec:
     - trident-external
 `
 
+const tridentActionSnapshotRestoreCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentactionsnapshotrestores.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              properties:
+                pvcName:
+                  type: string
+                volumeSnapshotName:
+                  type: string
+              required:
+              - pvcName
+              - volumeSnapshotName
+            status:
+              type: object
+              properties:
+                state:
+                  type: string
+                message:
+                  type: string
+                startTime:
+                  type: string
+                  format: date-time
+                completionTime:
+                  type: string
+                  format: date-time
+      subresources:
+        status: {}
+      additionalPrinterColumns:
+        - name: PVC
+          type: string
+          description: The PVC to restore
+          priority: 0
+          jsonPath: .spec.pvcName
+        - name: Snapshot
+          type: string
+          description: The VolumeSnapshot to restore from
+          priority: 0
+          jsonPath: .spec.volumeSnapshotName
+        - name: State
+          type: string
+          description: The state of the restore
+          priority: 0
+          jsonPath: .status.state
+        - name: Message
+          type: string
+          description: The reason the restore failed
+          priority: 1
+          jsonPath: .status.message
+  scope: Namespaced
+  names:
+    plural: tridentactionsnapshotrestores
+    singular: tridentactionsnapshotrestore
+    kind: TridentActionSnapshotRestore
+    shortNames:
+    - tasr
+    categories:
+    - trident
+    - trident-external
+`
+
 const tridentBackendConfigCRDYAMLv1 = `
 apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
@@ -2186,6 +2344,74 @@ spec:
     - trident
     - trident-internal`
 
+const tridentGroupSnapshotCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentgroupsnapshots.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+          openAPIV3Schema:
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+      additionalPrinterColumns:
+      - name: Created
+        type: date
+        description: Creation time of the group snapshot
+        jsonPath: .dateCreated
+  scope: Namespaced
+  names:
+    plural: tridentgroupsnapshots
+    singular: tridentgroupsnapshot
+    kind: TridentGroupSnapshot
+    shortNames:
+    - tgsnap
+    - tgsnapshot
+    categories:
+    - trident
+    - trident-internal`
+
+const tridentSnapshotPolicyCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentsnapshotpolicies.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+          openAPIV3Schema:
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+      additionalPrinterColumns:
+      - name: Schedule
+        type: string
+        description: The cron schedule of the snapshot policy
+        jsonPath: .spec.schedule
+      - name: Last Run
+        type: date
+        description: Time of the last scheduled run of the snapshot policy
+        jsonPath: .lastRun
+  scope: Namespaced
+  names:
+    plural: tridentsnapshotpolicies
+    singular: tridentsnapshotpolicy
+    kind: TridentSnapshotPolicy
+    shortNames:
+    - tsp
+    - tsnappolicy
+    categories:
+    - trident
+    - trident-internal`
+
 const tridentOrchestratorCRDYAMLv1 = `
 apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
@@ -2263,7 +2489,10 @@ const customResourceDefinitionYAMLv1 = tridentVersionCRDYAMLv1 +
 	"\n---" + tridentNodeCRDYAMLv1 +
 	"\n---" + tridentTransactionCRDYAMLv1 +
 	"\n---" + tridentSnapshotCRDYAMLv1 +
-	"\n---" + tridentVolumeReferenceCRDYAMLv1 + "\n"
+	"\n---" + tridentVolumeReferenceCRDYAMLv1 +
+	"\n---" + tridentActionSnapshotRestoreCRDYAMLv1 +
+	"\n---" + tridentGroupSnapshotCRDYAMLv1 +
+	"\n---" + tridentSnapshotPolicyCRDYAMLv1 + "\n"
 
 func GetCSIDriverYAML(name string, labels, controllingCRDetails map[string]string) string {
 	Log().WithFields(LogFields{
@@ -2290,6 +2519,7 @@ metadata:
   {OWNER_REF}
 spec:
   attachRequired: true
+  storageCapacity: true
 `
 
 func GetPrivilegedPodSecurityPolicyYAML(pspName string, labels, controllingCRDetails map[string]string) string {
@@ -2414,6 +2644,50 @@ func constructNodeSelector(nodeLabels map[string]string) string {
 	return nodeSelector
 }
 
+// constructControllerReadinessProbe returns a probe that passes only on the controller that has been elected
+// leader and bootstrapped, so that the Trident service sends requests only to that controller.
+func constructControllerReadinessProbe(ipLocalhost string) string {
+	return fmt.Sprintf(`readinessProbe:
+  exec:
+    command:
+    - tridentctl
+    - -s
+    - "%s:8000"
+    - get
+    - backend
+    - -o
+    - name
+  failureThreshold: 1
+  periodSeconds: 10
+  timeoutSeconds: 30
+`, ipLocalhost)
+}
+
+// constructRESTAuthorizationSecret returns a projected volume source that places the REST authorization policy
+// held by a secret among the certificates of the controller.
+func constructRESTAuthorizationSecret(secretName string) string {
+	return fmt.Sprintf(`- secret:
+    name: %s
+    items:
+    - key: %s
+      path: %s
+`, secretName, commonconfig.RESTAuthorizationPolicyKey, commonconfig.RESTAuthorizationPolicyFile)
+}
+
+// constructControllerPodAntiAffinity returns an affinity that spreads the controllers across nodes where possible,
+// so that losing a node does not take down the standby controllers with the leader.
+func constructControllerPodAntiAffinity(appLabel string) string {
+	return fmt.Sprintf(`podAntiAffinity:
+  preferredDuringSchedulingIgnoredDuringExecution:
+  - weight: 100
+    podAffinityTerm:
+      topologyKey: kubernetes.io/hostname
+      labelSelector:
+        matchLabels:
+          app: %s
+`, appLabel)
+}
+
 func constructTolerations(tolerations []map[string]string) string {
 	var tolerationsString string
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ghodss/yaml"
 	scc "github.com/openshift/api/security/v1"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	pspv1beta1 "k8s.io/api/policy/v1beta1"
 	csiv1 "k8s.io/api/storage/v1"
@@ -447,6 +448,101 @@ func TestGetCSIDeploymentYAMLImagePullPolicy(t *testing.T) {
 	}
 }
 
+func TestGetCSIDeploymentYAMLReplicas(t *testing.T) {
+	getContainerArgs := func(deployment *appsv1.Deployment) map[string][]string {
+		containerArgs := make(map[string][]string)
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			containerArgs[container.Name] = container.Args
+		}
+		return containerArgs
+	}
+
+	// A single controller does not elect a leader
+	yamlData := GetCSIDeploymentYAML(&DeploymentYAMLArguments{Labels: map[string]string{TridentAppLabelKey: "app"}})
+	var deployment appsv1.Deployment
+	if err := yaml.Unmarshal([]byte(yamlData), &deployment); err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+	assert.Equal(t, int32(1), *deployment.Spec.Replicas)
+	for name, args := range getContainerArgs(&deployment) {
+		assert.NotContains(t, args, "--leader_election", name)
+		assert.NotContains(t, args, "--leader-election", name)
+	}
+	assert.Nil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe)
+	assert.Nil(t, deployment.Spec.Template.Spec.Affinity.PodAntiAffinity)
+
+	// Several controllers elect a leader, and spread across nodes
+	yamlData = GetCSIDeploymentYAML(&DeploymentYAMLArguments{
+		Labels:   map[string]string{TridentAppLabelKey: "app"},
+		Replicas: 3,
+	})
+	deployment = appsv1.Deployment{}
+	if err := yaml.Unmarshal([]byte(yamlData), &deployment); err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+
+	containerArgs := getContainerArgs(&deployment)
+	assert.Contains(t, containerArgs["trident-main"], "--leader_election")
+	assert.NotContains(t, containerArgs["trident-autosupport"], "--leader-election")
+	for _, sidecar := range []string{"csi-provisioner", "csi-attacher", "csi-resizer", "csi-snapshotter"} {
+		assert.Contains(t, containerArgs[sidecar], "--leader-election", sidecar)
+	}
+
+	readinessProbe := deployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+	if assert.NotNil(t, readinessProbe) {
+		assert.Equal(t, []string{"tridentctl", "-s", "127.0.0.1:8000", "get", "backend", "-o", "name"},
+			readinessProbe.Exec.Command)
+	}
+
+	podAntiAffinity := deployment.Spec.Template.Spec.Affinity.PodAntiAffinity
+	if assert.NotNil(t, podAntiAffinity) {
+		terms := podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		assert.Len(t, terms, 1)
+		assert.Equal(t, "kubernetes.io/hostname", terms[0].PodAffinityTerm.TopologyKey)
+		assert.Equal(t, map[string]string{"app": "app"}, terms[0].PodAffinityTerm.LabelSelector.MatchLabels)
+	}
+	assert.NotNil(t, deployment.Spec.Template.Spec.Affinity.NodeAffinity)
+}
+
+func TestGetCSIDeploymentYAMLRESTAuthorization(t *testing.T) {
+	getCertsSources := func(deployment *appsv1.Deployment) []v1.VolumeProjection {
+		for _, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.Name == "certs" {
+				return volume.Projected.Sources
+			}
+		}
+		return nil
+	}
+
+	// Without a policy, the REST interface is not authorized
+	yamlData := GetCSIDeploymentYAML(&DeploymentYAMLArguments{Labels: map[string]string{TridentAppLabelKey: "app"}})
+	var deployment appsv1.Deployment
+	if err := yaml.Unmarshal([]byte(yamlData), &deployment); err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+	assert.NotContains(t, deployment.Spec.Template.Spec.Containers[0].Args,
+		"--rest_authorization_policy=/certs/restAuthorizationPolicy")
+	assert.Len(t, getCertsSources(&deployment), 2)
+
+	// The policy is read from its secret
+	yamlData = GetCSIDeploymentYAML(&DeploymentYAMLArguments{
+		Labels:                  map[string]string{TridentAppLabelKey: "app"},
+		RESTAuthorizationSecret: "trident-rest-policy",
+	})
+	deployment = appsv1.Deployment{}
+	if err := yaml.Unmarshal([]byte(yamlData), &deployment); err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+	assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Args,
+		"--rest_authorization_policy=/certs/restAuthorizationPolicy")
+	sources := getCertsSources(&deployment)
+	if assert.Len(t, sources, 3) {
+		assert.Equal(t, "trident-rest-policy", sources[2].Secret.Name)
+		assert.Equal(t, []v1.KeyToPath{{Key: "policy.yaml", Path: "restAuthorizationPolicy"}}, sources[2].Secret.Items)
+	}
+}
+
 func TestGetCSIDaemonSetYAMLLinux(t *testing.T) {
 	versions := []string{"1.21.0", "1.23.0", "1.25.0"}
 
@@ -2030,6 +2126,34 @@ func TestGetVolumeReferenceCRDYAML(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
 }
 
+func TestGetActionSnapshotRestoreCRDYAML(t *testing.T) {
+	expectedNames := apiextensionsv1.CustomResourceDefinitionNames{
+		Plural:     "tridentactionsnapshotrestores",
+		Singular:   "tridentactionsnapshotrestore",
+		Kind:       "TridentActionSnapshotRestore",
+		ShortNames: []string{"tasr"},
+		Categories: []string{"trident", "trident-external"},
+	}
+
+	actualYAML := GetActionSnapshotRestoreCRDYAML()
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.Equal(t, "tridentactionsnapshotrestores.trident.netapp.io", actual.Name)
+	assert.Equal(t, "trident.netapp.io", actual.Spec.Group)
+	assert.Equal(t, apiextensionsv1.NamespaceScoped, actual.Spec.Scope)
+	assert.True(t, reflect.DeepEqual(expectedNames, actual.Spec.Names))
+
+	assert.Len(t, actual.Spec.Versions, 1)
+	version := actual.Spec.Versions[0]
+	assert.NotNil(t, version.Subresources.Status, "expected status subresource")
+	assert.Equal(t, []string{"pvcName", "volumeSnapshotName"},
+		version.Schema.OpenAPIV3Schema.Properties["spec"].Required)
+	assert.Contains(t, version.Schema.OpenAPIV3Schema.Properties["status"].Properties, "state")
+
+	// The CRD must also be installed along with the rest of Trident's CRDs
+	assert.Contains(t, GetCRDsYAML(), actualYAML)
+}
+
 func TestGetBackendCRDYAML(t *testing.T) {
 	preserveValue := true
 	schema := apiextensionsv1.CustomResourceValidation{
@@ -2813,6 +2937,54 @@ func TestGetSnapshotCRDYAML(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
 }
 
+func TestGetGroupSnapshotCRDYAML(t *testing.T) {
+	expectedNames := apiextensionsv1.CustomResourceDefinitionNames{
+		Plural:     "tridentgroupsnapshots",
+		Singular:   "tridentgroupsnapshot",
+		Kind:       "TridentGroupSnapshot",
+		ShortNames: []string{"tgsnap", "tgsnapshot"},
+		Categories: []string{"trident", "trident-internal"},
+	}
+
+	actualYAML := GetGroupSnapshotCRDYAML()
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.Equal(t, "tridentgroupsnapshots.trident.netapp.io", actual.Name)
+	assert.Equal(t, "trident.netapp.io", actual.Spec.Group)
+	assert.Equal(t, apiextensionsv1.NamespaceScoped, actual.Spec.Scope)
+	assert.True(t, reflect.DeepEqual(expectedNames, actual.Spec.Names))
+
+	assert.Len(t, actual.Spec.Versions, 1)
+	assert.NotNil(t, actual.Spec.Versions[0].Schema.OpenAPIV3Schema.XPreserveUnknownFields)
+
+	// The CRD must also be installed along with the rest of Trident's CRDs
+	assert.Contains(t, GetCRDsYAML(), actualYAML)
+}
+
+func TestGetSnapshotPolicyCRDYAML(t *testing.T) {
+	expectedNames := apiextensionsv1.CustomResourceDefinitionNames{
+		Plural:     "tridentsnapshotpolicies",
+		Singular:   "tridentsnapshotpolicy",
+		Kind:       "TridentSnapshotPolicy",
+		ShortNames: []string{"tsp", "tsnappolicy"},
+		Categories: []string{"trident", "trident-internal"},
+	}
+
+	actualYAML := GetSnapshotPolicyCRDYAML()
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.Equal(t, "tridentsnapshotpolicies.trident.netapp.io", actual.Name)
+	assert.Equal(t, "trident.netapp.io", actual.Spec.Group)
+	assert.Equal(t, apiextensionsv1.NamespaceScoped, actual.Spec.Scope)
+	assert.True(t, reflect.DeepEqual(expectedNames, actual.Spec.Names))
+
+	assert.Len(t, actual.Spec.Versions, 1)
+	assert.NotNil(t, actual.Spec.Versions[0].Schema.OpenAPIV3Schema.XPreserveUnknownFields)
+
+	// The CRD must also be installed along with the rest of Trident's CRDs
+	assert.Contains(t, GetCRDsYAML(), actualYAML)
+}
+
 func TestGetOrchestratorCRDYAML(t *testing.T) {
 	preserveValue := true
 	schema := apiextensionsv1.CustomResourceValidation{
@@ -2875,7 +3047,8 @@ func TestGetCSIDriverYAML(t *testing.T) {
 			Name: "csi.trident.netapp.io",
 		},
 		Spec: csiv1.CSIDriverSpec{
-			AttachRequired: &required,
+			AttachRequired:  &required,
+			StorageCapacity: &required,
 		},
 	}
 
@@ -2885,6 +3058,7 @@ func TestGetCSIDriverYAML(t *testing.T) {
 	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
 	assert.True(t, reflect.DeepEqual(expected.TypeMeta, actual.TypeMeta))
 	assert.True(t, reflect.DeepEqual(expected.ObjectMeta, actual.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
 	assert.Equal(t, "csi.trident.netapp.io", actual.Name)
 }
 
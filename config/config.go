@@ -64,6 +64,10 @@ const (
 	ClientCertFile = "clientCert"
 	AESKeyFile     = "aesKey"
 
+	// RESTAuthorizationPolicyKey is the key of the REST authorization policy in the secret that holds it
+	RESTAuthorizationPolicyKey  = "policy.yaml"
+	RESTAuthorizationPolicyFile = "restAuthorizationPolicy"
+
 	certsPath = "/certs/"
 
 	CAKeyPath      = certsPath + CAKeyFile
@@ -74,6 +78,8 @@ const (
 	ClientCertPath = certsPath + ClientCertFile
 	AESKeyPath     = certsPath + AESKeyFile
 
+	RESTAuthorizationPolicyPath = certsPath + RESTAuthorizationPolicyFile
+
 	/* Protocol constants. This value denotes a volume's backing storage protocol. For example,
 	a Trident volume with  'file' protocol is most likely NFS, while a 'block' protocol volume is probably iSCSI. */
 	File        Protocol = "file"
@@ -143,6 +149,14 @@ const (
 	/* Kubernetes deployment constants */
 	ContainerTrident = "trident-main"
 
+	// ControllerLeaseName is the Lease held by the active controller when several controllers are deployed
+	ControllerLeaseName = "trident-controller-leader"
+
+	// Default timings of the controller leader election
+	LeaderElectionLeaseDuration = 15 * time.Second
+	LeaderElectionRenewDeadline = 10 * time.Second
+	LeaderElectionRetryPeriod   = 2 * time.Second
+
 	ContextDocker DriverContext = "docker"
 	ContextCSI    DriverContext = "csi"
 
@@ -182,8 +196,14 @@ const (
 	// ISCSISelfHealingWaitTime is an interval after which iSCSI self-healing attempts to fix stale sessions.
 	ISCSISelfHealingWaitTime = 420 * time.Second
 
+	// VolumeMetricsInterval is the minimum interval between queries of the storage backends for per-volume metrics
+	VolumeMetricsInterval = 60 * time.Second
+
 	// BackendStoragePollInterval is an interval  that core layer attempts to poll storage backend periodically
 	BackendStoragePollInterval = 300 * time.Second
+
+	// CredentialRefreshInterval is the interval at which backend credentials kept in an external store are reread
+	CredentialRefreshInterval = 300 * time.Second
 )
 
 var (
@@ -214,18 +234,24 @@ var (
 	OrchestratorVersion = versionutils.MustParseDate(version())
 
 	/* API Server and persistent store variables */
-	BaseURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion
-	VersionURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/version"
-	BackendURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backend"
-	BackendUUIDURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backendUUID"
-	VolumeURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/volume"
-	TransactionURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/txn"
-	StorageClassURL  = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/storageclass"
-	NodeURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/node"
-	SnapshotURL      = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshot"
-	ChapURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/chap"
-	PublicationURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/publication"
-	LoggingConfigURL = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/logging"
+	BaseURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion
+	VersionURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/version"
+	BackendURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backend"
+	BackendUUIDURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backendUUID"
+	VolumeURL         = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/volume"
+	MigrationURL      = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/migration"
+	PoolURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/pool"
+	ConsistencyURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/consistency"
+	ChangesURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/changes"
+	TransactionURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/txn"
+	StorageClassURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/storageclass"
+	NodeURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/node"
+	SnapshotURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshot"
+	GroupSnapshotURL  = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/groupsnapshot"
+	SnapshotPolicyURL = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshotpolicy"
+	ChapURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/chap"
+	PublicationURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/publication"
+	LoggingConfigURL  = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/logging"
 
 	UsingPassthroughStore bool
 	CurrentDriverContext  DriverContext
@@ -0,0 +1,198 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage/credentials"
+	drivers "github.com/netapp/trident/storage_drivers"
+)
+
+// externalCredentials identifies the externally stored credentials used by a backend.
+type externalCredentials struct {
+	backendUUID    string
+	backendName    string
+	credentialName string
+	credentialType string
+}
+
+// AddCredentialStore makes an external credential store available to backends whose credentials field has the
+// store's type.  Kubernetes Secrets are always available and need not be added.
+func (o *TridentOrchestrator) AddCredentialStore(ctx context.Context, provider credentials.Provider) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	credentialType := provider.Type()
+	if _, ok := o.credentialStores[credentialType]; ok {
+		Logc(ctx).WithField("type", credentialType).Warn("Adding credential store already present.")
+		return
+	}
+	Logc(ctx).WithField("type", credentialType).Info("Added credential store.")
+	o.credentialStores[credentialType] = provider
+}
+
+// getBackendCredentials reads the named backend credentials from the store selected by their type.
+func (o *TridentOrchestrator) getBackendCredentials(
+	ctx context.Context, credentialName, credentialType string,
+) (map[string]string, error) {
+	if credentialType == "" || credentialType == string(drivers.CredentialStoreK8sSecret) {
+		return o.storeClient.GetBackendSecret(ctx, credentialName)
+	}
+
+	provider, ok := o.credentialStores[credentialType]
+	if !ok {
+		return nil, fmt.Errorf("no credential store of type '%s' is configured", credentialType)
+	}
+	return provider.GetCredentials(ctx, credentialName)
+}
+
+// PeriodicallyRefreshBackendCredentials is intended to be run as a goroutine by the single Trident controller.
+// On every period it reads the credentials of each backend that keeps them in an external credential store, and
+// updates in place any backend whose credentials have changed.
+func (o *TridentOrchestrator) PeriodicallyRefreshBackendCredentials(interval time.Duration) {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowBackendRotate,
+		LogLayerCore)
+
+	Logc(ctx).WithField("interval", interval).Info("Starting backend credential refresh loop.")
+	defer Logc(ctx).Info("Stopping backend credential refresh loop.")
+
+	o.stopCredentialLoop = make(chan bool)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stopCredentialLoop:
+			// Exit on shutdown signal
+			return
+
+		case <-ticker.C:
+			if o.bootstrapError != nil {
+				Logc(ctx).WithError(o.bootstrapError).Trace("Backend credential refresh blocked by bootstrap error.")
+				continue
+			}
+			Logc(ctx).Trace("Backend credential refresh loop running.")
+			o.refreshBackendCredentials(ctx)
+		}
+	}
+}
+
+// refreshBackendCredentials updates each backend whose externally stored credentials have changed.  The stores
+// are read without holding the orchestrator lock, so that a slow store cannot block other operations.
+func (o *TridentOrchestrator) refreshBackendCredentials(ctx context.Context) {
+	for _, backendCredentials := range o.getExternalCredentials(ctx) {
+		logFields := LogFields{
+			"backend":        backendCredentials.backendName,
+			"credentialName": backendCredentials.credentialName,
+			"credentialType": backendCredentials.credentialType,
+		}
+
+		secret, err := o.getBackendCredentials(ctx, backendCredentials.credentialName,
+			backendCredentials.credentialType)
+		if err != nil {
+			Logc(ctx).WithFields(logFields).WithError(err).Warning(
+				"Could not read backend credentials; the backend keeps its current credentials.")
+			continue
+		}
+
+		o.rotateBackendCredentials(ctx, backendCredentials, secret)
+	}
+}
+
+// getExternalCredentials returns the backends whose credentials are kept in an external credential store.
+func (o *TridentOrchestrator) getExternalCredentials(ctx context.Context) []*externalCredentials {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	externals := make([]*externalCredentials, 0)
+
+	for backendUUID := range o.backendCredentials {
+		if _, ok := o.backends[backendUUID]; !ok {
+			delete(o.backendCredentials, backendUUID)
+		}
+	}
+
+	for backendUUID, backend := range o.backends {
+		if backend.State().IsDeleting() {
+			continue
+		}
+		credentialName, credentialType, err := backend.ConstructPersistent(ctx).GetBackendCredentials()
+		if err != nil || credentialName == "" || credentialType == string(drivers.CredentialStoreK8sSecret) {
+			continue
+		}
+		externals = append(externals, &externalCredentials{
+			backendUUID:    backendUUID,
+			backendName:    backend.Name(),
+			credentialName: credentialName,
+			credentialType: credentialType,
+		})
+	}
+
+	return externals
+}
+
+// rotateBackendCredentials updates a backend in place if its credentials differ from those it was last created
+// with.  The credentials are read again during the update, so the backend always gets the latest ones.
+func (o *TridentOrchestrator) rotateBackendCredentials(
+	ctx context.Context, backendCredentials *externalCredentials, secret map[string]string,
+) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	logFields := LogFields{
+		"backend":        backendCredentials.backendName,
+		"credentialName": backendCredentials.credentialName,
+		"credentialType": backendCredentials.credentialType,
+	}
+
+	if reflect.DeepEqual(o.backendCredentials[backendCredentials.backendUUID], secret) {
+		return
+	}
+
+	// The backend may have changed while the credentials were read
+	backend, ok := o.backends[backendCredentials.backendUUID]
+	if !ok || backend.State().IsDeleting() {
+		return
+	}
+	persistentBackend := backend.ConstructPersistent(ctx)
+	credentialName, credentialType, err := persistentBackend.GetBackendCredentials()
+	if err != nil || credentialName != backendCredentials.credentialName ||
+		credentialType != backendCredentials.credentialType {
+		return
+	}
+
+	// Rebuild the backend from the same config as it would be bootstrapped from, with its secrets hidden
+	persistentBackend, _, _, err = persistentBackend.ExtractBackendSecrets(credentialName)
+	if err == nil {
+		var configJSON string
+		if configJSON, err = persistentBackend.MarshalConfig(); err == nil {
+			_, err = o.updateBackendByBackendUUID(ctx, backend.Name(), configJSON, backend.BackendUUID(),
+				backend.ConfigRef())
+		}
+	}
+
+	backendCredentialRotationCounter.WithLabelValues(backendCredentials.backendName,
+		strconv.FormatBool(err == nil)).Inc()
+	if err != nil {
+		// Forget the credentials, so that the update is retried on the next period
+		delete(o.backendCredentials, backendCredentials.backendUUID)
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Could not update backend with changed credentials.")
+		return
+	}
+
+	Logc(ctx).WithFields(logFields).Info("Updated backend with changed credentials.")
+
+	// As for any backend update, the new driver must be told which nodes may access it
+	if updatedBackend, ok := o.backends[backendCredentials.backendUUID]; ok {
+		updatedBackend.InvalidateNodeAccess()
+		if err = o.reconcileNodeAccessOnBackend(ctx, updatedBackend); err != nil {
+			Logc(ctx).WithFields(logFields).WithError(err).Warning("Could not reconcile node access on backend.")
+		}
+	}
+}
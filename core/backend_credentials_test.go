@@ -0,0 +1,88 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage/credentials"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+)
+
+func TestRefreshBackendCredentials(t *testing.T) {
+	const backendName = "credentialsBackend"
+
+	credentialsDir := t.TempDir()
+	writeCredentials := func(password string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(credentialsDir, "svm1"), 0o700))
+		assert.NoError(t, os.WriteFile(filepath.Join(credentialsDir, "svm1", "username"), []byte("admin"), 0o600))
+		assert.NoError(t, os.WriteFile(filepath.Join(credentialsDir, "svm1", "password"), []byte(password), 0o600))
+	}
+
+	fakeConfigJSON, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File, nil, nil)
+	assert.NoError(t, err)
+	var configMap map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(fakeConfigJSON), &configMap))
+	configMap["credentials"] = map[string]string{"name": "svm1", "type": "file"}
+	configJSON, err := json.Marshal(configMap)
+	assert.NoError(t, err)
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	// Credentials of an unconfigured type cannot be read
+	_, err = orchestrator.AddBackend(ctx(), string(configJSON), "")
+	assert.Error(t, err, "expected error without a credential store")
+
+	provider, err := credentials.NewFileProvider(credentialsDir)
+	assert.NoError(t, err)
+	orchestrator.AddCredentialStore(ctx(), provider)
+	writeCredentials("one")
+
+	backendExternal, err := orchestrator.AddBackend(ctx(), string(configJSON), "tbc-uid")
+	assert.NoError(t, err, "unexpected error adding backend")
+	backendUUID := backendExternal.BackendUUID
+	assert.Equal(t, map[string]string{"username": "admin", "password": "one"},
+		orchestrator.backendCredentials[backendUUID])
+
+	rotationCtx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowBackendRotate,
+		LogLayerCore)
+
+	// Unchanged credentials leave the backend alone
+	originalBackend := orchestrator.backends[backendUUID]
+	orchestrator.refreshBackendCredentials(rotationCtx)
+	assert.Same(t, originalBackend, orchestrator.backends[backendUUID])
+
+	// Changed credentials update the backend in place, even though it is managed by a TridentBackendConfig
+	writeCredentials("two")
+	orchestrator.refreshBackendCredentials(rotationCtx)
+	updatedBackend := orchestrator.backends[backendUUID]
+	assert.NotSame(t, originalBackend, updatedBackend, "backend not updated")
+	assert.Equal(t, backendName, updatedBackend.Name())
+	assert.Equal(t, "tbc-uid", updatedBackend.ConfigRef())
+	assert.Equal(t, map[string]string{"username": "admin", "password": "two"},
+		orchestrator.backendCredentials[backendUUID])
+
+	// Credentials that cannot be read leave the backend alone
+	assert.NoError(t, os.RemoveAll(filepath.Join(credentialsDir, "svm1")))
+	orchestrator.refreshBackendCredentials(rotationCtx)
+	assert.Same(t, updatedBackend, orchestrator.backends[backendUUID])
+
+	// Other updates of a TridentBackendConfig-based backend are still refused
+	_, err = orchestrator.updateBackendByBackendUUID(ctx(), backendName, string(configJSON), backendUUID, "tbc-uid")
+	assert.Error(t, err, "expected error updating backend outside of the CRD controller")
+
+	// Deleted backends are forgotten
+	crdCtx := GenerateRequestContext(context.Background(), "", ContextSourceCRD, WorkflowBackendDelete, LogLayerCore)
+	assert.NoError(t, orchestrator.DeleteBackendByBackendUUID(crdCtx, backendName, backendUUID))
+	assert.Empty(t, orchestrator.getExternalCredentials(rotationCtx))
+	assert.Empty(t, orchestrator.backendCredentials)
+}
@@ -0,0 +1,308 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/netapp/trident/logging"
+	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// changeFeedHistory is how many of the latest change events are kept, so that watches may be resumed after them
+	changeFeedHistory = 1000
+	// changeFeedBuffer is how many change events a watcher may fall behind before its watch is ended
+	changeFeedBuffer = 100
+)
+
+// changeFeed broadcasts change events to watchers, and keeps the latest events so that a watcher may resume
+// after the last event it received.  Each event has a resume token made of the feed's epoch, which distinguishes
+// it from the feeds of earlier Trident runs, and the event's sequence number.
+type changeFeed struct {
+	mutex    sync.Mutex
+	epoch    string
+	sequence uint64
+	history  []*storage.ChangeEvent
+	watchers map[chan *storage.ChangeEvent]struct{}
+}
+
+func newChangeFeed() *changeFeed {
+	return &changeFeed{
+		epoch:    strconv.FormatInt(time.Now().UnixNano(), 36),
+		history:  make([]*storage.ChangeEvent, 0, changeFeedHistory),
+		watchers: make(map[chan *storage.ChangeEvent]struct{}),
+	}
+}
+
+func (f *changeFeed) resumeToken(sequence uint64) string {
+	return f.epoch + "." + strconv.FormatUint(sequence, 10)
+}
+
+// parseResumeToken returns the sequence number of the event with a resume token.
+func (f *changeFeed) parseResumeToken(token string) (uint64, error) {
+	index := strings.LastIndex(token, ".")
+	if index < 0 {
+		return 0, utils.InvalidInputError(fmt.Sprintf("invalid resume token %s", token))
+	}
+	sequence, err := strconv.ParseUint(token[index+1:], 10, 64)
+	if err != nil {
+		return 0, utils.InvalidInputError(fmt.Sprintf("invalid resume token %s", token))
+	}
+	if token[:index] != f.epoch {
+		return 0, utils.ResumeTokenExpiredError("the resume token is from an earlier run of Trident")
+	}
+	if sequence > f.sequence {
+		return 0, utils.InvalidInputError(fmt.Sprintf("invalid resume token %s", token))
+	}
+	return sequence, nil
+}
+
+// publish sends a change event to every watcher.  Watchers that have fallen too far behind are ended, and may
+// resume from the last event they received.
+func (f *changeFeed) publish(
+	eventType storage.ChangeEventType, objectType storage.ChangeObjectType, name string, object interface{},
+) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.sequence++
+	event := &storage.ChangeEvent{
+		ResumeToken: f.resumeToken(f.sequence),
+		Time:        time.Now().UTC().Format(time.RFC3339Nano),
+		Type:        eventType,
+		ObjectType:  objectType,
+		Name:        name,
+		Object:      object,
+	}
+
+	if len(f.history) == changeFeedHistory {
+		copy(f.history, f.history[1:])
+		f.history[len(f.history)-1] = event
+	} else {
+		f.history = append(f.history, event)
+	}
+
+	for watcher := range f.watchers {
+		select {
+		case watcher <- event:
+		default:
+			delete(f.watchers, watcher)
+			close(watcher)
+		}
+	}
+}
+
+// watch returns a channel of change events, starting after the event with a resume token, or with the next
+// event if no token is given, together with the resume token of the latest event already published.  The
+// channel is closed when the context is done, or when the watcher falls too far behind.
+func (f *changeFeed) watch(ctx context.Context, resumeToken string) (<-chan *storage.ChangeEvent, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	backlog := make([]*storage.ChangeEvent, 0)
+	if resumeToken != "" {
+		sequence, err := f.parseResumeToken(resumeToken)
+		if err != nil {
+			return nil, "", err
+		}
+		missed := f.sequence - sequence
+		if missed > uint64(len(f.history)) {
+			return nil, "", utils.ResumeTokenExpiredError(fmt.Sprintf(
+				"the resume token is older than the latest %d changes", len(f.history)))
+		}
+		backlog = f.history[len(f.history)-int(missed):]
+	}
+
+	watcher := make(chan *storage.ChangeEvent, len(backlog)+changeFeedBuffer)
+	for _, event := range backlog {
+		watcher <- event
+	}
+	f.watchers[watcher] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		if _, ok := f.watchers[watcher]; ok {
+			delete(f.watchers, watcher)
+			close(watcher)
+		}
+	}()
+
+	return watcher, f.resumeToken(f.sequence), nil
+}
+
+// WatchChanges returns a channel of events reporting changes to volumes, snapshots, backends, nodes and volume
+// publications, starting after the event with a resume token, or with the next change if no token is given.  The
+// resume token of the latest change already made is also returned.  The channel is closed when the context is
+// done, or when the caller falls too far behind, after which it may resume from the last event it received.
+func (o *TridentOrchestrator) WatchChanges(
+	ctx context.Context, resumeToken string,
+) (<-chan *storage.ChangeEvent, string, error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, "", o.bootstrapError
+	}
+
+	Logc(ctx).WithField("resumeToken", resumeToken).Debug("Watching changes.")
+
+	return o.changes.watch(ctx, resumeToken)
+}
+
+// changeFeedClient is a persistent store client that publishes a change event for each change it stores, so that
+// every change made by the orchestrator is reported once it is durable.
+type changeFeedClient struct {
+	persistentstore.Client
+	feed *changeFeed
+}
+
+func newChangeFeedClient(client persistentstore.Client, feed *changeFeed) *changeFeedClient {
+	return &changeFeedClient{Client: client, feed: feed}
+}
+
+func (c *changeFeedClient) AddBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.AddBackend(ctx, b); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeAdded, storage.ChangeObjectBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *changeFeedClient) UpdateBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.UpdateBackend(ctx, b); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeUpdated, storage.ChangeObjectBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *changeFeedClient) DeleteBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.DeleteBackend(ctx, b); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+// ReplaceBackendAndUpdateVolumes reports a renamed backend as the deletion of the old name and the addition of
+// the new one, since watchers know backends by name.
+func (c *changeFeedClient) ReplaceBackendAndUpdateVolumes(
+	ctx context.Context, origBackend, newBackend storage.Backend,
+) error {
+	if err := c.Client.ReplaceBackendAndUpdateVolumes(ctx, origBackend, newBackend); err != nil {
+		return err
+	}
+	if origBackend.Name() == newBackend.Name() {
+		c.feed.publish(storage.ChangeUpdated, storage.ChangeObjectBackend, newBackend.Name(),
+			newBackend.ConstructExternal(ctx))
+	} else {
+		c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectBackend, origBackend.Name(),
+			origBackend.ConstructExternal(ctx))
+		c.feed.publish(storage.ChangeAdded, storage.ChangeObjectBackend, newBackend.Name(),
+			newBackend.ConstructExternal(ctx))
+	}
+	return nil
+}
+
+func (c *changeFeedClient) AddVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.AddVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeAdded, storage.ChangeObjectVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) UpdateVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.UpdateVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeUpdated, storage.ChangeObjectVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) DeleteVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.DeleteVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) AddOrUpdateNode(ctx context.Context, n *utils.Node) error {
+	eventType := storage.ChangeAdded
+	if _, err := c.Client.GetNode(ctx, n.Name); err == nil {
+		eventType = storage.ChangeUpdated
+	}
+	if err := c.Client.AddOrUpdateNode(ctx, n); err != nil {
+		return err
+	}
+	c.feed.publish(eventType, storage.ChangeObjectNode, n.Name, n.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) DeleteNode(ctx context.Context, n *utils.Node) error {
+	if err := c.Client.DeleteNode(ctx, n); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectNode, n.Name, n.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) AddVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.AddVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeAdded, storage.ChangeObjectPublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) UpdateVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.UpdateVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeUpdated, storage.ChangeObjectPublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) DeleteVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.DeleteVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectPublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) AddSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.AddSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeAdded, storage.ChangeObjectSnapshot, snapshot.ID(), snapshot.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) UpdateSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.UpdateSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeUpdated, storage.ChangeObjectSnapshot, snapshot.ID(),
+		snapshot.ConstructExternal())
+	return nil
+}
+
+func (c *changeFeedClient) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.DeleteSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.feed.publish(storage.ChangeDeleted, storage.ChangeObjectSnapshot, snapshot.ID(),
+		snapshot.ConstructExternal())
+	return nil
+}
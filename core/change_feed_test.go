@@ -0,0 +1,146 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/fake"
+	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+func receiveChangeEvent(t *testing.T, events <-chan *storage.ChangeEvent) *storage.ChangeEvent {
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("Change feed closed unexpectedly")
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a change event")
+	}
+	return nil
+}
+
+func TestWatchChanges(t *testing.T) {
+	const (
+		backendName = "changeFeedBackend"
+		scName      = "changeFeedSC"
+		volumeName  = "changeFeedVolume"
+	)
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	watchCtx, cancel := context.WithCancel(ctx())
+	defer cancel()
+	events, startToken, err := o.WatchChanges(watchCtx, "")
+	assert.NoError(t, err)
+
+	pools := map[string]*fake.StoragePool{
+		"primary": {
+			Attrs: map[string]sa.Offer{
+				sa.Media:            sa.NewStringOffer("hdd"),
+				sa.ProvisioningType: sa.NewStringOffer("thick", "thin"),
+				sa.TestingAttribute: sa.NewBoolOffer(true),
+			},
+			Bytes: 100 * 1024 * 1024 * 1024,
+		},
+	}
+	configJSON, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File, pools, []fake.Volume{})
+	if err != nil {
+		t.Fatal("Unable to create mock driver config JSON: ", err)
+	}
+	if _, err = o.AddBackend(ctx(), configJSON, ""); err != nil {
+		t.Fatalf("Unable to add backend: %v", err)
+	}
+	if _, err = o.AddStorageClass(ctx(), &storageclass.Config{Name: scName}); err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+	if _, err = o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)); err != nil {
+		t.Fatal("Unable to create volume: ", err)
+	}
+
+	// Every change is reported in order
+	event := receiveChangeEvent(t, events)
+	assert.Equal(t, storage.ChangeAdded, event.Type)
+	assert.Equal(t, storage.ChangeObjectBackend, event.ObjectType)
+	assert.Equal(t, backendName, event.Name)
+	backendToken := event.ResumeToken
+
+	var volumeEvent *storage.ChangeEvent
+	for volumeEvent == nil {
+		if event = receiveChangeEvent(t, events); event.ObjectType == storage.ChangeObjectVolume {
+			volumeEvent = event
+		}
+	}
+	assert.Equal(t, storage.ChangeAdded, volumeEvent.Type)
+	assert.Equal(t, volumeName, volumeEvent.Name)
+	assert.IsType(t, &storage.VolumeExternal{}, volumeEvent.Object)
+
+	// Ending the watch closes its channel
+	cancel()
+	for range events {
+	}
+
+	// A watch resumes after the last event received
+	resumeCtx, resumeCancel := context.WithCancel(ctx())
+	defer resumeCancel()
+	events, latestToken, err := o.WatchChanges(resumeCtx, backendToken)
+	assert.NoError(t, err)
+	assert.NotEqual(t, startToken, latestToken)
+	for event = receiveChangeEvent(t, events); event.ObjectType != storage.ChangeObjectVolume; {
+		assert.NotEqual(t, backendToken, event.ResumeToken, "the watch should resume after the token")
+		event = receiveChangeEvent(t, events)
+	}
+	assert.Equal(t, volumeEvent.ResumeToken, event.ResumeToken)
+
+	// Tokens from another feed or that are malformed are rejected
+	_, _, err = o.WatchChanges(ctx(), "0.1")
+	assert.True(t, utils.IsResumeTokenExpiredError(err), "expected a resume token expired error")
+	_, _, err = o.WatchChanges(ctx(), "invalid")
+	assert.True(t, utils.IsInvalidInputError(err), "expected an invalid input error")
+}
+
+func TestChangeFeedHistory(t *testing.T) {
+	feed := newChangeFeed()
+	feed.publish(storage.ChangeAdded, storage.ChangeObjectNode, "node0", nil)
+	expiredToken := feed.resumeToken(feed.sequence)
+	for i := 0; i <= changeFeedHistory; i++ {
+		feed.publish(storage.ChangeUpdated, storage.ChangeObjectNode, "node0", nil)
+	}
+	assert.Len(t, feed.history, changeFeedHistory)
+
+	// Tokens older than the history can no longer be resumed
+	_, _, err := feed.watch(ctx(), expiredToken)
+	assert.True(t, utils.IsResumeTokenExpiredError(err), "expected a resume token expired error")
+
+	// Tokens from the future are invalid
+	_, _, err = feed.watch(ctx(), feed.resumeToken(feed.sequence+1))
+	assert.True(t, utils.IsInvalidInputError(err), "expected an invalid input error")
+
+	// A watcher that falls too far behind is ended
+	watchCtx, cancel := context.WithCancel(ctx())
+	defer cancel()
+	events, _, err := feed.watch(watchCtx, "")
+	assert.NoError(t, err)
+	for i := 0; i <= changeFeedBuffer; i++ {
+		feed.publish(storage.ChangeUpdated, storage.ChangeObjectNode, "node0", nil)
+	}
+	received := 0
+	for range events {
+		received++
+	}
+	assert.Equal(t, changeFeedBuffer, received)
+	assert.Empty(t, feed.watchers)
+}
@@ -0,0 +1,473 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	. "github.com/netapp/trident/logging"
+	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// CheckConsistency compares the volumes, publications and transactions in Trident's persistent store with the
+// volumes on each online backend and the volumes and attachments known to the container orchestrator, and
+// reports where they have drifted apart.  If repair is set, the inconsistencies that may be fixed without risk
+// to data are fixed: orphaned volumes found on their backends are adopted, volumes missing from their backends
+// are marked orphaned, stale publications are removed, and the records of dangling create transactions whose
+// objects exist are deleted.  Other issues are only reported.
+//
+// The backends and the container orchestrator are listed without holding the orchestrator lock, so a check
+// doesn't hold up other operations for as long as the listings take.  The lock is then taken to compare and
+// repair, and objects that changed in the meantime are left for the next check.
+func (o *TridentOrchestrator) CheckConsistency(
+	ctx context.Context, repair bool,
+) (report *storage.ConsistencyReport, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("consistency_check", &err)()
+
+	report = &storage.ConsistencyReport{
+		Repair:         repair,
+		Skipped:        make([]string, 0),
+		ListedBackends: make([]string, 0),
+		Issues:         make([]*storage.ConsistencyIssue, 0),
+	}
+
+	observed := o.observeConsistencyState(ctx, report)
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.checkBackendVolumes(ctx, report, observed)
+	o.checkVolumePublications(ctx, report, observed)
+	if observed.references != nil {
+		o.checkVolumeReferences(report, observed)
+	}
+	if err = o.checkVolumeTransactions(ctx, report); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(report.Skipped)
+	sort.Strings(report.ListedBackends)
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		if report.Issues[i].Type != report.Issues[j].Type {
+			return report.Issues[i].Type < report.Issues[j].Type
+		}
+		return report.Issues[i].Object < report.Issues[j].Object
+	})
+
+	Logc(ctx).WithFields(LogFields{
+		"repair":  repair,
+		"issues":  len(report.Issues),
+		"skipped": len(report.Skipped),
+	}).Info("Checked consistency.")
+
+	return report, nil
+}
+
+// consistencyObservation holds what a consistency check read from the backends and the container orchestrator
+// without the orchestrator lock, along with what Trident tracked before it started reading, so that objects
+// created, deleted or moved while it was reading aren't reported.
+type consistencyObservation struct {
+	// backendVolumes maps the UUID of each online backend that could be listed to the volumes on it
+	backendVolumes map[string]map[string]bool
+	// volumeBackends maps the name of each volume tracked beforehand to the UUID of its backend
+	volumeBackends map[string]string
+	// trackedInternalNames maps the UUID of each backend to the internal names of the volumes tracked beforehand
+	trackedInternalNames map[string]map[string]bool
+	// publications holds the volume and node names of the publications tracked beforehand
+	publications map[string]bool
+	// references holds the container orchestrator's references, or nil if they are unknown
+	references *controllerhelpers.VolumeReferences
+}
+
+// observeConsistencyState reads the state a consistency check compares Trident with.  It takes the orchestrator
+// lock only to note what Trident tracks beforehand, and lists the backends and the container orchestrator
+// without it.
+func (o *TridentOrchestrator) observeConsistencyState(
+	ctx context.Context, report *storage.ConsistencyReport,
+) *consistencyObservation {
+	observed := &consistencyObservation{
+		backendVolumes:       make(map[string]map[string]bool),
+		volumeBackends:       make(map[string]string),
+		trackedInternalNames: make(map[string]map[string]bool),
+		publications:         make(map[string]bool),
+	}
+
+	o.mutex.Lock()
+	backends := make([]storage.Backend, 0, len(o.backends))
+	for _, backend := range o.backends {
+		backends = append(backends, backend)
+	}
+	for _, volume := range o.volumes {
+		observed.volumeBackends[volume.Config.Name] = volume.BackendUUID
+		if observed.trackedInternalNames[volume.BackendUUID] == nil {
+			observed.trackedInternalNames[volume.BackendUUID] = make(map[string]bool)
+		}
+		observed.trackedInternalNames[volume.BackendUUID][volume.Config.InternalName] = true
+	}
+	for _, publication := range o.volumePublications.ListPublications() {
+		observed.publications[publication.VolumeName+"/"+publication.NodeName] = true
+	}
+	helper := o.getControllerHelper()
+	o.mutex.Unlock()
+
+	if lister, ok := helper.(controllerhelpers.VolumeReferenceLister); !ok {
+		report.Skipped = append(report.Skipped,
+			"container orchestrator: no container orchestrator tracks Trident volumes")
+	} else if references, err := lister.ListVolumeReferences(ctx); err != nil {
+		report.Skipped = append(report.Skipped, fmt.Sprintf("container orchestrator: %v", err))
+	} else {
+		observed.references = references
+	}
+
+	for _, backend := range backends {
+		if !backend.State().IsOnline() {
+			report.Skipped = append(report.Skipped, fmt.Sprintf("backend %s: backend is %s", backend.Name(),
+				backend.State()))
+			continue
+		}
+
+		backendVolumes, err := listBackendVolumeNames(ctx, backend)
+		if err != nil {
+			report.Skipped = append(report.Skipped, fmt.Sprintf("backend %s: could not list volumes; %v",
+				backend.Name(), err))
+			continue
+		}
+		observed.backendVolumes[backend.BackendUUID()] = backendVolumes
+		report.ListedBackends = append(report.ListedBackends, backend.Name())
+	}
+
+	return observed
+}
+
+// addConsistencyIssue adds an issue to a report, and applies its fix if the report is repairing.
+func addConsistencyIssue(
+	ctx context.Context, report *storage.ConsistencyReport, issue *storage.ConsistencyIssue, fix func() error,
+) {
+	report.Issues = append(report.Issues, issue)
+	if fix == nil || !report.Repair {
+		return
+	}
+
+	if err := fix(); err != nil {
+		issue.RepairError = err.Error()
+		Logc(ctx).WithFields(LogFields{
+			"type":   issue.Type,
+			"object": issue.Object,
+		}).WithError(err).Error("Could not repair inconsistency.")
+		return
+	}
+	issue.Repaired = true
+	Logc(ctx).WithFields(LogFields{
+		"type":   issue.Type,
+		"object": issue.Object,
+		"repair": issue.Repair,
+	}).Info("Repaired inconsistency.")
+}
+
+// checkBackendVolumes compares the volumes listed on each online backend with the volumes Trident tracks on it.
+// The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) checkBackendVolumes(
+	ctx context.Context, report *storage.ConsistencyReport, observed *consistencyObservation,
+) {
+	// Volumes being created or migrated may exist on a backend before Trident tracks them
+	pending := make(map[string]map[string]bool)
+	addPending := func(backendUUID, internalName string) {
+		if pending[backendUUID] == nil {
+			pending[backendUUID] = make(map[string]bool)
+		}
+		pending[backendUUID][internalName] = true
+	}
+	transactions, err := o.storeClient.GetVolumeTransactions(ctx)
+	if err != nil && !persistentstore.MatchKeyNotFoundErr(err) {
+		report.Skipped = append(report.Skipped,
+			fmt.Sprintf("backend volumes: could not read transactions; %v", err))
+		return
+	}
+	for _, txn := range transactions {
+		if txn.Op == storage.VolumeCreating && txn.VolumeCreatingConfig != nil {
+			addPending(txn.VolumeCreatingConfig.BackendUUID, txn.VolumeCreatingConfig.InternalName)
+		}
+	}
+	for _, txn := range o.volumeMigrations {
+		if txn.VolumeMigrationConfig != nil && txn.VolumeMigrationConfig.TargetConfig != nil {
+			addPending(txn.VolumeMigrationConfig.TargetBackendUUID,
+				txn.VolumeMigrationConfig.TargetConfig.InternalName)
+		}
+	}
+
+	for backendUUID, backendVolumes := range observed.backendVolumes {
+		backend, ok := o.backends[backendUUID]
+		if !ok {
+			continue
+		}
+
+		tracked := make(map[string]bool)
+		for _, volume := range o.volumes {
+			if volume.BackendUUID != backendUUID {
+				continue
+			}
+			tracked[volume.Config.InternalName] = true
+
+			// Skip volumes created or moved to this backend after it was listed
+			if observed.volumeBackends[volume.Config.Name] != backendUUID {
+				continue
+			}
+			o.checkBackendVolume(ctx, report, backend, volume, backendVolumes[volume.Config.InternalName])
+		}
+
+		for internalName := range backendVolumes {
+			// Skip volumes deleted or moved away by Trident after the backend was listed
+			if tracked[internalName] || pending[backendUUID][internalName] ||
+				observed.trackedInternalNames[backendUUID][internalName] {
+				continue
+			}
+			addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+				Type:    storage.UntrackedBackendVolumeIssue,
+				Object:  internalName,
+				Backend: backend.Name(),
+				Message: "the backend has a volume that Trident doesn't track; import it with tridentctl " +
+					"import volume if it is needed",
+			}, nil)
+		}
+	}
+}
+
+// checkBackendVolume compares a volume with its presence on its backend when the backend was listed.  Since the
+// listing may be stale, each fix first checks the volume on the backend again.  The caller should hold the
+// orchestrator lock.
+func (o *TridentOrchestrator) checkBackendVolume(
+	ctx context.Context, report *storage.ConsistencyReport, backend storage.Backend, volume *storage.Volume,
+	onBackend bool,
+) {
+	// Unmanaged imports keep their original names, so drivers don't list them
+	if volume.State.IsDeleting() || volume.Config.ImportNotManaged {
+		return
+	}
+
+	switch {
+	case volume.Orphaned && onBackend:
+		addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+			Type:    storage.OrphanedVolumeIssue,
+			Object:  volume.Config.Name,
+			Backend: backend.Name(),
+			Message: fmt.Sprintf("the volume is marked orphaned, but its backend has volume %s",
+				volume.Config.InternalName),
+			Repair: "adopt the volume",
+		}, func() error {
+			if err := backend.Driver().Get(ctx, volume.Config.InternalName); err != nil {
+				return fmt.Errorf("the backend no longer has volume %s; %v", volume.Config.InternalName, err)
+			}
+			volume.Orphaned = false
+			if err := o.updateVolumeOnPersistentStore(ctx, volume); err != nil {
+				volume.Orphaned = true
+				return err
+			}
+			return nil
+		})
+
+	case volume.Orphaned:
+		addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+			Type:    storage.MissingBackendVolumeIssue,
+			Object:  volume.Config.Name,
+			Backend: backend.Name(),
+			Message: fmt.Sprintf("the volume is orphaned, and its backend has no volume %s; delete it if it is "+
+				"no longer needed", volume.Config.InternalName),
+		}, nil)
+
+	case !onBackend:
+		addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+			Type:    storage.MissingBackendVolumeIssue,
+			Object:  volume.Config.Name,
+			Backend: backend.Name(),
+			Message: fmt.Sprintf("the backend has no volume %s", volume.Config.InternalName),
+			Repair:  "mark the volume orphaned",
+		}, func() error {
+			if err := backend.Driver().Get(ctx, volume.Config.InternalName); err == nil {
+				return fmt.Errorf("the backend now has volume %s", volume.Config.InternalName)
+			}
+			volume.Orphaned = true
+			if err := o.updateVolumeOnPersistentStore(ctx, volume); err != nil {
+				volume.Orphaned = false
+				return err
+			}
+			return nil
+		})
+	}
+}
+
+// listBackendVolumeNames returns the internal names of the volumes on a backend.
+func listBackendVolumeNames(ctx context.Context, backend storage.Backend) (map[string]bool, error) {
+	channel := make(chan *storage.VolumeExternalWrapper)
+	go backend.Driver().GetVolumeExternalWrappers(ctx, channel)
+
+	// Read until the driver closes the channel, even after an error, so the driver isn't blocked
+	var err error
+	names := make(map[string]bool)
+	for wrapper := range channel {
+		if wrapper.Error != nil {
+			err = wrapper.Error
+		} else if wrapper.Volume != nil && wrapper.Volume.Config != nil {
+			names[wrapper.Volume.Config.InternalName] = true
+		}
+	}
+	return names, err
+}
+
+// checkVolumePublications finds publications whose volumes no longer exist, and, if the container
+// orchestrator's attachments are known, publications that it hadn't attached when they were listed.  Since the
+// attachments may be stale, unpublishing first lists them again.  The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) checkVolumePublications(
+	ctx context.Context, report *storage.ConsistencyReport, observed *consistencyObservation,
+) {
+	// Attachments are listed again at most once, and only if an unattached publication is to be repaired
+	var currentReferences *controllerhelpers.VolumeReferences
+	isAttached := func(volumeName, nodeName string) (bool, error) {
+		if currentReferences == nil {
+			references, err := o.getControllerHelper().(controllerhelpers.VolumeReferenceLister).
+				ListVolumeReferences(ctx)
+			if err != nil {
+				return false, err
+			}
+			currentReferences = references
+		}
+		return utils.SliceContainsString(currentReferences.Attachments[volumeName], nodeName), nil
+	}
+
+	for _, publication := range o.volumePublications.ListPublications() {
+		volumeName, nodeName := publication.VolumeName, publication.NodeName
+
+		_, isVolume := o.volumes[volumeName]
+		_, isSubordinate := o.subordinateVolumes[volumeName]
+		if !isVolume && !isSubordinate {
+			addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+				Type:    storage.StalePublicationIssue,
+				Object:  volumeName,
+				Node:    nodeName,
+				Message: "the volume is published to the node, but Trident has no such volume",
+				Repair:  "delete the publication",
+			}, func() error {
+				if err := o.deleteVolumePublication(ctx, volumeName, nodeName); err != nil &&
+					!utils.IsNotFoundError(err) {
+					return err
+				}
+				return nil
+			})
+			continue
+		}
+
+		// Skip publications made after the attachments were listed
+		if observed.references == nil || !observed.publications[volumeName+"/"+nodeName] {
+			continue
+		}
+		if !utils.SliceContainsString(observed.references.Attachments[volumeName], nodeName) {
+			addConsistencyIssue(ctx, report, &storage.ConsistencyIssue{
+				Type:    storage.UnattachedPublicationIssue,
+				Object:  volumeName,
+				Node:    nodeName,
+				Message: "the volume is published to the node, but the container orchestrator hasn't attached it",
+				Repair:  "unpublish the volume from the node",
+			}, func() error {
+				if attached, err := isAttached(volumeName, nodeName); err != nil {
+					return fmt.Errorf("could not list the container orchestrator's attachments; %v", err)
+				} else if attached {
+					return fmt.Errorf("the container orchestrator has since attached the volume to node %s",
+						nodeName)
+				}
+				return o.unpublishVolume(ctx, volumeName, nodeName)
+			})
+		}
+	}
+}
+
+// checkVolumeReferences finds volumes referenced by the container orchestrator that Trident doesn't have.  The
+// caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) checkVolumeReferences(
+	report *storage.ConsistencyReport, observed *consistencyObservation,
+) {
+	for volumeName, referenceName := range observed.references.Volumes {
+		_, isVolume := o.volumes[volumeName]
+		_, isSubordinate := o.subordinateVolumes[volumeName]
+		if isVolume || isSubordinate {
+			continue
+		}
+		report.Issues = append(report.Issues, &storage.ConsistencyIssue{
+			Type:    storage.MissingVolumeIssue,
+			Object:  volumeName,
+			Message: fmt.Sprintf("%s refers to the volume, but Trident has no such volume", referenceName),
+		})
+	}
+}
+
+// checkVolumeTransactions finds transactions left behind by operations that didn't finish.  Since every
+// short-lived operation holds the orchestrator lock for its whole transaction, any such transaction found while
+// holding the lock is dangling.  Only the records of create transactions whose objects Trident tracks are
+// deleted; processing the others could destroy data, so they are left for Trident to process when it restarts.
+// The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) checkVolumeTransactions(ctx context.Context, report *storage.ConsistencyReport) error {
+	transactions, err := o.storeClient.GetVolumeTransactions(ctx)
+	if err != nil {
+		if persistentstore.MatchKeyNotFoundErr(err) {
+			return nil
+		}
+		return fmt.Errorf("could not read transactions; %v", err)
+	}
+
+	for _, txn := range transactions {
+		switch txn.Op {
+		case storage.VolumeCreating, storage.UpgradeVolume:
+			// Long-running operations, whose transactions are reaped separately
+			continue
+		case storage.MigrateVolume:
+			if o.isVolumeMigrating(txn.Config.Name) {
+				continue
+			}
+		}
+
+		txn := txn
+		issue := &storage.ConsistencyIssue{
+			Type:    storage.DanglingTransactionIssue,
+			Object:  txn.Name(),
+			Message: fmt.Sprintf("a %s operation didn't finish; restart Trident to process it", txn.Op),
+		}
+		var fix func() error
+		if o.isTransactionObjectTracked(txn) {
+			issue.Message = fmt.Sprintf("a %s operation didn't finish, but its object exists", txn.Op)
+			issue.Repair = "delete the transaction"
+			fix = func() error {
+				return o.DeleteVolumeTransaction(ctx, txn)
+			}
+		}
+		addConsistencyIssue(ctx, report, issue, fix)
+	}
+	return nil
+}
+
+// isTransactionObjectTracked returns whether a volume or snapshot create transaction's object is tracked, in
+// which case the create succeeded and only the transaction record is left over.  The caller should hold the
+// orchestrator lock.
+func (o *TridentOrchestrator) isTransactionObjectTracked(txn *storage.VolumeTransaction) bool {
+	switch txn.Op {
+	case storage.AddVolume:
+		_, ok := o.volumes[txn.Config.Name]
+		return ok
+	case storage.AddSnapshot:
+		if txn.SnapshotConfig == nil {
+			return false
+		}
+		_, ok := o.snapshots[txn.SnapshotConfig.ID()]
+		return ok
+	default:
+		return false
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/fake"
+	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+func getConsistencyIssues(report *storage.ConsistencyReport) map[storage.ConsistencyIssueType][]string {
+	issues := make(map[storage.ConsistencyIssueType][]string)
+	for _, issue := range report.Issues {
+		issues[issue.Type] = append(issues[issue.Type], issue.Object)
+	}
+	return issues
+}
+
+func TestCheckConsistency(t *testing.T) {
+	const (
+		backendName = "consistencyBackend"
+		scName      = "consistencySC"
+	)
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	pools := map[string]*fake.StoragePool{
+		"primary": {
+			Attrs: map[string]sa.Offer{
+				sa.Media:            sa.NewStringOffer("hdd"),
+				sa.ProvisioningType: sa.NewStringOffer("thick", "thin"),
+				sa.TestingAttribute: sa.NewBoolOffer(true),
+			},
+			Bytes: 100 * 1024 * 1024 * 1024,
+		},
+	}
+	configJSON, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File, pools, []fake.Volume{})
+	if err != nil {
+		t.Fatal("Unable to create mock driver config JSON: ", err)
+	}
+	backend, err := o.AddBackend(ctx(), configJSON, "")
+	if err != nil {
+		t.Fatalf("Unable to add backend: %v", err)
+	}
+	if _, err = o.AddStorageClass(ctx(), &storageclass.Config{Name: scName}); err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+	for _, volumeName := range []string{"adoptable", "missing", "created"} {
+		if _, err = o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)); err != nil {
+			t.Fatal("Unable to create volume: ", err)
+		}
+	}
+
+	// Introduce drift between the store, the backend and the in-memory state
+	driver := o.backends[backend.BackendUUID].Driver().(*fakedriver.StorageDriver)
+	driver.Volumes["untracked"] = fake.Volume{Name: "untracked", PhysicalPool: "primary", SizeBytes: 1}
+	delete(driver.Volumes, o.volumes["missing"].Config.InternalName)
+	o.volumes["adoptable"].Orphaned = true
+
+	stalePublication := &utils.VolumePublication{Name: "gone/node1", VolumeName: "gone", NodeName: "node1"}
+	assert.NoError(t, o.storeClient.AddVolumePublication(ctx(), stalePublication))
+	assert.NoError(t, o.volumePublications.Set(stalePublication.VolumeName, stalePublication.NodeName,
+		stalePublication))
+
+	danglingTxn := &storage.VolumeTransaction{
+		Config: tu.GenerateVolumeConfig("deleted", 1, scName, config.File),
+		Op:     storage.DeleteVolume,
+	}
+	assert.NoError(t, o.storeClient.AddVolumeTransaction(ctx(), danglingTxn))
+	leftoverTxn := &storage.VolumeTransaction{Config: o.volumes["created"].Config, Op: storage.AddVolume}
+	assert.NoError(t, o.storeClient.AddVolumeTransaction(ctx(), leftoverTxn))
+
+	// A check reports every issue without changing anything
+	report, err := o.CheckConsistency(ctx(), false)
+	assert.NoError(t, err)
+	assert.False(t, report.Repair)
+	assert.Len(t, report.Skipped, 1, "without a container orchestrator, its checks should be skipped")
+	assert.Equal(t, []string{backendName}, report.ListedBackends)
+	assert.Equal(t, map[storage.ConsistencyIssueType][]string{
+		storage.OrphanedVolumeIssue:         {"adoptable"},
+		storage.MissingBackendVolumeIssue:   {"missing"},
+		storage.UntrackedBackendVolumeIssue: {"untracked"},
+		storage.StalePublicationIssue:       {"gone"},
+		storage.DanglingTransactionIssue:    {"created", "deleted"},
+	}, getConsistencyIssues(report))
+	for _, issue := range report.Issues {
+		assert.False(t, issue.Repaired)
+	}
+	assert.True(t, o.volumes["adoptable"].Orphaned)
+
+	// A repair fixes the safe issues
+	report, err = o.CheckConsistency(ctx(), true)
+	assert.NoError(t, err)
+	assert.Len(t, report.Issues, 6)
+	for _, issue := range report.Issues {
+		assert.Empty(t, issue.RepairError)
+		assert.Equal(t, issue.Repair != "", issue.Repaired, "issue %s should be repaired if repairable", issue.Type)
+	}
+	assert.False(t, o.volumes["adoptable"].Orphaned)
+	assert.True(t, o.volumes["missing"].Orphaned)
+	_, found := o.volumePublications.TryGet("gone", "node1")
+	assert.False(t, found)
+	_, found = o.volumes["created"]
+	assert.True(t, found, "repairing a leftover create transaction should not delete its volume")
+	transactions, err := o.storeClient.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, transactions, 1, "only the leftover create transaction should be deleted")
+
+	// Only the issues that need an administrator remain
+	report, err = o.CheckConsistency(ctx(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, map[storage.ConsistencyIssueType][]string{
+		storage.MissingBackendVolumeIssue:   {"missing"},
+		storage.UntrackedBackendVolumeIssue: {"untracked"},
+		storage.DanglingTransactionIssue:    {"deleted"},
+	}, getConsistencyIssues(report))
+}
@@ -85,6 +85,37 @@ var (
 		},
 		[]string{"backend_type", "backend_uuid"},
 	)
+	snapshotPolicyGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "snapshot_policy_count",
+			Help:      "The total number of snapshot policies",
+		},
+	)
+	snapshotPolicyOperationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "snapshot_policy_operations_total",
+			Help:      "The number of snapshots created and pruned by snapshot policies",
+		},
+		[]string{"snapshot_policy", "operation", "success"},
+	)
+	volumeAutogrowCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "volume_autogrow_total",
+			Help:      "The number of volumes grown automatically as they filled",
+		},
+		[]string{"backend", "success"},
+	)
+	backendCredentialRotationCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "backend_credential_rotations_total",
+			Help:      "The number of backends updated because their external credentials changed",
+		},
+		[]string{"backend", "success"},
+	)
 	operationDurationInMsSummary = promauto.NewSummaryVec(
 		prometheus.SummaryOpts{
 			Namespace:  config.OrchestratorName,
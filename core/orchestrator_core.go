@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +27,7 @@ import (
 	. "github.com/netapp/trident/logging"
 	persistentstore "github.com/netapp/trident/persistent_store"
 	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/credentials"
 	"github.com/netapp/trident/storage/factory"
 	sa "github.com/netapp/trident/storage_attribute"
 	storageclass "github.com/netapp/trident/storage_class"
@@ -86,9 +88,17 @@ type TridentOrchestrator struct {
 	nodes                    cache.NodeCache
 	volumePublications       *cache.VolumePublicationCache
 	snapshots                map[string]*storage.Snapshot
+	groupSnapshots           map[string]*storage.GroupSnapshot
+	snapshotPolicies         map[string]*storage.SnapshotPolicy
+	volumeUsage              map[string]*storage.VolumeUsage
+	volumeMigrations         map[string]*storage.VolumeTransaction // key is volume name
+	credentialStores         map[string]credentials.Provider       // key is credentials type
+	backendCredentials       map[string]map[string]string          // key is UUID, for externally stored credentials
 	storeClient              persistentstore.Client
+	changes                  *changeFeed
 	bootstrapped             bool
 	bootstrapError           error
+	standby                  atomic.Bool
 	txnMonitorTicker         *time.Ticker
 	txnMonitorChannel        chan struct{}
 	txnMonitorStopped        bool
@@ -96,11 +106,16 @@ type TridentOrchestrator struct {
 	volumePublicationsSynced bool
 	stopNodeAccessLoop       chan bool
 	stopReconcileBackendLoop chan bool
+	stopSnapshotPolicyLoop   chan bool
+	stopAutogrowLoop         chan bool
+	stopCredentialLoop       chan bool
+	stopMigrationLoop        chan bool
 	uuid                     string
 }
 
 // NewTridentOrchestrator returns a storage orchestrator instance
 func NewTridentOrchestrator(client persistentstore.Client) *TridentOrchestrator {
+	changes := newChangeFeed()
 	return &TridentOrchestrator{
 		backends:           make(map[string]storage.Backend), // key is UUID, not name
 		volumes:            make(map[string]*storage.Volume),
@@ -110,8 +125,15 @@ func NewTridentOrchestrator(client persistentstore.Client) *TridentOrchestrator
 		nodes:              *cache.NewNodeCache(),
 		volumePublications: cache.NewVolumePublicationCache(),
 		snapshots:          make(map[string]*storage.Snapshot), // key is ID, not name
+		groupSnapshots:     make(map[string]*storage.GroupSnapshot),
+		snapshotPolicies:   make(map[string]*storage.SnapshotPolicy),
+		volumeUsage:        make(map[string]*storage.VolumeUsage),
+		volumeMigrations:   make(map[string]*storage.VolumeTransaction),
+		credentialStores:   make(map[string]credentials.Provider),
+		backendCredentials: make(map[string]map[string]string),
 		mutex:              &sync.Mutex{},
-		storeClient:        client,
+		storeClient:        newChangeFeedClient(client, changes),
+		changes:            changes,
 		bootstrapped:       false,
 		bootstrapError:     utils.NotReadyError(),
 	}
@@ -166,6 +188,9 @@ func (o *TridentOrchestrator) Bootstrap(monitorTransactions bool) error {
 		Logc(ctx).Warning("Trident is bootstrapping with no frontend.")
 	}
 
+	// A controller that bootstraps is no longer standing by
+	o.standby.Store(false)
+
 	// Transform persistent state, if necessary
 	if err = o.transformPersistentState(ctx); err != nil {
 		o.bootstrapError = utils.BootstrapError(err)
@@ -401,6 +426,46 @@ func (o *TridentOrchestrator) bootstrapSnapshots(ctx context.Context) error {
 	return nil
 }
 
+func (o *TridentOrchestrator) bootstrapGroupSnapshots(ctx context.Context) error {
+	groupSnapshots, err := o.storeClient.GetGroupSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, g := range groupSnapshots {
+		// TODO:  If the API evolves, check the Version field here.
+		groupSnapshot := storage.NewGroupSnapshot(g.Config, g.Created)
+		o.groupSnapshots[groupSnapshot.ID()] = groupSnapshot
+
+		Logc(ctx).WithFields(LogFields{
+			"groupSnapshot": groupSnapshot.Config.Name,
+			"volumes":       groupSnapshot.Config.VolumeNames,
+			"handler":       "Bootstrap",
+		}).Info("Added an existing group snapshot.")
+	}
+	return nil
+}
+
+func (o *TridentOrchestrator) bootstrapSnapshotPolicies(ctx context.Context) error {
+	policies, err := o.storeClient.GetSnapshotPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		// TODO:  If the API evolves, check the Version field here.
+		policy := storage.NewSnapshotPolicy(p.Config, p.Created)
+		policy.LastRun = p.LastRun
+		o.snapshotPolicies[policy.ID()] = policy
+
+		Logc(ctx).WithFields(LogFields{
+			"snapshotPolicy": policy.Config.Name,
+			"schedule":       policy.Config.Schedule,
+			"lastRun":        policy.LastRun,
+			"handler":        "Bootstrap",
+		}).Info("Added an existing snapshot policy.")
+	}
+	return nil
+}
+
 func (o *TridentOrchestrator) bootstrapVolTxns(ctx context.Context) error {
 	volTxns, err := o.storeClient.GetVolumeTransactions(ctx)
 	if err != nil && !persistentstore.MatchKeyNotFoundErr(err) {
@@ -504,7 +569,8 @@ func (o *TridentOrchestrator) bootstrap(ctx context.Context) error {
 	for _, f := range []bootstrapFunc{
 		o.bootstrapBackends,
 		// Volumes, storage classes, and snapshots require backends to be bootstrapped.
-		o.bootstrapStorageClasses, o.bootstrapVolumes, o.bootstrapSnapshots,
+		o.bootstrapStorageClasses, o.bootstrapVolumes, o.bootstrapSnapshots, o.bootstrapGroupSnapshots,
+		o.bootstrapSnapshotPolicies,
 		// Volume transactions require volumes and snapshots to be bootstrapped.
 		o.bootstrapVolTxns,
 		// Node access reconciliation is part of node bootstrap and requires volume publications to be bootstrapped.
@@ -561,6 +627,18 @@ func (o *TridentOrchestrator) Stop() {
 	if o.stopReconcileBackendLoop != nil {
 		o.stopReconcileBackendLoop <- true
 	}
+	if o.stopSnapshotPolicyLoop != nil {
+		o.stopSnapshotPolicyLoop <- true
+	}
+	if o.stopAutogrowLoop != nil {
+		o.stopAutogrowLoop <- true
+	}
+	if o.stopCredentialLoop != nil {
+		o.stopCredentialLoop <- true
+	}
+	if o.stopMigrationLoop != nil {
+		o.stopMigrationLoop <- true
+	}
 
 	// Stop transaction monitor
 	o.StopTransactionMonitor()
@@ -608,6 +686,7 @@ func (o *TridentOrchestrator) updateMetrics() {
 
 	scGauge.Set(float64(len(o.storageClasses)))
 	nodeGauge.Set(float64(o.nodes.Len()))
+	snapshotPolicyGauge.Set(float64(len(o.snapshotPolicies)))
 	snapshotGauge.Reset()
 	snapshotAllocatedBytesGauge.Reset()
 	for _, snapshot := range o.snapshots {
@@ -637,7 +716,7 @@ func (o *TridentOrchestrator) handleFailedTransaction(ctx context.Context, v *st
 			"storageClass": v.Config.StorageClass,
 			"op":           v.Op,
 		}).Info("Processed volume transaction log.")
-	case storage.AddSnapshot, storage.DeleteSnapshot:
+	case storage.AddSnapshot, storage.DeleteSnapshot, storage.RestoreSnapshot:
 		Logc(ctx).WithFields(LogFields{
 			"volume":   v.SnapshotConfig.VolumeName,
 			"snapshot": v.SnapshotConfig.Name,
@@ -656,6 +735,14 @@ func (o *TridentOrchestrator) handleFailedTransaction(ctx context.Context, v *st
 			"backendUUID": v.VolumeCreatingConfig.BackendUUID,
 			"op":          v.Op,
 		}).Info("Processed volume creating transaction log.")
+	case storage.MigrateVolume:
+		Logc(ctx).WithFields(LogFields{
+			"volume":        v.Config.Name,
+			"targetBackend": v.VolumeMigrationConfig.TargetBackendUUID,
+			"targetPool":    v.VolumeMigrationConfig.TargetPool,
+			"state":         v.VolumeMigrationConfig.State,
+			"op":            v.Op,
+		}).Info("Processed volume migration transaction log.")
 	}
 
 	switch v.Op {
@@ -857,6 +944,24 @@ func (o *TridentOrchestrator) handleFailedTransaction(ctx context.Context, v *st
 			return fmt.Errorf("failed to clean up volume addition transaction: %v", err)
 		}
 
+	case storage.RestoreSnapshot:
+		// A restore either happened on the backend or it didn't, and nothing in the persistent
+		// store depends on the outcome, so there is nothing to roll back.  The user may simply
+		// repeat the restore once the transaction is gone.
+		Logc(ctx).WithFields(LogFields{
+			"volume":   v.SnapshotConfig.VolumeName,
+			"snapshot": v.SnapshotConfig.Name,
+		}).Warningf("Snapshot restore may not have completed. Repeat restoring the snapshot using %s.",
+			config.OrchestratorClientName)
+
+		if err := o.DeleteVolumeTransaction(ctx, v); err != nil {
+			return fmt.Errorf("failed to clean up snapshot restore transaction: %v", err)
+		}
+
+	case storage.MigrateVolume:
+		// Migrations are long-running, so resume following this one rather than rolling it back
+		o.volumeMigrations[v.Config.Name] = v
+
 	case storage.UpgradeVolume, storage.VolumeCreating:
 		// Do nothing
 	}
@@ -917,9 +1022,23 @@ func (o *TridentOrchestrator) validateBackendUpdate(oldBackend, newBackend stora
 }
 
 func (o *TridentOrchestrator) GetVersion(_ context.Context) (string, error) {
+	if o.standby.Load() {
+		return config.OrchestratorVersion.String(), nil
+	}
 	return config.OrchestratorVersion.String(), o.bootstrapError
 }
 
+// SetStandby marks whether this orchestrator is a standby controller waiting to be elected leader before it
+// bootstraps.  A standby orchestrator reports its version, so that it is seen as alive, but is otherwise not ready.
+func (o *TridentOrchestrator) SetStandby(standby bool) {
+	o.standby.Store(standby)
+}
+
+// IsStandby returns whether this orchestrator is a standby controller waiting to be elected leader.
+func (o *TridentOrchestrator) IsStandby() bool {
+	return o.standby.Load()
+}
+
 // AddBackend handles creation of a new storage backend
 func (o *TridentOrchestrator) AddBackend(
 	ctx context.Context, configJSON, configRef string,
@@ -1075,22 +1194,32 @@ func (o *TridentOrchestrator) validateAndCreateBackendFromConfig(
 	}
 
 	// If Credentials are set, fetch them and set them in the configJSON matching field names
+	var secretType string
 	if len(commonConfig.Credentials) != 0 {
-		secretName, _, err := commonConfig.GetCredentials()
+		var secretName string
+		secretName, secretType, err = commonConfig.GetCredentials()
 		if err != nil {
 			return nil, err
 		} else if secretName == "" {
 			return nil, fmt.Errorf("credentials `name` field cannot be empty")
 		}
 
-		if backendSecret, err = o.storeClient.GetBackendSecret(ctx, secretName); err != nil {
+		if backendSecret, err = o.getBackendCredentials(ctx, secretName, secretType); err != nil {
 			return nil, err
 		} else if backendSecret == nil {
 			return nil, fmt.Errorf("backend credentials not found")
 		}
 	}
 
-	return factory.NewStorageBackendForConfig(ctx, configInJSON, configRef, backendUUID, commonConfig, backendSecret)
+	backendExternal, err = factory.NewStorageBackendForConfig(ctx, configInJSON, configRef, backendUUID,
+		commonConfig, backendSecret)
+
+	// Remember externally stored credentials, so that the backend may be updated when they change
+	if backendExternal != nil && backendSecret != nil && secretType != string(drivers.CredentialStoreK8sSecret) {
+		o.backendCredentials[backendExternal.BackendUUID()] = backendSecret
+	}
+
+	return backendExternal, err
 }
 
 // UpdateBackend updates an existing backend.
@@ -1202,7 +1331,7 @@ func (o *TridentOrchestrator) updateBackendByBackendUUID(
 
 	// Do not allow update of TridentBackendConfig-based backends using tridentctl
 	if originalConfigRef != "" {
-		if !o.isCRDContext(ctx) {
+		if !o.isCRDContext(ctx) && !o.isCredentialRotationContext(ctx) {
 			Logc(ctx).WithFields(LogFields{
 				"backendName": backendName,
 				"backendUUID": backendUUID,
@@ -1730,6 +1859,9 @@ func (o *TridentOrchestrator) addVolume(
 	if _, ok := o.subordinateVolumes[volumeConfig.Name]; ok {
 		return nil, fmt.Errorf("volume %s exists but is a subordinate volume", volumeConfig.Name)
 	}
+	if err = volumeConfig.ValidateAutogrow(); err != nil {
+		return nil, utils.InvalidInputError(err.Error())
+	}
 
 	// If a volume is already being created, retry the operation with the same backend
 	// instead of continuing here and potentially starting over on a different backend.
@@ -1994,6 +2126,13 @@ func (o *TridentOrchestrator) UpdateVolume(ctx context.Context, volume string, p
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
+
+	// A migration's cutover replaces the volume's config with the one it started with, which would discard the
+	// update, and the volume's data may be copied to its new backend before or after a passphrase rotation
+	if o.isVolumeMigrating(volume) {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is migrating", volume))
+	}
+
 	vol, err := o.storeClient.GetVolume(ctx, volume)
 	if err != nil {
 		return err
@@ -2013,6 +2152,86 @@ func (o *TridentOrchestrator) UpdateVolume(ctx context.Context, volume string, p
 	return nil
 }
 
+// ModifyVolume changes the modifiable attributes of a volume on its backend, and then records them in the
+// volume's config.  Attributes that already have the requested values are left alone.
+func (o *TridentOrchestrator) ModifyVolume(
+	ctx context.Context, volumeName string, modification storage.VolumeModification,
+) (externalVol *storage.VolumeExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_modify", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	if _, ok := o.subordinateVolumes[volumeName]; ok {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"volume %s is a subordinate volume; modify its source volume instead", volumeName))
+	}
+
+	volume, found := o.volumes[volumeName]
+	if !found {
+		return nil, utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
+	}
+	if volume.State.IsDeleting() {
+		return nil, utils.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
+	}
+	if volume.Orphaned {
+		return nil, utils.VolumeStateError(fmt.Sprintf("volume %s is orphaned", volumeName))
+	}
+	if o.isVolumeMigrating(volumeName) {
+		return nil, utils.VolumeStateError(fmt.Sprintf("volume %s is migrating", volumeName))
+	}
+
+	if err = modification.Validate(); err != nil {
+		return nil, utils.InvalidInputError(fmt.Sprintf("invalid modification of volume %s; %v", volumeName, err))
+	}
+
+	changes := modification.Changes(volume.Config)
+	if len(changes) == 0 {
+		Logc(ctx).WithField("volume", volumeName).Debug("Volume already has the requested attributes.")
+		return volume.ConstructExternal(), nil
+	}
+
+	backend, found := o.backends[volume.BackendUUID]
+	if !found {
+		return nil, utils.NotFoundError(fmt.Sprintf("backend %s not found", volume.BackendUUID))
+	}
+
+	// Modify a copy of the config, so the cached volume is only changed once the backend and the store are
+	newConfig := volume.Config.ConstructClone()
+	changes.Apply(newConfig)
+
+	if err = backend.ModifyVolume(ctx, newConfig, changes); err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"volume":       volumeName,
+			"backend":      backend.Name(),
+			"modification": changes,
+		}).WithError(err).Error("Unable to modify the volume.")
+		return nil, fmt.Errorf("unable to modify volume %s; %v", volumeName, err)
+	}
+
+	newVolume := storage.NewVolume(newConfig, volume.BackendUUID, volume.Pool, volume.Orphaned, volume.State)
+	if err = o.storeClient.UpdateVolume(ctx, newVolume); err != nil {
+		return nil, fmt.Errorf("volume %s was modified, but its new attributes could not be saved; %v", volumeName,
+			err)
+	}
+	// The cached volume is shared with its backend, so it is updated in place
+	volume.Config = newConfig
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":       volumeName,
+		"modification": changes,
+	}).Info("Orchestrator modified the volume on the storage backend.")
+
+	return volume.ConstructExternal(), nil
+}
+
 func (o *TridentOrchestrator) CloneVolume(
 	ctx context.Context, volumeConfig *storage.VolumeConfig,
 ) (externalVol *storage.VolumeExternal, err error) {
@@ -2071,6 +2290,10 @@ func (o *TridentOrchestrator) cloneVolumeInitial(
 		}
 		return nil, utils.NotFoundError(fmt.Sprintf("source volume not found: %s", volumeConfig.CloneSourceVolume))
 	}
+	if o.isVolumeMigrating(volumeConfig.CloneSourceVolume) {
+		return nil, utils.VolumeStateError(fmt.Sprintf("source volume %s is migrating",
+			volumeConfig.CloneSourceVolume))
+	}
 
 	Logc(ctx).WithFields(LogFields{
 		"Config.Size": sourceVolume.Config.Size,
@@ -2643,7 +2866,8 @@ func (o *TridentOrchestrator) AddVolumeTransaction(ctx context.Context, volTxn *
 		return err
 	}
 	if oldTxn != nil {
-		if oldTxn.Op != storage.UpgradeVolume && oldTxn.Op != storage.VolumeCreating {
+		if oldTxn.Op != storage.UpgradeVolume && oldTxn.Op != storage.VolumeCreating &&
+			oldTxn.Op != storage.MigrateVolume {
 			err = o.handleFailedTransaction(ctx, oldTxn)
 			if err != nil {
 				return fmt.Errorf("unable to process the preexisting transaction for volume %s:  %v",
@@ -3064,6 +3288,9 @@ func (o *TridentOrchestrator) DeleteVolume(ctx context.Context, volumeName strin
 	if !ok {
 		return utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
 	}
+	if o.isVolumeMigrating(volumeName) {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is migrating", volumeName))
+	}
 	if volume.Orphaned {
 		Logc(ctx).WithFields(LogFields{
 			"volume":      volumeName,
@@ -3152,6 +3379,9 @@ func (o *TridentOrchestrator) publishVolume(
 	if volume.State.IsDeleting() {
 		return utils.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
 	}
+	if o.isVolumeCuttingOver(volume.Config.Name) {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is cutting over to its new backend", volumeName))
+	}
 
 	publishInfo.BackendUUID = volume.BackendUUID
 	backend, ok := o.backends[volume.BackendUUID]
@@ -3336,6 +3566,11 @@ func (o *TridentOrchestrator) unpublishVolume(ctx context.Context, volumeName, n
 		TridentUUID: o.uuid,
 	}
 
+	// NVMe access is granted per host NQN, so the driver needs it to revoke access.
+	if node := o.nodes.Get(nodeName); node != nil {
+		publishInfo.HostNQN = node.NQN
+	}
+
 	volume, ok := o.subordinateVolumes[volumeName]
 	if ok {
 		// If volume is a subordinate, replace it with its source volume since that is what we will manipulate.
@@ -3371,6 +3606,7 @@ func (o *TridentOrchestrator) unpublishVolume(ctx context.Context, volumeName, n
 		publishInfo.Nodes = append(publishInfo.Nodes, n)
 	}
 
+	publishInfo.BackendUUID = volume.BackendUUID
 	backend, ok := o.backends[volume.BackendUUID]
 	if !ok {
 		// Not a not found error because this is not user input.
@@ -3894,6 +4130,9 @@ func (o *TridentOrchestrator) CreateSnapshot(
 	if volume.State.IsDeleting() {
 		return nil, utils.VolumeStateError(fmt.Sprintf("source volume %s is deleting", snapshotConfig.VolumeName))
 	}
+	if o.isVolumeMigrating(snapshotConfig.VolumeName) {
+		return nil, utils.VolumeStateError(fmt.Sprintf("source volume %s is migrating", snapshotConfig.VolumeName))
+	}
 
 	// Get the backend
 	if backend, ok = o.backends[volume.BackendUUID]; !ok {
@@ -4235,6 +4474,140 @@ func (o *TridentOrchestrator) DeleteSnapshot(ctx context.Context, volumeName, sn
 	return o.deleteSnapshot(ctx, snapshot.Config)
 }
 
+// RestoreSnapshot restores a volume in place to the state captured in one of its snapshots.
+func (o *TridentOrchestrator) RestoreSnapshot(ctx context.Context, volumeName, snapshotName string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_restore", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	volume, ok := o.volumes[volumeName]
+	if !ok {
+		return utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
+	}
+	if volume.State.IsDeleting() {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
+	}
+	if o.isVolumeMigrating(volumeName) {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is migrating", volumeName))
+	}
+
+	snapshotID := storage.MakeSnapshotID(volumeName, snapshotName)
+	snapshot, ok := o.snapshots[snapshotID]
+	if !ok {
+		return utils.NotFoundError(fmt.Sprintf("snapshot %s not found on volume %s", snapshotName, volumeName))
+	}
+	if snapshot.State != storage.SnapshotStateOnline {
+		return fmt.Errorf("snapshot %s on volume %s is not ready to be restored; state is %s",
+			snapshotName, volumeName, snapshot.State)
+	}
+
+	backend, ok := o.backends[volume.BackendUUID]
+	if !ok {
+		return utils.NotFoundError(fmt.Sprintf("backend %s not found", volume.BackendUUID))
+	}
+
+	// Changing the contents of a volume out from under a running workload is sure to corrupt it
+	if publications := o.volumePublications.ListPublicationsForVolume(volumeName); len(publications) > 0 {
+		nodeNames := make([]string, 0, len(publications))
+		for _, publication := range publications {
+			nodeNames = append(nodeNames, publication.NodeName)
+		}
+		return utils.VolumeStateError(fmt.Sprintf("cannot restore volume %s while it is published to node(s) %s",
+			volumeName, strings.Join(nodeNames, ", ")))
+	}
+
+	if backend.RestoreRequiresNewestSnapshot() {
+		if err = o.ensureNewestSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+
+	volTxn := &storage.VolumeTransaction{
+		Config:         volume.Config,
+		SnapshotConfig: snapshot.Config,
+		Op:             storage.RestoreSnapshot,
+	}
+	if err = o.AddVolumeTransaction(ctx, volTxn); err != nil {
+		return err
+	}
+
+	defer func() {
+		errTxn := o.DeleteVolumeTransaction(ctx, volTxn)
+		if errTxn != nil {
+			Logc(ctx).WithFields(LogFields{
+				"volume":    volumeName,
+				"snapshot":  snapshotName,
+				"backend":   volume.BackendUUID,
+				"error":     errTxn,
+				"operation": volTxn.Op,
+			}).Warnf("Unable to delete snapshot restore transaction. Repeat the restore using %s or restart %v.",
+				config.OrchestratorClientName, config.OrchestratorName)
+		}
+		// Only combine errors if the transaction cleanup failed, so that callers can still inspect the restore error
+		if errTxn != nil {
+			errList := make([]string, 0, 2)
+			for _, e := range []error{err, errTxn} {
+				if e != nil {
+					errList = append(errList, e.Error())
+				}
+			}
+			err = fmt.Errorf(strings.Join(errList, ", "))
+		}
+	}()
+
+	if err = backend.RestoreSnapshot(ctx, snapshot.Config, volume.Config); err != nil {
+		if utils.IsUnsupportedError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to restore volume %s from snapshot %s on backend %s; %v",
+			volumeName, snapshotName, backend.Name(), err)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":   volumeName,
+		"snapshot": snapshotName,
+		"backend":  backend.Name(),
+	}).Info("Restored volume from snapshot.")
+
+	return nil
+}
+
+// ensureNewestSnapshot returns an error if the volume has any snapshot newer than the one specified.
+// It assumes the caller holds the orchestrator lock.
+func (o *TridentOrchestrator) ensureNewestSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	created, err := time.Parse(storage.SnapshotTimestampFormat, snapshot.Created)
+	if err != nil {
+		return fmt.Errorf("could not determine creation time of snapshot %s; %v", snapshot.Config.Name, err)
+	}
+
+	snapshots, err := o.volumeSnapshots(snapshot.Config.VolumeName)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range snapshots {
+		sCreated, err := time.Parse(storage.SnapshotTimestampFormat, s.Created)
+		if err != nil {
+			Logc(ctx).WithField("snapshot", s.ID()).WithError(err).Warning("Could not parse snapshot creation time.")
+			continue
+		}
+		if sCreated.After(created) {
+			return utils.InvalidInputError(fmt.Sprintf(
+				"volume %s may only be restored from its newest snapshot, and snapshot %s is newer than %s",
+				snapshot.Config.VolumeName, s.Config.Name, snapshot.Config.Name))
+		}
+	}
+
+	return nil
+}
+
 func (o *TridentOrchestrator) ListSnapshots(ctx context.Context) (snapshots []*storage.SnapshotExternal, err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
@@ -4336,68 +4709,572 @@ func (o *TridentOrchestrator) ReadSnapshotsForVolume(
 	return externalSnapshots, nil
 }
 
-func (o *TridentOrchestrator) ReloadVolumes(ctx context.Context) (err error) {
+// CreateGroupSnapshot creates a snapshot of each volume in a group at the same point in time.  All the volumes
+// must reside on the same backend.  Holding the orchestrator lock fences the volumes against other Trident
+// operations for the duration, which lets backends without native group snapshots snapshot each volume in turn.
+func (o *TridentOrchestrator) CreateGroupSnapshot(
+	ctx context.Context, groupConfig *storage.GroupSnapshotConfig,
+) (externalGroupSnapshot *storage.GroupSnapshotExternal, err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
 	if o.bootstrapError != nil {
-		return o.bootstrapError
+		return nil, o.bootstrapError
 	}
 
-	defer recordTiming("volume_reload", &err)()
+	defer recordTiming("group_snapshot_create", &err)()
 
-	// Lock out all other workflows while we reload the volumes
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
-	// Make a temporary copy of backends in case anything goes wrong
-	tempBackends := make(map[string]storage.Backend)
-	for k, v := range o.backends {
-		tempBackends[k] = v
-	}
-	// Make a temporary copy of volumes in case anything goes wrong
-	tempVolumes := make(map[string]*storage.Volume)
-	for k, v := range o.volumes {
-		tempVolumes[k] = v
+	if err = groupConfig.Validate(); err != nil {
+		return nil, utils.InvalidInputError(err.Error())
 	}
 
-	// Clear out cached volumes in the backends
-	for _, backend := range o.backends {
-		backend.SetVolumes(make(map[string]*storage.Volume))
+	// Check if the group snapshot already exists
+	if groupSnapshot, ok := o.groupSnapshots[groupConfig.Name]; ok {
+		sameVolumes := len(groupSnapshot.Config.VolumeNames) == len(groupConfig.VolumeNames)
+		for _, volumeName := range groupConfig.VolumeNames {
+			sameVolumes = sameVolumes && utils.SliceContainsString(groupSnapshot.Config.VolumeNames, volumeName)
+		}
+		if !sameVolumes {
+			return nil, utils.FoundError(fmt.Sprintf("group snapshot %s already exists with different volumes",
+				groupConfig.Name))
+		}
+		return o.constructExternalGroupSnapshot(groupSnapshot), nil
 	}
 
-	// Re-run the volume bootstrapping code
-	err = o.bootstrapVolumes(ctx)
+	// Get the volumes and ensure they share a backend
+	var backend storage.Backend
+	volConfigs := make([]*storage.VolumeConfig, 0, len(groupConfig.VolumeNames))
+	snapConfigs := make([]*storage.SnapshotConfig, 0, len(groupConfig.VolumeNames))
+	groupConfig.InternalName = groupConfig.Name
 
-	// If anything went wrong, reinstate the original volumes
-	if err != nil {
-		Logc(ctx).Errorf("Volume reload failed, restoring original volume list: %v", err)
-		o.backends = tempBackends
-		o.volumes = tempVolumes
-	}
+	for _, volumeName := range groupConfig.VolumeNames {
+		volume, ok := o.volumes[volumeName]
+		if !ok {
+			return nil, utils.NotFoundError(fmt.Sprintf("source volume %s not found", volumeName))
+		}
+		if volume.State.IsDeleting() {
+			return nil, utils.VolumeStateError(fmt.Sprintf("source volume %s is deleting", volumeName))
+		}
+		if o.isVolumeMigrating(volumeName) {
+			return nil, utils.VolumeStateError(fmt.Sprintf("source volume %s is migrating", volumeName))
+		}
 
-	return err
-}
+		volumeBackend, ok := o.backends[volume.BackendUUID]
+		if !ok {
+			// Should never get here but just to be safe
+			return nil, utils.NotFoundError(fmt.Sprintf("backend %s for the source volume not found: %s",
+				volume.BackendUUID, volumeName))
+		}
+		if backend == nil {
+			backend = volumeBackend
+		} else if backend.BackendUUID() != volumeBackend.BackendUUID() {
+			return nil, utils.InvalidInputError(fmt.Sprintf(
+				"volumes in group snapshot %s must be on the same backend; volume %s is on backend %s, not %s",
+				groupConfig.Name, volumeName, volumeBackend.Name(), backend.Name()))
+		}
+		if !backend.SupportsGroupSnapshots() {
+			return nil, utils.UnsupportedError(fmt.Sprintf("backend %s can neither snapshot volumes at a single "+
+				"point in time nor quiesce them, so group snapshot %s would not be crash-consistent",
+				backend.Name(), groupConfig.Name))
+		}
 
-// ResizeVolume resizes a volume to the new size.
-func (o *TridentOrchestrator) ResizeVolume(ctx context.Context, volumeName, newSize string) (err error) {
-	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+		snapConfig := &storage.SnapshotConfig{
+			Version:             config.OrchestratorAPIVersion,
+			Name:                groupConfig.Name,
+			InternalName:        groupConfig.InternalName,
+			VolumeName:          volumeName,
+			VolumeInternalName:  volume.Config.InternalName,
+			LUKSPassphraseNames: volume.Config.LUKSPassphraseNames,
+		}
+		if _, ok := o.snapshots[snapConfig.ID()]; ok {
+			return nil, utils.FoundError(fmt.Sprintf("snapshot %s already exists", snapConfig.ID()))
+		}
 
-	if o.bootstrapError != nil {
-		return o.bootstrapError
+		// Ensure a snapshot is even possible before creating any transactions
+		if err = backend.CanSnapshot(ctx, snapConfig, volume.Config); err != nil {
+			return nil, err
+		}
+
+		volConfigs = append(volConfigs, volume.Config)
+		snapConfigs = append(snapConfigs, snapConfig)
 	}
 
-	defer recordTiming("volume_resize", &err)()
+	// Add a transaction for each snapshot in case the operation must be rolled back later
+	txns := make([]*storage.VolumeTransaction, 0, len(snapConfigs))
+	for i, snapConfig := range snapConfigs {
+		txn := &storage.VolumeTransaction{
+			Config:         volConfigs[i],
+			SnapshotConfig: snapConfig,
+			Op:             storage.AddSnapshot,
+		}
+		if err = o.AddVolumeTransaction(ctx, txn); err != nil {
+			break
+		}
+		txns = append(txns, txn)
+	}
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
-	defer o.updateMetrics()
+	var snapshots []*storage.Snapshot
 
-	if _, ok := o.subordinateVolumes[volumeName]; ok {
-		return o.resizeSubordinateVolume(ctx, volumeName, newSize)
-	}
+	// Recovery function in case of error
+	defer func() {
+		err = o.addGroupSnapshotCleanup(ctx, err, backend, groupConfig, snapshots, txns)
+	}()
 
-	volume, found := o.volumes[volumeName]
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the snapshots
+	snapshots, err = backend.CreateGroupSnapshot(ctx, groupConfig, snapConfigs, volConfigs)
+	if err != nil {
+		if utils.IsMaxLimitReachedError(err) {
+			return nil, utils.MaxLimitReachedError(fmt.Sprintf(
+				"failed to create group snapshot %s on backend %s: %v", groupConfig.Name, backend.Name(), err))
+		}
+		return nil, fmt.Errorf("failed to create group snapshot %s on backend %s: %v",
+			groupConfig.Name, backend.Name(), err)
+	}
+
+	// Save references to the new snapshots, followed by the group itself
+	for _, snapshot := range snapshots {
+		if err = o.storeClient.AddSnapshot(ctx, snapshot); err != nil {
+			return nil, err
+		}
+		o.snapshots[snapshot.ID()] = snapshot
+	}
+
+	created := time.Now().UTC().Format(storage.SnapshotTimestampFormat)
+	if len(snapshots) > 0 && snapshots[0].Created != "" {
+		created = snapshots[0].Created
+	}
+	groupSnapshot := storage.NewGroupSnapshot(groupConfig, created)
+	if err = o.storeClient.AddGroupSnapshot(ctx, groupSnapshot); err != nil {
+		return nil, err
+	}
+	o.groupSnapshots[groupSnapshot.ID()] = groupSnapshot
+
+	Logc(ctx).WithFields(LogFields{
+		"groupSnapshot": groupConfig.Name,
+		"volumes":       groupConfig.VolumeNames,
+		"backend":       backend.Name(),
+	}).Info("Created group snapshot.")
+
+	return groupSnapshot.ConstructExternal(snapshots), nil
+}
+
+// addGroupSnapshotCleanup is used as a deferred method from the group snapshot create method
+// to clean up in case anything goes wrong during the operation.
+func (o *TridentOrchestrator) addGroupSnapshotCleanup(
+	ctx context.Context, err error, backend storage.Backend, groupConfig *storage.GroupSnapshotConfig,
+	snapshots []*storage.Snapshot, volTxns []*storage.VolumeTransaction,
+) error {
+	var cleanupErr, txErr error
+	if err != nil {
+		// We failed somewhere.  If the snapshots were created on the backend, they must all be removed
+		// from the backend and the persistent store so that the group snapshot remains all or nothing.
+		for _, snapshot := range snapshots {
+			if _, ok := o.snapshots[snapshot.ID()]; ok {
+				if deleteErr := o.storeClient.DeleteSnapshot(ctx, snapshot); deleteErr != nil {
+					cleanupErr = fmt.Errorf("unable to delete snapshot %s from the persistent store during "+
+						"cleanup:  %v", snapshot.ID(), deleteErr)
+				}
+				delete(o.snapshots, snapshot.ID())
+			}
+
+			volume, ok := o.volumes[snapshot.Config.VolumeName]
+			if !ok {
+				continue
+			}
+			if deleteErr := backend.DeleteSnapshot(ctx, snapshot.Config, volume.Config); deleteErr != nil {
+				cleanupErr = fmt.Errorf("unable to delete snapshot %s from backend during cleanup:  %v",
+					snapshot.ID(), deleteErr)
+			}
+		}
+	}
+	if cleanupErr == nil {
+		// Only clean up the snapshot transactions if we've succeeded at cleaning up on
+		// the backend or if we didn't need to do so in the first place.
+		for _, volTxn := range volTxns {
+			if deleteErr := o.DeleteVolumeTransaction(ctx, volTxn); deleteErr != nil {
+				txErr = fmt.Errorf("unable to clean up snapshot transaction: %v", deleteErr)
+			}
+		}
+	}
+	if cleanupErr != nil || txErr != nil {
+		// Report on all errors we encountered.
+		errList := make([]string, 0, 3)
+		for _, e := range []error{err, cleanupErr, txErr} {
+			if e != nil {
+				errList = append(errList, e.Error())
+			}
+		}
+		err = errors.New(strings.Join(errList, ", "))
+		Logc(ctx).Warnf("Unable to clean up artifacts of group snapshot %s creation: %v. Repeat creating the "+
+			"group snapshot or restart %v.", groupConfig.Name, err, config.OrchestratorName)
+	}
+	return err
+}
+
+// constructExternalGroupSnapshot returns the external form of a group snapshot along with those of
+// its member snapshots that are still known to Trident.  It assumes the caller holds the orchestrator lock.
+func (o *TridentOrchestrator) constructExternalGroupSnapshot(
+	groupSnapshot *storage.GroupSnapshot,
+) *storage.GroupSnapshotExternal {
+	snapshots := make([]*storage.Snapshot, 0, len(groupSnapshot.Config.VolumeNames))
+	for _, snapshotID := range groupSnapshot.Config.SnapshotIDs() {
+		if snapshot, ok := o.snapshots[snapshotID]; ok {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return groupSnapshot.ConstructExternal(snapshots)
+}
+
+func (o *TridentOrchestrator) GetGroupSnapshot(
+	ctx context.Context, groupSnapshotName string,
+) (externalGroupSnapshot *storage.GroupSnapshotExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("group_snapshot_get", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	groupSnapshot, ok := o.groupSnapshots[groupSnapshotName]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("group snapshot %v was not found", groupSnapshotName))
+	}
+	return o.constructExternalGroupSnapshot(groupSnapshot), nil
+}
+
+func (o *TridentOrchestrator) ListGroupSnapshots(
+	ctx context.Context,
+) (groupSnapshots []*storage.GroupSnapshotExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("group_snapshot_list", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	groupSnapshots = make([]*storage.GroupSnapshotExternal, 0, len(o.groupSnapshots))
+	for _, groupSnapshot := range o.groupSnapshots {
+		groupSnapshots = append(groupSnapshots, o.constructExternalGroupSnapshot(groupSnapshot))
+	}
+	sort.Slice(groupSnapshots, func(i, j int) bool {
+		return groupSnapshots[i].ID() < groupSnapshots[j].ID()
+	})
+	return groupSnapshots, nil
+}
+
+// DeleteGroupSnapshot deletes the member snapshots of a group snapshot, followed by the group itself.
+// Member snapshots that no longer exist are ignored, so a partially deleted group may be deleted again.
+func (o *TridentOrchestrator) DeleteGroupSnapshot(ctx context.Context, groupSnapshotName string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("group_snapshot_delete", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	groupSnapshot, ok := o.groupSnapshots[groupSnapshotName]
+	if !ok {
+		return utils.NotFoundError(fmt.Sprintf("group snapshot %s not found", groupSnapshotName))
+	}
+
+	for _, snapshotID := range groupSnapshot.Config.SnapshotIDs() {
+		snapshot, ok := o.snapshots[snapshotID]
+		if !ok {
+			continue
+		}
+
+		if err = o.deleteGroupSnapshotMember(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to delete snapshot %s of group snapshot %s; %v", snapshotID,
+				groupSnapshotName, err)
+		}
+	}
+
+	if err = o.storeClient.DeleteGroupSnapshot(ctx, groupSnapshot); err != nil {
+		return err
+	}
+	delete(o.groupSnapshots, groupSnapshotName)
+
+	Logc(ctx).WithField("groupSnapshot", groupSnapshotName).Info("Deleted group snapshot.")
+
+	return nil
+}
+
+// deleteGroupSnapshotMember deletes one snapshot belonging to a group snapshot under a snapshot delete
+// transaction.  It assumes the caller holds the orchestrator lock.
+func (o *TridentOrchestrator) deleteGroupSnapshotMember(ctx context.Context, snapshot *storage.Snapshot) error {
+	volume, ok := o.volumes[snapshot.Config.VolumeName]
+	if !ok || o.backends[volume.BackendUUID] == nil {
+		// The volume or its backend is gone, so there is nothing left to delete but the record of the snapshot
+		if err := o.storeClient.DeleteSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+		delete(o.snapshots, snapshot.ID())
+		return nil
+	}
+
+	volTxn := &storage.VolumeTransaction{
+		Config:         volume.Config,
+		SnapshotConfig: snapshot.Config,
+		Op:             storage.DeleteSnapshot,
+	}
+	if err := o.AddVolumeTransaction(ctx, volTxn); err != nil {
+		return err
+	}
+
+	err := o.deleteSnapshot(ctx, snapshot.Config)
+
+	if errTxn := o.DeleteVolumeTransaction(ctx, volTxn); errTxn != nil {
+		Logc(ctx).WithFields(LogFields{
+			"volume":    snapshot.Config.VolumeName,
+			"snapshot":  snapshot.Config.Name,
+			"error":     errTxn,
+			"operation": volTxn.Op,
+		}).Warnf("Unable to delete snapshot transaction. Repeat deletion using %s or restart %v.",
+			config.OrchestratorClientName, config.OrchestratorName)
+		if err == nil {
+			err = errTxn
+		}
+	}
+
+	return err
+}
+
+// AddSnapshotPolicy adds a Trident snapshot policy.  The policy's first scheduled run is the first activation of
+// its schedule after the time it was added.
+func (o *TridentOrchestrator) AddSnapshotPolicy(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+) (externalPolicy *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_policy_add", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	if err = policyConfig.Validate(); err != nil {
+		return nil, utils.InvalidInputError(err.Error())
+	}
+	if _, ok := o.snapshotPolicies[policyConfig.Name]; ok {
+		return nil, utils.FoundError(fmt.Sprintf("snapshot policy %s already exists", policyConfig.Name))
+	}
+
+	policyConfig.Version = config.OrchestratorAPIVersion
+	policy := storage.NewSnapshotPolicy(policyConfig, time.Now().UTC().Format(time.RFC3339))
+	if err = o.storeClient.AddSnapshotPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	o.snapshotPolicies[policy.ID()] = policy
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": policy.Config.Name,
+		"schedule":       policy.Config.Schedule,
+	}).Info("Added snapshot policy.")
+
+	return policy.ConstructExternal(), nil
+}
+
+// UpdateSnapshotPolicy replaces the configuration of a Trident snapshot policy.  The time of its last scheduled
+// run is kept, so a new schedule takes effect from that time.
+func (o *TridentOrchestrator) UpdateSnapshotPolicy(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+) (externalPolicy *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_policy_update", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if err = policyConfig.Validate(); err != nil {
+		return nil, utils.InvalidInputError(err.Error())
+	}
+	policy, ok := o.snapshotPolicies[policyConfig.Name]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("snapshot policy %s not found", policyConfig.Name))
+	}
+
+	policyConfig.Version = config.OrchestratorAPIVersion
+	updatedPolicy := policy.ConstructClone()
+	updatedPolicy.Config = policyConfig
+	if err = o.storeClient.UpdateSnapshotPolicy(ctx, updatedPolicy); err != nil {
+		return nil, err
+	}
+	o.snapshotPolicies[updatedPolicy.ID()] = updatedPolicy
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": updatedPolicy.Config.Name,
+		"schedule":       updatedPolicy.Config.Schedule,
+	}).Info("Updated snapshot policy.")
+
+	return updatedPolicy.ConstructExternal(), nil
+}
+
+func (o *TridentOrchestrator) GetSnapshotPolicy(
+	ctx context.Context, policyName string,
+) (externalPolicy *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_policy_get", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	policy, ok := o.snapshotPolicies[policyName]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("snapshot policy %v was not found", policyName))
+	}
+	return policy.ConstructExternal(), nil
+}
+
+func (o *TridentOrchestrator) ListSnapshotPolicies(
+	ctx context.Context,
+) (policies []*storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_policy_list", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	policies = make([]*storage.SnapshotPolicyExternal, 0, len(o.snapshotPolicies))
+	for _, policy := range o.snapshotPolicies {
+		policies = append(policies, policy.ConstructExternal())
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].ID() < policies[j].ID()
+	})
+	return policies, nil
+}
+
+// DeleteSnapshotPolicy deletes a Trident snapshot policy.  Snapshots already created by the policy are kept,
+// and they are no longer pruned except when their volume is deleted.
+func (o *TridentOrchestrator) DeleteSnapshotPolicy(ctx context.Context, policyName string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("snapshot_policy_delete", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	policy, ok := o.snapshotPolicies[policyName]
+	if !ok {
+		return utils.NotFoundError(fmt.Sprintf("snapshot policy %s not found", policyName))
+	}
+
+	if err = o.storeClient.DeleteSnapshotPolicy(ctx, policy); err != nil {
+		return err
+	}
+	delete(o.snapshotPolicies, policyName)
+
+	Logc(ctx).WithField("snapshotPolicy", policyName).Info("Deleted snapshot policy.")
+
+	return nil
+}
+
+func (o *TridentOrchestrator) ReloadVolumes(ctx context.Context) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("volume_reload", &err)()
+
+	// Lock out all other workflows while we reload the volumes
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	// Make a temporary copy of backends in case anything goes wrong
+	tempBackends := make(map[string]storage.Backend)
+	for k, v := range o.backends {
+		tempBackends[k] = v
+	}
+	// Make a temporary copy of volumes in case anything goes wrong
+	tempVolumes := make(map[string]*storage.Volume)
+	for k, v := range o.volumes {
+		tempVolumes[k] = v
+	}
+
+	// Clear out cached volumes in the backends
+	for _, backend := range o.backends {
+		backend.SetVolumes(make(map[string]*storage.Volume))
+	}
+
+	// Re-run the volume bootstrapping code
+	err = o.bootstrapVolumes(ctx)
+
+	// If anything went wrong, reinstate the original volumes
+	if err != nil {
+		Logc(ctx).Errorf("Volume reload failed, restoring original volume list: %v", err)
+		o.backends = tempBackends
+		o.volumes = tempVolumes
+	}
+
+	return err
+}
+
+// ResizeVolume resizes a volume to the new size.
+func (o *TridentOrchestrator) ResizeVolume(ctx context.Context, volumeName, newSize string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("volume_resize", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	if _, ok := o.subordinateVolumes[volumeName]; ok {
+		return o.resizeSubordinateVolume(ctx, volumeName, newSize)
+	}
+
+	volume, found := o.volumes[volumeName]
 	if !found {
 		return utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
 	}
@@ -4410,6 +5287,9 @@ func (o *TridentOrchestrator) ResizeVolume(ctx context.Context, volumeName, newS
 	if volume.State.IsDeleting() {
 		return utils.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
 	}
+	if o.isVolumeMigrating(volumeName) {
+		return utils.VolumeStateError(fmt.Sprintf("volume %s is migrating", volumeName))
+	}
 
 	// Create a new config for the volume transaction
 	cloneConfig := volume.Config.ConstructClone()
@@ -4629,6 +5509,10 @@ func (o *TridentOrchestrator) AddStorageClass(
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	if err = scConfig.PlacementPolicy.Validate(); err != nil {
+		return nil, utils.InvalidInputError(err.Error())
+	}
+
 	sc := storageclass.New(scConfig)
 	if _, ok := o.storageClasses[sc.GetName()]; ok {
 		return nil, fmt.Errorf("storage class %s already exists", sc.GetName())
@@ -4731,6 +5615,98 @@ func (o *TridentOrchestrator) DeleteStorageClass(ctx context.Context, scName str
 	return nil
 }
 
+// GetCapacity returns the space available for new volumes in a storage class.  If a topology
+// is specified, only those pools that can create volumes accessible from it are considered.
+// Pools on the same backend that draw from the same physical storage are counted only once.
+func (o *TridentOrchestrator) GetCapacity(
+	ctx context.Context, scName string, topology map[string]string,
+) (capacity *storageclass.Capacity, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("storageclass_capacity", &err)()
+
+	o.mutex.Lock()
+
+	sc, found := o.storageClasses[scName]
+	if !found {
+		o.mutex.Unlock()
+		return nil, utils.NotFoundError(fmt.Sprintf("storage class %v was not found", scName))
+	}
+
+	pools := sc.GetStoragePoolsForProtocol(ctx, config.ProtocolAny, config.ModeAny)
+	if len(topology) > 0 {
+		pools = storageclass.FilterPoolsOnTopology(ctx, pools, []map[string]string{topology})
+	}
+
+	reportingPools := make([]storage.Pool, 0, len(pools))
+	for _, pool := range pools {
+		backend := pool.Backend()
+		if backend == nil || !backend.State().IsOnline() || !backend.CanReportCapacity() {
+			continue
+		}
+		reportingPools = append(reportingPools, pool)
+	}
+
+	// Don't hold the lock while the backends are queried, as the CO asks for capacity often and queries may be slow
+	o.mutex.Unlock()
+
+	capacity = &storageclass.Capacity{}
+	countedPools := make(map[string]bool)
+
+	for _, pool := range reportingPools {
+		backend := pool.Backend()
+
+		logFields := LogFields{
+			"storageClass": scName,
+			"backend":      backend.Name(),
+			"pool":         pool.Name(),
+		}
+
+		poolCapacity, err := backend.GetPoolCapacity(ctx, pool)
+		if utils.IsUnsupportedError(err) {
+			Logc(ctx).WithFields(logFields).WithError(err).Debug("Pool cannot report capacity.")
+			continue
+		} else if err != nil {
+			Logc(ctx).WithFields(logFields).WithError(err).Warning("Could not get pool capacity.")
+			continue
+		}
+
+		physicalPools := make([]string, len(poolCapacity.PhysicalPools))
+		copy(physicalPools, poolCapacity.PhysicalPools)
+		if len(physicalPools) == 0 {
+			physicalPools = append(physicalPools, pool.Name())
+		}
+		sort.Strings(physicalPools)
+
+		poolKey := backend.BackendUUID() + "/" + strings.Join(physicalPools, ",")
+		if countedPools[poolKey] {
+			Logc(ctx).WithFields(logFields).Trace("Pool capacity already counted.")
+			continue
+		}
+		countedPools[poolKey] = true
+
+		capacity.TotalBytes += poolCapacity.TotalBytes
+		capacity.AvailableBytes += poolCapacity.FreeBytes
+		if poolCapacity.FreeBytes > capacity.MaximumVolumeBytes {
+			capacity.MaximumVolumeBytes = poolCapacity.FreeBytes
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"storageClass":       scName,
+		"topology":           topology,
+		"totalBytes":         capacity.TotalBytes,
+		"availableBytes":     capacity.AvailableBytes,
+		"maximumVolumeBytes": capacity.MaximumVolumeBytes,
+	}).Debug("Calculated storage class capacity.")
+
+	return capacity, nil
+}
+
 func (o *TridentOrchestrator) reconcileNodeAccessOnAllBackends(ctx context.Context) error {
 	if config.CurrentDriverContext != config.ContextCSI {
 		return nil
@@ -5432,6 +6408,14 @@ func (o *TridentOrchestrator) isCRDContext(ctx context.Context) bool {
 	return ctxSource != nil && ctxSource == ContextSourceCRD
 }
 
+// isCredentialRotationContext checks if the context is for a backend update made by Trident itself after the
+// backend's externally stored credentials changed.
+func (o *TridentOrchestrator) isCredentialRotationContext(ctx context.Context) bool {
+	ctxSource := ctx.Value(ContextKeyRequestSource)
+	return ctxSource != nil && ctxSource == ContextSourcePeriodic &&
+		ctx.Value(ContextKeyWorkflow) == WorkflowBackendRotate
+}
+
 // EstablishMirror creates a net-new replication mirror relationship between 2 volumes on a backend
 func (o *TridentOrchestrator) EstablishMirror(ctx context.Context, backendUUID, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule string) (err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
@@ -5616,6 +6600,200 @@ func (o *TridentOrchestrator) GetCHAP(
 	return backend.GetChapInfo(ctx, volumeName, nodeName)
 }
 
+// GetVolumeHealth reports whether a volume is in an abnormal condition on its storage backend.
+func (o *TridentOrchestrator) GetVolumeHealth(
+	ctx context.Context, volumeName string,
+) (health *storage.VolumeHealth, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_health", &err)()
+
+	o.mutex.Lock()
+
+	volume, ok := o.subordinateVolumes[volumeName]
+	if ok {
+		// A subordinate volume is only as healthy as its source volume
+		if volume, ok = o.volumes[volume.Config.ShareSourceVolume]; !ok {
+			o.mutex.Unlock()
+			return nil, utils.NotFoundError(fmt.Sprintf("source volume for subordinate volume %s not found",
+				volumeName))
+		}
+	} else if volume, ok = o.volumes[volumeName]; !ok {
+		o.mutex.Unlock()
+		return nil, utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
+	}
+
+	if volume.State.IsMissingBackend() {
+		o.mutex.Unlock()
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("backend for volume %s is missing", volume.Config.Name),
+		}, nil
+	}
+
+	backend, ok := o.backends[volume.BackendUUID]
+	if !ok {
+		o.mutex.Unlock()
+		// Not a not found error because this is not user input
+		return nil, fmt.Errorf("backend %s not found", volume.BackendUUID)
+	}
+
+	volConfig := volume.Config.ConstructClone()
+
+	// Don't hold the lock while the backend is queried, as health checks are frequent and may be slow
+	o.mutex.Unlock()
+
+	if backend.State().IsFailed() {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("backend %s has failed", backend.Name()),
+		}, nil
+	}
+
+	if !backend.CanCheckVolumeHealth() {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"volume health checks are not implemented by backends of type %v", backend.GetDriverName()))
+	}
+
+	if health, err = backend.GetVolumeHealth(ctx, volConfig); err != nil {
+		return nil, err
+	}
+
+	if health.Abnormal {
+		Logc(ctx).WithFields(LogFields{
+			"volume":  volumeName,
+			"backend": backend.Name(),
+			"message": health.Message,
+		}).Warning("Volume is in an abnormal condition.")
+	}
+
+	return health, nil
+}
+
+// UpdateVolumeUsage records the space used in a volume's filesystem, as reported by a node on which the volume
+// is mounted.  The usage is kept only in memory, since nodes report it periodically.
+func (o *TridentOrchestrator) UpdateVolumeUsage(
+	ctx context.Context, volumeName string, usage *storage.VolumeUsage,
+) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	defer recordTiming("volume_usage_update", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if _, ok := o.subordinateVolumes[volumeName]; ok {
+		// Subordinate volumes share the space of their source volume, which is grown on its own
+		return nil
+	}
+	if _, ok := o.volumes[volumeName]; !ok {
+		return utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
+	}
+	if usage.UsedBytes < 0 || usage.TotalBytes < 0 {
+		return utils.InvalidInputError(fmt.Sprintf("invalid usage reported for volume %s", volumeName))
+	}
+
+	o.volumeUsage[volumeName] = &storage.VolumeUsage{
+		UsedBytes:  usage.UsedBytes,
+		TotalBytes: usage.TotalBytes,
+		Reported:   time.Now().UTC().Format(time.RFC3339),
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":     volumeName,
+		"usedBytes":  usage.UsedBytes,
+		"totalBytes": usage.TotalBytes,
+	}).Trace("Updated volume usage.")
+
+	return nil
+}
+
+// GetVolumeMetrics returns the space used by each volume and its performance, as reported by the backends that
+// can report them.  Each backend is asked once for the metrics of all of its volumes, concurrently with the other
+// backends.  A backend that fails is left out, so that it cannot hide the metrics of the others.
+func (o *TridentOrchestrator) GetVolumeMetrics(
+	ctx context.Context,
+) (volumeMetrics []*storage.VolumeMetricsExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_metrics", &err)()
+
+	o.mutex.Lock()
+
+	backends := make(map[string]storage.Backend)
+	backendVolumes := make(map[string][]*storage.VolumeConfig)
+	for _, volume := range o.volumes {
+		if volume.State.IsDeleting() || volume.State.IsMissingBackend() {
+			continue
+		}
+		backend, ok := o.backends[volume.BackendUUID]
+		if !ok || !backend.State().IsOnline() || !backend.CanReportVolumeMetrics() {
+			continue
+		}
+		backends[volume.BackendUUID] = backend
+		backendVolumes[volume.BackendUUID] = append(backendVolumes[volume.BackendUUID],
+			volume.Config.ConstructClone())
+	}
+
+	// Don't hold the lock while the backends are queried, as that may be slow
+	o.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	var metricsMutex sync.Mutex
+	volumeMetrics = make([]*storage.VolumeMetricsExternal, 0)
+
+	for backendUUID, volConfigs := range backendVolumes {
+		wg.Add(1)
+		go func(backend storage.Backend, volConfigs []*storage.VolumeConfig) {
+			defer wg.Done()
+
+			metrics, err := backend.GetVolumeMetrics(ctx, volConfigs)
+			if err != nil {
+				logEntry := Logc(ctx).WithField("backend", backend.Name()).WithError(err)
+				if utils.IsUnsupportedError(err) {
+					logEntry.Debug("Backend cannot report volume metrics.")
+				} else {
+					logEntry.Warning("Could not get volume metrics from backend.")
+				}
+				return
+			}
+
+			metricsMutex.Lock()
+			defer metricsMutex.Unlock()
+
+			for _, volConfig := range volConfigs {
+				if m, ok := metrics[volConfig.Name]; ok {
+					volumeMetrics = append(volumeMetrics, &storage.VolumeMetricsExternal{
+						Volume:        volConfig.Name,
+						Backend:       backend.Name(),
+						RequestName:   volConfig.RequestName,
+						Namespace:     volConfig.Namespace,
+						VolumeMetrics: *m,
+					})
+				}
+			}
+		}(backends[backendUUID], volConfigs)
+	}
+
+	wg.Wait()
+
+	sort.Slice(volumeMetrics, func(i, j int) bool { return volumeMetrics[i].Volume < volumeMetrics[j].Volume })
+
+	return volumeMetrics, nil
+}
+
 /******************************************************************************
 REST API Handlers for retrieving and setting the current logging configuration.
 ******************************************************************************/
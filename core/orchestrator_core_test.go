@@ -892,6 +892,30 @@ func TestUpdateVolume_LUKSPassphraseNames(t *testing.T) {
 	// Not found
 	assert.Error(t, err)
 
+	// ////////////////////////////////////////////////////////////////////////////////////////////////////////////
+	// Negative case: volume is migrating
+	orchestrator = getOrchestrator(t, false)
+	vol = &storage.Volume{
+		Config:      &storage.VolumeConfig{Name: "test-vol", LUKSPassphraseNames: []string{}},
+		BackendUUID: "12345",
+	}
+	orchestrator.volumes[vol.Config.Name] = vol
+	err = orchestrator.storeClient.AddVolume(context.TODO(), vol)
+	assert.NoError(t, err)
+	orchestrator.volumeMigrations[vol.Config.Name] = &storage.VolumeTransaction{
+		Config:                vol.Config,
+		VolumeMigrationConfig: &storage.VolumeMigrationConfig{State: storage.VolumeMigrationMoving},
+		Op:                    storage.MigrateVolume,
+	}
+
+	err = orchestrator.UpdateVolume(context.TODO(), "test-vol", &[]string{"A"})
+	assert.True(t, utils.IsVolumeStateError(err))
+	assert.Empty(t, orchestrator.volumes[vol.Config.Name].Config.LUKSPassphraseNames)
+
+	storedVol, err = orchestrator.storeClient.GetVolume(context.TODO(), "test-vol")
+	assert.NoError(t, err)
+	assert.Empty(t, storedVol.Config.LUKSPassphraseNames)
+
 	// ////////////////////////////////////////////////////////////////////////////////////////////////////////////
 	// Negative case: bootstrap error
 	orchestrator = getOrchestrator(t, false)
@@ -2396,6 +2420,233 @@ func TestStorageClassOnlyBootstrap(t *testing.T) {
 	cleanup(t, orchestrator)
 }
 
+func TestGetCapacity(t *testing.T) {
+	const (
+		scName    = "capacity-sc"
+		poolBytes = uint64(100 * 1024 * 1024 * 1024)
+		// Each backend created by addBackend holds two 1 GB volumes
+		poolFreeBytes = poolBytes - 2000000000
+	)
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	addBackendStorageClass(t, orchestrator, "capacity-backend-1", scName, config.File)
+	addBackend(t, orchestrator, "capacity-backend-2", config.File)
+
+	capacity, err := orchestrator.GetCapacity(ctx(), scName, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*poolBytes, capacity.TotalBytes)
+	assert.Equal(t, 2*poolFreeBytes, capacity.AvailableBytes)
+	assert.Equal(t, poolFreeBytes, capacity.MaximumVolumeBytes)
+
+	_, err = orchestrator.AddVolume(ctx(), &storage.VolumeConfig{
+		Name:         "capacity-volume",
+		Size:         "1073741824",
+		Protocol:     config.File,
+		VolumeMode:   config.Filesystem,
+		AccessMode:   config.ReadWriteOnce,
+		StorageClass: scName,
+	})
+	assert.NoError(t, err)
+
+	capacity, err = orchestrator.GetCapacity(ctx(), scName, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*poolBytes, capacity.TotalBytes)
+	assert.Equal(t, 2*poolFreeBytes-1073741824, capacity.AvailableBytes)
+	assert.Equal(t, poolFreeBytes, capacity.MaximumVolumeBytes)
+
+	// Pools without supported topologies are accessible from any topology
+	capacity, err = orchestrator.GetCapacity(ctx(), scName, map[string]string{"topology.kubernetes.io/zone": "z1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2*poolBytes, capacity.TotalBytes)
+
+	_, err = orchestrator.GetCapacity(ctx(), "missing-sc", nil)
+	assert.True(t, utils.IsNotFoundError(err))
+}
+
+func TestGetVolumeHealth(t *testing.T) {
+	const (
+		backendName = "health-backend"
+		scName      = "health-sc"
+		volumeName  = "health-volume"
+	)
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	addBackendStorageClass(t, orchestrator, backendName, scName, config.File)
+	volume, err := orchestrator.AddVolume(ctx(), &storage.VolumeConfig{
+		Name:         volumeName,
+		Size:         "1073741824",
+		Protocol:     config.File,
+		VolumeMode:   config.Filesystem,
+		AccessMode:   config.ReadWriteOnce,
+		StorageClass: scName,
+	})
+	assert.NoError(t, err)
+
+	health, err := orchestrator.GetVolumeHealth(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.False(t, health.Abnormal)
+
+	// Inject an unhealthy state into the fake driver
+	backend, err := orchestrator.getBackendByBackendName(backendName)
+	assert.NoError(t, err)
+	f, ok := backend.Driver().(*fakedriver.StorageDriver)
+	if !ok {
+		t.Fatalf("%e", utils.TypeAssertionError("backend.Driver().(*fakedriver.StorageDriver)"))
+	}
+	assert.NoError(t, f.SetVolumeHealth(volume.Config.InternalName, true, "volume is offline"))
+
+	health, err = orchestrator.GetVolumeHealth(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+	assert.Equal(t, "volume is offline", health.Message)
+
+	// A volume deleted out of band is abnormal
+	delete(f.Volumes, volume.Config.InternalName)
+
+	health, err = orchestrator.GetVolumeHealth(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+
+	_, err = orchestrator.GetVolumeHealth(ctx(), "missing-volume")
+	assert.True(t, utils.IsNotFoundError(err))
+}
+
+func TestGetVolumeHealth_Unsupported(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	volume := &storage.Volume{
+		Config:      &storage.VolumeConfig{Name: "vol1", InternalName: "pvc_vol1"},
+		BackendUUID: "backend-uuid",
+		State:       storage.VolumeStateOnline,
+	}
+	o.volumes[volume.Config.Name] = volume
+	o.backends["backend-uuid"] = mockBackend
+
+	mockBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	mockBackend.EXPECT().GetDriverName().Return("mock").AnyTimes()
+	mockBackend.EXPECT().CanCheckVolumeHealth().Return(false)
+
+	_, err := o.GetVolumeHealth(ctx(), "vol1")
+	assert.True(t, utils.IsUnsupportedError(err))
+
+	// Volumes whose backend is missing are abnormal
+	volume.State = storage.VolumeStateMissingBackend
+
+	health, err := o.GetVolumeHealth(ctx(), "vol1")
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+}
+
+func TestGetVolumeMetrics(t *testing.T) {
+	const (
+		backendName = "metrics-backend"
+		scName      = "metrics-sc"
+	)
+
+	mockCtrl := gomock.NewController(t)
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	addBackendStorageClass(t, o, backendName, scName, config.File)
+	for _, volumeName := range []string{"metrics-volume-2", "metrics-volume-1"} {
+		volumeConfig := tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)
+		volumeConfig.RequestName = "pvc-" + volumeName
+		volumeConfig.Namespace = "default"
+		_, err := o.AddVolume(ctx(), volumeConfig)
+		assert.NoError(t, err)
+	}
+
+	// A backend that fails does not hide the metrics of the others
+	failingBackend := mockstorage.NewMockBackend(mockCtrl)
+	failingBackend.EXPECT().Name().Return("failing-backend").AnyTimes()
+	failingBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	failingBackend.EXPECT().CanReportVolumeMetrics().Return(true).AnyTimes()
+	failingBackend.EXPECT().GetVolumeMetrics(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("failed"))
+	o.backends["failing-uuid"] = failingBackend
+	o.volumes["failing-volume"] = &storage.Volume{
+		Config:      &storage.VolumeConfig{Name: "failing-volume", InternalName: "failing-volume"},
+		BackendUUID: "failing-uuid",
+		State:       storage.VolumeStateOnline,
+	}
+
+	metrics, err := o.GetVolumeMetrics(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.VolumeMetricsExternal{
+		{
+			Volume:        "metrics-volume-1",
+			Backend:       backendName,
+			RequestName:   "pvc-metrics-volume-1",
+			Namespace:     "default",
+			VolumeMetrics: storage.VolumeMetrics{TotalBytes: 1073741824},
+		},
+		{
+			Volume:        "metrics-volume-2",
+			Backend:       backendName,
+			RequestName:   "pvc-metrics-volume-2",
+			Namespace:     "default",
+			VolumeMetrics: storage.VolumeMetrics{TotalBytes: 1073741824},
+		},
+	}, metrics)
+
+	delete(o.volumes, "failing-volume")
+	delete(o.backends, "failing-uuid")
+}
+
+func TestModifyVolume(t *testing.T) {
+	const (
+		backendName = "modify-backend"
+		scName      = "modify-sc"
+		volumeName  = "modify-volume"
+	)
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	addBackendStorageClass(t, o, backendName, scName, config.File)
+	volumeConfig := tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)
+	volumeConfig.SnapshotPolicy = "none"
+	_, err := o.AddVolume(ctx(), volumeConfig)
+	assert.NoError(t, err)
+
+	// Changed attributes are recorded in the cache and the store
+	volume, err := o.ModifyVolume(ctx(), volumeName, storage.VolumeModification{
+		storage.ModifiableSnapshotPolicy: "default",
+		storage.ModifiableSnapshotDir:    "true",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", volume.Config.SnapshotPolicy)
+	assert.Equal(t, "true", volume.Config.SnapshotDir)
+	assert.Equal(t, "default", o.volumes[volumeName].Config.SnapshotPolicy)
+	persistentVolume, err := inMemoryClient.GetVolume(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.Equal(t, "default", persistentVolume.Config.SnapshotPolicy)
+
+	// Invalid modifications are refused before reaching the backend
+	_, err = o.ModifyVolume(ctx(), volumeName, storage.VolumeModification{"size": "2Gi"})
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+
+	// Attributes the backend cannot change are refused, and the volume is left alone
+	_, err = o.ModifyVolume(ctx(), volumeName, storage.VolumeModification{
+		storage.ModifiableTieringPolicy:   "auto",
+		storage.ModifiableUnixPermissions: "0700",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "", o.volumes[volumeName].Config.TieringPolicy)
+	assert.NotEqual(t, "0700", o.volumes[volumeName].Config.UnixPermissions)
+
+	_, err = o.ModifyVolume(ctx(), "missing-volume", storage.VolumeModification{
+		storage.ModifiableSnapshotPolicy: "default",
+	})
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+}
+
 func TestFirstVolumeRecovery(t *testing.T) {
 	const (
 		backendName      = "firstRecoveryBackend"
@@ -2417,6 +2668,35 @@ func TestFirstVolumeRecovery(t *testing.T) {
 	cleanup(t, orchestrator)
 }
 
+func TestOrchestratorStandby(t *testing.T) {
+	orchestrator := NewTridentOrchestrator(inMemoryClient)
+	orchestrator.SetStandby(true)
+	assert.True(t, orchestrator.IsStandby())
+
+	// A standby controller reports its version, so that it is seen as alive, but is otherwise not ready
+	version, err := orchestrator.GetVersion(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, config.OrchestratorVersion.String(), version)
+
+	backends, err := orchestrator.ListBackends(ctx())
+	assert.Nil(t, backends)
+	assert.True(t, utils.IsNotReadyError(err))
+
+	// Bootstrapping ends the standby
+	assert.NoError(t, orchestrator.Bootstrap(false))
+	assert.False(t, orchestrator.IsStandby())
+
+	_, err = orchestrator.GetVersion(ctx())
+	assert.NoError(t, err)
+	_, err = orchestrator.ListBackends(ctx())
+	assert.NoError(t, err)
+
+	// A controller that is neither standing by nor bootstrapped reports that it is not ready
+	orchestrator = NewTridentOrchestrator(inMemoryClient)
+	_, err = orchestrator.GetVersion(ctx())
+	assert.True(t, utils.IsNotReadyError(err))
+}
+
 func TestOrchestratorNotReady(t *testing.T) {
 	var (
 		err            error
@@ -6661,6 +6941,368 @@ func TestDeleteSnapshotError(t *testing.T) {
 	}
 }
 
+func TestRestoreSnapshot(t *testing.T) {
+	backendUUID := "abcd"
+	volName := "vol"
+	snapName := "snap"
+	volume := &storage.Volume{Config: &storage.VolumeConfig{Name: volName}, BackendUUID: backendUUID}
+	snapshot := &storage.Snapshot{
+		Config:  &storage.SnapshotConfig{Name: snapName, VolumeName: volName},
+		Created: "2023-01-01T10:00:00Z",
+		State:   storage.SnapshotStateOnline,
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().Name().Return("backend").AnyTimes()
+	mockBackend.EXPECT().RestoreRequiresNewestSnapshot().Return(true)
+	mockBackend.EXPECT().RestoreSnapshot(gomock.Any(), snapshot.Config, volume.Config).Return(nil)
+
+	mockStoreClient := mockpersistentstore.NewMockStoreClient(mockCtrl)
+	mockStoreClient.EXPECT().GetVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockStoreClient.EXPECT().AddVolumeTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, txn *storage.VolumeTransaction) error {
+			assert.Equal(t, storage.RestoreSnapshot, txn.Op, "unexpected transaction operation")
+			return nil
+		})
+	mockStoreClient.EXPECT().DeleteVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+	o := getOrchestrator(t, false)
+	o.storeClient = mockStoreClient
+	o.backends[backendUUID] = mockBackend
+	o.volumes[volName] = volume
+	o.snapshots[snapshot.ID()] = snapshot
+
+	err := o.RestoreSnapshot(ctx(), volName, snapName)
+	assert.NoError(t, err, "unexpected error restoring snapshot")
+}
+
+func TestRestoreSnapshotError(t *testing.T) {
+	backendUUID := "abcd"
+	volName := "vol"
+	snapName := "snap"
+	newerSnapName := "newerSnap"
+
+	tests := []struct {
+		name                  string
+		volumeState           storage.VolumeState
+		snapshotState         storage.SnapshotState
+		noVolume              bool
+		noSnapshot            bool
+		noBackend             bool
+		published             bool
+		newerSnapshot         bool
+		requiresNewest        bool
+		restoreErr            error
+		expectRestore         bool
+		expectNotFound        bool
+		expectVolumeState     bool
+		expectInvalidInput    bool
+		expectUnsupported     bool
+		expectTransactionFlow bool
+	}{
+		{name: "VolumeNotFound", noVolume: true, expectNotFound: true},
+		{name: "VolumeDeleting", volumeState: storage.VolumeStateDeleting, expectVolumeState: true},
+		{name: "SnapshotNotFound", noSnapshot: true, expectNotFound: true},
+		{name: "SnapshotNotOnline", snapshotState: storage.SnapshotStateCreating},
+		{name: "BackendNotFound", noBackend: true, expectNotFound: true},
+		{name: "VolumePublished", published: true, expectVolumeState: true},
+		{name: "NotNewestSnapshot", newerSnapshot: true, requiresNewest: true, expectInvalidInput: true},
+		{
+			name:                  "RestoreUnsupported",
+			restoreErr:            utils.UnsupportedError("unsupported"),
+			expectRestore:         true,
+			expectUnsupported:     true,
+			expectTransactionFlow: true,
+		},
+		{
+			name:                  "RestoreFailed",
+			newerSnapshot:         true,
+			restoreErr:            errors.New("restore failed"),
+			expectRestore:         true,
+			expectTransactionFlow: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			volume := &storage.Volume{
+				Config: &storage.VolumeConfig{Name: volName}, BackendUUID: backendUUID, State: tt.volumeState,
+			}
+			snapshotState := storage.SnapshotStateOnline
+			if tt.snapshotState != "" {
+				snapshotState = tt.snapshotState
+			}
+			snapshot := &storage.Snapshot{
+				Config:  &storage.SnapshotConfig{Name: snapName, VolumeName: volName},
+				Created: "2023-01-01T10:00:00Z",
+				State:   snapshotState,
+			}
+			newerSnapshot := &storage.Snapshot{
+				Config:  &storage.SnapshotConfig{Name: newerSnapName, VolumeName: volName},
+				Created: "2023-01-01T11:00:00Z",
+				State:   storage.SnapshotStateOnline,
+			}
+
+			mockBackend := mockstorage.NewMockBackend(mockCtrl)
+			mockBackend.EXPECT().Name().Return("backend").AnyTimes()
+			mockBackend.EXPECT().RestoreRequiresNewestSnapshot().Return(tt.requiresNewest).AnyTimes()
+			if tt.expectRestore {
+				mockBackend.EXPECT().RestoreSnapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.restoreErr)
+			}
+
+			mockStoreClient := mockpersistentstore.NewMockStoreClient(mockCtrl)
+			if tt.expectTransactionFlow {
+				mockStoreClient.EXPECT().GetVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockStoreClient.EXPECT().AddVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil)
+				mockStoreClient.EXPECT().DeleteVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			o := getOrchestrator(t, false)
+			o.storeClient = mockStoreClient
+			if !tt.noBackend {
+				o.backends[backendUUID] = mockBackend
+			}
+			if !tt.noVolume {
+				o.volumes[volName] = volume
+			}
+			if !tt.noSnapshot {
+				o.snapshots[snapshot.ID()] = snapshot
+			}
+			if tt.newerSnapshot {
+				o.snapshots[newerSnapshot.ID()] = newerSnapshot
+			}
+			if tt.published {
+				_ = o.volumePublications.Set(volName, "node1",
+					&utils.VolumePublication{Name: volName + "/node1", VolumeName: volName, NodeName: "node1"})
+			}
+
+			err := o.RestoreSnapshot(ctx(), volName, snapName)
+			assert.Error(t, err, "expected error restoring snapshot")
+			assert.Equal(t, tt.expectNotFound, utils.IsNotFoundError(err), "unexpected not found error")
+			assert.Equal(t, tt.expectVolumeState, utils.IsVolumeStateError(err), "unexpected volume state error")
+			assert.Equal(t, tt.expectInvalidInput, utils.IsInvalidInputError(err), "unexpected invalid input error")
+			assert.Equal(t, tt.expectUnsupported, utils.IsUnsupportedError(err), "unexpected unsupported error")
+		})
+	}
+}
+
+func TestGroupSnapshot(t *testing.T) {
+	const (
+		backendName     = "groupSnapBackend"
+		scName          = "groupSnapSC"
+		groupName       = "groupsnapshot-1234"
+		backendProtocol = config.File
+	)
+	volumeNames := []string{"groupSnapVolume1", "groupSnapVolume2"}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	addBackendStorageClass(t, orchestrator, backendName, scName, backendProtocol)
+	for _, volumeName := range volumeNames {
+		if _, err := orchestrator.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName,
+			config.File)); err != nil {
+			t.Fatal("Unable to create volume: ", err)
+		}
+	}
+
+	groupConfig := &storage.GroupSnapshotConfig{Name: groupName, VolumeNames: volumeNames}
+	groupSnapshot, err := orchestrator.CreateGroupSnapshot(ctx(), groupConfig)
+	assert.NoError(t, err, "unexpected error creating group snapshot")
+	assert.Equal(t, groupName, groupSnapshot.ID())
+	assert.Len(t, groupSnapshot.Snapshots, len(volumeNames))
+	for _, volumeName := range volumeNames {
+		snapshot, err := orchestrator.GetSnapshot(ctx(), volumeName, groupName)
+		assert.NoError(t, err, "member snapshot not found")
+		assert.Equal(t, groupName, snapshot.Config.InternalName)
+	}
+
+	// Creating the same group snapshot again returns the existing one
+	groupSnapshot, err = orchestrator.CreateGroupSnapshot(ctx(),
+		&storage.GroupSnapshotConfig{Name: groupName, VolumeNames: []string{volumeNames[1], volumeNames[0]}})
+	assert.NoError(t, err, "unexpected error repeating group snapshot creation")
+	assert.Len(t, groupSnapshot.Snapshots, len(volumeNames))
+
+	// Reusing the name for a different set of volumes is an error
+	_, err = orchestrator.CreateGroupSnapshot(ctx(),
+		&storage.GroupSnapshotConfig{Name: groupName, VolumeNames: volumeNames[:1]})
+	assert.True(t, utils.IsFoundError(err), "expected found error")
+
+	groupSnapshots, err := orchestrator.ListGroupSnapshots(ctx())
+	assert.NoError(t, err, "unexpected error listing group snapshots")
+	assert.Len(t, groupSnapshots, 1)
+
+	// The group snapshot must survive a restart
+	newOrchestrator := getOrchestrator(t, false)
+	bootstrappedGroupSnapshot, err := newOrchestrator.GetGroupSnapshot(ctx(), groupName)
+	assert.NoError(t, err, "group snapshot not found after bootstrap")
+	assert.ElementsMatch(t, volumeNames, bootstrappedGroupSnapshot.Config.VolumeNames)
+	assert.Len(t, bootstrappedGroupSnapshot.Snapshots, len(volumeNames))
+
+	err = orchestrator.DeleteGroupSnapshot(ctx(), groupName)
+	assert.NoError(t, err, "unexpected error deleting group snapshot")
+	for _, volumeName := range volumeNames {
+		_, err = orchestrator.GetSnapshot(ctx(), volumeName, groupName)
+		assert.True(t, utils.IsNotFoundError(err), "member snapshot not deleted")
+	}
+	_, err = orchestrator.GetGroupSnapshot(ctx(), groupName)
+	assert.True(t, utils.IsNotFoundError(err), "group snapshot not deleted")
+	_, err = inMemoryClient.GetGroupSnapshot(ctx(), groupName)
+	assert.True(t, persistentstore.MatchKeyNotFoundErr(err), "group snapshot not deleted from store")
+
+	err = orchestrator.DeleteGroupSnapshot(ctx(), groupName)
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+}
+
+func TestCreateGroupSnapshotError(t *testing.T) {
+	volumeNames := []string{"vol1", "vol2"}
+	groupName := "group"
+
+	tests := []struct {
+		name               string
+		volumeNames        []string
+		secondBackend      bool
+		createErr          error
+		unsupported        bool
+		noGroupSnapshots   bool
+		expectCreate       bool
+		expectNotFound     bool
+		expectInvalidInput bool
+		expectUnsupported  bool
+	}{
+		{name: "NoVolumes", volumeNames: []string{}, expectInvalidInput: true},
+		{name: "DuplicateVolume", volumeNames: []string{"vol1", "vol1"}, expectInvalidInput: true},
+		{name: "VolumeNotFound", volumeNames: []string{"vol1", "vol3"}, expectNotFound: true},
+		{name: "DifferentBackends", volumeNames: volumeNames, secondBackend: true, expectInvalidInput: true},
+		{name: "CannotSnapshot", volumeNames: volumeNames, unsupported: true, expectUnsupported: true},
+		{name: "NoGroupSnapshots", volumeNames: volumeNames, noGroupSnapshots: true, expectUnsupported: true},
+		{name: "CreateFailed", volumeNames: volumeNames, createErr: errors.New("failed"), expectCreate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockBackend := mockstorage.NewMockBackend(mockCtrl)
+			mockBackend.EXPECT().Name().Return("backend1").AnyTimes()
+			mockBackend.EXPECT().BackendUUID().Return("uuid1").AnyTimes()
+			mockBackend.EXPECT().GetDriverName().Return("fake").AnyTimes()
+			mockBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+			mockBackend.EXPECT().SupportsGroupSnapshots().Return(!tt.noGroupSnapshots).AnyTimes()
+			if tt.unsupported {
+				mockBackend.EXPECT().CanSnapshot(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(utils.UnsupportedError("snapshots are not supported")).AnyTimes()
+			} else {
+				mockBackend.EXPECT().CanSnapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			}
+			mockBackend2 := mockstorage.NewMockBackend(mockCtrl)
+			mockBackend2.EXPECT().Name().Return("backend2").AnyTimes()
+			mockBackend2.EXPECT().BackendUUID().Return("uuid2").AnyTimes()
+			mockBackend2.EXPECT().GetDriverName().Return("fake").AnyTimes()
+			mockBackend2.EXPECT().State().Return(storage.Online).AnyTimes()
+
+			mockStoreClient := mockpersistentstore.NewMockStoreClient(mockCtrl)
+			if tt.expectCreate {
+				mockBackend.EXPECT().CreateGroupSnapshot(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(nil, tt.createErr)
+				mockStoreClient.EXPECT().GetVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				mockStoreClient.EXPECT().AddVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mockStoreClient.EXPECT().DeleteVolumeTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			}
+
+			o := getOrchestrator(t, false)
+			o.storeClient = mockStoreClient
+			o.backends["uuid1"] = mockBackend
+			o.backends["uuid2"] = mockBackend2
+			o.volumes["vol1"] = &storage.Volume{Config: &storage.VolumeConfig{Name: "vol1"}, BackendUUID: "uuid1"}
+			o.volumes["vol2"] = &storage.Volume{Config: &storage.VolumeConfig{Name: "vol2"}, BackendUUID: "uuid1"}
+			if tt.secondBackend {
+				o.volumes["vol2"].BackendUUID = "uuid2"
+			}
+
+			_, err := o.CreateGroupSnapshot(ctx(),
+				&storage.GroupSnapshotConfig{Name: groupName, VolumeNames: tt.volumeNames})
+			assert.Error(t, err, "expected error creating group snapshot")
+			assert.Equal(t, tt.expectNotFound, utils.IsNotFoundError(err), "unexpected not found error")
+			assert.Equal(t, tt.expectInvalidInput, utils.IsInvalidInputError(err), "unexpected invalid input error")
+			assert.Equal(t, tt.expectUnsupported, utils.IsUnsupportedError(err), "unexpected unsupported error")
+			assert.Empty(t, o.groupSnapshots, "group snapshot should not exist")
+		})
+	}
+}
+
+func TestSnapshotPolicy(t *testing.T) {
+	const policyName = "nightly"
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	policyConfig := &storage.SnapshotPolicyConfig{
+		Name:           policyName,
+		Schedule:       "0 2 * * *",
+		RetentionCount: 7,
+		StorageClasses: []string{"gold"},
+	}
+	policy, err := orchestrator.AddSnapshotPolicy(ctx(), policyConfig)
+	assert.NoError(t, err, "unexpected error adding snapshot policy")
+	assert.Equal(t, policyName, policy.ID())
+	assert.Equal(t, config.OrchestratorAPIVersion, policy.Config.Version)
+	assert.NotEmpty(t, policy.NextRun)
+
+	_, err = orchestrator.AddSnapshotPolicy(ctx(), policyConfig)
+	assert.True(t, utils.IsFoundError(err), "expected found error")
+
+	_, err = orchestrator.AddSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{Name: "invalid", Schedule: "daily"})
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+
+	// An update replaces the configuration but keeps the time of the last run
+	orchestrator.snapshotPolicies[policyName].LastRun = "2023-05-01T02:00:00Z"
+	policy, err = orchestrator.UpdateSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name:           policyName,
+		Schedule:       "0 3 * * *",
+		RetentionAge:   "168h",
+		StorageClasses: []string{"gold"},
+	})
+	assert.NoError(t, err, "unexpected error updating snapshot policy")
+	assert.Equal(t, "0 3 * * *", policy.Config.Schedule)
+	assert.Equal(t, "2023-05-01T02:00:00Z", policy.LastRun)
+	assert.Equal(t, "2023-05-01T03:00:00Z", policy.NextRun)
+
+	_, err = orchestrator.UpdateSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name:           "missing",
+		Schedule:       "@daily",
+		RetentionCount: 1,
+		StorageClasses: []string{"gold"},
+	})
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+
+	policies, err := orchestrator.ListSnapshotPolicies(ctx())
+	assert.NoError(t, err, "unexpected error listing snapshot policies")
+	assert.Len(t, policies, 1)
+
+	// The snapshot policy must survive a restart
+	newOrchestrator := getOrchestrator(t, false)
+	bootstrappedPolicy, err := newOrchestrator.GetSnapshotPolicy(ctx(), policyName)
+	assert.NoError(t, err, "snapshot policy not found after bootstrap")
+	assert.Equal(t, "168h", bootstrappedPolicy.Config.RetentionAge)
+
+	err = orchestrator.DeleteSnapshotPolicy(ctx(), policyName)
+	assert.NoError(t, err, "unexpected error deleting snapshot policy")
+	_, err = orchestrator.GetSnapshotPolicy(ctx(), policyName)
+	assert.True(t, utils.IsNotFoundError(err), "snapshot policy not deleted")
+	_, err = inMemoryClient.GetSnapshotPolicy(ctx(), policyName)
+	assert.True(t, persistentstore.MatchKeyNotFoundErr(err), "snapshot policy not deleted from store")
+
+	err = orchestrator.DeleteSnapshotPolicy(ctx(), policyName)
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+}
+
 func TestHandleFailedSnapshot(t *testing.T) {
 	backendUUID := "abcd"
 	snapName := "snap"
@@ -6723,13 +7365,15 @@ func TestListLogWorkflows(t *testing.T) {
 
 	flows, err := o.ListLoggingWorkflows(ctx())
 	expected := []string{
-		"backend=create,delete,get,list,update", "controller=get_capabilities,publish,unpublish",
-		"core=bootstrap,init,node_reconcile,version", "cr=reconcile", "crd_controller=create", "grpc=trace",
+		"backend=create,delete,get,list,rotate,update", "controller=get_capabilities,publish,unpublish",
+		"core=bootstrap,init,node_reconcile,version", "cr=reconcile", "crd_controller=create",
+		"group_snapshot=create,delete,get,get_capabilities", "grpc=trace",
 		"k8s_client=trace_api,trace_factory", "node=create,delete,get,get_capabilities,get_info,get_response,list,update",
 		"node_server=publish,stage,unpublish,unstage", "plugin=activate,create,deactivate,get,list",
-		"snapshot=clone_from,create,delete,get,list,update", "storage_class=create,delete,get,list,update",
+		"snapshot=clone_from,create,delete,get,list,restore,update",
+		"snapshot_policy=create,delete,get,list,schedule,update", "storage_class=create,delete,get,get_capacity,list,update",
 		"storage_client=create", "trident_rest=logger",
-		"volume=clone,create,delete,get,get_capabilities,get_path,get_stats,import,list,mount,resize,unmount,update,upgrade",
+		"volume=autogrow,clone,create,delete,get,get_capabilities,get_health,get_path,get_stats,import,list,migrate,mount,resize,unmount,update,upgrade",
 	}
 	assert.Equal(t, expected, flows)
 	assert.NoError(t, err)
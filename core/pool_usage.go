@@ -0,0 +1,196 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// PoolRebalanceThreshold is how far the used fraction of a pool must exceed the mean of all pools before volumes
+// are suggested for migration out of it.
+const PoolRebalanceThreshold = 0.2
+
+// GetPoolUsage reports how full each storage pool is, and suggests volume migrations that would even out the
+// usage of the pools.  The report is read-only; suggested migrations may be started with MigrateVolume.
+func (o *TridentOrchestrator) GetPoolUsage(ctx context.Context) (report *storage.PoolUsageReport, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("pool_usage_get", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	report = &storage.PoolUsageReport{Pools: make([]*storage.PoolUsage, 0)}
+	for _, backend := range o.backends {
+		if !backend.State().IsOnline() {
+			continue
+		}
+		for _, pool := range backend.Storage() {
+			report.Pools = append(report.Pools, storage.GetPoolUsage(ctx, pool))
+		}
+	}
+
+	sort.Slice(report.Pools, func(i, j int) bool {
+		if report.Pools[i].Backend != report.Pools[j].Backend {
+			return report.Pools[i].Backend < report.Pools[j].Backend
+		}
+		return report.Pools[i].Pool < report.Pools[j].Pool
+	})
+
+	report.Suggestions = o.suggestPoolRebalancing(ctx, report.Pools)
+
+	return report, nil
+}
+
+// suggestPoolRebalancing suggests migrating the largest volumes out of each pool that is much fuller than the
+// mean, into the emptiest pool that can take them, until the pool is no longer much fuller than the mean.  Only
+// physical pools whose capacity is known are considered, and only migrations that MigrateVolume would accept are
+// suggested.  The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) suggestPoolRebalancing(
+	ctx context.Context, usages []*storage.PoolUsage,
+) []*storage.PoolRebalanceSuggestion {
+	// Work on copies of the usages, which are updated as migrations are suggested
+	pools := make([]*storage.PoolUsage, 0, len(usages))
+	meanUsed := 0.0
+	for _, usage := range usages {
+		if usage.HasCapacity() && usage.IsPhysical() {
+			poolCopy := *usage
+			pools = append(pools, &poolCopy)
+			meanUsed += usage.UsedFraction()
+		}
+	}
+	if len(pools) < 2 {
+		return nil
+	}
+	meanUsed /= float64(len(pools))
+
+	// Consider the fullest pools first
+	sort.SliceStable(pools, func(i, j int) bool {
+		return pools[i].UsedFraction() > pools[j].UsedFraction()
+	})
+
+	suggestions := make([]*storage.PoolRebalanceSuggestion, 0)
+	for _, source := range pools {
+		if source.UsedFraction() <= meanUsed+PoolRebalanceThreshold {
+			continue
+		}
+
+		for _, volume := range o.getRebalanceCandidateVolumes(source) {
+			if source.UsedFraction() <= meanUsed+PoolRebalanceThreshold {
+				break
+			}
+			sizeBytes, _ := strconv.ParseUint(volume.Config.Size, 10, 64)
+
+			target := o.getRebalanceTargetPool(volume, source, pools, sizeBytes)
+			if target == nil {
+				continue
+			}
+
+			source.FreeBytes += sizeBytes
+			target.FreeBytes -= sizeBytes
+			source.Volumes--
+			target.Volumes++
+
+			suggestions = append(suggestions, &storage.PoolRebalanceSuggestion{
+				Volume:        volume.Config.Name,
+				SizeBytes:     sizeBytes,
+				SourceBackend: source.Backend,
+				SourcePool:    source.Pool,
+				TargetBackend: target.Backend,
+				TargetPool:    target.Pool,
+			})
+		}
+	}
+
+	Logc(ctx).WithField("suggestions", len(suggestions)).Debug("Suggested pool rebalancing.")
+
+	return suggestions
+}
+
+// getRebalanceCandidateVolumes returns the volumes in a pool that may be migrated, the largest first.
+// The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) getRebalanceCandidateVolumes(pool *storage.PoolUsage) []*storage.Volume {
+	backend, ok := o.backends[pool.BackendUUID]
+	if !ok {
+		return nil
+	}
+
+	volumes := make([]*storage.Volume, 0)
+	for _, volume := range backend.Volumes() {
+		if volume.Pool != pool.Pool || volume.State.IsDeleting() || volume.Orphaned ||
+			volume.Config.ImportNotManaged || volume.Config.IsMirrorDestination ||
+			len(volume.Config.SubordinateVolumes) > 0 || o.isVolumeMigrating(volume.Config.Name) {
+			continue
+		}
+		if sizeBytes, err := strconv.ParseUint(volume.Config.Size, 10, 64); err != nil || sizeBytes == 0 {
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		sizeI, _ := strconv.ParseUint(volumes[i].Config.Size, 10, 64)
+		sizeJ, _ := strconv.ParseUint(volumes[j].Config.Size, 10, 64)
+		if sizeI != sizeJ {
+			return sizeI > sizeJ
+		}
+		return volumes[i].Config.Name < volumes[j].Config.Name
+	})
+
+	return volumes
+}
+
+// getRebalanceTargetPool returns the emptiest pool to which a volume could be migrated from the source pool, such
+// that the target ends up less full than the source, or nil if there is none.  The caller should hold the
+// orchestrator lock.
+func (o *TridentOrchestrator) getRebalanceTargetPool(
+	volume *storage.Volume, source *storage.PoolUsage, pools []*storage.PoolUsage, sizeBytes uint64,
+) *storage.PoolUsage {
+	sourceBackend, ok := o.backends[source.BackendUUID]
+	if !ok {
+		return nil
+	}
+
+	sourceAfter := *source
+	sourceAfter.FreeBytes += sizeBytes
+
+	var best *storage.PoolUsage
+	for _, target := range pools {
+		if target == source || target.FreeBytes < sizeBytes {
+			continue
+		}
+
+		targetBackend, ok := o.backends[target.BackendUUID]
+		if !ok {
+			continue
+		}
+		if target.BackendUUID == source.BackendUUID {
+			if !sourceBackend.CanMoveVolumes() {
+				continue
+			}
+		} else if o.canMigrateVolumeBetweenBackends(volume, sourceBackend, targetBackend) != nil {
+			continue
+		}
+
+		targetAfter := *target
+		targetAfter.FreeBytes -= sizeBytes
+		if targetAfter.UsedFraction() >= sourceAfter.UsedFraction() {
+			continue
+		}
+
+		if best == nil || target.UsedFraction() < best.UsedFraction() {
+			best = target
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/fake"
+	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+func TestGetPoolUsage(t *testing.T) {
+	const (
+		backendName = "usageBackend"
+		scName      = "usageSC"
+		gib         = 1024 * 1024 * 1024
+	)
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	pool := func(sizeBytes uint64) *fake.StoragePool {
+		return &fake.StoragePool{
+			Attrs: map[string]sa.Offer{
+				sa.Media:            sa.NewStringOffer("hdd"),
+				sa.ProvisioningType: sa.NewStringOffer("thick", "thin"),
+				sa.TestingAttribute: sa.NewBoolOffer(true),
+			},
+			Bytes: sizeBytes,
+		}
+	}
+	configJSON, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File,
+		map[string]*fake.StoragePool{"primary": pool(10 * gib), "secondary": pool(100 * gib)}, []fake.Volume{})
+	if err != nil {
+		t.Fatal("Unable to create mock driver config JSON: ", err)
+	}
+	if _, err = o.AddBackend(ctx(), configJSON, ""); err != nil {
+		t.Fatalf("Unable to add backend: %v", err)
+	}
+
+	// Fill the smaller pool to 80%
+	_, err = o.AddStorageClass(ctx(), &storageclass.Config{
+		Name:  scName,
+		Pools: map[string][]string{backendName: {"primary"}},
+	})
+	if err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+	for i := 0; i < 4; i++ {
+		volumeName := fmt.Sprintf("usageVolume%d", i)
+		if _, err = o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 2, scName, config.File)); err != nil {
+			t.Fatal("Unable to create volume: ", err)
+		}
+	}
+
+	report, err := o.GetPoolUsage(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, report.Pools, 2)
+
+	primary, secondary := report.Pools[0], report.Pools[1]
+	assert.Equal(t, "primary", primary.Pool)
+	assert.Equal(t, uint64(10*gib), primary.TotalBytes)
+	assert.Equal(t, uint64(2*gib), primary.FreeBytes)
+	assert.Equal(t, 4, primary.Volumes)
+	assert.InDelta(t, 0.8, primary.UsedFraction(), 0.001)
+	assert.Equal(t, "secondary", secondary.Pool)
+	assert.Equal(t, 0, secondary.Volumes)
+	assert.Equal(t, 0.0, secondary.UsedFraction())
+
+	// Moving one volume brings the fuller pool within the threshold of the mean
+	expected := []*storage.PoolRebalanceSuggestion{{
+		Volume:        "usageVolume0",
+		SizeBytes:     2 * gib,
+		SourceBackend: backendName,
+		SourcePool:    "primary",
+		TargetBackend: backendName,
+		TargetPool:    "secondary",
+	}}
+	assert.Equal(t, expected, report.Suggestions)
+
+	// The suggestions do not change the pools
+	assert.Equal(t, "primary", o.volumes["usageVolume0"].Pool)
+
+	// Volumes already migrating are not suggested
+	_, err = o.MigrateVolume(ctx(), "usageVolume0", backendName, "secondary")
+	assert.NoError(t, err)
+	report, err = o.GetPoolUsage(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, report.Suggestions, 1)
+	assert.Equal(t, "usageVolume1", report.Suggestions[0].Volume)
+
+	// Complete the migration, so that its transaction is removed
+	o.advanceVolumeMigrations(ctx())
+	assert.Empty(t, o.volumeMigrations)
+}
+
+func TestAddStorageClassInvalidPlacementPolicy(t *testing.T) {
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	_, err := o.AddStorageClass(ctx(), &storageclass.Config{
+		Name:            "placementSC",
+		PlacementPolicy: storageclass.PlacementPolicy("mostUsed"),
+	})
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+
+	_, err = o.AddStorageClass(ctx(), &storageclass.Config{
+		Name:            "placementSC",
+		PlacementPolicy: storageclass.PlacementLeastUsed,
+	})
+	assert.NoError(t, err)
+}
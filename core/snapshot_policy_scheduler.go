@@ -0,0 +1,242 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// SnapshotPolicyCheckPeriod is the interval at which snapshot policy schedules are evaluated, which is also the
+// finest granularity of a cron schedule.
+const SnapshotPolicyCheckPeriod = time.Minute
+
+// dueSnapshotPolicy is a scheduled run of a snapshot policy along with the volumes it selected.
+type dueSnapshotPolicy struct {
+	config      *storage.SnapshotPolicyConfig
+	volumeNames []string
+}
+
+// PeriodicallyRunSnapshotPolicies is intended to be run as a goroutine by the single Trident controller, since
+// it creates and deletes snapshots.  On every period it runs the snapshot policies whose schedules have come due
+// and prunes the snapshots that have fallen outside their policy's retention.
+func (o *TridentOrchestrator) PeriodicallyRunSnapshotPolicies() {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowSnapshotPolicySchedule,
+		LogLayerCore)
+
+	Logc(ctx).Info("Starting snapshot policy scheduler.")
+	defer Logc(ctx).Info("Stopping snapshot policy scheduler.")
+
+	o.stopSnapshotPolicyLoop = make(chan bool)
+	ticker := time.NewTicker(SnapshotPolicyCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stopSnapshotPolicyLoop:
+			// Exit on shutdown signal
+			return
+
+		case tick := <-ticker.C:
+			if o.bootstrapError != nil {
+				Logc(ctx).WithError(o.bootstrapError).Trace("Snapshot policy scheduler blocked by bootstrap error.")
+				continue
+			}
+			Logc(ctx).WithField("tick", tick).Trace("Snapshot policy scheduler running.")
+			o.runSnapshotPolicies(ctx, tick.UTC())
+		}
+	}
+}
+
+// runSnapshotPolicies creates snapshots for each policy whose schedule has come due by the given time, and
+// then prunes the snapshots of all policies.
+func (o *TridentOrchestrator) runSnapshotPolicies(ctx context.Context, now time.Time) {
+	for _, due := range o.claimDueSnapshotPolicies(ctx, now) {
+		o.createPolicySnapshots(ctx, due, now)
+	}
+	o.prunePolicySnapshots(ctx, now)
+}
+
+// claimDueSnapshotPolicies returns the snapshot policies whose next run is due, recording the run in the
+// persistent store before any snapshot is created.  A policy whose run cannot be recorded is skipped, so a
+// restart can never repeat a run.  Runs missed while Trident was down are collapsed into one.
+func (o *TridentOrchestrator) claimDueSnapshotPolicies(ctx context.Context, now time.Time) []*dueSnapshotPolicy {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	dueRuns := make([]*dueSnapshotPolicy, 0)
+
+	for _, policy := range o.snapshotPolicies {
+		nextRun := policy.NextRun()
+		if nextRun.IsZero() || nextRun.After(now) {
+			continue
+		}
+
+		updatedPolicy := policy.ConstructClone()
+		updatedPolicy.LastRun = now.Format(time.RFC3339)
+		if err := o.storeClient.UpdateSnapshotPolicy(ctx, updatedPolicy); err != nil {
+			Logc(ctx).WithField("snapshotPolicy", policy.ID()).WithError(err).Error(
+				"Could not record snapshot policy run; skipping it.")
+			continue
+		}
+		o.snapshotPolicies[updatedPolicy.ID()] = updatedPolicy
+
+		volumeNames := make([]string, 0)
+		for volumeName, volume := range o.volumes {
+			if volume.State.IsDeleting() || o.isVolumeMigrating(volumeName) ||
+				!policy.Config.SelectsVolume(volume.Config) {
+				continue
+			}
+			volumeNames = append(volumeNames, volumeName)
+		}
+		sort.Strings(volumeNames)
+
+		dueRuns = append(dueRuns, &dueSnapshotPolicy{config: updatedPolicy.Config, volumeNames: volumeNames})
+	}
+
+	return dueRuns
+}
+
+// createPolicySnapshots creates one snapshot of each volume selected by a scheduled policy run.  A failure on
+// one volume does not prevent snapshots of the others.
+func (o *TridentOrchestrator) createPolicySnapshots(ctx context.Context, due *dueSnapshotPolicy, now time.Time) {
+	snapshotName := due.config.SnapshotName(now)
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": due.config.Name,
+		"snapshot":       snapshotName,
+		"volumes":        len(due.volumeNames),
+	}).Info("Running snapshot policy.")
+
+	for _, volumeName := range due.volumeNames {
+		snapshotConfig := &storage.SnapshotConfig{
+			Version:    config.OrchestratorAPIVersion,
+			Name:       snapshotName,
+			VolumeName: volumeName,
+			PolicyName: due.config.Name,
+		}
+
+		_, err := o.CreateSnapshot(ctx, snapshotConfig)
+		snapshotPolicyOperationsCounter.WithLabelValues(due.config.Name, "create",
+			strconv.FormatBool(err == nil)).Inc()
+		if err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"snapshotPolicy": due.config.Name,
+				"volume":         volumeName,
+				"snapshot":       snapshotName,
+			}).WithError(err).Error("Could not create scheduled snapshot.")
+		}
+	}
+}
+
+// prunePolicySnapshots deletes the snapshots created by snapshot policies that exceed their policy's retention
+// count or age.  All policy snapshots of a volume being deleted are removed as well, since they would otherwise
+// keep the volume from being deleted.  Snapshots whose policy no longer exists are otherwise left alone.
+func (o *TridentOrchestrator) prunePolicySnapshots(ctx context.Context, now time.Time) {
+	o.mutex.Lock()
+
+	// Group the snapshots created by each policy on each volume
+	policySnapshots := make(map[string]map[string][]*storage.Snapshot)
+	for _, snapshot := range o.snapshots {
+		policyName := snapshot.Config.PolicyName
+		if policyName == "" {
+			continue
+		}
+		if policySnapshots[policyName] == nil {
+			policySnapshots[policyName] = make(map[string][]*storage.Snapshot)
+		}
+		volumeName := snapshot.Config.VolumeName
+		policySnapshots[policyName][volumeName] = append(policySnapshots[policyName][volumeName], snapshot)
+	}
+
+	expired := make([]*storage.SnapshotConfig, 0)
+	for policyName, volumeSnapshots := range policySnapshots {
+		policy := o.snapshotPolicies[policyName]
+
+		for volumeName, snapshots := range volumeSnapshots {
+			volume, ok := o.volumes[volumeName]
+			if !ok || volume.State.IsDeleting() {
+				for _, snapshot := range snapshots {
+					expired = append(expired, snapshot.Config)
+				}
+				continue
+			}
+			if policy == nil {
+				continue
+			}
+			expired = append(expired, expiredPolicySnapshots(ctx, policy.Config, snapshots, now)...)
+		}
+	}
+
+	o.mutex.Unlock()
+
+	for _, snapshotConfig := range expired {
+		err := o.DeleteSnapshot(ctx, snapshotConfig.VolumeName, snapshotConfig.Name)
+		snapshotPolicyOperationsCounter.WithLabelValues(snapshotConfig.PolicyName, "prune",
+			strconv.FormatBool(err == nil)).Inc()
+		if err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"snapshotPolicy": snapshotConfig.PolicyName,
+				"volume":         snapshotConfig.VolumeName,
+				"snapshot":       snapshotConfig.Name,
+			}).WithError(err).Error("Could not prune scheduled snapshot.")
+			continue
+		}
+		Logc(ctx).WithFields(LogFields{
+			"snapshotPolicy": snapshotConfig.PolicyName,
+			"volume":         snapshotConfig.VolumeName,
+			"snapshot":       snapshotConfig.Name,
+		}).Info("Pruned scheduled snapshot.")
+	}
+}
+
+// expiredPolicySnapshots returns the snapshots of one volume that fall outside a policy's retention, keeping
+// at most the retention count of the newest snapshots and none older than the retention age.
+func expiredPolicySnapshots(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig, snapshots []*storage.Snapshot, now time.Time,
+) []*storage.SnapshotConfig {
+	retentionAge, err := policyConfig.GetRetentionAge()
+	if err != nil {
+		Logc(ctx).WithField("snapshotPolicy", policyConfig.Name).WithError(err).Error(
+			"Invalid snapshot policy retention; not pruning its snapshots.")
+		return nil
+	}
+
+	// Drivers report creation times in RFC3339, but a snapshot whose time cannot be parsed is treated as the
+	// oldest one and is never aged out.
+	createdTimes := make(map[string]time.Time, len(snapshots))
+	for _, snapshot := range snapshots {
+		if created, err := time.Parse(time.RFC3339, snapshot.Created); err == nil {
+			createdTimes[snapshot.ID()] = created
+		}
+	}
+
+	// Newest first.  Policy snapshot names embed the time of their run, so they break ties between snapshots
+	// created within the same second.
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		createdI, createdJ := createdTimes[snapshots[i].ID()], createdTimes[snapshots[j].ID()]
+		if !createdI.Equal(createdJ) {
+			return createdI.After(createdJ)
+		}
+		return snapshots[i].Config.Name > snapshots[j].Config.Name
+	})
+
+	expired := make([]*storage.SnapshotConfig, 0)
+	for i, snapshot := range snapshots {
+		if policyConfig.RetentionCount > 0 && i >= policyConfig.RetentionCount {
+			expired = append(expired, snapshot.Config)
+			continue
+		}
+		created, ok := createdTimes[snapshot.ID()]
+		if retentionAge > 0 && ok && created.Add(retentionAge).Before(now) {
+			expired = append(expired, snapshot.Config)
+		}
+	}
+	return expired
+}
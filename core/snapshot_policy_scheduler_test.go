@@ -0,0 +1,148 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+func TestRunSnapshotPolicies(t *testing.T) {
+	const (
+		backendName = "snapPolicyBackend"
+		scName      = "snapPolicySC"
+		policyName  = "hourly"
+		dbVolume    = "snapPolicyDBVolume"
+		webVolume   = "snapPolicyWebVolume"
+	)
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	addBackendStorageClass(t, orchestrator, backendName, scName, config.File)
+	for volumeName, app := range map[string]string{dbVolume: "db", webVolume: "web"} {
+		volumeConfig := tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)
+		volumeConfig.Labels = map[string]string{"app": app}
+		if _, err := orchestrator.AddVolume(ctx(), volumeConfig); err != nil {
+			t.Fatal("Unable to create volume: ", err)
+		}
+	}
+
+	_, err := orchestrator.AddSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name:           policyName,
+		Schedule:       "@hourly",
+		RetentionCount: 2,
+		VolumeSelector: map[string]string{"app": "db"},
+		StorageClasses: []string{scName},
+	})
+	assert.NoError(t, err, "unexpected error adding snapshot policy")
+	orchestrator.snapshotPolicies[policyName].Created = "2023-05-01T10:30:00Z"
+
+	policySnapshotNames := func(volumeName string) []string {
+		snapshots, err := orchestrator.ListSnapshotsForVolume(ctx(), volumeName)
+		assert.NoError(t, err, "unexpected error listing snapshots")
+		names := make([]string, 0)
+		for _, snapshot := range snapshots {
+			if snapshot.Config.PolicyName == policyName {
+				names = append(names, snapshot.Config.Name)
+			}
+		}
+		return names
+	}
+
+	// Nothing is due before the first activation of the schedule
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 10, 45, 0, 0, time.UTC))
+	assert.Empty(t, policySnapshotNames(dbVolume))
+
+	// Only the selected volume is snapshotted, and the run is persisted
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 11, 0, 0, 0, time.UTC))
+	assert.Equal(t, []string{"hourly-20230501-110000"}, policySnapshotNames(dbVolume))
+	assert.Empty(t, policySnapshotNames(webVolume))
+	persistentPolicy, err := inMemoryClient.GetSnapshotPolicy(ctx(), policyName)
+	assert.NoError(t, err, "snapshot policy not found in store")
+	assert.Equal(t, "2023-05-01T11:00:00Z", persistentPolicy.LastRun)
+
+	// A policy runs only once per activation
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 11, 0, 30, 0, time.UTC))
+	assert.Len(t, policySnapshotNames(dbVolume), 1)
+
+	// Older snapshots are pruned beyond the retention count
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 13, 0, 0, 0, time.UTC))
+	assert.ElementsMatch(t, []string{"hourly-20230501-120000", "hourly-20230501-130000"},
+		policySnapshotNames(dbVolume))
+
+	// Missed runs are collapsed into one
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 17, 30, 0, 0, time.UTC))
+	assert.ElementsMatch(t, []string{"hourly-20230501-130000", "hourly-20230501-173000"},
+		policySnapshotNames(dbVolume))
+	policy, err := orchestrator.GetSnapshotPolicy(ctx(), policyName)
+	assert.NoError(t, err, "snapshot policy not found")
+	assert.Equal(t, "2023-05-01T18:00:00Z", policy.NextRun)
+
+	// The snapshots of a volume being deleted are pruned so that its deletion can complete
+	err = orchestrator.DeleteVolume(ctx(), dbVolume)
+	assert.NoError(t, err, "unexpected error deleting volume")
+	orchestrator.runSnapshotPolicies(ctx(), time.Date(2023, 5, 1, 17, 31, 0, 0, time.UTC))
+	_, err = orchestrator.GetVolume(ctx(), dbVolume)
+	assert.True(t, utils.IsNotFoundError(err), "volume not deleted")
+
+	err = orchestrator.DeleteSnapshotPolicy(ctx(), policyName)
+	assert.NoError(t, err, "unexpected error deleting snapshot policy")
+}
+
+func TestExpiredPolicySnapshots(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	newSnapshot := func(name, created string) *storage.Snapshot {
+		return storage.NewSnapshot(&storage.SnapshotConfig{Name: name, VolumeName: "vol"}, created, 0,
+			storage.SnapshotStateOnline)
+	}
+	snapshots := func() []*storage.Snapshot {
+		return []*storage.Snapshot{
+			newSnapshot("oldest", "2023-05-01T06:00:00Z"),
+			newSnapshot("newest", "2023-05-01T11:00:00Z"),
+			newSnapshot("unknown", "not a time"),
+			newSnapshot("middle", "2023-05-01T10:00:00Z"),
+		}
+	}
+	expiredNames := func(policyConfig *storage.SnapshotPolicyConfig) []string {
+		names := make([]string, 0)
+		for _, snapshotConfig := range expiredPolicySnapshots(ctx(), policyConfig, snapshots(), now) {
+			names = append(names, snapshotConfig.Name)
+		}
+		return names
+	}
+
+	tests := map[string]struct {
+		policyConfig *storage.SnapshotPolicyConfig
+		expected     []string
+	}{
+		"Count": {
+			policyConfig: &storage.SnapshotPolicyConfig{RetentionCount: 2},
+			expected:     []string{"oldest", "unknown"},
+		},
+		"Age": {
+			policyConfig: &storage.SnapshotPolicyConfig{RetentionAge: "3h"},
+			expected:     []string{"oldest"},
+		},
+		"Count and age": {
+			policyConfig: &storage.SnapshotPolicyConfig{RetentionCount: 3, RetentionAge: "90m"},
+			expected:     []string{"middle", "oldest", "unknown"},
+		},
+		"Invalid age": {
+			policyConfig: &storage.SnapshotPolicyConfig{RetentionCount: 1, RetentionAge: "soon"},
+			expected:     []string{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ElementsMatch(t, test.expected, expiredNames(test.policyConfig))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/netapp/trident/frontend"
 	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/credentials"
 	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 )
@@ -17,6 +18,7 @@ import (
 type Orchestrator interface {
 	Bootstrap(monitorTransactions bool) error
 	AddFrontend(ctx context.Context, f frontend.Plugin)
+	AddCredentialStore(ctx context.Context, provider credentials.Provider)
 	GetFrontend(ctx context.Context, name string) (frontend.Plugin, error)
 	GetVersion(ctx context.Context) (string, error)
 
@@ -39,6 +41,14 @@ type Orchestrator interface {
 
 	AddVolume(ctx context.Context, volumeConfig *storage.VolumeConfig) (*storage.VolumeExternal, error)
 	UpdateVolume(ctx context.Context, volume string, passphraseNames *[]string) error
+	ModifyVolume(
+		ctx context.Context, volumeName string, modification storage.VolumeModification,
+	) (*storage.VolumeExternal, error)
+	MigrateVolume(
+		ctx context.Context, volumeName, backendName, poolName string,
+	) (*storage.VolumeMigrationExternal, error)
+	GetVolumeMigration(ctx context.Context, volumeName string) (*storage.VolumeMigrationExternal, error)
+	ListVolumeMigrations(ctx context.Context) ([]*storage.VolumeMigrationExternal, error)
 	AttachVolume(ctx context.Context, volumeName, mountpoint string, publishInfo *utils.VolumePublishInfo) error
 	CloneVolume(ctx context.Context, volumeConfig *storage.VolumeConfig) (*storage.VolumeExternal, error)
 	DetachVolume(ctx context.Context, volumeName, mountpoint string) error
@@ -57,6 +67,9 @@ type Orchestrator interface {
 	ResizeVolume(ctx context.Context, volumeName, newSize string) error
 	SetVolumeState(ctx context.Context, volumeName string, state storage.VolumeState) error
 	ReloadVolumes(ctx context.Context) error
+	GetVolumeHealth(ctx context.Context, volumeName string) (*storage.VolumeHealth, error)
+	UpdateVolumeUsage(ctx context.Context, volumeName string, usage *storage.VolumeUsage) error
+	GetVolumeMetrics(ctx context.Context) ([]*storage.VolumeMetricsExternal, error)
 
 	ListSubordinateVolumes(ctx context.Context, sourceVolumeName string) ([]*storage.VolumeExternal, error)
 	GetSubordinateSourceVolume(ctx context.Context, subordinateVolumeName string) (*storage.VolumeExternal, error)
@@ -68,11 +81,33 @@ type Orchestrator interface {
 	ListSnapshotsForVolume(ctx context.Context, volumeName string) ([]*storage.SnapshotExternal, error)
 	ReadSnapshotsForVolume(ctx context.Context, volumeName string) ([]*storage.SnapshotExternal, error)
 	DeleteSnapshot(ctx context.Context, volumeName, snapshotName string) error
+	RestoreSnapshot(ctx context.Context, volumeName, snapshotName string) error
+
+	CreateGroupSnapshot(
+		ctx context.Context, groupConfig *storage.GroupSnapshotConfig,
+	) (*storage.GroupSnapshotExternal, error)
+	GetGroupSnapshot(ctx context.Context, groupSnapshotName string) (*storage.GroupSnapshotExternal, error)
+	ListGroupSnapshots(ctx context.Context) ([]*storage.GroupSnapshotExternal, error)
+	DeleteGroupSnapshot(ctx context.Context, groupSnapshotName string) error
+
+	AddSnapshotPolicy(
+		ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+	) (*storage.SnapshotPolicyExternal, error)
+	UpdateSnapshotPolicy(
+		ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+	) (*storage.SnapshotPolicyExternal, error)
+	GetSnapshotPolicy(ctx context.Context, policyName string) (*storage.SnapshotPolicyExternal, error)
+	ListSnapshotPolicies(ctx context.Context) ([]*storage.SnapshotPolicyExternal, error)
+	DeleteSnapshotPolicy(ctx context.Context, policyName string) error
 
 	AddStorageClass(ctx context.Context, scConfig *storageclass.Config) (*storageclass.External, error)
 	DeleteStorageClass(ctx context.Context, scName string) error
 	GetStorageClass(ctx context.Context, scName string) (*storageclass.External, error)
 	ListStorageClasses(ctx context.Context) ([]*storageclass.External, error)
+	GetCapacity(ctx context.Context, scName string, topology map[string]string) (*storageclass.Capacity, error)
+	GetPoolUsage(ctx context.Context) (*storage.PoolUsageReport, error)
+	CheckConsistency(ctx context.Context, repair bool) (*storage.ConsistencyReport, error)
+	WatchChanges(ctx context.Context, resumeToken string) (<-chan *storage.ChangeEvent, string, error)
 
 	AddNode(ctx context.Context, node *utils.Node, nodeEventCallback NodeEventCallback) error
 	UpdateNode(ctx context.Context, nodeName string, flags *utils.NodePublicationStateFlags) error
@@ -81,6 +116,10 @@ type Orchestrator interface {
 	DeleteNode(ctx context.Context, nodeName string) error
 	PeriodicallyReconcileNodeAccessOnBackends()
 	PeriodicallyReconcileBackendState(duration time.Duration)
+	PeriodicallyRunSnapshotPolicies()
+	PeriodicallyAutogrowVolumes()
+	PeriodicallyMigrateVolumes()
+	PeriodicallyRefreshBackendCredentials(interval time.Duration)
 
 	ReconcileVolumePublications(ctx context.Context, attachedLegacyVolumes []*utils.VolumePublicationExternal) error
 	GetVolumePublication(ctx context.Context, volumeName, nodeName string) (*utils.VolumePublication, error)
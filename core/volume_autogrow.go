@@ -0,0 +1,172 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	. "github.com/netapp/trident/logging"
+)
+
+// AutogrowCheckPeriod is the interval at which the usage reported for volumes is compared to their autogrow
+// thresholds.  Nodes report usage whenever the container orchestrator collects volume statistics.
+const AutogrowCheckPeriod = time.Minute
+
+// autogrowCandidate is a volume whose reported usage has crossed its autogrow threshold.
+type autogrowCandidate struct {
+	volumeName  string
+	backendName string
+	usedPercent int64
+	newSize     uint64
+}
+
+// PeriodicallyAutogrowVolumes is intended to be run as a goroutine by the single Trident controller.  On every
+// period it grows the volumes whose reported usage has crossed their autogrow threshold.
+func (o *TridentOrchestrator) PeriodicallyAutogrowVolumes() {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowVolumeAutogrow,
+		LogLayerCore)
+
+	Logc(ctx).Info("Starting volume autogrow loop.")
+	defer Logc(ctx).Info("Stopping volume autogrow loop.")
+
+	o.stopAutogrowLoop = make(chan bool)
+	ticker := time.NewTicker(AutogrowCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stopAutogrowLoop:
+			// Exit on shutdown signal
+			return
+
+		case <-ticker.C:
+			if o.bootstrapError != nil {
+				Logc(ctx).WithError(o.bootstrapError).Trace("Volume autogrow blocked by bootstrap error.")
+				continue
+			}
+			Logc(ctx).Trace("Volume autogrow loop running.")
+			o.autogrowVolumes(ctx)
+		}
+	}
+}
+
+// autogrowVolumes grows each volume whose reported usage has crossed its autogrow threshold.
+func (o *TridentOrchestrator) autogrowVolumes(ctx context.Context) {
+	for _, candidate := range o.getAutogrowCandidates(ctx) {
+		o.autogrowVolume(ctx, candidate)
+	}
+}
+
+// getAutogrowCandidates returns the volumes whose last reported usage has crossed their autogrow threshold.
+// The usage of each candidate is discarded, so that a volume is not grown again until a node reports its
+// usage at the new size.
+func (o *TridentOrchestrator) getAutogrowCandidates(ctx context.Context) []*autogrowCandidate {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	candidates := make([]*autogrowCandidate, 0)
+
+	for volumeName, usage := range o.volumeUsage {
+		volume, ok := o.volumes[volumeName]
+		if !ok {
+			delete(o.volumeUsage, volumeName)
+			continue
+		}
+		if !volume.Config.AutogrowEnabled() || volume.State.IsDeleting() {
+			continue
+		}
+		// Keep the usage of a migrating volume, so that it is grown once the migration completes
+		if o.isVolumeMigrating(volumeName) {
+			continue
+		}
+
+		newSize, err := volume.Config.AutogrowSize(usage)
+		if err != nil {
+			Logc(ctx).WithField("volume", volumeName).WithError(err).Error("Invalid volume autogrow settings.")
+			continue
+		}
+		if newSize == 0 {
+			continue
+		}
+		delete(o.volumeUsage, volumeName)
+
+		backendName := ""
+		if backend, ok := o.backends[volume.BackendUUID]; ok {
+			backendName = backend.Name()
+		}
+		candidates = append(candidates, &autogrowCandidate{
+			volumeName:  volumeName,
+			backendName: backendName,
+			usedPercent: usage.UsedBytes * 100 / usage.TotalBytes,
+			newSize:     newSize,
+		})
+	}
+
+	return candidates
+}
+
+// autogrowVolume resizes a volume through the normal resize path, and then informs the container orchestrator
+// of the new size so that it may complete the expansion.
+func (o *TridentOrchestrator) autogrowVolume(ctx context.Context, candidate *autogrowCandidate) {
+	logFields := LogFields{
+		"volume":      candidate.volumeName,
+		"backend":     candidate.backendName,
+		"usedPercent": candidate.usedPercent,
+		"newSize":     candidate.newSize,
+	}
+	helper := o.getControllerHelper()
+
+	err := o.ResizeVolume(ctx, candidate.volumeName, strconv.FormatUint(candidate.newSize, 10))
+	volumeAutogrowCounter.WithLabelValues(candidate.backendName, strconv.FormatBool(err == nil)).Inc()
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Could not grow volume automatically.")
+		if helper != nil {
+			helper.RecordVolumeEvent(ctx, candidate.volumeName, controllerhelpers.EventTypeWarning,
+				"AutogrowFailed", fmt.Sprintf("Could not grow volume at %d%% used; %v", candidate.usedPercent, err))
+		}
+		return
+	}
+
+	// The backend may have rounded the size up
+	volume, err := o.GetVolume(ctx, candidate.volumeName)
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Could not find volume after growing it.")
+		return
+	}
+	size, err := strconv.ParseInt(volume.Config.Size, 10, 64)
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Could not parse volume size after growing it.")
+		return
+	}
+
+	Logc(ctx).WithFields(logFields).WithField("size", size).Info("Volume grown automatically.")
+
+	if helper == nil {
+		return
+	}
+	if err = helper.UpdateVolumeSize(ctx, candidate.volumeName, size); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error(
+			"Could not update the container orchestrator with the new volume size.")
+		helper.RecordVolumeEvent(ctx, candidate.volumeName, controllerhelpers.EventTypeWarning, "AutogrowFailed",
+			fmt.Sprintf("Volume grown to %d bytes, but its claim could not be updated; %v", size, err))
+		return
+	}
+	helper.RecordVolumeEvent(ctx, candidate.volumeName, controllerhelpers.EventTypeNormal, "AutogrowSuccess",
+		fmt.Sprintf("Volume grown to %d bytes at %d%% used.", size, candidate.usedPercent))
+}
+
+// getControllerHelper returns the frontend that relays volume changes to the container orchestrator, if any.
+func (o *TridentOrchestrator) getControllerHelper() controllerhelpers.ControllerHelper {
+	for _, name := range []string{controllerhelpers.KubernetesHelper, controllerhelpers.PlainCSIHelper} {
+		if fe, ok := o.frontends[name]; ok {
+			if helper, ok := fe.(controllerhelpers.ControllerHelper); ok {
+				return helper
+			}
+		}
+	}
+	return nil
+}
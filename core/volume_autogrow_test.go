@@ -0,0 +1,115 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	mockcontrollerhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
+	"github.com/netapp/trident/storage"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+// mockHelperFrontend adds the frontend methods to a mock controller helper
+type mockHelperFrontend struct {
+	*mockcontrollerhelpers.MockControllerHelper
+}
+
+func (f *mockHelperFrontend) Activate() error   { return nil }
+func (f *mockHelperFrontend) Deactivate() error { return nil }
+func (f *mockHelperFrontend) GetName() string   { return controllerhelpers.KubernetesHelper }
+
+func TestUpdateVolumeUsage(t *testing.T) {
+	o := getOrchestrator(t, false)
+	o.volumes["vol1"] = &storage.Volume{Config: &storage.VolumeConfig{Name: "vol1"}}
+	o.subordinateVolumes["sub1"] = &storage.Volume{Config: &storage.VolumeConfig{Name: "sub1"}}
+
+	err := o.UpdateVolumeUsage(ctx(), "vol1", &storage.VolumeUsage{UsedBytes: 10, TotalBytes: 100})
+	assert.NoError(t, err, "unexpected error updating volume usage")
+	assert.Equal(t, int64(10), o.volumeUsage["vol1"].UsedBytes)
+	assert.NotEmpty(t, o.volumeUsage["vol1"].Reported)
+
+	err = o.UpdateVolumeUsage(ctx(), "sub1", &storage.VolumeUsage{UsedBytes: 10, TotalBytes: 100})
+	assert.NoError(t, err, "unexpected error updating subordinate volume usage")
+	assert.NotContains(t, o.volumeUsage, "sub1")
+
+	err = o.UpdateVolumeUsage(ctx(), "vol2", &storage.VolumeUsage{UsedBytes: 10, TotalBytes: 100})
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+
+	err = o.UpdateVolumeUsage(ctx(), "vol1", &storage.VolumeUsage{UsedBytes: -1, TotalBytes: 100})
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+}
+
+func TestAutogrowVolumes(t *testing.T) {
+	const (
+		backendName = "autogrowBackend"
+		scName      = "autogrowSC"
+		growVolume  = "autogrowVolume"
+		fixedVolume = "fixedSizeVolume"
+		gib         = 1024 * 1024 * 1024
+	)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockHelper := mockcontrollerhelpers.NewMockControllerHelper(mockCtrl)
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	orchestrator.AddFrontend(ctx(), &mockHelperFrontend{mockHelper})
+	addBackendStorageClass(t, orchestrator, backendName, scName, config.File)
+
+	volumeConfig := tu.GenerateVolumeConfig(growVolume, 1, scName, config.File)
+	volumeConfig.AutogrowThreshold = "80"
+	volumeConfig.AutogrowStep = "1Gi"
+	volumeConfig.AutogrowMaxSize = "2Gi"
+	if _, err := orchestrator.AddVolume(ctx(), volumeConfig); err != nil {
+		t.Fatal("Unable to create volume: ", err)
+	}
+	if _, err := orchestrator.AddVolume(ctx(), tu.GenerateVolumeConfig(fixedVolume, 1, scName,
+		config.File)); err != nil {
+		t.Fatal("Unable to create volume: ", err)
+	}
+
+	invalidConfig := tu.GenerateVolumeConfig("invalidAutogrowVolume", 1, scName, config.File)
+	invalidConfig.AutogrowThreshold = "100"
+	_, err := orchestrator.AddVolume(ctx(), invalidConfig)
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+
+	volumeSize := func(volumeName string) string {
+		volume, err := orchestrator.GetVolume(ctx(), volumeName)
+		assert.NoError(t, err, "volume not found")
+		return volume.Config.Size
+	}
+	reportUsage := func(volumeName string, usedBytes, totalBytes int64) {
+		err := orchestrator.UpdateVolumeUsage(ctx(), volumeName,
+			&storage.VolumeUsage{UsedBytes: usedBytes, TotalBytes: totalBytes})
+		assert.NoError(t, err, "unexpected error updating volume usage")
+	}
+
+	// Volumes below their threshold, or without autogrow, are not grown
+	reportUsage(growVolume, gib/2, gib)
+	reportUsage(fixedVolume, gib, gib)
+	orchestrator.autogrowVolumes(ctx())
+	assert.Equal(t, "1073741824", volumeSize(growVolume))
+	assert.Equal(t, "1073741824", volumeSize(fixedVolume))
+
+	// A volume past its threshold is grown, and the container orchestrator is told of its new size
+	mockHelper.EXPECT().UpdateVolumeSize(gomock.Any(), growVolume, int64(2*gib)).Return(nil)
+	mockHelper.EXPECT().RecordVolumeEvent(gomock.Any(), growVolume, controllerhelpers.EventTypeNormal,
+		"AutogrowSuccess", gomock.Any())
+	reportUsage(growVolume, gib*9/10, gib)
+	orchestrator.autogrowVolumes(ctx())
+	assert.Equal(t, "2147483648", volumeSize(growVolume))
+	assert.NotContains(t, orchestrator.volumeUsage, growVolume, "usage should be consumed by autogrow")
+
+	// A volume is not grown beyond its maximum size
+	reportUsage(growVolume, 2*gib, 2*gib)
+	orchestrator.autogrowVolumes(ctx())
+	assert.Equal(t, "2147483648", volumeSize(growVolume))
+}
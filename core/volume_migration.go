@@ -0,0 +1,805 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	storageattribute "github.com/netapp/trident/storage_attribute"
+	drivers "github.com/netapp/trident/storage_drivers"
+	"github.com/netapp/trident/utils"
+)
+
+// VolumeMigrationPeriod is the interval at which running volume migrations are advanced.
+const VolumeMigrationPeriod = 30 * time.Second
+
+// maxVolumeMoveAttempts is the number of times a volume is moved between pools of its backend before its
+// migration fails.
+const maxVolumeMoveAttempts = 3
+
+// MigrateVolume starts migrating a volume to a pool of the same or another backend.  The volume keeps its name, so
+// it stays bound to any container orchestrator volume.  Within a backend the driver moves the volume without
+// disrupting its clients.  Between backends of the same type the volume is mirrored to a new volume, and once it
+// has been unpublished from all nodes a final transfer cuts over to the new volume and the original is deleted.
+// The migration is recorded as a long-running transaction that is advanced periodically and resumed after a
+// restart.
+func (o *TridentOrchestrator) MigrateVolume(
+	ctx context.Context, volumeName, backendName, poolName string,
+) (migration *storage.VolumeMigrationExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_migrate", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	if _, ok := o.subordinateVolumes[volumeName]; ok {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"volume %s is a subordinate volume; migrate its source volume instead", volumeName))
+	}
+
+	volume, found := o.volumes[volumeName]
+	if !found {
+		return nil, utils.NotFoundError(fmt.Sprintf("volume %s not found", volumeName))
+	}
+	if volume.State.IsDeleting() {
+		return nil, utils.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
+	}
+	if volume.Orphaned {
+		return nil, utils.VolumeStateError(fmt.Sprintf("volume %s is orphaned", volumeName))
+	}
+	if o.isVolumeMigrating(volumeName) {
+		return nil, utils.FoundError(fmt.Sprintf("volume %s is already migrating", volumeName))
+	}
+	if volume.Config.ImportNotManaged {
+		return nil, utils.UnsupportedError(fmt.Sprintf("volume %s is not managed by Trident", volumeName))
+	}
+	if len(volume.Config.SubordinateVolumes) > 0 {
+		return nil, utils.UnsupportedError(fmt.Sprintf("volume %s has subordinate volumes", volumeName))
+	}
+	if volume.Config.IsMirrorDestination {
+		return nil, utils.UnsupportedError(fmt.Sprintf("volume %s is a mirror destination", volumeName))
+	}
+
+	sourceBackend, found := o.backends[volume.BackendUUID]
+	if !found {
+		return nil, utils.NotFoundError(fmt.Sprintf("backend %s not found", volume.BackendUUID))
+	}
+	targetBackend, err := o.getBackendByBackendName(backendName)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := targetBackend.Storage()[poolName]; !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found on backend %s", poolName, backendName))
+	}
+	if targetBackend.BackendUUID() == volume.BackendUUID && poolName == volume.Pool {
+		return nil, utils.InvalidInputError(fmt.Sprintf("volume %s is already in pool %s of backend %s",
+			volumeName, poolName, backendName))
+	}
+
+	migrationConfig := &storage.VolumeMigrationConfig{
+		StartTime:         time.Now(),
+		SourceBackendUUID: volume.BackendUUID,
+		SourcePool:        volume.Pool,
+		TargetBackendUUID: targetBackend.BackendUUID(),
+		TargetPool:        poolName,
+		State:             storage.VolumeMigrationMoving,
+	}
+
+	if migrationConfig.IsBetweenBackends() {
+		if err = o.canMigrateVolumeBetweenBackends(volume, sourceBackend, targetBackend); err != nil {
+			return nil, err
+		}
+		migrationConfig.State = storage.VolumeMigrationMirroring
+	} else if !sourceBackend.CanMoveVolumes() {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"backend %s cannot move volumes between its pools", sourceBackend.Name()))
+	}
+
+	txn := &storage.VolumeTransaction{
+		Config:                volume.Config.ConstructClone(),
+		VolumeMigrationConfig: migrationConfig,
+		Op:                    storage.MigrateVolume,
+	}
+	if err = o.AddVolumeTransaction(ctx, txn); err != nil {
+		return nil, err
+	}
+	o.volumeMigrations[volumeName] = txn
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":        volumeName,
+		"sourceBackend": sourceBackend.Name(),
+		"sourcePool":    volume.Pool,
+		"targetBackend": backendName,
+		"targetPool":    poolName,
+	}).Info("Orchestrator started migrating the volume.")
+
+	return o.volumeMigrationExternal(txn), nil
+}
+
+// canMigrateVolumeBetweenBackends returns an error if a volume cannot be mirrored to another backend.  Snapshots
+// and clones are not carried over to the new volume, so volumes with either may not be migrated.
+func (o *TridentOrchestrator) canMigrateVolumeBetweenBackends(
+	volume *storage.Volume, sourceBackend, targetBackend storage.Backend,
+) error {
+	if sourceBackend.GetDriverName() != targetBackend.GetDriverName() {
+		return utils.UnsupportedError(fmt.Sprintf(
+			"volumes may only be migrated between backends of the same type; %s is %s, but %s is %s",
+			sourceBackend.Name(), sourceBackend.GetDriverName(), targetBackend.Name(), targetBackend.GetDriverName()))
+	}
+	if !sourceBackend.CanMirror() || !targetBackend.CanMirror() {
+		return utils.UnsupportedError(fmt.Sprintf(
+			"volumes may only be migrated between backends that support mirroring; %s does not",
+			sourceBackend.GetDriverName()))
+	}
+	if !targetBackend.State().IsOnline() {
+		return fmt.Errorf("backend %s is not online", targetBackend.Name())
+	}
+
+	for _, snapshot := range o.snapshots {
+		if snapshot.Config.VolumeName == volume.Config.Name {
+			return utils.UnsupportedError(fmt.Sprintf(
+				"volume %s has snapshots, which cannot be migrated to another backend", volume.Config.Name))
+		}
+	}
+	for _, clone := range o.volumes {
+		if clone.Config.CloneSourceVolume == volume.Config.Name {
+			return utils.UnsupportedError(fmt.Sprintf(
+				"volume %s has clones, so it cannot be migrated to another backend", volume.Config.Name))
+		}
+	}
+
+	return nil
+}
+
+// GetVolumeMigration returns the progress of a running volume migration.
+func (o *TridentOrchestrator) GetVolumeMigration(
+	ctx context.Context, volumeName string,
+) (migration *storage.VolumeMigrationExternal, err error) {
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_migration_get", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	txn, ok := o.volumeMigrations[volumeName]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("volume %s is not migrating", volumeName))
+	}
+
+	return o.volumeMigrationExternal(txn), nil
+}
+
+// ListVolumeMigrations returns the progress of all running volume migrations.
+func (o *TridentOrchestrator) ListVolumeMigrations(
+	ctx context.Context,
+) (migrations []*storage.VolumeMigrationExternal, err error) {
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	defer recordTiming("volume_migration_list", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	migrations = make([]*storage.VolumeMigrationExternal, 0, len(o.volumeMigrations))
+	for _, txn := range o.volumeMigrations {
+		migrations = append(migrations, o.volumeMigrationExternal(txn))
+	}
+
+	return migrations, nil
+}
+
+// volumeMigrationExternal describes the progress of a migration, naming its backends where they are known.
+// The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) volumeMigrationExternal(txn *storage.VolumeTransaction) *storage.VolumeMigrationExternal {
+	migrationConfig := txn.VolumeMigrationConfig
+
+	backendName := func(backendUUID string) string {
+		if backend, ok := o.backends[backendUUID]; ok {
+			return backend.Name()
+		}
+		return backendUUID
+	}
+
+	return &storage.VolumeMigrationExternal{
+		Volume:          txn.Config.Name,
+		SourceBackend:   backendName(migrationConfig.SourceBackendUUID),
+		SourcePool:      migrationConfig.SourcePool,
+		TargetBackend:   backendName(migrationConfig.TargetBackendUUID),
+		TargetPool:      migrationConfig.TargetPool,
+		State:           migrationConfig.State,
+		PercentComplete: migrationConfig.PercentComplete,
+		StartTime:       migrationConfig.StartTime,
+		Message:         migrationConfig.Message,
+	}
+}
+
+// isVolumeMigrating returns whether a volume is being migrated.  A failed migration is still reported, but its
+// volume is no longer migrating.  The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) isVolumeMigrating(volumeName string) bool {
+	txn, ok := o.volumeMigrations[volumeName]
+	return ok && txn.VolumeMigrationConfig.State != storage.VolumeMigrationFailed
+}
+
+// isVolumeCuttingOver returns whether a volume is being cut over to a new volume on another backend, during which
+// it may not be published.  The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) isVolumeCuttingOver(volumeName string) bool {
+	txn, ok := o.volumeMigrations[volumeName]
+	return ok && txn.VolumeMigrationConfig.State.IsCuttingOver()
+}
+
+// PeriodicallyMigrateVolumes is intended to be run as a goroutine by the single Trident controller.  On every
+// period it advances each running volume migration as far as it can.
+func (o *TridentOrchestrator) PeriodicallyMigrateVolumes() {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowVolumeMigrate,
+		LogLayerCore)
+
+	Logc(ctx).Info("Starting volume migration loop.")
+	defer Logc(ctx).Info("Stopping volume migration loop.")
+
+	o.stopMigrationLoop = make(chan bool)
+	ticker := time.NewTicker(VolumeMigrationPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-o.stopMigrationLoop:
+			// Exit on shutdown signal
+			return
+
+		case <-ticker.C:
+			if o.bootstrapError != nil {
+				Logc(ctx).WithError(o.bootstrapError).Trace("Volume migration blocked by bootstrap error.")
+				continue
+			}
+			Logc(ctx).Trace("Volume migration loop running.")
+			o.advanceVolumeMigrations(ctx)
+		}
+	}
+}
+
+// advanceVolumeMigrations advances each running volume migration, recording why any of them cannot progress.
+// The orchestrator lock is not held while the backends are called, as moving, mirroring and cutting over may be
+// slow; that is safe because other operations on a migrating volume are refused.  Each migration is advanced on
+// a copy of its state, which is recorded in its transaction under the lock whenever it progresses.
+func (o *TridentOrchestrator) advanceVolumeMigrations(ctx context.Context) {
+	o.mutex.Lock()
+	txns := make([]*storage.VolumeTransaction, 0, len(o.volumeMigrations))
+	for _, txn := range o.volumeMigrations {
+		txns = append(txns, txn)
+	}
+	o.mutex.Unlock()
+
+	for _, txn := range txns {
+		volumeName := txn.Config.Name
+
+		o.mutex.Lock()
+		migrationConfig := *txn.VolumeMigrationConfig
+		o.mutex.Unlock()
+		if migrationConfig.State != storage.VolumeMigrationFailed {
+			migrationConfig.Message = ""
+		}
+
+		done, err := o.advanceVolumeMigration(ctx, txn, &migrationConfig)
+		if err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"volume": volumeName,
+				"state":  migrationConfig.State,
+			}).WithError(err).Warning("Volume migration could not progress.")
+			migrationConfig.Message = err.Error()
+		}
+		if done {
+			continue
+		}
+
+		// Record any progress so that it is reported and survives a restart
+		if err = o.saveVolumeMigration(ctx, txn, &migrationConfig); err != nil {
+			Logc(ctx).WithField("volume", volumeName).WithError(err).Error(
+				"Could not record the progress of the volume migration.")
+		}
+	}
+}
+
+// saveVolumeMigration records the progress of a migration, made on a copy of its state, in its transaction.
+func (o *TridentOrchestrator) saveVolumeMigration(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.recordVolumeMigration(ctx, txn, migrationConfig)
+}
+
+// recordVolumeMigration records the progress of a migration, made on a copy of its state, in its transaction,
+// and writes the transaction to the persistent store if it changed.  The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) recordVolumeMigration(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+) error {
+	previous := *txn.VolumeMigrationConfig
+	if *migrationConfig == previous {
+		return nil
+	}
+
+	*txn.VolumeMigrationConfig = *migrationConfig
+	if err := o.storeClient.UpdateVolumeTransaction(ctx, txn); err != nil {
+		*txn.VolumeMigrationConfig = previous
+		return err
+	}
+	return nil
+}
+
+// advanceVolumeMigration advances a migration by one or more states, returning whether it has completed or
+// failed.  The progress is made on migrationConfig, a copy of the migration's state.  The caller should not hold
+// the orchestrator lock.
+func (o *TridentOrchestrator) advanceVolumeMigration(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+) (bool, error) {
+	o.mutex.Lock()
+	volume, ok := o.volumes[txn.Config.Name]
+	if migrationConfig.State == storage.VolumeMigrationFailed {
+		defer o.mutex.Unlock()
+		// A failed migration is reported until its volume is migrated again or deleted
+		if !ok && o.volumeMigrations[txn.Config.Name] == txn {
+			delete(o.volumeMigrations, txn.Config.Name)
+		}
+		return true, nil
+	}
+	if !ok {
+		defer o.mutex.Unlock()
+		// Volumes may not be deleted while migrating, so this should never happen
+		Logc(ctx).WithField("volume", txn.Config.Name).Error("Volume for the migration transaction wasn't found.")
+		return true, o.completeVolumeMigration(ctx, txn)
+	}
+	volConfig := volume.Config.ConstructClone()
+	o.mutex.Unlock()
+
+	var err error
+	if migrationConfig.State == storage.VolumeMigrationMoving {
+		return o.advanceVolumeMove(ctx, txn, migrationConfig, volConfig)
+	}
+	if migrationConfig.State == storage.VolumeMigrationMirroring {
+		if err = o.advanceVolumeMirror(ctx, txn, migrationConfig, volConfig); err != nil {
+			return false, err
+		}
+	}
+	if migrationConfig.State == storage.VolumeMigrationAwaitingCutover {
+		if err = o.beginVolumeCutover(ctx, txn, migrationConfig); err != nil {
+			return false, err
+		}
+	}
+	if migrationConfig.State == storage.VolumeMigrationCuttingOver {
+		if err = o.advanceVolumeCutover(ctx, txn, migrationConfig, volConfig); err != nil {
+			return false, err
+		}
+	}
+	if migrationConfig.State == storage.VolumeMigrationCleaningUp {
+		return o.finishVolumeCutover(ctx, txn, migrationConfig)
+	}
+
+	return false, nil
+}
+
+// advanceVolumeMove follows the move of a volume between pools of its backend, and records the new pool once
+// the move completes.  The caller should not hold the orchestrator lock.
+func (o *TridentOrchestrator) advanceVolumeMove(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+	volConfig *storage.VolumeConfig,
+) (bool, error) {
+	o.mutex.Lock()
+	backend, pool, err := o.getVolumeMigrationTarget(migrationConfig)
+	o.mutex.Unlock()
+	if err != nil {
+		return false, err
+	}
+
+	restartFailed := migrationConfig.MoveFailures+1 < maxVolumeMoveAttempts
+	moving, percentComplete, err := backend.MoveVolume(ctx, volConfig, pool, restartFailed)
+	if utils.IsVolumeMoveFailedError(err) {
+		if !moving {
+			return o.failVolumeMigration(ctx, txn, migrationConfig, err)
+		}
+		// The failed move was restarted, and the failure is reported until the next period
+		migrationConfig.MoveFailures++
+		migrationConfig.PercentComplete = percentComplete
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+	migrationConfig.PercentComplete = percentComplete
+	if moving {
+		return false, nil
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	volume := o.volumes[txn.Config.Name]
+	newVolume := storage.NewVolume(volume.Config, volume.BackendUUID, pool.Name(), volume.Orphaned, volume.State)
+	if err = o.storeClient.UpdateVolume(ctx, newVolume); err != nil {
+		return false, fmt.Errorf("volume %s was moved, but its new pool could not be saved; %v",
+			volume.Config.Name, err)
+	}
+	// The cached volume is shared with its backend, so it is updated in place
+	volume.Pool = pool.Name()
+
+	return true, o.completeVolumeMigration(ctx, txn)
+}
+
+// failVolumeMigration ends a migration whose volume could not be moved, leaving the volume in its original pool.
+// The transaction is deleted so that the volume may be managed again, while the failure is kept in memory so that
+// it is reported until the volume is migrated again or deleted.  The caller should not hold the orchestrator lock.
+func (o *TridentOrchestrator) failVolumeMigration(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+	moveErr error,
+) (bool, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if err := o.DeleteVolumeTransaction(ctx, txn); err != nil {
+		return false, fmt.Errorf("%v; failed to clean up volume migration transaction; %v", moveErr, err)
+	}
+	migrationConfig.State = storage.VolumeMigrationFailed
+	migrationConfig.Message = moveErr.Error()
+	*txn.VolumeMigrationConfig = *migrationConfig
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":       txn.Config.Name,
+		"pool":         migrationConfig.TargetPool,
+		"moveFailures": migrationConfig.MoveFailures + 1,
+	}).WithError(moveErr).Warning("Volume migration failed; the volume remains in its original pool.")
+
+	return true, nil
+}
+
+// advanceVolumeMirror creates the new volume of a migration between backends as a mirror of the original volume,
+// and waits for the mirror to be established.  The caller should not hold the orchestrator lock.
+func (o *TridentOrchestrator) advanceVolumeMirror(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+	volConfig *storage.VolumeConfig,
+) error {
+	o.mutex.Lock()
+	sourceBackend, found := o.backends[migrationConfig.SourceBackendUUID]
+	targetBackend, pool, err := o.getVolumeMigrationTarget(migrationConfig)
+	volAttributes := make(map[string]storageattribute.Request)
+	if sc, ok := o.storageClasses[volConfig.StorageClass]; ok {
+		volAttributes = sc.GetAttributes()
+	}
+	o.mutex.Unlock()
+	if !found {
+		return fmt.Errorf("backend %s not found", migrationConfig.SourceBackendUUID)
+	}
+	if err != nil {
+		return err
+	}
+	sourceHandle, err := getVolumeMigrationSourceHandle(ctx, sourceBackend, txn.Config.InternalName)
+	if err != nil {
+		return err
+	}
+
+	if migrationConfig.TargetConfig == nil {
+		targetConfig := volConfig.ConstructClone()
+		targetConfig.InternalID = ""
+		targetConfig.AccessInfo = utils.VolumeAccessInfo{}
+		targetConfig.CloneSourceVolume = ""
+		targetConfig.CloneSourceVolumeInternal = ""
+		targetConfig.CloneSourceSnapshot = ""
+		targetConfig.ImportOriginalName = ""
+		targetConfig.ImportBackendUUID = ""
+		targetConfig.IsMirrorDestination = true
+		targetConfig.PeerVolumeHandle = sourceHandle
+		targetBackend.Driver().CreatePrepare(ctx, targetConfig)
+
+		// Record the name of the new volume before creating it, so that it may be found after a restart
+		withTarget := *migrationConfig
+		withTarget.TargetConfig = targetConfig
+		if err = o.saveVolumeMigration(ctx, txn, &withTarget); err != nil {
+			return err
+		}
+		*migrationConfig = withTarget
+	}
+
+	mirrorBackend, ok := targetBackend.(storage.Mirrorer)
+	if !ok {
+		return fmt.Errorf("backend %s does not support mirroring", targetBackend.Name())
+	}
+	targetName := migrationConfig.TargetConfig.InternalName
+
+	status, err := mirrorBackend.GetMirrorStatus(ctx, targetName, sourceHandle)
+	if err != nil || status == "" {
+		// The new volume has not been created or is not yet a mirror destination.  Creating it may complete its
+		// config, which is shared with the recorded migration until saved, so a copy is created.
+		targetConfig := migrationConfig.TargetConfig.ConstructClone()
+		if err = createVolumeMigrationTarget(ctx, targetBackend, pool, targetConfig, volAttributes); err != nil {
+			if utils.IsVolumeCreatingError(err) {
+				migrationConfig.Message = "waiting for the new volume to be created"
+				return nil
+			}
+			return fmt.Errorf("could not create the new volume on backend %s; %v", targetBackend.Name(), err)
+		}
+		migrationConfig.TargetConfig = targetConfig
+
+		if err = mirrorBackend.EstablishMirror(ctx, targetName, sourceHandle, "", ""); err != nil {
+			return fmt.Errorf("could not mirror the volume to backend %s; %v", targetBackend.Name(), err)
+		}
+		migrationConfig.Message = "waiting for the mirror to be established"
+		return nil
+	}
+
+	if status != v1.MirrorStateEstablished {
+		migrationConfig.Message = fmt.Sprintf("waiting for the mirror to be established; mirror is %s", status)
+		return nil
+	}
+
+	migrationConfig.State = storage.VolumeMigrationAwaitingCutover
+	return nil
+}
+
+// createVolumeMigrationTarget creates the new volume of a migration between backends.  Until the cutover the
+// volume belongs to its source backend, so the new volume is created by the target backend's driver without
+// being cached on the target backend.
+func createVolumeMigrationTarget(
+	ctx context.Context, backend storage.Backend, pool storage.Pool, volConfig *storage.VolumeConfig,
+	volAttributes map[string]storageattribute.Request,
+) error {
+	if !backend.State().IsOnline() {
+		return fmt.Errorf("backend %s is not online", backend.Name())
+	}
+
+	if err := backend.Driver().Create(ctx, volConfig, pool, volAttributes); err != nil {
+		if !drivers.IsVolumeExistsError(err) {
+			return err
+		}
+		Logc(ctx).WithFields(LogFields{
+			"backend": backend.Name(),
+			"volume":  volConfig.InternalName,
+		}).Debug("New volume of the migration already exists.")
+	}
+
+	return backend.Driver().CreateFollowup(ctx, volConfig)
+}
+
+// beginVolumeCutover moves a migration between backends to its cutover once its volume has been unpublished from
+// all nodes.  The cutover state is recorded before anything changes, so that the volume cannot be published
+// again, even after a restart.  The caller should not hold the orchestrator lock.
+func (o *TridentOrchestrator) beginVolumeCutover(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if publications := o.volumePublications.ListPublicationsForVolume(txn.Config.Name); len(publications) > 0 {
+		migrationConfig.Message = fmt.Sprintf(
+			"waiting for the volume to be unpublished from %d node(s) before cutting over", len(publications))
+		return nil
+	}
+
+	cuttingOver := *migrationConfig
+	cuttingOver.State = storage.VolumeMigrationCuttingOver
+	if err := o.recordVolumeMigration(ctx, txn, &cuttingOver); err != nil {
+		return err
+	}
+	*migrationConfig = cuttingOver
+	return nil
+}
+
+// advanceVolumeCutover transfers a final snapshot of an unpublished volume to its new volume, and makes the new
+// volume writable.  The caller should not hold the orchestrator lock.
+func (o *TridentOrchestrator) advanceVolumeCutover(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+	volConfig *storage.VolumeConfig,
+) error {
+	o.mutex.Lock()
+	sourceBackend, found := o.backends[migrationConfig.SourceBackendUUID]
+	targetBackend, _, err := o.getVolumeMigrationTarget(migrationConfig)
+	o.mutex.Unlock()
+	if !found {
+		return fmt.Errorf("backend %s not found", migrationConfig.SourceBackendUUID)
+	}
+	if err != nil {
+		return err
+	}
+	sourceHandle, err := getVolumeMigrationSourceHandle(ctx, sourceBackend, txn.Config.InternalName)
+	if err != nil {
+		return err
+	}
+
+	if migrationConfig.CutoverSnapshot == "" {
+		snapshotName := "migration-" + uuid.NewString()
+		snapConfig := &storage.SnapshotConfig{
+			Version:            config.OrchestratorAPIVersion,
+			Name:               snapshotName,
+			InternalName:       snapshotName,
+			VolumeName:         volConfig.Name,
+			VolumeInternalName: volConfig.InternalName,
+		}
+		snapshot, err := sourceBackend.CreateSnapshot(ctx, snapConfig, volConfig)
+		if err != nil {
+			return fmt.Errorf("could not create the cutover snapshot; %v", err)
+		}
+		withSnapshot := *migrationConfig
+		withSnapshot.CutoverSnapshot = snapshot.ID()
+		if err = o.saveVolumeMigration(ctx, txn, &withSnapshot); err != nil {
+			return err
+		}
+		*migrationConfig = withSnapshot
+	}
+
+	mirrorBackend, ok := targetBackend.(storage.Mirrorer)
+	if !ok {
+		return fmt.Errorf("backend %s does not support mirroring", targetBackend.Name())
+	}
+	targetName := migrationConfig.TargetConfig.InternalName
+
+	waiting, err := mirrorBackend.PromoteMirror(ctx, targetName, sourceHandle, migrationConfig.CutoverSnapshot)
+	if err != nil {
+		return fmt.Errorf("could not promote the new volume on backend %s; %v", targetBackend.Name(), err)
+	}
+	if waiting {
+		// Transfer the snapshot now if the backend can, rather than waiting for the replication schedule
+		if updater, ok := targetBackend.(storage.MirrorUpdater); ok {
+			err = updater.UpdateMirror(ctx, targetName, sourceHandle, migrationConfig.CutoverSnapshot)
+			if err != nil && !utils.IsUnsupportedError(err) {
+				return fmt.Errorf("could not transfer the cutover snapshot; %v", err)
+			}
+		}
+		migrationConfig.Message = "waiting for the cutover snapshot to be transferred"
+		return nil
+	}
+
+	cleaningUp := *migrationConfig
+	cleaningUp.State = storage.VolumeMigrationCleaningUp
+	if err = o.saveVolumeMigration(ctx, txn, &cleaningUp); err != nil {
+		return err
+	}
+	*migrationConfig = cleaningUp
+	return nil
+}
+
+// finishVolumeCutover switches a volume to its new backend, and then deletes the original volume.  The caller
+// should not hold the orchestrator lock.
+func (o *TridentOrchestrator) finishVolumeCutover(
+	ctx context.Context, txn *storage.VolumeTransaction, migrationConfig *storage.VolumeMigrationConfig,
+) (bool, error) {
+	volumeName := txn.Config.Name
+
+	o.mutex.Lock()
+	sourceBackend, found := o.backends[migrationConfig.SourceBackendUUID]
+	if !found {
+		o.mutex.Unlock()
+		return false, fmt.Errorf("backend %s not found", migrationConfig.SourceBackendUUID)
+	}
+	targetBackend, _, err := o.getVolumeMigrationTarget(migrationConfig)
+	if err != nil {
+		o.mutex.Unlock()
+		return false, err
+	}
+
+	newConfig := migrationConfig.TargetConfig.ConstructClone()
+	newConfig.IsMirrorDestination = false
+	newConfig.PeerVolumeHandle = ""
+
+	if volume := o.volumes[volumeName]; volume.BackendUUID != migrationConfig.TargetBackendUUID {
+		newVolume := storage.NewVolume(newConfig, migrationConfig.TargetBackendUUID, migrationConfig.TargetPool,
+			false, storage.VolumeStateOnline)
+		if err = o.storeClient.UpdateVolume(ctx, newVolume); err != nil {
+			o.mutex.Unlock()
+			return false, fmt.Errorf("could not save the new backend of volume %s; %v", volumeName, err)
+		}
+		sourceBackend.RemoveCachedVolume(volumeName)
+		targetBackend.Volumes()[volumeName] = newVolume
+		o.volumes[volumeName] = newVolume
+
+		Logc(ctx).WithFields(LogFields{
+			"volume":  volumeName,
+			"backend": targetBackend.Name(),
+			"pool":    migrationConfig.TargetPool,
+		}).Info("Orchestrator cut the volume over to its new backend.")
+	}
+	o.mutex.Unlock()
+
+	// The cutover snapshot was transferred to the new volume, where Trident does not track it
+	snapConfig := &storage.SnapshotConfig{
+		Version:            config.OrchestratorAPIVersion,
+		VolumeName:         volumeName,
+		VolumeInternalName: newConfig.InternalName,
+	}
+	if _, snapConfig.Name, err = storage.ParseSnapshotID(migrationConfig.CutoverSnapshot); err == nil {
+		snapConfig.InternalName = snapConfig.Name
+		if err = targetBackend.DeleteSnapshot(ctx, snapConfig, newConfig); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"volume":   volumeName,
+				"snapshot": snapConfig.Name,
+			}).WithError(err).Warning("Could not delete the cutover snapshot from the migrated volume.")
+		}
+	}
+
+	// Delete the original volume, which the transaction config still describes.  It is no longer cached on its
+	// backend, so its driver deletes it directly.
+	if mirrorBackend, ok := sourceBackend.(storage.Mirrorer); ok {
+		if err = mirrorBackend.ReleaseMirror(ctx, txn.Config.InternalName); err != nil {
+			Logc(ctx).WithField("volume", txn.Config.InternalName).WithError(err).Warning(
+				"Could not release the mirror of the original volume.")
+		}
+	}
+	if state := sourceBackend.State(); !state.IsOnline() && !state.IsDeleting() {
+		return false, fmt.Errorf("backend %s is %s", sourceBackend.Name(), state)
+	}
+	if err = sourceBackend.Driver().Destroy(ctx, txn.Config); err != nil {
+		return false, fmt.Errorf("could not delete the original volume from backend %s; %v",
+			sourceBackend.Name(), err)
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return true, o.completeVolumeMigration(ctx, txn)
+}
+
+// getVolumeMigrationTarget returns the backend and pool to which a volume is migrating.
+// The caller should hold the orchestrator lock.
+func (o *TridentOrchestrator) getVolumeMigrationTarget(
+	migrationConfig *storage.VolumeMigrationConfig,
+) (storage.Backend, storage.Pool, error) {
+	backend, found := o.backends[migrationConfig.TargetBackendUUID]
+	if !found {
+		return nil, nil, fmt.Errorf("backend %s not found", migrationConfig.TargetBackendUUID)
+	}
+	pool, found := backend.Storage()[migrationConfig.TargetPool]
+	if !found {
+		return nil, nil, fmt.Errorf("pool %s not found on backend %s", migrationConfig.TargetPool, backend.Name())
+	}
+	return backend, pool, nil
+}
+
+// getVolumeMigrationSourceHandle returns the handle by which the new volume of a migration between backends
+// refers to the original volume.
+func getVolumeMigrationSourceHandle(
+	ctx context.Context, sourceBackend storage.Backend, internalName string,
+) (string, error) {
+	mirrorBackend, ok := sourceBackend.(storage.Mirrorer)
+	if !ok {
+		return "", fmt.Errorf("backend %s does not support mirroring", sourceBackend.Name())
+	}
+
+	_, _, sourceID, err := mirrorBackend.GetReplicationDetails(ctx, internalName, "")
+	if err != nil {
+		return "", err
+	}
+	return sourceID + ":" + internalName, nil
+}
+
+// completeVolumeMigration deletes the transaction of a finished migration.  The caller should hold the
+// orchestrator lock.
+func (o *TridentOrchestrator) completeVolumeMigration(ctx context.Context, txn *storage.VolumeTransaction) error {
+	if err := o.DeleteVolumeTransaction(ctx, txn); err != nil {
+		return fmt.Errorf("failed to clean up volume migration transaction; %v", err)
+	}
+	delete(o.volumeMigrations, txn.Config.Name)
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":  txn.Config.Name,
+		"backend": txn.VolumeMigrationConfig.TargetBackendUUID,
+		"pool":    txn.VolumeMigrationConfig.TargetPool,
+	}).Info("Orchestrator migrated the volume.")
+
+	return nil
+}
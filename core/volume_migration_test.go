@@ -0,0 +1,247 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/fake"
+	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils"
+)
+
+// addMigrationBackend adds a fake backend with two pools between which volumes may be moved
+func addMigrationBackend(t *testing.T, o *TridentOrchestrator, backendName string) {
+	pool := func() *fake.StoragePool {
+		return &fake.StoragePool{
+			Attrs: map[string]sa.Offer{
+				sa.Media:            sa.NewStringOffer("hdd"),
+				sa.ProvisioningType: sa.NewStringOffer("thick", "thin"),
+				sa.TestingAttribute: sa.NewBoolOffer(true),
+			},
+			Bytes: 100 * 1024 * 1024 * 1024,
+		}
+	}
+	configJSON, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File,
+		map[string]*fake.StoragePool{"primary": pool(), "secondary": pool()}, []fake.Volume{})
+	if err != nil {
+		t.Fatal("Unable to create mock driver config JSON: ", err)
+	}
+	if _, err = o.AddBackend(ctx(), configJSON, ""); err != nil {
+		t.Fatalf("Unable to add backend: %v", err)
+	}
+}
+
+// addMigrationVolume adds a volume to any of the fake backends with two pools
+func addMigrationVolume(t *testing.T, o *TridentOrchestrator, volumeName string) *storage.Volume {
+	const scName = "migrateSC"
+	if _, ok := o.storageClasses[scName]; !ok {
+		_, err := o.AddStorageClass(ctx(), &storageclass.Config{
+			Name:       scName,
+			Attributes: map[string]sa.Request{sa.TestingAttribute: sa.NewBoolRequest(true)},
+		})
+		if err != nil {
+			t.Fatal("Unable to add storage class: ", err)
+		}
+	}
+	if _, err := o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)); err != nil {
+		t.Fatal("Unable to create volume: ", err)
+	}
+	return o.volumes[volumeName]
+}
+
+func TestMigrateVolumeBetweenPools(t *testing.T) {
+	const (
+		backendName = "migrateBackend"
+		volumeName  = "migrateVolume"
+	)
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+	addMigrationBackend(t, o, backendName)
+
+	volume := addMigrationVolume(t, o, volumeName)
+	backendUUID := volume.BackendUUID
+	sourcePool := volume.Pool
+	targetPool := "primary"
+	if sourcePool == targetPool {
+		targetPool = "secondary"
+	}
+
+	// Migrations are refused for missing volumes and pools, and to the volume's own pool
+	_, err := o.MigrateVolume(ctx(), "missingVolume", backendName, targetPool)
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+	_, err = o.MigrateVolume(ctx(), volumeName, backendName, "missingPool")
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+	_, err = o.MigrateVolume(ctx(), volumeName, backendName, sourcePool)
+	assert.True(t, utils.IsInvalidInputError(err), "expected invalid input error")
+
+	migration, err := o.MigrateVolume(ctx(), volumeName, backendName, targetPool)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeMigrationMoving, migration.State)
+	assert.Equal(t, backendName, migration.TargetBackend)
+	assert.Equal(t, targetPool, migration.TargetPool)
+
+	// A migrating volume may not be migrated again or deleted
+	_, err = o.MigrateVolume(ctx(), volumeName, backendName, targetPool)
+	assert.True(t, utils.IsFoundError(err), "expected found error")
+	err = o.DeleteVolume(ctx(), volumeName)
+	assert.True(t, utils.IsVolumeStateError(err), "expected volume state error")
+
+	// Nor may it be resized, snapshotted, cloned or modified, as the changes could be lost at the cutover
+	err = o.ResizeVolume(ctx(), volumeName, "2147483648")
+	assert.True(t, utils.IsVolumeStateError(err), "expected volume state error")
+	_, err = o.CreateSnapshot(ctx(), &storage.SnapshotConfig{Name: "snap", VolumeName: volumeName})
+	assert.True(t, utils.IsVolumeStateError(err), "expected volume state error")
+	cloneConfig := tu.GenerateVolumeConfig("migrateClone", 1, "migrateSC", config.File)
+	cloneConfig.CloneSourceVolume = volumeName
+	_, err = o.CloneVolume(ctx(), cloneConfig)
+	assert.True(t, utils.IsVolumeStateError(err), "expected volume state error")
+	_, err = o.ModifyVolume(ctx(), volumeName, storage.VolumeModification{storage.ModifiableSnapshotPolicy: "none"})
+	assert.True(t, utils.IsVolumeStateError(err), "expected volume state error")
+
+	// The migration is recorded so that it may be resumed after a restart
+	txns, err := o.storeClient.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 1)
+	assert.Equal(t, storage.MigrateVolume, txns[0].Op)
+
+	migrations, err := o.ListVolumeMigrations(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 1)
+
+	// The fake driver moves volumes at once, so the migration completes on the first pass
+	o.advanceVolumeMigrations(ctx())
+
+	assert.Equal(t, targetPool, o.volumes[volumeName].Pool)
+	assert.Equal(t, backendUUID, o.volumes[volumeName].BackendUUID)
+	persistentVolume, err := o.storeClient.GetVolume(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.Equal(t, targetPool, persistentVolume.Pool)
+
+	_, err = o.GetVolumeMigration(ctx(), volumeName)
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+	txns, err = o.storeClient.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 0)
+
+	assert.NoError(t, o.DeleteVolume(ctx(), volumeName))
+}
+
+func TestMigrateVolumeBetweenPools_MoveFailures(t *testing.T) {
+	const (
+		backendName = "migrateBackend"
+		volumeName  = "migrateVolume"
+	)
+
+	mockCtrl := gomock.NewController(t)
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+	addMigrationBackend(t, o, backendName)
+
+	volume := addMigrationVolume(t, o, volumeName)
+	sourcePool := volume.Pool
+	targetPool := "primary"
+	if sourcePool == targetPool {
+		targetPool = "secondary"
+	}
+	_, err := o.MigrateVolume(ctx(), volumeName, backendName, targetPool)
+	assert.NoError(t, err)
+
+	// Stand in for the backend, whose moves to the target pool keep failing
+	backend := o.backends[volume.BackendUUID]
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().Name().Return(backendName).AnyTimes()
+	mockBackend.EXPECT().Storage().Return(backend.Storage()).AnyTimes()
+	o.backends[volume.BackendUUID] = mockBackend
+	moveErr := utils.VolumeMoveFailedError("move failed")
+
+	// A failed move is restarted and counted, and the failure is reported
+	mockBackend.EXPECT().MoveVolume(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(true, 0, moveErr).Times(2)
+	o.advanceVolumeMigrations(ctx())
+	o.advanceVolumeMigrations(ctx())
+
+	migration, err := o.GetVolumeMigration(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeMigrationMoving, migration.State)
+	assert.Equal(t, moveErr.Error(), migration.Message)
+	assert.Equal(t, 2, o.volumeMigrations[volumeName].VolumeMigrationConfig.MoveFailures)
+	assert.True(t, o.isVolumeMigrating(volumeName))
+
+	// Once the moves have failed too often, the migration fails and the volume stays in its original pool
+	mockBackend.EXPECT().MoveVolume(gomock.Any(), gomock.Any(), gomock.Any(), false).Return(false, 0, moveErr)
+	o.advanceVolumeMigrations(ctx())
+
+	migration, err = o.GetVolumeMigration(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeMigrationFailed, migration.State)
+	assert.Equal(t, moveErr.Error(), migration.Message)
+	assert.False(t, o.isVolumeMigrating(volumeName))
+	assert.Equal(t, sourcePool, o.volumes[volumeName].Pool)
+	txns, err := o.storeClient.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 0)
+
+	// The failure is still reported on later passes, without the backend being asked to move the volume
+	o.advanceVolumeMigrations(ctx())
+	migration, err = o.GetVolumeMigration(ctx(), volumeName)
+	assert.NoError(t, err)
+	assert.Equal(t, moveErr.Error(), migration.Message)
+
+	// The volume may be migrated again, which replaces the failed migration
+	o.backends[volume.BackendUUID] = backend
+	migration, err = o.MigrateVolume(ctx(), volumeName, backendName, targetPool)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeMigrationMoving, migration.State)
+	assert.Empty(t, migration.Message)
+
+	o.advanceVolumeMigrations(ctx())
+	assert.Equal(t, targetPool, o.volumes[volumeName].Pool)
+	assert.NoError(t, o.DeleteVolume(ctx(), volumeName))
+}
+
+func TestMigrateVolumeBetweenBackendsRequiresMirroring(t *testing.T) {
+	const volumeName = "migrateVolume"
+
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+	addMigrationBackend(t, o, "sourceBackend")
+	addMigrationBackend(t, o, "targetBackend")
+
+	volume := addMigrationVolume(t, o, volumeName)
+	targetBackend := "targetBackend"
+	if o.backends[volume.BackendUUID].Name() == targetBackend {
+		targetBackend = "sourceBackend"
+	}
+
+	// The fake driver cannot mirror volumes, so they may not be migrated to another backend
+	_, err := o.MigrateVolume(ctx(), volumeName, targetBackend, "primary")
+	assert.True(t, utils.IsUnsupportedError(err), "expected unsupported error")
+	assert.Empty(t, o.volumeMigrations)
+}
+
+func TestResumeVolumeMigration(t *testing.T) {
+	o := getOrchestrator(t, false)
+	txn := &storage.VolumeTransaction{
+		Config: &storage.VolumeConfig{Name: "migrateVolume"},
+		VolumeMigrationConfig: &storage.VolumeMigrationConfig{
+			TargetPool: "secondary",
+			State:      storage.VolumeMigrationCuttingOver,
+		},
+		Op: storage.MigrateVolume,
+	}
+
+	// A migration found at bootstrap is resumed rather than rolled back
+	assert.NoError(t, o.handleFailedTransaction(ctx(), txn))
+	assert.Equal(t, txn, o.volumeMigrations["migrateVolume"])
+	assert.True(t, o.isVolumeCuttingOver("migrateVolume"))
+}
@@ -46,11 +46,12 @@ const (
 	EventForceUpdate EventType = "forceupdate"
 	EventDelete      EventType = "delete"
 
-	ObjectTypeTridentBackendConfig      string = "TridentBackendConfig"
-	ObjectTypeTridentBackend            string = "TridentBackend"
-	ObjectTypeSecret                    string = "secret"
-	ObjectTypeTridentMirrorRelationship string = "TridentMirrorRelationship"
-	ObjectTypeTridentSnapshotInfo       string = "TridentSnapshotInfo"
+	ObjectTypeTridentBackendConfig         string = "TridentBackendConfig"
+	ObjectTypeTridentBackend               string = "TridentBackend"
+	ObjectTypeSecret                       string = "secret"
+	ObjectTypeTridentMirrorRelationship    string = "TridentMirrorRelationship"
+	ObjectTypeTridentSnapshotInfo          string = "TridentSnapshotInfo"
+	ObjectTypeTridentActionSnapshotRestore string = "TridentActionSnapshotRestore"
 
 	OperationStatusSuccess string = "Success"
 	OperationStatusFailed  string = "Failed"
@@ -118,6 +119,10 @@ type TridentCrdController struct {
 	snapshotInfoLister listers.TridentSnapshotInfoLister
 	snapshotInfoSynced cache.InformerSynced
 
+	// TridentActionSnapshotRestore CRD handling
+	actionSnapshotRestoreLister listers.TridentActionSnapshotRestoreLister
+	actionSnapshotRestoreSynced cache.InformerSynced
+
 	// TridentNode CRD handling
 	nodesLister listers.TridentNodeLister
 	nodesSynced cache.InformerSynced
@@ -206,6 +211,7 @@ func newTridentCrdControllerImpl(
 	backendConfigInformer := crdInformer.TridentBackendConfigs()
 	mirrorInformer := allNSCrdInformer.TridentMirrorRelationships()
 	snapshotInfoInformer := allNSCrdInformer.TridentSnapshotInfos()
+	actionSnapshotRestoreInformer := allNSCrdInformer.TridentActionSnapshotRestores()
 	nodeInformer := crdInformer.TridentNodes()
 	storageClassInformer := crdInformer.TridentStorageClasses()
 	transactionInformer := txnInformer.TridentTransactions()
@@ -224,41 +230,43 @@ func newTridentCrdControllerImpl(
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
 
 	controller := &TridentCrdController{
-		orchestrator:             orchestrator,
-		kubeClientset:            kubeClientset,
-		snapshotClientSet:        snapshotClientset,
-		crdClientset:             crdClientset,
-		crdControllerStopChan:    make(chan struct{}),
-		crdInformerFactory:       crdInformerFactory,
-		crdInformer:              crdInformer,
-		txnInformerFactory:       txnInformerFactory,
-		txnInformer:              txnInformer,
-		kubeInformerFactory:      kubeInformerFactory,
-		kubeInformer:             kubeInformer,
-		backendsLister:           backendInformer.Lister(),
-		backendsSynced:           backendInformer.Informer().HasSynced,
-		backendConfigsLister:     backendConfigInformer.Lister(),
-		backendConfigsSynced:     backendConfigInformer.Informer().HasSynced,
-		mirrorLister:             mirrorInformer.Lister(),
-		mirrorSynced:             mirrorInformer.Informer().HasSynced,
-		snapshotInfoLister:       snapshotInfoInformer.Lister(),
-		snapshotInfoSynced:       snapshotInfoInformer.Informer().HasSynced,
-		nodesLister:              nodeInformer.Lister(),
-		nodesSynced:              nodeInformer.Informer().HasSynced,
-		storageClassesLister:     storageClassInformer.Lister(),
-		storageClassesSynced:     storageClassInformer.Informer().HasSynced,
-		transactionsLister:       transactionInformer.Lister(),
-		transactionsSynced:       transactionInformer.Informer().HasSynced,
-		versionsLister:           versionInformer.Lister(),
-		versionsSynced:           versionInformer.Informer().HasSynced,
-		volumesLister:            volumeInformer.Lister(),
-		volumesSynced:            volumeInformer.Informer().HasSynced,
-		volumePublicationsLister: volumePublicationInformer.Lister(),
-		volumePublicationsSynced: volumePublicationInformer.Informer().HasSynced,
-		snapshotsLister:          snapshotInformer.Lister(),
-		snapshotsSynced:          snapshotInformer.Informer().HasSynced,
-		secretsLister:            secretInformer.Lister(),
-		secretsSynced:            secretInformer.Informer().HasSynced,
+		orchestrator:                orchestrator,
+		kubeClientset:               kubeClientset,
+		snapshotClientSet:           snapshotClientset,
+		crdClientset:                crdClientset,
+		crdControllerStopChan:       make(chan struct{}),
+		crdInformerFactory:          crdInformerFactory,
+		crdInformer:                 crdInformer,
+		txnInformerFactory:          txnInformerFactory,
+		txnInformer:                 txnInformer,
+		kubeInformerFactory:         kubeInformerFactory,
+		kubeInformer:                kubeInformer,
+		backendsLister:              backendInformer.Lister(),
+		backendsSynced:              backendInformer.Informer().HasSynced,
+		backendConfigsLister:        backendConfigInformer.Lister(),
+		backendConfigsSynced:        backendConfigInformer.Informer().HasSynced,
+		mirrorLister:                mirrorInformer.Lister(),
+		mirrorSynced:                mirrorInformer.Informer().HasSynced,
+		snapshotInfoLister:          snapshotInfoInformer.Lister(),
+		snapshotInfoSynced:          snapshotInfoInformer.Informer().HasSynced,
+		actionSnapshotRestoreLister: actionSnapshotRestoreInformer.Lister(),
+		actionSnapshotRestoreSynced: actionSnapshotRestoreInformer.Informer().HasSynced,
+		nodesLister:                 nodeInformer.Lister(),
+		nodesSynced:                 nodeInformer.Informer().HasSynced,
+		storageClassesLister:        storageClassInformer.Lister(),
+		storageClassesSynced:        storageClassInformer.Informer().HasSynced,
+		transactionsLister:          transactionInformer.Lister(),
+		transactionsSynced:          transactionInformer.Informer().HasSynced,
+		versionsLister:              versionInformer.Lister(),
+		versionsSynced:              versionInformer.Informer().HasSynced,
+		volumesLister:               volumeInformer.Lister(),
+		volumesSynced:               volumeInformer.Informer().HasSynced,
+		volumePublicationsLister:    volumePublicationInformer.Lister(),
+		volumePublicationsSynced:    volumePublicationInformer.Informer().HasSynced,
+		snapshotsLister:             snapshotInformer.Lister(),
+		snapshotsSynced:             snapshotInformer.Informer().HasSynced,
+		secretsLister:               secretInformer.Lister(),
+		secretsSynced:               secretInformer.Informer().HasSynced,
 		workqueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(),
 			crdControllerQueueName),
 		recorder: recorder,
@@ -293,6 +301,12 @@ func newTridentCrdControllerImpl(
 		DeleteFunc: controller.deleteCRHandler,
 	})
 
+	_, _ = actionSnapshotRestoreInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.addCRHandler,
+		UpdateFunc: controller.updateCRHandler,
+		DeleteFunc: controller.deleteCRHandler,
+	})
+
 	_, _ = secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		// Do not handle AddFunc here otherwise everytime trident is restarted,
 		// there will be unwarranted reconciles and backend initializations
@@ -384,6 +398,7 @@ func (c *TridentCrdController) Run(ctx context.Context, threadiness int, stopCh
 		c.mirrorSynced,
 		c.snapshotsSynced,
 		c.snapshotInfoSynced,
+		c.actionSnapshotRestoreSynced,
 		c.secretsSynced); !ok {
 		waitErr := fmt.Errorf("failed to wait for caches to sync")
 		Logx(ctx).Errorf("Error: %v", waitErr)
@@ -581,6 +596,8 @@ func (c *TridentCrdController) processNextWorkItem() bool {
 			handleFunction = c.handleTridentMirrorRelationship
 		case ObjectTypeTridentSnapshotInfo:
 			handleFunction = c.handleTridentSnapshotInfo
+		case ObjectTypeTridentActionSnapshotRestore:
+			handleFunction = c.handleActionSnapshotRestore
 		default:
 			return fmt.Errorf("unknown objectType in the workqueue: %v", keyItem.objectType)
 		}
@@ -707,6 +724,9 @@ func (c *TridentCrdController) removeFinalizers(ctx context.Context, obj interfa
 		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
 			return c.removeTSIFinalizers(ctx, crd)
 		}
+	case *tridentv1.TridentActionSnapshotRestore:
+		// nothing to do
+		return nil
 	default:
 		Logx(ctx).Warnf("unexpected type %T", crd)
 		return fmt.Errorf("unexpected type %T", crd)
@@ -0,0 +1,199 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/netapp/trident/frontend/csi"
+	. "github.com/netapp/trident/logging"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+)
+
+// handleActionSnapshotRestore carries out the in-place restore requested by a TridentActionSnapshotRestore CR.
+// Actions run at most once; the outcome is recorded in the CR status and is never revisited.
+func (c *TridentCrdController) handleActionSnapshotRestore(keyItem *KeyItem) error {
+	key := keyItem.key
+	ctx := keyItem.ctx
+
+	// Deleting an action CR has no effect on the storage
+	if keyItem.event == EventDelete {
+		return nil
+	}
+
+	// Convert the namespace/name string into a distinct namespace and name
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		Logx(ctx).WithField("key", key).Error("Invalid key.")
+		return nil
+	}
+
+	// Get the resource with this namespace/name
+	actionCR, err := c.actionSnapshotRestoreLister.TridentActionSnapshotRestores(namespace).Get(name)
+	if err != nil {
+		// The resource may no longer exist, in which case we stop processing.
+		if errors.IsNotFound(err) {
+			Logx(ctx).WithField("key", key).Debug("Object in work queue no longer exists.")
+			return nil
+		}
+		return err
+	}
+
+	logFields := LogFields{"TridentActionSnapshotRestore": actionCR.Name, "namespace": namespace}
+
+	if !actionCR.ObjectMeta.DeletionTimestamp.IsZero() {
+		Logx(ctx).WithFields(logFields).Debug("Snapshot restore action is being deleted, ignoring.")
+		return nil
+	}
+
+	if actionCR.IsComplete() {
+		Logx(ctx).WithFields(logFields).Debug("Snapshot restore action is already complete, ignoring.")
+		return nil
+	}
+
+	// An action left in progress was interrupted, most likely by a restart, and its outcome is unknown
+	if actionCR.IsInProgress() {
+		restoreErr := fmt.Errorf("snapshot restore was interrupted")
+		return c.updateActionSnapshotRestoreCRComplete(ctx, actionCR, restoreErr)
+	}
+
+	if valid, reason := actionCR.IsValid(); !valid {
+		Logx(ctx).WithFields(logFields).WithField("reason", reason).Warn(
+			"Invalid TridentActionSnapshotRestore provided.")
+		c.recorder.Event(actionCR, corev1.EventTypeWarning, netappv1.ActionSnapshotRestoreInvalid, reason)
+		return c.updateActionSnapshotRestoreCRComplete(ctx, actionCR, fmt.Errorf(reason))
+	}
+
+	// Record that the action has started, so that it cannot run again
+	if actionCR, err = c.updateActionSnapshotRestoreCRInProgress(ctx, actionCR); err != nil {
+		return err
+	}
+
+	restoreErr := c.restoreSnapshotForAction(ctx, actionCR)
+	if restoreErr != nil {
+		Logx(ctx).WithFields(logFields).WithError(restoreErr).Error("Snapshot restore failed.")
+	} else {
+		Logx(ctx).WithFields(logFields).Info("Snapshot restore succeeded.")
+	}
+
+	return c.updateActionSnapshotRestoreCRComplete(ctx, actionCR, restoreErr)
+}
+
+// restoreSnapshotForAction resolves the PVC and VolumeSnapshot named in a TridentActionSnapshotRestore CR
+// to a Trident volume and snapshot, and then asks the orchestrator to restore the snapshot.
+func (c *TridentCrdController) restoreSnapshotForAction(
+	ctx context.Context, actionCR *netappv1.TridentActionSnapshotRestore,
+) error {
+	namespace := actionCR.Namespace
+
+	// Find the Trident volume behind the PVC
+	pvc, err := c.kubeClientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, actionCR.Spec.PVCName, getOpts)
+	if err != nil {
+		return fmt.Errorf("could not get PVC %s/%s; %v", namespace, actionCR.Spec.PVCName, err)
+	}
+	if pvc.Status.Phase != corev1.ClaimBound || pvc.Spec.VolumeName == "" {
+		return fmt.Errorf("PVC %s/%s is not bound to a volume", namespace, pvc.Name)
+	}
+	volumeName := pvc.Spec.VolumeName
+
+	// Find the Trident snapshot behind the VolumeSnapshot
+	vs, err := c.snapshotClientSet.SnapshotV1().VolumeSnapshots(namespace).Get(
+		ctx, actionCR.Spec.VolumeSnapshotName, getOpts)
+	if err != nil {
+		return fmt.Errorf("could not get VolumeSnapshot %s/%s; %v", namespace, actionCR.Spec.VolumeSnapshotName, err)
+	}
+	if vs.Spec.Source.PersistentVolumeClaimName == nil || *vs.Spec.Source.PersistentVolumeClaimName != pvc.Name {
+		return fmt.Errorf("VolumeSnapshot %s/%s is not a snapshot of PVC %s", namespace, vs.Name, pvc.Name)
+	}
+	if vs.Status == nil || vs.Status.BoundVolumeSnapshotContentName == nil ||
+		*vs.Status.BoundVolumeSnapshotContentName == "" {
+		return fmt.Errorf("VolumeSnapshot %s/%s is not bound to a VolumeSnapshotContent", namespace, vs.Name)
+	}
+	if vs.Status.ReadyToUse == nil || !*vs.Status.ReadyToUse {
+		return fmt.Errorf("VolumeSnapshot %s/%s is not ready to use", namespace, vs.Name)
+	}
+
+	vscName := *vs.Status.BoundVolumeSnapshotContentName
+	vsc, err := c.snapshotClientSet.SnapshotV1().VolumeSnapshotContents().Get(ctx, vscName, getOpts)
+	if err != nil {
+		return fmt.Errorf("could not get VolumeSnapshotContent %s; %v", vscName, err)
+	}
+	if vsc.Spec.Driver != csi.Provisioner {
+		return fmt.Errorf("VolumeSnapshot %s/%s is not a Trident snapshot", namespace, vs.Name)
+	}
+	if vsc.Status == nil || vsc.Status.SnapshotHandle == nil || *vsc.Status.SnapshotHandle == "" {
+		return fmt.Errorf("snapshot handle for VolumeSnapshotContent %s is not set", vscName)
+	}
+
+	snapshotVolumeName, snapshotName, err := storage.ParseSnapshotID(*vsc.Status.SnapshotHandle)
+	if err != nil {
+		return fmt.Errorf("unrecognized snapshot handle %s; %v", *vsc.Status.SnapshotHandle, err)
+	}
+	if snapshotVolumeName != volumeName {
+		return fmt.Errorf("VolumeSnapshot %s/%s does not belong to the volume bound to PVC %s",
+			namespace, vs.Name, pvc.Name)
+	}
+
+	return c.orchestrator.RestoreSnapshot(ctx, volumeName, snapshotName)
+}
+
+// updateActionSnapshotRestoreCRInProgress marks a TridentActionSnapshotRestore CR as started.
+func (c *TridentCrdController) updateActionSnapshotRestoreCRInProgress(
+	ctx context.Context, actionCR *netappv1.TridentActionSnapshotRestore,
+) (*netappv1.TridentActionSnapshotRestore, error) {
+	actionCRCopy := actionCR.DeepCopy()
+	now := metav1.Now()
+	actionCRCopy.Status = netappv1.TridentActionSnapshotRestoreStatus{
+		State:     netappv1.TridentActionStateInProgress,
+		StartTime: &now,
+	}
+
+	updatedCR, err := c.crdClientset.TridentV1().TridentActionSnapshotRestores(actionCRCopy.Namespace).UpdateStatus(
+		ctx, actionCRCopy, updateOpts)
+	if err != nil {
+		Logx(ctx).WithField("TridentActionSnapshotRestore", actionCR.Name).WithError(err).Error(
+			"Could not update TridentActionSnapshotRestore status.")
+		return nil, err
+	}
+
+	return updatedCR, nil
+}
+
+// updateActionSnapshotRestoreCRComplete records the outcome of a TridentActionSnapshotRestore CR.
+func (c *TridentCrdController) updateActionSnapshotRestoreCRComplete(
+	ctx context.Context, actionCR *netappv1.TridentActionSnapshotRestore, restoreErr error,
+) error {
+	actionCRCopy := actionCR.DeepCopy()
+	now := metav1.Now()
+	if actionCRCopy.Status.StartTime == nil {
+		actionCRCopy.Status.StartTime = &now
+	}
+	actionCRCopy.Status.CompletionTime = &now
+
+	if restoreErr != nil {
+		actionCRCopy.Status.State = netappv1.TridentActionStateFailed
+		actionCRCopy.Status.Message = restoreErr.Error()
+		c.recorder.Event(actionCR, corev1.EventTypeWarning, netappv1.ActionSnapshotRestoreFailed, restoreErr.Error())
+	} else {
+		actionCRCopy.Status.State = netappv1.TridentActionStateSucceeded
+		actionCRCopy.Status.Message = ""
+		c.recorder.Event(actionCR, corev1.EventTypeNormal, netappv1.ActionSnapshotRestoreSucceeded,
+			"Volume restored from snapshot")
+	}
+
+	_, err := c.crdClientset.TridentV1().TridentActionSnapshotRestores(actionCRCopy.Namespace).UpdateStatus(
+		ctx, actionCRCopy, updateOpts)
+	if err != nil {
+		Logx(ctx).WithField("TridentActionSnapshotRestore", actionCR.Name).WithError(err).Error(
+			"Could not update TridentActionSnapshotRestore status.")
+	}
+
+	return err
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/frontend/csi"
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	tasrNamespace = "default"
+	tasrName      = "restore1"
+	tasrPVC       = "pvc1"
+	tasrPV        = "pvc-1234"
+	tasrVS        = "snap1"
+	tasrVSC       = "snapcontent-1234"
+	tasrSnapshot  = "snapshot-1234"
+)
+
+func newTestActionSnapshotRestoreController(
+	t *testing.T, orchestrator *mockcore.MockOrchestrator,
+) *TridentCrdController {
+	kubeClient := GetTestKubernetesClientset()
+	snapClient := GetTestSnapshotClientset()
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, "trident", kubeClient, snapClient, crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend, error: %v", err.Error())
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: tasrPVC, Namespace: tasrNamespace},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: tasrPV},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	_, err = kubeClient.CoreV1().PersistentVolumeClaims(tasrNamespace).Create(ctx(), pvc, createOpts)
+	assert.NoError(t, err)
+
+	vs := &snapshotv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: tasrVS, Namespace: tasrNamespace},
+		Spec: snapshotv1.VolumeSnapshotSpec{
+			Source: snapshotv1.VolumeSnapshotSource{PersistentVolumeClaimName: utils.Ptr(tasrPVC)},
+		},
+		Status: &snapshotv1.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: utils.Ptr(tasrVSC),
+			ReadyToUse:                     utils.Ptr(true),
+		},
+	}
+	_, err = snapClient.SnapshotV1().VolumeSnapshots(tasrNamespace).Create(ctx(), vs, createOpts)
+	assert.NoError(t, err)
+
+	vsc := &snapshotv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: tasrVSC},
+		Spec:       snapshotv1.VolumeSnapshotContentSpec{Driver: csi.Provisioner},
+		Status:     &snapshotv1.VolumeSnapshotContentStatus{SnapshotHandle: utils.Ptr(tasrPV + "/" + tasrSnapshot)},
+	}
+	_, err = snapClient.SnapshotV1().VolumeSnapshotContents().Create(ctx(), vsc, createOpts)
+	assert.NoError(t, err)
+
+	return crdController
+}
+
+func addTestActionSnapshotRestore(
+	t *testing.T, crdController *TridentCrdController, spec netappv1.TridentActionSnapshotRestoreSpec,
+) *KeyItem {
+	actionCR := &netappv1.TridentActionSnapshotRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: tasrName, Namespace: tasrNamespace},
+		Spec:       spec,
+	}
+	actionCR, err := crdController.crdClientset.TridentV1().TridentActionSnapshotRestores(tasrNamespace).Create(
+		ctx(), actionCR, createOpts)
+	assert.NoError(t, err)
+
+	// Populate the lister cache directly, since the informers are not running
+	informer := crdController.crdInformerFactory.Trident().V1().TridentActionSnapshotRestores().Informer()
+	assert.NoError(t, informer.GetIndexer().Add(actionCR))
+
+	return &KeyItem{
+		key:        tasrNamespace + "/" + tasrName,
+		objectType: ObjectTypeTridentActionSnapshotRestore,
+		event:      EventAdd,
+		ctx:        ctx(),
+	}
+}
+
+func getTestActionSnapshotRestore(
+	t *testing.T, crdController *TridentCrdController,
+) *netappv1.TridentActionSnapshotRestore {
+	actionCR, err := crdController.crdClientset.TridentV1().TridentActionSnapshotRestores(tasrNamespace).Get(
+		ctx(), tasrName, getOpts)
+	assert.NoError(t, err)
+	return actionCR
+}
+
+func TestHandleActionSnapshotRestore(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	orchestrator.EXPECT().RestoreSnapshot(gomock.Any(), tasrPV, tasrSnapshot).Return(nil)
+
+	crdController := newTestActionSnapshotRestoreController(t, orchestrator)
+	keyItem := addTestActionSnapshotRestore(t, crdController, netappv1.TridentActionSnapshotRestoreSpec{
+		PVCName:            tasrPVC,
+		VolumeSnapshotName: tasrVS,
+	})
+
+	err := crdController.handleActionSnapshotRestore(keyItem)
+	assert.NoError(t, err)
+
+	actionCR := getTestActionSnapshotRestore(t, crdController)
+	assert.Equal(t, netappv1.TridentActionStateSucceeded, actionCR.Status.State)
+	assert.Empty(t, actionCR.Status.Message)
+	assert.NotNil(t, actionCR.Status.StartTime)
+	assert.NotNil(t, actionCR.Status.CompletionTime)
+}
+
+func TestHandleActionSnapshotRestore_Failed(t *testing.T) {
+	tests := []struct {
+		name string
+		spec netappv1.TridentActionSnapshotRestoreSpec
+		mock func(orchestrator *mockcore.MockOrchestrator)
+	}{
+		{
+			name: "InvalidSpec",
+			spec: netappv1.TridentActionSnapshotRestoreSpec{PVCName: tasrPVC},
+		},
+		{
+			name: "PVCNotFound",
+			spec: netappv1.TridentActionSnapshotRestoreSpec{PVCName: "missing", VolumeSnapshotName: tasrVS},
+		},
+		{
+			name: "VolumeSnapshotNotFound",
+			spec: netappv1.TridentActionSnapshotRestoreSpec{PVCName: tasrPVC, VolumeSnapshotName: "missing"},
+		},
+		{
+			name: "RestoreFailed",
+			spec: netappv1.TridentActionSnapshotRestoreSpec{PVCName: tasrPVC, VolumeSnapshotName: tasrVS},
+			mock: func(orchestrator *mockcore.MockOrchestrator) {
+				orchestrator.EXPECT().RestoreSnapshot(gomock.Any(), tasrPV, tasrSnapshot).Return(
+					errors.New("restore failed"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+			if tt.mock != nil {
+				tt.mock(orchestrator)
+			}
+
+			crdController := newTestActionSnapshotRestoreController(t, orchestrator)
+			keyItem := addTestActionSnapshotRestore(t, crdController, tt.spec)
+
+			err := crdController.handleActionSnapshotRestore(keyItem)
+			assert.NoError(t, err)
+
+			actionCR := getTestActionSnapshotRestore(t, crdController)
+			assert.Equal(t, netappv1.TridentActionStateFailed, actionCR.Status.State)
+			assert.NotEmpty(t, actionCR.Status.Message)
+			assert.NotNil(t, actionCR.Status.CompletionTime)
+		})
+	}
+}
+
+func TestHandleActionSnapshotRestore_Complete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	crdController := newTestActionSnapshotRestoreController(t, orchestrator)
+	keyItem := addTestActionSnapshotRestore(t, crdController, netappv1.TridentActionSnapshotRestoreSpec{
+		PVCName:            tasrPVC,
+		VolumeSnapshotName: tasrVS,
+	})
+
+	// A completed action must never run again
+	informer := crdController.crdInformerFactory.Trident().V1().TridentActionSnapshotRestores().Informer()
+	actionCR := getTestActionSnapshotRestore(t, crdController)
+	actionCR.Status.State = netappv1.TridentActionStateSucceeded
+	assert.NoError(t, informer.GetIndexer().Update(actionCR))
+
+	err := crdController.handleActionSnapshotRestore(keyItem)
+	assert.NoError(t, err)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
@@ -288,6 +289,49 @@ func (c *ControllerRestClient) GetChap(ctx context.Context, volumeID, nodeName s
 	return getResponse.CHAP, nil
 }
 
+type GetVolumeHealthResponse struct {
+	Health *storage.VolumeHealth `json:"health"`
+	Error  string                `json:"error,omitempty"`
+}
+
+// GetVolumeHealth requests the condition of a volume on its storage backend from the Trident controller
+func (c *ControllerRestClient) GetVolumeHealth(ctx context.Context, volumeName string) (*storage.VolumeHealth, error) {
+	url := config.VolumeURL + "/" + volumeName + "/health"
+	resp, respBody, err := c.InvokeAPI(ctx, nil, "GET", url, false, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not communicate with the Trident CSI Controller: %v", err)
+	}
+
+	getResponse := GetVolumeHealthResponse{}
+	if err := json.Unmarshal(respBody, &getResponse); err != nil {
+		return nil, fmt.Errorf("could not parse volume health: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not get volume health; %s", getResponse.Error)
+	}
+	return getResponse.Health, nil
+}
+
+// UpdateVolumeUsage reports the space used in a volume's filesystem to the Trident controller
+func (c *ControllerRestClient) UpdateVolumeUsage(
+	ctx context.Context, volumeName string, usage *storage.VolumeUsage,
+) error {
+	body, err := json.Marshal(usage)
+	if err != nil {
+		return fmt.Errorf("could not marshal JSON; %v", err)
+	}
+	url := config.VolumeURL + "/" + volumeName + "/usage"
+	resp, _, err := c.InvokeAPI(ctx, body, "PUT", url, false, false)
+	if err != nil {
+		return fmt.Errorf("could not communicate with the Trident CSI Controller: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not update volume usage; status %d", resp.StatusCode)
+	}
+	return nil
+}
+
 type ListVolumePublicationsResponse struct {
 	VolumePublications []*utils.VolumePublicationExternal `json:"volumePublications"`
 	Error              string                             `json:"error,omitempty"`
@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
@@ -22,6 +23,8 @@ type TridentController interface {
 	GetNodes(ctx context.Context) ([]string, error)
 	DeleteNode(ctx context.Context, name string) error
 	GetChap(ctx context.Context, volume, node string) (*utils.IscsiChapInfo, error)
+	GetVolumeHealth(ctx context.Context, volume string) (*storage.VolumeHealth, error)
+	UpdateVolumeUsage(ctx context.Context, volume string, usage *storage.VolumeUsage) error
 	UpdateVolumeLUKSPassphraseNames(ctx context.Context, volume string, passphraseNames []string) error
 	ListVolumePublicationsForNode(ctx context.Context, nodeName string) ([]*utils.VolumePublicationExternal, error)
 	// TODO (bpresnel) Enable later with rate-limiting?
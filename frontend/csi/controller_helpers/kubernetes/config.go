@@ -59,6 +59,12 @@ const (
 	AnnMirrorRelationship = annPrefix + "/mirrorRelationship"
 	AnnVolumeShareFromPVC = annPrefix + "/shareFromPVC"
 	AnnVolumeShareToNS    = annPrefix + "/shareToNamespace"
+
+	// Orchestrator-defined storage class parameters that set volume attributes rather than select pools.
+	// Each may also be set on a PVC with an annotation having the annotation prefix.
+	SCParameterAutogrowThreshold = "autogrowThreshold"
+	SCParameterAutogrowStep      = "autogrowStep"
+	SCParameterAutogrowMaxSize   = "autogrowMaxSize"
 )
 
 var features = map[controllerhelpers.Feature]*versionutils.Version{
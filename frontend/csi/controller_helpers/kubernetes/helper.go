@@ -5,6 +5,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -89,6 +90,29 @@ func (h *helper) GetVolumeConfig(
 	volumeConfig := getVolumeConfig(ctx, pvc.Spec.AccessModes, pvc.Spec.VolumeMode, pvName, pvcSize,
 		annotations, sc, requisiteTopology, preferredTopology)
 
+	// Autogrow relies on Kubernetes to complete each expansion, so it requires a storage class that allows it
+	if volumeConfig.AutogrowEnabled() && (sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion) {
+		Logc(ctx).WithFields(LogFields{
+			"PVC":          pvc.Name,
+			"storageClass": sc.Name,
+		}).Warning("Ignoring autogrow settings, as the storage class does not allow volume expansion.")
+		volumeConfig.AutogrowThreshold = ""
+		volumeConfig.AutogrowStep = ""
+		volumeConfig.AutogrowMaxSize = ""
+	}
+
+	// Identify the PVC so that per-volume metrics may be attributed to it
+	volumeConfig.RequestName = pvc.Name
+	volumeConfig.Namespace = pvc.Namespace
+
+	// Keep the PVC labels so that Trident snapshot policies may select the volume
+	if len(pvc.Labels) > 0 {
+		volumeConfig.Labels = make(map[string]string, len(pvc.Labels))
+		for k, v := range pvc.Labels {
+			volumeConfig.Labels[k] = v
+		}
+	}
+
 	// Check if we're cloning a PVC, and if so, do some further validation
 	if cloneSourcePVName, err := h.getCloneSourceInfo(ctx, pvc); err != nil {
 		return nil, err
@@ -393,6 +417,41 @@ func (h *helper) GetSnapshotConfig(volumeName, snapshotName string) (*storage.Sn
 	}, nil
 }
 
+// GetStorageClassName accepts the parameters of a storage class being queried by the CSI
+// provisioner and returns the name of a cached Trident storage class with the same parameters.
+// Parameters defined by Kubernetes for CSI are ignored, as they are not part of a Trident storage class.
+func (h *helper) GetStorageClassName(ctx context.Context, parameters map[string]string) (string, error) {
+	for _, obj := range h.scIndexer.List() {
+		sc, ok := obj.(*k8sstoragev1.StorageClass)
+		if !ok || sc.Provisioner != csi.Provisioner {
+			continue
+		}
+		if storageClassParametersMatch(sc.Parameters, parameters) {
+			Logc(ctx).WithField("storageClass", sc.Name).Trace("Found storage class with matching parameters.")
+			return sc.Name, nil
+		}
+	}
+
+	return "", utils.NotFoundError("no storage class found with matching parameters")
+}
+
+// storageClassParametersMatch returns true if two sets of storage class parameters are the same,
+// not counting any Kubernetes-defined CSI parameters.
+func storageClassParametersMatch(scParameters, parameters map[string]string) bool {
+	filter := func(params map[string]string) map[string]string {
+		filtered := make(map[string]string, len(params))
+		for k, v := range params {
+			if strings.HasPrefix(k, CSIParameterPrefix) || k == K8sFsType {
+				continue
+			}
+			filtered[k] = v
+		}
+		return filtered
+	}
+
+	return reflect.DeepEqual(filter(scParameters), filter(parameters))
+}
+
 // RecordVolumeEvent accepts the name of a CSI volume (i.e. a PV name), finds the associated
 // PVC, and posts and event message on the PVC object with the K8S API server.
 func (h *helper) RecordVolumeEvent(ctx context.Context, name, eventType, reason, message string) {
@@ -494,7 +553,22 @@ func getVolumeConfig(
 		MountOptions:        strings.Join(storageClass.MountOptions, ","),
 		RequisiteTopologies: requisiteTopology,
 		PreferredTopologies: preferredTopology,
+		AutogrowThreshold:   getVolumeAttribute(annotations, storageClass.Parameters, SCParameterAutogrowThreshold),
+		AutogrowStep:        getVolumeAttribute(annotations, storageClass.Parameters, SCParameterAutogrowStep),
+		AutogrowMaxSize:     getVolumeAttribute(annotations, storageClass.Parameters, SCParameterAutogrowMaxSize),
+	}
+}
+
+// getVolumeAttribute returns a volume attribute that may be set by a PVC annotation or, failing that, by a
+// storage class parameter with or without the Trident prefix.  An empty string is returned if it is not set.
+func getVolumeAttribute(annotations, scParameters map[string]string, name string) string {
+	if val := getAnnotation(annotations, annPrefix+"/"+name); val != "" {
+		return val
+	}
+	if val := getAnnotation(scParameters, annPrefix+"/"+name); val != "" {
+		return val
 	}
+	return getAnnotation(scParameters, name)
 }
 
 // getAnnotation returns an annotation from a map, or an empty string if not found.
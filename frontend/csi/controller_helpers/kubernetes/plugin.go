@@ -662,6 +662,50 @@ func (h *helper) isAttachmentValid(ctx context.Context, attachment k8sstoragev1.
 	return true
 }
 
+// ListVolumeReferences returns the Trident volumes referenced by CSI PVs, and the nodes to which Kubernetes has
+// attached them.  Attachments are included whether or not they have completed, since a volume is published to
+// a node before its attachment is marked as attached.  This method doesn't call the orchestrator, so that the
+// orchestrator may call it while holding its lock.
+func (h *helper) ListVolumeReferences(ctx context.Context) (*controllerhelpers.VolumeReferences, error) {
+	pvs, err := h.kubeClient.CoreV1().PersistentVolumes().List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("could not list PVs; %v", err)
+	}
+
+	references := &controllerhelpers.VolumeReferences{
+		Volumes:     make(map[string]string),
+		Attachments: make(map[string][]string),
+	}
+
+	// Map each PV to the Trident volume it holds
+	pvVolumes := make(map[string]string)
+	for _, pv := range pvs.Items {
+		if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != csi.Provisioner {
+			continue
+		}
+		pvVolumes[pv.Name] = pv.Spec.CSI.VolumeHandle
+		references.Volumes[pv.Spec.CSI.VolumeHandle] = pv.Name
+	}
+
+	attachments, err := h.listVolumeAttachments(ctx)
+	if err != nil && !utils.IsNotFoundError(err) {
+		return nil, fmt.Errorf("could not list volume attachments; %v", err)
+	}
+	for _, attachment := range attachments {
+		if attachment.Spec.Attacher != csi.Provisioner || attachment.Spec.NodeName == "" ||
+			attachment.Spec.Source.PersistentVolumeName == nil {
+			continue
+		}
+		volumeName, ok := pvVolumes[*attachment.Spec.Source.PersistentVolumeName]
+		if !ok {
+			volumeName = *attachment.Spec.Source.PersistentVolumeName
+		}
+		references.Attachments[volumeName] = append(references.Attachments[volumeName], attachment.Spec.NodeName)
+	}
+
+	return references, nil
+}
+
 // addPVC is the add handler for the PVC watcher.
 func (h *helper) addPVC(obj interface{}) {
 	ctx := GenerateRequestContext(nil, "", ContextSourceK8S, WorkflowVolumeCreate, LogLayerCSIFrontend)
@@ -1056,6 +1100,10 @@ func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.
 		newKey := removeSCParameterPrefix(k)
 		switch newKey {
 
+		case SCParameterAutogrowThreshold, SCParameterAutogrowStep, SCParameterAutogrowMaxSize:
+			// These set attributes of each volume, so they are read when the volume is created
+			continue
+
 		case storageattribute.RequiredStorage, storageattribute.AdditionalStoragePools:
 			// format:  additionalStoragePools: "backend1:pool1,pool2;backend2:pool1"
 			additionalPools, err := storageattribute.CreateBackendStoragePoolsMapFromEncodedString(v)
@@ -1082,6 +1130,10 @@ func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.
 			}
 			scConfig.ExcludePools = excludeStoragePools
 
+		case storageattribute.PlacementPolicy:
+			// format:  placementPolicy: "leastUsed"
+			scConfig.PlacementPolicy = storageclass.PlacementPolicy(v)
+
 		case storageattribute.StoragePools:
 			// format:  storagePools: "backend1:pool1,pool2;backend2:pool1"
 			pools, err := storageattribute.CreateBackendStoragePoolsMapFromEncodedString(v)
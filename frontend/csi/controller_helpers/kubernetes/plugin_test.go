@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/netapp/trident/frontend/csi"
 	mockcore "github.com/netapp/trident/mocks/mock_core"
@@ -632,6 +633,53 @@ func TestProcessAddedStorageClass_CreateBackendSPMapFromEncodedString_Failure(t
 	plugin.processAddedStorageClass(ctx, sc)
 }
 
+func TestProcessAddedStorageClass_AutogrowParameters(t *testing.T) {
+	mockCore, plugin := newMockPlugin(t)
+	ctx := context.TODO()
+	sc := &k8sstoragev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: FakeStorageClass,
+		},
+		Provisioner: csi.Provisioner,
+		Parameters: map[string]string{
+			"backendType":                         "ontap-nas",
+			"trident.netapp.io/autogrowThreshold": "80",
+			"autogrowStep":                        "10Gi",
+			"autogrowMaxSize":                     "1Ti",
+		},
+	}
+
+	btAttr, _ := storageattribute.CreateAttributeRequestFromAttributeValue("backendType", "ontap-nas")
+	expectedSCConfig := &storageclass.Config{
+		Name: FakeStorageClass,
+		Attributes: map[string]storageattribute.Request{
+			"backendType": btAttr,
+		},
+	}
+
+	// Autogrow parameters apply to volumes, so they must not become storage class attributes
+	mockCore.EXPECT().AddStorageClass(gomock.Any(), expectedSCConfig).Return(nil, nil).Times(1)
+	plugin.processAddedStorageClass(ctx, sc)
+}
+
+func TestGetVolumeAttribute(t *testing.T) {
+	scParameters := map[string]string{
+		"trident.netapp.io/autogrowThreshold": "80",
+		"autogrowThreshold":                   "70",
+		"autogrowStep":                        "10Gi",
+	}
+	annotations := map[string]string{
+		"trident.netapp.io/autogrowMaxSize": "1Ti",
+		"trident.netapp.io/autogrowStep":    "20%",
+	}
+
+	assert.Equal(t, "80", getVolumeAttribute(nil, scParameters, SCParameterAutogrowThreshold))
+	assert.Equal(t, "20%", getVolumeAttribute(annotations, scParameters, SCParameterAutogrowStep))
+	assert.Equal(t, "10Gi", getVolumeAttribute(nil, scParameters, SCParameterAutogrowStep))
+	assert.Equal(t, "1Ti", getVolumeAttribute(annotations, scParameters, SCParameterAutogrowMaxSize))
+	assert.Equal(t, "", getVolumeAttribute(nil, nil, SCParameterAutogrowMaxSize))
+}
+
 func TestProcessAddedStorageClass_CreateAttributeRequestFromAttributeValue_Failure(t *testing.T) {
 	_, plugin := newMockPlugin(t)
 	ctx := context.TODO()
@@ -775,6 +823,63 @@ func TestListVolumeAttachments(t *testing.T) {
 	}
 }
 
+func TestListVolumeReferences(t *testing.T) {
+	ctx := context.Background()
+	pvName := "pv1"
+	otherPVName := "pv2"
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: pvName},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: csi.Provisioner, VolumeHandle: "vol1"},
+			},
+		},
+	}
+	otherPV := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: otherPVName},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "other.csi.driver", VolumeHandle: "vol2"},
+			},
+		},
+	}
+	attachment := &k8sstoragev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "attachment1"},
+		Spec: k8sstoragev1.VolumeAttachmentSpec{
+			Attacher: csi.Provisioner,
+			NodeName: "node1",
+			Source:   k8sstoragev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+		},
+	}
+	otherAttachment := &k8sstoragev1.VolumeAttachment{
+		ObjectMeta: metav1.ObjectMeta{Name: "attachment2"},
+		Spec: k8sstoragev1.VolumeAttachmentSpec{
+			Attacher: "other.csi.driver",
+			NodeName: "node1",
+			Source:   k8sstoragev1.VolumeAttachmentSource{PersistentVolumeName: &otherPVName},
+		},
+	}
+
+	h := &helper{kubeClient: k8sfake.NewSimpleClientset(pv, otherPV, attachment, otherAttachment)}
+	references, err := h.ListVolumeReferences(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"vol1": pvName}, references.Volumes)
+	assert.Equal(t, map[string][]string{"vol1": {"node1"}}, references.Attachments,
+		"attachments should be included before they complete")
+
+	// Errors from Kubernetes are returned
+	clientSet := &k8sfake.Clientset{}
+	clientSet.Fake.PrependReactor("list", "*",
+		func(_ k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, nil, errors.New("list failed")
+		},
+	)
+	h = &helper{kubeClient: clientSet}
+	references, err = h.ListVolumeReferences(ctx)
+	assert.Error(t, err)
+	assert.Nil(t, references)
+}
+
 func TestReconcileVolumePublications(t *testing.T) {
 	ctx := context.Background()
 	volume := "bar"
@@ -1070,3 +1175,41 @@ func TestIsAttachmentValid(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorageClassName(t *testing.T) {
+	_, plugin := newMockPlugin(t)
+	plugin.scIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+
+	otherSC := &k8sstoragev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "other"},
+		Provisioner: "fakeProvisioner",
+		Parameters:  map[string]string{"backendType": "ontap-nas"},
+	}
+	nasSC := &k8sstoragev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "nas"},
+		Provisioner: csi.Provisioner,
+		Parameters: map[string]string{
+			"backendType":               "ontap-nas",
+			"csi.storage.k8s.io/fsType": "nfs",
+		},
+	}
+	sanSC := &k8sstoragev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "san"},
+		Provisioner: csi.Provisioner,
+		Parameters:  map[string]string{"backendType": "ontap-san", "fsType": "ext4"},
+	}
+	for _, sc := range []*k8sstoragev1.StorageClass{otherSC, nasSC, sanSC} {
+		assert.NoError(t, plugin.scIndexer.Add(sc))
+	}
+
+	name, err := plugin.GetStorageClassName(context.Background(), map[string]string{"backendType": "ontap-nas"})
+	assert.NoError(t, err)
+	assert.Equal(t, "nas", name)
+
+	name, err = plugin.GetStorageClassName(context.Background(), map[string]string{"backendType": "ontap-san"})
+	assert.NoError(t, err)
+	assert.Equal(t, "san", name)
+
+	_, err = plugin.GetStorageClassName(context.Background(), map[string]string{"backendType": "ontap-nas-economy"})
+	assert.True(t, utils.IsNotFoundError(err))
+}
@@ -245,3 +245,42 @@ func (h *helper) resizePVC(
 
 	return pvcUpdated, nil
 }
+
+// UpdateVolumeSize accepts the name of a CSI volume (i.e. a PV name) that Trident has grown on its own
+// initiative, such as by autogrow, and raises the storage request of the bound PVC to match.  Kubernetes then
+// calls ControllerExpandVolume, which finds the volume already grown, and expands the filesystem if needed.
+func (h *helper) UpdateVolumeSize(ctx context.Context, name string, sizeBytes int64) error {
+	Logc(ctx).WithFields(LogFields{
+		"name":      name,
+		"sizeBytes": sizeBytes,
+	}).Trace(">>>> UpdateVolumeSize")
+	defer Logc(ctx).Trace("<<<< UpdateVolumeSize")
+
+	pvc, err := h.getPVCForCSIVolume(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	newSize := resource.NewQuantity(sizeBytes, resource.BinarySI)
+	requestedSize := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if requestedSize.Cmp(*newSize) >= 0 {
+		return nil
+	}
+
+	pvcClone := pvc.DeepCopy()
+	if pvcClone.Spec.Resources.Requests == nil {
+		pvcClone.Spec.Resources.Requests = make(v1.ResourceList)
+	}
+	pvcClone.Spec.Resources.Requests[v1.ResourceStorage] = *newSize
+	if _, err = h.patchPVC(ctx, pvc, pvcClone); err != nil {
+		return fmt.Errorf("could not update the size of PVC %s; %v", pvc.Name, err)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"PVC":          pvc.Name,
+		"PVC_old_size": requestedSize.String(),
+		"PVC_new_size": newSize.String(),
+	}).Info("K8S helper updated the PVC request after the volume was grown.")
+
+	return nil
+}
@@ -107,6 +107,23 @@ func (h *helper) GetSnapshotConfig(volumeName, snapshotName string) (*storage.Sn
 	}, nil
 }
 
+// GetStorageClassName accepts the parameters of a storage class being queried by the CSI
+// provisioner and returns the name of the matching storage class, registering one if needed.
+func (h *helper) GetStorageClassName(ctx context.Context, parameters map[string]string) (string, error) {
+	// GetStorageClass consumes some parameters, so work on a copy
+	options := make(map[string]string, len(parameters))
+	for k, v := range parameters {
+		options[k] = v
+	}
+
+	scConfig, err := frontendcommon.GetStorageClass(ctx, options, h.orchestrator)
+	if err != nil {
+		return "", err
+	}
+
+	return scConfig.Name, nil
+}
+
 func (h *helper) GetNodeTopologyLabels(_ context.Context, _ string) (map[string]string, error) {
 	return map[string]string{}, nil
 }
@@ -129,6 +146,15 @@ func (h *helper) RecordVolumeEvent(ctx context.Context, name, eventType, reason,
 	}).Trace("Volume event.")
 }
 
+// UpdateVolumeSize has nothing to do, as there is no container orchestrator to inform of the new size.
+func (h *helper) UpdateVolumeSize(ctx context.Context, name string, sizeBytes int64) error {
+	Logc(ctx).WithFields(LogFields{
+		"name":      name,
+		"sizeBytes": sizeBytes,
+	}).Trace("Volume size updated.")
+	return nil
+}
+
 // RecordNodeEvent accepts the name of a CSI node and writes the specified
 // event message to the debug Log().
 func (h *helper) RecordNodeEvent(ctx context.Context, name, eventType, reason, message string) {
@@ -40,6 +40,10 @@ type ControllerHelper interface {
 	// a SnapshotConfig structure as needed by Trident to create a new snapshot.
 	GetSnapshotConfig(volumeName, snapshotName string) (*storage.SnapshotConfig, error)
 
+	// GetStorageClassName accepts the parameters of a storage class being queried by the CSI
+	// provisioner and returns the name of the Trident storage class having those parameters.
+	GetStorageClassName(ctx context.Context, parameters map[string]string) (string, error)
+
 	// GetNodeTopologyLabels returns topology labels for a given node
 	// Example: map[string]string{"topology.kubernetes.io/region": "us-east1"}
 	GetNodeTopologyLabels(ctx context.Context, nodeName string) (map[string]string, error)
@@ -53,6 +57,11 @@ type ControllerHelper interface {
 	// event message in a manner appropriate to the container orchestrator.
 	RecordVolumeEvent(ctx context.Context, name, eventType, reason, message string)
 
+	// UpdateVolumeSize accepts the name of a CSI volume that Trident has grown on its own initiative,
+	// such as by autogrow, and records the new size with the container orchestrator so that it may
+	// complete the expansion, including growing the filesystem on a block volume.
+	UpdateVolumeSize(ctx context.Context, name string, sizeBytes int64) error
+
 	// RecordNodeEvent accepts the name of a CSI node and writes the specified
 	// event message in a manner appropriate to the container orchestrator.
 	RecordNodeEvent(ctx context.Context, name, eventType, reason, message string)
@@ -64,3 +73,18 @@ type ControllerHelper interface {
 	// CSI version in the plain-CSI case.  This value is reported in Trident's telemetry.
 	Version() string
 }
+
+// VolumeReferences describes the references to Trident volumes held by the container orchestrator.
+type VolumeReferences struct {
+	// Volumes maps the name of each referenced Trident volume to the name of the CO object referencing it
+	Volumes map[string]string
+	// Attachments maps the name of each Trident volume to the nodes to which the CO has attached it
+	Attachments map[string][]string
+}
+
+// VolumeReferenceLister is implemented by the helpers whose container orchestrator tracks volumes and their
+// attachments, so that Trident's state may be checked against it.
+type VolumeReferenceLister interface {
+	// ListVolumeReferences returns the references to Trident volumes held by the container orchestrator.
+	ListVolumeReferences(ctx context.Context) (*VolumeReferences, error)
+}
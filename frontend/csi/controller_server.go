@@ -13,6 +13,7 @@ import (
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
@@ -356,6 +357,8 @@ func (p *Plugin) ControllerPublishVolume(
 	volumePublishInfo := &utils.VolumePublishInfo{
 		Localhost:      false,
 		HostIQN:        []string{nodeInfo.IQN},
+		HostNQN:        nodeInfo.NQN,
+		HostWWPN:       nodeInfo.WWPNs,
 		HostIP:         nodeInfo.IPs,
 		HostName:       nodeInfo.Name,
 		Unmanaged:      volume.Config.ImportNotManaged,
@@ -390,6 +393,9 @@ func (p *Plugin) ControllerPublishVolume(
 
 	publishInfo["mountOptions"] = volumePublishInfo.MountOptions
 	publishInfo["filesystemType"] = volumePublishInfo.FilesystemType
+	if volume.Config.AutogrowEnabled() {
+		publishInfo["autogrow"] = "true"
+	}
 	switch volume.Config.Protocol {
 	case tridentconfig.File:
 		if volumePublishInfo.FilesystemType == "smb" {
@@ -400,6 +406,25 @@ func (p *Plugin) ControllerPublishVolume(
 			publishInfo["nfsPath"] = volumePublishInfo.NfsPath
 		}
 	case tridentconfig.Block:
+		if volumePublishInfo.SANType == utils.NVMe {
+			publishInfo["sanType"] = volumePublishInfo.SANType
+			publishInfo["nvmeSubsystemNqn"] = volumePublishInfo.NVMeSubsystemNQN
+			publishInfo["nvmeSubsystemUUID"] = volumePublishInfo.NVMeSubsystemUUID
+			publishInfo["nvmeNamespaceUUID"] = volumePublishInfo.NVMeNamespaceUUID
+			publishInfo["nvmeTargetIPs"] = strings.Join(volumePublishInfo.NVMeTargetIPs, ",")
+			publishInfo["LUKSEncryption"] = volumePublishInfo.LUKSEncryption
+			break
+		}
+		if volumePublishInfo.SANType == utils.FCP {
+			publishInfo["sanType"] = volumePublishInfo.SANType
+			publishInfo["fcTargetWWNN"] = volumePublishInfo.FCTargetWWNN
+			publishInfo["fcpLunNumber"] = strconv.Itoa(int(volumePublishInfo.FCPLunNumber))
+			publishInfo["fcpLunSerial"] = volumePublishInfo.FCPLunSerial
+			publishInfo["fcpIgroup"] = volumePublishInfo.FCPIgroup
+			publishInfo["LUKSEncryption"] = volumePublishInfo.LUKSEncryption
+			publishInfo["sharedTarget"] = strconv.FormatBool(volumePublishInfo.SharedTarget)
+			break
+		}
 		stashIscsiTargetPortals(publishInfo, volumePublishInfo)
 		publishInfo["iscsiTargetIqn"] = volumePublishInfo.IscsiTargetIQN
 		publishInfo["iscsiLunNumber"] = strconv.Itoa(int(volumePublishInfo.IscsiLunNumber))
@@ -627,6 +652,9 @@ func (p *Plugin) ListVolumes(
 				for _, publication := range publications {
 					entry.Status.PublishedNodeIds = append(entry.Status.PublishedNodeIds, publication.NodeName)
 				}
+				// We must also include the volume condition when we report VOLUME_CONDITION capability, but
+				// only Trident's cached state is consulted here to avoid querying the backend for every volume
+				entry.Status.VolumeCondition = getCSIVolumeConditionFromState(volume)
 				entries = append(entries, entry)
 			}
 		} else {
@@ -638,9 +666,44 @@ func (p *Plugin) ListVolumes(
 	return &csi.ListVolumesResponse{Entries: entries, NextToken: nextToken}, nil
 }
 
-func (p *Plugin) GetCapacity(_ context.Context, _ *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	// Trident doesn't report pool capacities
-	return nil, status.Error(codes.Unimplemented, "")
+func (p *Plugin) GetCapacity(
+	ctx context.Context, req *csi.GetCapacityRequest,
+) (*csi.GetCapacityResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowStorageClassGetCapacity)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GetCapacity", "Type": "CSI_Controller"}
+	Logc(ctx).WithFields(fields).Trace(">>>> GetCapacity")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< GetCapacity")
+
+	// Find the storage class whose pools should be summed
+	scName, err := p.controllerHelper.GetStorageClassName(ctx, req.GetParameters())
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			// The CO may ask about a storage class before Trident learns of it, so report no capacity
+			Logc(ctx).WithFields(fields).WithError(err).Debug("Storage class not found, reporting no capacity.")
+			return &csi.GetCapacityResponse{}, nil
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	var topology map[string]string
+	if req.GetAccessibleTopology() != nil {
+		topology = req.GetAccessibleTopology().GetSegments()
+	}
+
+	capacity, err := p.orchestrator.GetCapacity(ctx, scName, topology)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return &csi.GetCapacityResponse{}, nil
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	return &csi.GetCapacityResponse{
+		AvailableCapacity: bytesToInt64(capacity.AvailableBytes),
+		MaximumVolumeSize: &wrappers.Int64Value{Value: bytesToInt64(capacity.MaximumVolumeBytes)},
+	}, nil
 }
 
 func (p *Plugin) ControllerGetCapabilities(
@@ -972,10 +1035,49 @@ func (p *Plugin) ControllerExpandVolume(
 }
 
 func (p *Plugin) ControllerGetVolume(
-	_ context.Context, _ *csi.ControllerGetVolumeRequest,
+	ctx context.Context, req *csi.ControllerGetVolumeRequest,
 ) (*csi.ControllerGetVolumeResponse, error) {
-	// Trident doesn't support ControllerGetVolume
-	return nil, status.Error(codes.Unimplemented, "")
+	ctx = SetContextWorkflow(ctx, WorkflowVolumeGet)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "ControllerGetVolume", "Type": "CSI_Controller"}
+	Logc(ctx).WithFields(fields).Trace(">>>> ControllerGetVolume")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< ControllerGetVolume")
+
+	volumeID := req.GetVolumeId()
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "no volume ID provided")
+	}
+
+	volume, err := p.orchestrator.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	csiVolume, err := p.getCSIVolumeFromTridentVolume(ctx, volume)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	volumeStatus := &csi.ControllerGetVolumeResponse_VolumeStatus{
+		PublishedNodeIds: []string{},
+	}
+
+	// Find all the nodes to which this volume has been published
+	publications, err := p.orchestrator.ListVolumePublicationsForVolume(ctx, volumeID)
+	if err != nil {
+		msg := fmt.Sprintf("error listing volume publications for volume %s", volumeID)
+		Logc(ctx).WithError(err).Error(msg)
+		return nil, status.Error(codes.Internal, msg)
+	}
+	for _, publication := range publications {
+		volumeStatus.PublishedNodeIds = append(volumeStatus.PublishedNodeIds, publication.NodeName)
+	}
+
+	health, err := p.orchestrator.GetVolumeHealth(ctx, volumeID)
+	volumeStatus.VolumeCondition = getCSIVolumeCondition(ctx, volumeID, health, err)
+
+	return &csi.ControllerGetVolumeResponse{Volume: csiVolume, Status: volumeStatus}, nil
 }
 
 func (p *Plugin) getCSIVolumeFromTridentVolume(
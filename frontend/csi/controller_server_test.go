@@ -11,11 +11,14 @@ import (
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	tridentconfig "github.com/netapp/trident/config"
 	mockcore "github.com/netapp/trident/mocks/mock_core"
 	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
 	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 )
 
@@ -121,6 +124,35 @@ func TestControllerPublishVolume(t *testing.T) {
 	assert.Equal(t, expectedPublishContext, publishContext)
 }
 
+func TestControllerPublishVolume_Autogrow(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	// Create a mocked orchestrator
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	// Create a mocked helper
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	// Create an instance of ControllerServer for this test
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	// Create fake objects for this test
+	req := generateFakePublishVolumeRequest()
+	fakeVolumeExternal := generateFakeVolumeExternal(req.VolumeId)
+	fakeVolumeExternal.Config.AutogrowThreshold = "80%"
+	fakeNode := generateFakeNode(req.NodeId)
+
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), req.VolumeId).Return(fakeVolumeExternal, nil)
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), req.NodeId).Return(fakeNode.ConstructExternal(), nil)
+	mockOrchestrator.EXPECT().PublishVolume(gomock.Any(), req.VolumeId, gomock.Any()).Return(nil)
+
+	publishResponse, err := controllerServer.ControllerPublishVolume(ctx, req)
+	assert.Nilf(t, err, "unexpected error publishing volume; %v", err)
+
+	publishContext := publishResponse.PublishContext
+	expectedPublishContext := map[string]string{
+		"autogrow": "true", "filesystemType": "", "mountOptions": "", "protocol": "",
+	}
+	assert.Equal(t, expectedPublishContext, publishContext)
+}
+
 func TestControllerPublishVolume_BlockProtocol(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	// Create a mocked orchestrator
@@ -217,3 +249,130 @@ func TestControllerUnpublishVolume_NotFoundErrors(t *testing.T) {
 	_, err = controllerServer.ControllerUnpublishVolume(ctx, req)
 	assert.Nil(t, err, "unexpected error unpublishing volume")
 }
+
+func TestGetCapacity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	// Create a mocked orchestrator
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	// Create a mocked helper
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	// Create an instance of ControllerServer for this test
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	params := map[string]string{"backendType": "ontap-nas"}
+	segments := map[string]string{"topology.kubernetes.io/zone": "z1"}
+	req := &csi.GetCapacityRequest{
+		Parameters:         params,
+		AccessibleTopology: &csi.Topology{Segments: segments},
+	}
+
+	mockHelper.EXPECT().GetStorageClassName(gomock.Any(), params).Return("gold", nil).Times(1)
+	mockOrchestrator.EXPECT().GetCapacity(gomock.Any(), "gold", segments).Return(&storageclass.Capacity{
+		TotalBytes:         3000,
+		AvailableBytes:     2000,
+		MaximumVolumeBytes: 1500,
+	}, nil).Times(1)
+
+	resp, err := controllerServer.GetCapacity(ctx, req)
+	assert.NoError(t, err, "unexpected error getting capacity")
+	assert.Equal(t, int64(2000), resp.GetAvailableCapacity())
+	assert.Equal(t, int64(1500), resp.GetMaximumVolumeSize().GetValue())
+}
+
+func TestGetCapacity_Errors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	// Create a mocked orchestrator
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	// Create a mocked helper
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	// Create an instance of ControllerServer for this test
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	req := &csi.GetCapacityRequest{}
+
+	// An unknown storage class reports no capacity
+	mockHelper.EXPECT().GetStorageClassName(gomock.Any(), gomock.Any()).
+		Return("", utils.NotFoundError("not found")).Times(1)
+
+	resp, err := controllerServer.GetCapacity(ctx, req)
+	assert.NoError(t, err, "unexpected error getting capacity")
+	assert.Equal(t, int64(0), resp.GetAvailableCapacity())
+
+	// A storage class unknown to the orchestrator reports no capacity
+	mockHelper.EXPECT().GetStorageClassName(gomock.Any(), gomock.Any()).Return("gold", nil).Times(1)
+	mockOrchestrator.EXPECT().GetCapacity(gomock.Any(), "gold", nil).
+		Return(nil, utils.NotFoundError("not found")).Times(1)
+
+	resp, err = controllerServer.GetCapacity(ctx, req)
+	assert.NoError(t, err, "unexpected error getting capacity")
+	assert.Equal(t, int64(0), resp.GetAvailableCapacity())
+
+	// Other orchestrator errors are returned
+	mockHelper.EXPECT().GetStorageClassName(gomock.Any(), gomock.Any()).Return("gold", nil).Times(1)
+	mockOrchestrator.EXPECT().GetCapacity(gomock.Any(), "gold", nil).
+		Return(nil, errors.New("failed")).Times(1)
+
+	_, err = controllerServer.GetCapacity(ctx, req)
+	assert.Error(t, err, "expected error getting capacity")
+}
+
+func TestControllerGetVolume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	// Create a mocked orchestrator
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	// Create a mocked helper
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	// Create an instance of ControllerServer for this test
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	fakeVolumeExternal := generateFakeVolumeExternal("volumeID")
+	fakeVolumeExternal.Config.Size = "1073741824"
+	publications := []*utils.VolumePublicationExternal{{VolumeName: "volumeID", NodeName: "nodeId"}}
+
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "volumeID").Return(fakeVolumeExternal, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "volumeID").Return(publications, nil)
+	mockOrchestrator.EXPECT().GetVolumeHealth(gomock.Any(), "volumeID").
+		Return(&storage.VolumeHealth{Abnormal: true, Message: "volume is full"}, nil)
+
+	req := &csi.ControllerGetVolumeRequest{VolumeId: "volumeID"}
+	resp, err := controllerServer.ControllerGetVolume(ctx, req)
+
+	assert.NoError(t, err, "unexpected error getting volume")
+	assert.Equal(t, "volumeID", resp.GetVolume().GetVolumeId())
+	assert.Equal(t, int64(1073741824), resp.GetVolume().GetCapacityBytes())
+	assert.Equal(t, []string{"nodeId"}, resp.GetStatus().GetPublishedNodeIds())
+	assert.True(t, resp.GetStatus().GetVolumeCondition().GetAbnormal())
+	assert.Equal(t, "volume is full", resp.GetStatus().GetVolumeCondition().GetMessage())
+}
+
+func TestControllerGetVolume_Errors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	// Create a mocked orchestrator
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	// Create a mocked helper
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	// Create an instance of ControllerServer for this test
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	// Missing volume ID
+	_, err := controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Unknown volume
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "volumeID").Return(nil, utils.NotFoundError("not found"))
+
+	_, err = controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "volumeID"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Health that cannot be determined leaves the condition unset
+	fakeVolumeExternal := generateFakeVolumeExternal("volumeID")
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "volumeID").Return(fakeVolumeExternal, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "volumeID").Return(nil, nil)
+	mockOrchestrator.EXPECT().GetVolumeHealth(gomock.Any(), "volumeID").
+		Return(nil, utils.UnsupportedError("not supported"))
+
+	resp, err := controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "volumeID"})
+	assert.NoError(t, err, "unexpected error getting volume")
+	assert.Empty(t, resp.GetStatus().GetPublishedNodeIds())
+	assert.Nil(t, resp.GetStatus().GetVolumeCondition())
+}
@@ -0,0 +1,201 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tridentconfig "github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+func (p *Plugin) GroupControllerGetCapabilities(
+	ctx context.Context, _ *csi.GroupControllerGetCapabilitiesRequest,
+) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotGetCapabilities)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GroupControllerGetCapabilities", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Trace(">>>> GroupControllerGetCapabilities")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< GroupControllerGetCapabilities")
+
+	return &csi.GroupControllerGetCapabilitiesResponse{Capabilities: p.gcsCap}, nil
+}
+
+func (p *Plugin) CreateVolumeGroupSnapshot(
+	ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest,
+) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotCreate)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "CreateVolumeGroupSnapshot", "Type": "CSI_GroupController", "name": req.Name}
+	Logc(ctx).WithFields(fields).Debug(">>>> CreateVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< CreateVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetName()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot name provided")
+	}
+
+	volumeNames := req.GetSourceVolumeIds()
+	if len(volumeNames) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no source volume IDs provided")
+	}
+
+	groupConfig := &storage.GroupSnapshotConfig{
+		Version:     tridentconfig.OrchestratorAPIVersion,
+		Name:        groupSnapshotName,
+		VolumeNames: volumeNames,
+	}
+	if err := groupConfig.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Create the group snapshot, which returns the existing one if it was already created from the same volumes
+	groupSnapshot, err := p.orchestrator.CreateGroupSnapshot(ctx, groupConfig)
+	if err != nil {
+		if utils.IsInvalidInputError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if utils.IsUnsupportedError(err) {
+			// CSI snapshotter has no exponential backoff for retries, so slow it down here
+			time.Sleep(10 * time.Second)
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		} else if utils.IsMaxLimitReachedError(err) {
+			// CSI snapshotter has no exponential backoff for retries, so slow it down here
+			time.Sleep(10 * time.Second)
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	csiGroupSnapshot, err := p.getCSIGroupSnapshotFromTridentGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+func (p *Plugin) DeleteVolumeGroupSnapshot(
+	ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest,
+) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotDelete)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "DeleteVolumeGroupSnapshot", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Debug(">>>> DeleteVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot ID provided")
+	}
+
+	groupSnapshot, err := p.orchestrator.GetGroupSnapshot(ctx, groupSnapshotName)
+	if err != nil {
+		// In CSI, delete is idempotent, so don't return an error if the group snapshot doesn't exist
+		if utils.IsNotFoundError(err) {
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	if err = checkGroupSnapshotMembers(groupSnapshot, req.GetSnapshotIds()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = p.orchestrator.DeleteGroupSnapshot(ctx, groupSnapshotName); err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"groupSnapshotName": groupSnapshotName,
+			"error":             err,
+		}).Debugf("Could not delete group snapshot.")
+
+		if !utils.IsNotFoundError(err) {
+			return nil, p.getCSIErrorForOrchestratorError(err)
+		}
+	}
+
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+func (p *Plugin) GetVolumeGroupSnapshot(
+	ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest,
+) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotGet)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GetVolumeGroupSnapshot", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Trace(">>>> GetVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< GetVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot ID provided")
+	}
+
+	groupSnapshot, err := p.orchestrator.GetGroupSnapshot(ctx, groupSnapshotName)
+	if err != nil {
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	if err = checkGroupSnapshotMembers(groupSnapshot, req.GetSnapshotIds()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	csiGroupSnapshot, err := p.getCSIGroupSnapshotFromTridentGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+// checkGroupSnapshotMembers returns an error if any of the snapshot IDs supplied by the CO
+// is not a member of the group snapshot.
+func checkGroupSnapshotMembers(groupSnapshot *storage.GroupSnapshotExternal, snapshotIDs []string) error {
+	memberIDs := groupSnapshot.Config.SnapshotIDs()
+	for _, snapshotID := range snapshotIDs {
+		if !utils.SliceContainsString(memberIDs, snapshotID) {
+			return fmt.Errorf("snapshot %s is not part of group snapshot %s", snapshotID, groupSnapshot.ID())
+		}
+	}
+	return nil
+}
+
+func (p *Plugin) getCSIGroupSnapshotFromTridentGroupSnapshot(
+	ctx context.Context, groupSnapshot *storage.GroupSnapshotExternal,
+) (*csi.VolumeGroupSnapshot, error) {
+	createdSeconds, err := time.Parse(time.RFC3339, groupSnapshot.Created)
+	if err != nil {
+		Logc(ctx).WithField("time", groupSnapshot.Created).Error("Could not parse RFC3339 group snapshot time.")
+		createdSeconds = time.Now()
+	}
+
+	readyToUse := len(groupSnapshot.Snapshots) == len(groupSnapshot.Config.VolumeNames)
+	csiSnapshots := make([]*csi.Snapshot, 0, len(groupSnapshot.Snapshots))
+	for _, snapshot := range groupSnapshot.Snapshots {
+		csiSnapshot, err := p.getCSISnapshotFromTridentSnapshot(ctx, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		csiSnapshot.GroupSnapshotId = groupSnapshot.ID()
+		readyToUse = readyToUse && csiSnapshot.ReadyToUse
+		csiSnapshots = append(csiSnapshots, csiSnapshot)
+	}
+
+	return &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: groupSnapshot.ID(),
+		Snapshots:       csiSnapshots,
+		CreationTime:    &timestamp.Timestamp{Seconds: createdSeconds.Unix()},
+		ReadyToUse:      readyToUse,
+	}, nil
+}
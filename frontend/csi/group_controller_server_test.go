@@ -0,0 +1,263 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+func generateFakeGroupSnapshot(name string, volumeNames ...string) *storage.GroupSnapshotExternal {
+	groupSnapshot := storage.NewGroupSnapshot(&storage.GroupSnapshotConfig{
+		Name:         name,
+		InternalName: name,
+		VolumeNames:  volumeNames,
+	}, "2023-05-01T10:00:00Z")
+
+	snapshots := make([]*storage.Snapshot, 0, len(volumeNames))
+	for _, volumeName := range volumeNames {
+		snapshots = append(snapshots, &storage.Snapshot{
+			Config: &storage.SnapshotConfig{
+				Name:         name,
+				InternalName: name,
+				VolumeName:   volumeName,
+			},
+			Created:   "2023-05-01T10:00:00Z",
+			SizeBytes: 1024,
+			State:     storage.SnapshotStateOnline,
+		})
+	}
+	return groupSnapshot.ConstructExternal(snapshots)
+}
+
+func TestGroupControllerGetCapabilities(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	plugin := generateController(mockcore.NewMockOrchestrator(mockCtrl), mockhelpers.NewMockControllerHelper(mockCtrl))
+	plugin.addGroupControllerServiceCapabilities(ctx, []csi.GroupControllerServiceCapability_RPC_Type{
+		csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+	})
+
+	resp, err := plugin.GroupControllerGetCapabilities(ctx, &csi.GroupControllerGetCapabilitiesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetCapabilities(), 1)
+	assert.Equal(t, csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+		resp.GetCapabilities()[0].GetRpc().GetType())
+
+	pluginCaps, err := plugin.GetPluginCapabilities(ctx, &csi.GetPluginCapabilitiesRequest{})
+	assert.NoError(t, err)
+	serviceTypes := make([]csi.PluginCapability_Service_Type, 0)
+	for _, capability := range pluginCaps.GetCapabilities() {
+		serviceTypes = append(serviceTypes, capability.GetService().GetType())
+	}
+	assert.Contains(t, serviceTypes, csi.PluginCapability_Service_GROUP_CONTROLLER_SERVICE)
+}
+
+func TestCreateVolumeGroupSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	plugin := generateController(mockOrchestrator, mockhelpers.NewMockControllerHelper(mockCtrl))
+
+	groupSnapshot := generateFakeGroupSnapshot("groupsnapshot-1", "vol1", "vol2")
+	mockOrchestrator.EXPECT().CreateGroupSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, groupConfig *storage.GroupSnapshotConfig) (*storage.GroupSnapshotExternal, error) {
+			assert.Equal(t, "groupsnapshot-1", groupConfig.Name)
+			assert.Equal(t, []string{"vol1", "vol2"}, groupConfig.VolumeNames)
+			return groupSnapshot, nil
+		})
+	for _, volumeName := range []string{"vol1", "vol2"} {
+		mockOrchestrator.EXPECT().GetVolume(gomock.Any(), volumeName).Return(&storage.VolumeExternal{
+			Config: &storage.VolumeConfig{Name: volumeName, Size: "1Gi"},
+		}, nil)
+	}
+
+	resp, err := plugin.CreateVolumeGroupSnapshot(ctx, &csi.CreateVolumeGroupSnapshotRequest{
+		Name:            "groupsnapshot-1",
+		SourceVolumeIds: []string{"vol1", "vol2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "groupsnapshot-1", resp.GetGroupSnapshot().GetGroupSnapshotId())
+	assert.True(t, resp.GetGroupSnapshot().GetReadyToUse())
+	assert.Len(t, resp.GetGroupSnapshot().GetSnapshots(), 2)
+	for _, snapshot := range resp.GetGroupSnapshot().GetSnapshots() {
+		assert.Equal(t, "groupsnapshot-1", snapshot.GetGroupSnapshotId())
+		assert.Equal(t, storage.MakeSnapshotID(snapshot.GetSourceVolumeId(), "groupsnapshot-1"),
+			snapshot.GetSnapshotId())
+	}
+}
+
+func TestCreateVolumeGroupSnapshot_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *csi.CreateVolumeGroupSnapshotRequest
+		coreErr      error
+		expectCreate bool
+		expectedCode codes.Code
+	}{
+		{
+			name:         "NoName",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{SourceVolumeIds: []string{"vol1"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NoVolumes",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "DuplicateVolumes",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group", SourceVolumeIds: []string{"vol1", "vol1"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "DifferentBackends",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group", SourceVolumeIds: []string{"vol1", "vol2"}},
+			coreErr:      utils.InvalidInputError("different backends"),
+			expectCreate: true,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "VolumeNotFound",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group", SourceVolumeIds: []string{"vol1", "vol2"}},
+			coreErr:      utils.NotFoundError("not found"),
+			expectCreate: true,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "NameInUse",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group", SourceVolumeIds: []string{"vol1", "vol2"}},
+			coreErr:      utils.FoundError("exists"),
+			expectCreate: true,
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "CreateFailed",
+			req:          &csi.CreateVolumeGroupSnapshotRequest{Name: "group", SourceVolumeIds: []string{"vol1", "vol2"}},
+			coreErr:      errors.New("failed"),
+			expectCreate: true,
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+			plugin := generateController(mockOrchestrator, mockhelpers.NewMockControllerHelper(mockCtrl))
+
+			if tt.expectCreate {
+				mockOrchestrator.EXPECT().CreateGroupSnapshot(gomock.Any(), gomock.Any()).Return(nil, tt.coreErr)
+			}
+
+			resp, err := plugin.CreateVolumeGroupSnapshot(ctx, tt.req)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestDeleteVolumeGroupSnapshot(t *testing.T) {
+	groupSnapshot := generateFakeGroupSnapshot("group", "vol1", "vol2")
+	memberIDs := []string{storage.MakeSnapshotID("vol1", "group"), storage.MakeSnapshotID("vol2", "group")}
+
+	tests := []struct {
+		name         string
+		req          *csi.DeleteVolumeGroupSnapshotRequest
+		getErr       error
+		deleteErr    error
+		expectDelete bool
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Deleted",
+			req:          &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group", SnapshotIds: memberIDs},
+			expectDelete: true,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "NoID",
+			req:          &csi.DeleteVolumeGroupSnapshotRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "NotFound",
+			req:          &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group"},
+			getErr:       utils.NotFoundError("not found"),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "SnapshotMismatch",
+			req: &csi.DeleteVolumeGroupSnapshotRequest{
+				GroupSnapshotId: "group",
+				SnapshotIds:     []string{storage.MakeSnapshotID("vol3", "group")},
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "DeleteFailed",
+			req:          &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "group"},
+			deleteErr:    errors.New("failed"),
+			expectDelete: true,
+			expectedCode: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+			plugin := generateController(mockOrchestrator, mockhelpers.NewMockControllerHelper(mockCtrl))
+
+			if tt.req.GroupSnapshotId != "" {
+				if tt.getErr != nil {
+					mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "group").Return(nil, tt.getErr)
+				} else {
+					mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "group").Return(groupSnapshot, nil)
+				}
+			}
+			if tt.expectDelete {
+				mockOrchestrator.EXPECT().DeleteGroupSnapshot(gomock.Any(), "group").Return(tt.deleteErr)
+			}
+
+			_, err := plugin.DeleteVolumeGroupSnapshot(ctx, tt.req)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestGetVolumeGroupSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	plugin := generateController(mockOrchestrator, mockhelpers.NewMockControllerHelper(mockCtrl))
+
+	// A group snapshot missing one of its members is not ready to use
+	groupSnapshot := generateFakeGroupSnapshot("group", "vol1", "vol2")
+	groupSnapshot.Snapshots = groupSnapshot.Snapshots[:1]
+
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "group").Return(groupSnapshot, nil)
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(&storage.VolumeExternal{
+		Config: &storage.VolumeConfig{Name: "vol1", Size: "1Gi"},
+	}, nil)
+
+	resp, err := plugin.GetVolumeGroupSnapshot(ctx, &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "group"})
+	assert.NoError(t, err)
+	assert.Equal(t, "group", resp.GetGroupSnapshot().GetGroupSnapshotId())
+	assert.Len(t, resp.GetGroupSnapshot().GetSnapshots(), 1)
+	assert.False(t, resp.GetGroupSnapshot().GetReadyToUse())
+
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "missing").Return(nil,
+		utils.NotFoundError("not found"))
+
+	_, err = plugin.GetVolumeGroupSnapshot(ctx, &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
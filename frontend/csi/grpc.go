@@ -20,7 +20,10 @@ import (
 // NonBlockingGRPCServer Defines Non blocking GRPC server interfaces
 type NonBlockingGRPCServer interface {
 	// Start services at the endpoint
-	Start(endpoint string, ids csi.IdentityServer, cs csi.ControllerServer, ns csi.NodeServer)
+	Start(
+		endpoint string, ids csi.IdentityServer, cs csi.ControllerServer, ns csi.NodeServer,
+		gcs csi.GroupControllerServer,
+	)
 	// GracefulStop Stops the service gracefully
 	GracefulStop()
 	// Stops the service forcefully
@@ -38,8 +41,9 @@ type nonBlockingGRPCServer struct {
 
 func (s *nonBlockingGRPCServer) Start(
 	endpoint string, ids csi.IdentityServer, cs csi.ControllerServer, ns csi.NodeServer,
+	gcs csi.GroupControllerServer,
 ) {
-	go s.serve(endpoint, ids, cs, ns)
+	go s.serve(endpoint, ids, cs, ns, gcs)
 }
 
 func (s *nonBlockingGRPCServer) GracefulStop() {
@@ -52,6 +56,7 @@ func (s *nonBlockingGRPCServer) Stop() {
 
 func (s *nonBlockingGRPCServer) serve(
 	endpoint string, ids csi.IdentityServer, cs csi.ControllerServer, ns csi.NodeServer,
+	gcs csi.GroupControllerServer,
 ) {
 	proto, addr, err := ParseEndpoint(endpoint)
 	if err != nil {
@@ -113,6 +118,10 @@ func (s *nonBlockingGRPCServer) serve(
 		csi.RegisterNodeServer(server, ns)
 		Log().Debug("Registered CSI node server.")
 	}
+	if gcs != nil {
+		csi.RegisterGroupControllerServer(server, gcs)
+		Log().Debug("Registered CSI group controller server.")
+	}
 
 	if err := server.Serve(listener); err != nil {
 		Log().Fatal(err)
@@ -22,6 +22,7 @@ import (
 
 	tridentconfig "github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
@@ -29,12 +30,24 @@ const (
 	tridentDeviceInfoPath           = "/var/lib/trident/tracking"
 	lockID                          = "csi_node_server"
 	AttachISCSIVolumeTimeoutShort   = 20 * time.Second
+	AttachNVMeVolumeTimeout         = 20 * time.Second
+	AttachFCPVolumeTimeout          = 20 * time.Second
 	iSCSINodeUnstageMaxDuration     = 15 * time.Second
 	iSCSISelfHealingLockContext     = "ISCSISelfHealingThread"
 	defaultNodeReconciliationPeriod = 1 * time.Minute
 	maxJitterValue                  = 5000
+
+	// volumeConditionCacheTTL is how long a volume's condition is reused before the controller is asked again, as
+	// the CO collects volume statistics far more often than a volume's health is likely to change
+	volumeConditionCacheTTL = 5 * time.Minute
 )
 
+// cachedVolumeCondition is a volume's condition as last reported by the controller
+type cachedVolumeCondition struct {
+	condition *csi.VolumeCondition
+	expires   time.Time
+}
+
 var (
 	topologyLabels = make(map[string]string)
 	iscsiUtils     = utils.IscsiUtils
@@ -65,6 +78,12 @@ func (p *Plugin) NodeStageVolume(
 			return p.nodeStageNFSVolume(ctx, req)
 		}
 	case string(tridentconfig.Block):
+		switch req.PublishContext["sanType"] {
+		case utils.NVMe:
+			return p.nodeStageNVMeVolume(ctx, req)
+		case utils.FCP:
+			return p.nodeStageFCPVolume(ctx, req)
+		}
 		return p.nodeStageISCSIVolume(ctx, req)
 	case string(tridentconfig.BlockOnFile):
 		return p.nodeStageNFSBlockVolume(ctx, req)
@@ -150,6 +169,12 @@ func (p *Plugin) nodeUnstageVolume(
 			return p.nodeUnstageNFSVolume(ctx, req)
 		}
 	case tridentconfig.Block:
+		switch publishInfo.SANType {
+		case utils.NVMe:
+			return p.nodeUnstageNVMeVolume(ctx, req, publishInfo)
+		case utils.FCP:
+			return p.nodeUnstageFCPVolume(ctx, req, publishInfo, force)
+		}
 		return p.nodeUnstageISCSIVolumeRetry(ctx, req, publishInfo, force)
 	case tridentconfig.BlockOnFile:
 		if force {
@@ -283,6 +308,8 @@ func (p *Plugin) NodeUnpublishVolume(
 		return nil, status.Errorf(codes.Internal, fmtStr, targetPath, req.VolumeId, err)
 	}
 
+	p.volumeConditions.Delete(req.VolumeId)
+
 	return &csi.NodeUnpublishVolumeResponse{}, nil
 }
 
@@ -309,6 +336,7 @@ func (p *Plugin) NodeGetVolumeStats(
 
 	// If raw block volume, don't return usage.
 	isRawBlock := false
+	autogrowEnabled := false
 	if req.StagingTargetPath != "" {
 		trackingInfo, err := p.nodeHelper.ReadTrackingInfo(ctx, req.VolumeId)
 		if err != nil {
@@ -321,10 +349,13 @@ func (p *Plugin) NodeGetVolumeStats(
 		publishInfo := &trackingInfo.VolumePublishInfo
 
 		isRawBlock = publishInfo.FilesystemType == tridentconfig.FsRaw
+		autogrowEnabled = publishInfo.AutogrowEnabled
 	}
+	volumeCondition := p.getVolumeCondition(ctx, req.GetVolumeId())
+
 	if isRawBlock {
 		// Return no capacity info for raw block volumes, we cannot reliably determine the capacity.
-		return &csi.NodeGetVolumeStatsResponse{}, nil
+		return &csi.NodeGetVolumeStatsResponse{VolumeCondition: volumeCondition}, nil
 	} else {
 		// If filesystem, return usage reported by FS.
 		available, capacity, usage, inodes, inodesFree, inodesUsed, err := utils.GetFilesystemStats(
@@ -333,7 +364,15 @@ func (p *Plugin) NodeGetVolumeStats(
 			Logc(ctx).Errorf("unable to get filesystem stats at path: %s; %v", req.GetVolumePath(), err)
 			return nil, status.Error(codes.Unknown, "Failed to get filesystem stats")
 		}
+
+		// Report the usage of volumes with autogrow enabled to the controller, which grows them as they fill.
+		// The CO needn't wait for the controller, so the usage is reported in the background.
+		if autogrowEnabled {
+			go p.reportVolumeUsage(req.GetVolumeId(), &storage.VolumeUsage{UsedBytes: usage, TotalBytes: capacity})
+		}
+
 		return &csi.NodeGetVolumeStatsResponse{
+			VolumeCondition: volumeCondition,
 			Usage: []*csi.VolumeUsage{
 				{
 					Unit:      csi.VolumeUsage_BYTES,
@@ -352,6 +391,36 @@ func (p *Plugin) NodeGetVolumeStats(
 	}
 }
 
+// getVolumeCondition returns whether the storage backend reports any problem with a volume.  The controller, and
+// in turn the backend, is only asked once the condition cached from its last answer has expired.
+func (p *Plugin) getVolumeCondition(ctx context.Context, volumeID string) *csi.VolumeCondition {
+	if value, ok := p.volumeConditions.Load(volumeID); ok {
+		if cached := value.(cachedVolumeCondition); time.Now().Before(cached.expires) {
+			return cached.condition
+		}
+	}
+
+	health, err := p.restClient.GetVolumeHealth(ctx, volumeID)
+	condition := getCSIVolumeCondition(ctx, volumeID, health, err)
+	p.volumeConditions.Store(volumeID, cachedVolumeCondition{
+		condition: condition,
+		expires:   time.Now().Add(volumeConditionCacheTTL),
+	})
+
+	return condition
+}
+
+// reportVolumeUsage sends the usage of a volume's filesystem to the controller.  It is called in the background,
+// so it doesn't use the context of the request that measured the usage.
+func (p *Plugin) reportVolumeUsage(volumeID string, usage *storage.VolumeUsage) {
+	ctx := GenerateRequestContext(nil, "", ContextSourceCSI, WorkflowVolumeGetStats, LogLayerCSIFrontend)
+
+	if err := p.restClient.UpdateVolumeUsage(ctx, volumeID, usage); err != nil {
+		Logc(ctx).WithError(err).WithField("volumeId", volumeID).Debug(
+			"Could not report volume usage to the controller.")
+	}
+}
+
 // NodeExpandVolume handles volume expansion for Block (i.e. iSCSI) volumes.  The CO only calls NodeExpandVolume
 // for the Block protocol as the filesystem has to be mounted to perform the resize. This is enforced in our
 // ControllerExpandVolume method where we return true for nodeExpansionRequired when the protocol is Block and
@@ -437,7 +506,14 @@ func (p *Plugin) nodeExpandVolume(
 		if fsType, err = utils.VerifyFilesystemSupport(publishInfo.FilesystemType); err != nil {
 			break
 		}
-		err = nodePrepareISCSIVolumeForExpansion(ctx, publishInfo, requiredBytes)
+		if publishInfo.SANType == utils.NVMe {
+			err = utils.NVMeRescanNamespace(ctx, publishInfo.NVMeSubsystemNQN, publishInfo.NVMeNamespaceUUID,
+				requiredBytes)
+		} else if publishInfo.SANType == utils.FCP {
+			err = utils.FCPRescanDevices(ctx, publishInfo.FCTargetWWNN, publishInfo.FCPLunNumber, requiredBytes)
+		} else {
+			err = nodePrepareISCSIVolumeForExpansion(ctx, publishInfo, requiredBytes)
+		}
 		mountOptions = publishInfo.MountOptions
 	case tridentconfig.BlockOnFile:
 		if fsType, err = utils.GetVerifiedBlockFsType(publishInfo.FilesystemType); err != nil {
@@ -633,6 +709,22 @@ func (p *Plugin) nodeGetInfo(ctx context.Context) *utils.Node {
 		Logc(ctx).WithField("IQN", iscsiWWN).Info("Discovered iSCSI initiator name.")
 	}
 
+	nvmeNQN, err := utils.GetHostNqn(ctx)
+	if err != nil || nvmeNQN == "" {
+		Logc(ctx).Debug("Could not find NVMe host NQN.")
+	} else {
+		Logc(ctx).WithField("NQN", nvmeNQN).Info("Discovered NVMe host NQN.")
+	}
+
+	fcpWWPNs, err := utils.GetFCPHostPortWWPNs(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Warn("Problem getting FC host port names.")
+	} else if len(fcpWWPNs) == 0 {
+		Logc(ctx).Debug("Could not find any FC host ports.")
+	} else {
+		Logc(ctx).WithField("WWPNs", fcpWWPNs).Info("Discovered FC host port names.")
+	}
+
 	ips, err := utils.GetIPAddresses(ctx)
 	if err != nil {
 		Logc(ctx).WithField("error", err).Error("Could not get IP addresses.")
@@ -673,6 +765,8 @@ func (p *Plugin) nodeGetInfo(ctx context.Context) *utils.Node {
 	node := &utils.Node{
 		Name:     p.nodeName,
 		IQN:      iscsiWWN,
+		NQN:      nvmeNQN,
+		WWPNs:    fcpWWPNs,
 		IPs:      ips,
 		NodePrep: nil,
 		HostInfo: p.hostInfo,
@@ -738,6 +832,7 @@ func (p *Plugin) nodeStageNFSVolume(
 	}
 
 	publishInfo.MountOptions = req.PublishContext["mountOptions"]
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
 	publishInfo.NfsServerIP = req.PublishContext["nfsServerIp"]
 	publishInfo.NfsPath = req.PublishContext["nfsPath"]
 
@@ -843,6 +938,7 @@ func (p *Plugin) nodeStageSMBVolume(
 
 	// Have to check if it's windows
 	publishInfo.MountOptions = req.PublishContext["mountOptions"]
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
 	publishInfo.SMBServer = req.PublishContext["smbServer"]
 	publishInfo.SMBPath = req.PublishContext["smbPath"]
 
@@ -992,6 +1088,11 @@ func (p *Plugin) populatePublishedISCSISessions(ctx context.Context) {
 
 		publishInfo := &trackingInfo.VolumePublishInfo
 
+		// NVMe and FCP volumes have no iSCSI sessions to heal
+		if publishInfo.SANType == utils.NVMe || publishInfo.SANType == utils.FCP {
+			continue
+		}
+
 		newCtx := context.WithValue(ctx, utils.SessionInfoSource, utils.SessionSourceTrackingInfo)
 		utils.AddISCSISession(newCtx, &publishedISCSISessions, publishInfo, volumeID, "", utils.NotInvalid)
 	}
@@ -1061,6 +1162,7 @@ func (p *Plugin) nodeStageISCSIVolume(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	publishInfo.MountOptions = req.PublishContext["mountOptions"]
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
 	publishInfo.IscsiTargetIQN = req.PublishContext["iscsiTargetIqn"]
 	publishInfo.IscsiLunNumber = int32(lunID)
 	publishInfo.IscsiLunSerial = req.PublishContext["iscsiLunSerial"]
@@ -1362,6 +1464,281 @@ func (p *Plugin) nodePublishISCSIVolume(
 	return &csi.NodePublishVolumeResponse{}, nil
 }
 
+func (p *Plugin) nodeStageNVMeVolume(
+	ctx context.Context, req *csi.NodeStageVolumeRequest,
+) (*csi.NodeStageVolumeResponse, error) {
+	var err error
+	var fstype string
+
+	mountCapability := req.GetVolumeCapability().GetMount()
+	blockCapability := req.GetVolumeCapability().GetBlock()
+
+	if mountCapability == nil && blockCapability == nil {
+		return nil, status.Error(codes.InvalidArgument, "mount or block capability required")
+	} else if mountCapability != nil && blockCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, "mixed block and mount capabilities")
+	}
+
+	if mountCapability != nil && mountCapability.GetFsType() != "" {
+		fstype = mountCapability.GetFsType()
+	}
+
+	if fstype == "" {
+		fstype = req.PublishContext["filesystemType"]
+	}
+
+	if fstype == tridentconfig.FsRaw && mountCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, "mount capability requested with raw blocks")
+	} else if fstype != tridentconfig.FsRaw && blockCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("block capability requested with %s", fstype))
+	}
+
+	var isLUKS bool
+	if req.PublishContext["LUKSEncryption"] != "" {
+		isLUKS, err = strconv.ParseBool(req.PublishContext["LUKSEncryption"])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse LUKSEncryption into a bool, got %v",
+				req.PublishContext["LUKSEncryption"])
+		}
+	}
+
+	publishInfo := &utils.VolumePublishInfo{
+		Localhost:      true,
+		FilesystemType: fstype,
+		SANType:        utils.NVMe,
+		LUKSEncryption: strconv.FormatBool(isLUKS),
+	}
+	publishInfo.MountOptions = req.PublishContext["mountOptions"]
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
+	publishInfo.NVMeSubsystemNQN = req.PublishContext["nvmeSubsystemNqn"]
+	publishInfo.NVMeSubsystemUUID = req.PublishContext["nvmeSubsystemUUID"]
+	publishInfo.NVMeNamespaceUUID = req.PublishContext["nvmeNamespaceUUID"]
+	if targetIPs := req.PublishContext["nvmeTargetIPs"]; targetIPs != "" {
+		publishInfo.NVMeTargetIPs = strings.Split(targetIPs, ",")
+	}
+
+	// Perform the connect/discovery/(optionally)format & get the device back in the publish info
+	if err = utils.AttachNVMeVolumeRetry(ctx, req.VolumeContext["internalName"], "", publishInfo,
+		req.GetSecrets(), AttachNVMeVolumeTimeout); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to stage volume: %v", err))
+	}
+
+	volumeId, stagingTargetPath, err := p.getVolumeIdAndStagingPath(req)
+	if err != nil {
+		return nil, err
+	}
+	if isLUKS {
+		luksDevice, err := utils.NewLUKSDeviceFromMappingPath(ctx, publishInfo.DevicePath, req.VolumeContext["internalName"])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		// Ensure we update the passphrase incase it has never been set before
+		err = ensureLUKSVolumePassphrase(ctx, p.restClient, luksDevice, volumeId, req.GetSecrets(), true)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "could not set LUKS volume passphrase")
+		}
+	}
+
+	volTrackingInfo := &utils.VolumeTrackingInfo{
+		VolumePublishInfo: *publishInfo,
+		StagingTargetPath: stagingTargetPath,
+		PublishedPaths:    map[string]struct{}{},
+	}
+	// Save the device info to the volume tracking info path for use in the publish & unstage calls.
+	if err := p.nodeHelper.WriteTrackingInfo(ctx, volumeId, volTrackingInfo); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.NodeStageVolumeResponse{}, nil
+}
+
+func (p *Plugin) nodeUnstageNVMeVolume(
+	ctx context.Context, req *csi.NodeUnstageVolumeRequest, publishInfo *utils.VolumePublishInfo,
+) (*csi.NodeUnstageVolumeResponse, error) {
+	if publishInfo.LUKSEncryption != "" {
+		isLUKS, err := strconv.ParseBool(publishInfo.LUKSEncryption)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse LUKSEncryption into a bool, got %v", publishInfo.LUKSEncryption)
+		}
+		if isLUKS {
+			if err := utils.EnsureLUKSDeviceClosed(ctx, publishInfo.DevicePath); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	// Each volume has its own subsystem, so the subsystem can always be disconnected.
+	if err := utils.DetachNVMeVolume(ctx, publishInfo); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	volumeId, stagingTargetPath, err := p.getVolumeIdAndStagingPath(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that the temporary mount point created during a filesystem expand operation is removed.
+	if err := utils.UmountAndRemoveTemporaryMountPoint(ctx, stagingTargetPath); err != nil {
+		Logc(ctx).WithField("stagingTargetPath", stagingTargetPath).Errorf(
+			"Failed to remove directory in staging target path; %s", err)
+		errStr := fmt.Sprintf("failed to remove temporary directory in staging target path %s; %s",
+			stagingTargetPath, err)
+		return nil, status.Error(codes.Internal, errStr)
+	}
+
+	// Delete the device info we saved to the volume tracking info path so unstage can succeed.
+	if err := p.nodeHelper.DeleteTrackingInfo(ctx, volumeId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.NodeUnstageVolumeResponse{}, nil
+}
+
+func (p *Plugin) nodeStageFCPVolume(
+	ctx context.Context, req *csi.NodeStageVolumeRequest,
+) (*csi.NodeStageVolumeResponse, error) {
+	var err error
+	var fstype string
+
+	mountCapability := req.GetVolumeCapability().GetMount()
+	blockCapability := req.GetVolumeCapability().GetBlock()
+
+	if mountCapability == nil && blockCapability == nil {
+		return nil, status.Error(codes.InvalidArgument, "mount or block capability required")
+	} else if mountCapability != nil && blockCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, "mixed block and mount capabilities")
+	}
+
+	if mountCapability != nil && mountCapability.GetFsType() != "" {
+		fstype = mountCapability.GetFsType()
+	}
+
+	if fstype == "" {
+		fstype = req.PublishContext["filesystemType"]
+	}
+
+	if fstype == tridentconfig.FsRaw && mountCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, "mount capability requested with raw blocks")
+	} else if fstype != tridentconfig.FsRaw && blockCapability != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("block capability requested with %s", fstype))
+	}
+
+	lunID, err := strconv.Atoi(req.PublishContext["fcpLunNumber"])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sharedTarget, err := strconv.ParseBool(req.PublishContext["sharedTarget"])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var isLUKS bool
+	if req.PublishContext["LUKSEncryption"] != "" {
+		isLUKS, err = strconv.ParseBool(req.PublishContext["LUKSEncryption"])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse LUKSEncryption into a bool, got %v",
+				req.PublishContext["LUKSEncryption"])
+		}
+	}
+
+	publishInfo := &utils.VolumePublishInfo{
+		Localhost:      true,
+		FilesystemType: fstype,
+		SharedTarget:   sharedTarget,
+		SANType:        utils.FCP,
+		LUKSEncryption: strconv.FormatBool(isLUKS),
+	}
+	publishInfo.MountOptions = req.PublishContext["mountOptions"]
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
+	publishInfo.FCTargetWWNN = req.PublishContext["fcTargetWWNN"]
+	publishInfo.FCPLunNumber = int32(lunID)
+	publishInfo.FCPLunSerial = req.PublishContext["fcpLunSerial"]
+	publishInfo.FCPIgroup = req.PublishContext["fcpIgroup"]
+
+	// Perform the scan/(optionally)format & get the device back in the publish info
+	if err = utils.AttachFCPVolumeRetry(ctx, req.VolumeContext["internalName"], "", publishInfo,
+		req.GetSecrets(), AttachFCPVolumeTimeout); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to stage volume: %v", err))
+	}
+
+	volumeId, stagingTargetPath, err := p.getVolumeIdAndStagingPath(req)
+	if err != nil {
+		return nil, err
+	}
+	if isLUKS {
+		luksDevice, err := utils.NewLUKSDeviceFromMappingPath(ctx, publishInfo.DevicePath, req.VolumeContext["internalName"])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		// Ensure we update the passphrase incase it has never been set before
+		err = ensureLUKSVolumePassphrase(ctx, p.restClient, luksDevice, volumeId, req.GetSecrets(), true)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "could not set LUKS volume passphrase")
+		}
+	}
+
+	volTrackingInfo := &utils.VolumeTrackingInfo{
+		VolumePublishInfo: *publishInfo,
+		StagingTargetPath: stagingTargetPath,
+		PublishedPaths:    map[string]struct{}{},
+	}
+	// Save the device info to the volume tracking info path for use in the publish & unstage calls.
+	if err := p.nodeHelper.WriteTrackingInfo(ctx, volumeId, volTrackingInfo); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.NodeStageVolumeResponse{}, nil
+}
+
+func (p *Plugin) nodeUnstageFCPVolume(
+	ctx context.Context, req *csi.NodeUnstageVolumeRequest, publishInfo *utils.VolumePublishInfo, force bool,
+) (*csi.NodeUnstageVolumeResponse, error) {
+	if publishInfo.LUKSEncryption != "" {
+		isLUKS, err := strconv.ParseBool(publishInfo.LUKSEncryption)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse LUKSEncryption into a bool, got %v", publishInfo.LUKSEncryption)
+		}
+		if isLUKS {
+			if err := utils.EnsureLUKSDeviceClosed(ctx, publishInfo.DevicePath); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	// Delete the device from the host.  FC has no sessions to log out of.
+	unmappedMpathDevice, err := utils.PrepareFCPDeviceForRemoval(ctx, publishInfo, p.unsafeDetach, force)
+	if err != nil && !p.unsafeDetach {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	volumeId, stagingTargetPath, err := p.getVolumeIdAndStagingPath(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that the temporary mount point created during a filesystem expand operation is removed.
+	if err := utils.UmountAndRemoveTemporaryMountPoint(ctx, stagingTargetPath); err != nil {
+		Logc(ctx).WithField("stagingTargetPath", stagingTargetPath).Errorf(
+			"Failed to remove directory in staging target path; %s", err)
+		errStr := fmt.Sprintf("failed to remove temporary directory in staging target path %s; %s",
+			stagingTargetPath, err)
+		return nil, status.Error(codes.Internal, errStr)
+	}
+
+	// Delete the device info we saved to the volume tracking info path so unstage can succeed.
+	if err := p.nodeHelper.DeleteTrackingInfo(ctx, volumeId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// If there is multipath device, flush(remove) mappings
+	if unmappedMpathDevice != "" {
+		utils.RemoveMultipathDeviceMapping(ctx, unmappedMpathDevice)
+	}
+
+	return &csi.NodeUnstageVolumeResponse{}, nil
+}
+
 func (p *Plugin) nodeStageNFSBlockVolume(
 	ctx context.Context, req *csi.NodeStageVolumeRequest,
 ) (*csi.NodeStageVolumeResponse, error) {
@@ -1376,6 +1753,7 @@ func (p *Plugin) nodeStageNFSBlockVolume(
 	}
 
 	publishInfo.MountOptions = utils.SanitizeMountOptions(req.PublishContext["mountOptions"], []string{"ro"})
+	publishInfo.AutogrowEnabled = req.PublishContext["autogrow"] == "true"
 	publishInfo.NfsServerIP = req.PublishContext["nfsServerIp"]
 	publishInfo.NfsPath = req.PublishContext["nfsPath"]
 	publishInfo.NfsUniqueID = req.PublishContext["nfsUniqueID"]
@@ -16,6 +16,7 @@ import (
 
 	mockControllerAPI "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_api"
 	mockNodeHelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_node_helpers"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
@@ -825,3 +826,37 @@ func TestUpdateNodePublicationState_SuccessfullyUpdatesNodeAsCleaned(t *testing.
 	err := nodeServer.updateNodePublicationState(ctx, nodeState)
 	assert.NoError(t, err, "expected no error")
 }
+
+func TestGetVolumeCondition(t *testing.T) {
+	ctx := context.Background()
+	volumeID := "foo"
+
+	mockCtrl := gomock.NewController(t)
+	mockClient := mockControllerAPI.NewMockTridentController(mockCtrl)
+	nodeServer := &Plugin{
+		role:       CSINode,
+		restClient: mockClient,
+	}
+
+	// The controller is only asked once while the condition is cached
+	mockClient.EXPECT().GetVolumeHealth(ctx, volumeID).
+		Return(&storage.VolumeHealth{Abnormal: true, Message: "volume is full"}, nil)
+	condition := nodeServer.getVolumeCondition(ctx, volumeID)
+	assert.True(t, condition.GetAbnormal())
+	assert.Equal(t, "volume is full", condition.GetMessage())
+	assert.Equal(t, condition, nodeServer.getVolumeCondition(ctx, volumeID))
+
+	// An expired condition is asked for again
+	nodeServer.volumeConditions.Store(volumeID, cachedVolumeCondition{
+		condition: condition,
+		expires:   time.Now().Add(-time.Second),
+	})
+	mockClient.EXPECT().GetVolumeHealth(ctx, volumeID).Return(&storage.VolumeHealth{}, nil)
+	assert.False(t, nodeServer.getVolumeCondition(ctx, volumeID).GetAbnormal())
+
+	// Health that cannot be determined leaves the condition unset
+	nodeServer.volumeConditions.Delete(volumeID)
+	mockClient.EXPECT().GetVolumeHealth(ctx, volumeID).Return(nil, errors.New("not supported"))
+	assert.Nil(t, nodeServer.getVolumeCondition(ctx, volumeID))
+	assert.Nil(t, nodeServer.getVolumeCondition(ctx, volumeID))
+}
@@ -52,12 +52,16 @@ type Plugin struct {
 
 	grpc NonBlockingGRPCServer
 
-	csCap []*csi.ControllerServiceCapability
-	nsCap []*csi.NodeServiceCapability
-	vCap  []*csi.VolumeCapability_AccessMode
+	csCap  []*csi.ControllerServiceCapability
+	nsCap  []*csi.NodeServiceCapability
+	gcsCap []*csi.GroupControllerServiceCapability
+	vCap   []*csi.VolumeCapability_AccessMode
 
 	opCache sync.Map
 
+	// volumeConditions caches the condition of each volume reported by NodeGetVolumeStats
+	volumeConditions sync.Map
+
 	nodeIsRegistered bool
 
 	iSCSISelfHealingTicker   *time.Ticker
@@ -106,6 +110,14 @@ func NewControllerPlugin(
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
 		csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+	})
+
+	// Define group controller capabilities
+	p.addGroupControllerServiceCapabilities(ctx, []csi.GroupControllerServiceCapability_RPC_Type{
+		csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
 	})
 
 	// Define volume capabilities
@@ -168,6 +180,7 @@ func NewNodePlugin(
 				csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
 				csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
 				csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+				csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
 			},
 		)
 	}
@@ -247,6 +260,14 @@ func NewAllInOnePlugin(
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
 		csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+	})
+
+	// Define group controller capabilities
+	p.addGroupControllerServiceCapabilities(ctx, []csi.GroupControllerServiceCapability_RPC_Type{
+		csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
 	})
 
 	p.addNodeServiceCapabilities([]csi.NodeServiceCapability_RPC_Type{
@@ -254,6 +275,7 @@ func NewAllInOnePlugin(
 		csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
 		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
 		csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
 	})
 	port := "34571"
 	for _, envVar := range os.Environ() {
@@ -303,7 +325,7 @@ func (p *Plugin) Activate() error {
 				p.startReconcilingNodePublications(ctx)
 			}
 		}
-		p.grpc.Start(p.endpoint, p, p, p)
+		p.grpc.Start(p.endpoint, p, p, p, p)
 	}()
 	return nil
 }
@@ -346,6 +368,19 @@ func (p *Plugin) addControllerServiceCapabilities(ctx context.Context, cl []csi.
 	p.csCap = csCap
 }
 
+func (p *Plugin) addGroupControllerServiceCapabilities(
+	ctx context.Context, cl []csi.GroupControllerServiceCapability_RPC_Type,
+) {
+	var gcsCap []*csi.GroupControllerServiceCapability
+
+	for _, c := range cl {
+		Logc(ctx).WithField("capability", c.String()).Info("Enabling group controller service capability.")
+		gcsCap = append(gcsCap, NewGroupControllerServiceCapability(c))
+	}
+
+	p.gcsCap = gcsCap
+}
+
 func (p *Plugin) addNodeServiceCapabilities(cl []csi.NodeServiceCapability_RPC_Type) {
 	var nsCap []*csi.NodeServiceCapability
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -16,6 +17,7 @@ import (
 	"github.com/netapp/trident/config"
 	controllerAPI "github.com/netapp/trident/frontend/csi/controller_api"
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/crypto"
 )
@@ -44,6 +46,18 @@ func NewControllerServiceCapability(cap csi.ControllerServiceCapability_RPC_Type
 	}
 }
 
+func NewGroupControllerServiceCapability(
+	cap csi.GroupControllerServiceCapability_RPC_Type,
+) *csi.GroupControllerServiceCapability {
+	return &csi.GroupControllerServiceCapability{
+		Type: &csi.GroupControllerServiceCapability_Rpc{
+			Rpc: &csi.GroupControllerServiceCapability_RPC{
+				Type: cap,
+			},
+		},
+	}
+}
+
 func NewNodeServiceCapability(cap csi.NodeServiceCapability_RPC_Type) *csi.NodeServiceCapability {
 	return &csi.NodeServiceCapability{
 		Type: &csi.NodeServiceCapability_Rpc{
@@ -54,6 +68,55 @@ func NewNodeServiceCapability(cap csi.NodeServiceCapability_RPC_Type) *csi.NodeS
 	}
 }
 
+// bytesToInt64 converts a byte count to the signed type used by CSI, saturating instead of overflowing.
+func bytesToInt64(bytes uint64) int64 {
+	if bytes > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(bytes)
+}
+
+// getCSIVolumeCondition converts the result of a Trident volume health check into a CSI volume condition.
+// If a volume's health cannot be determined, for instance because its backend has no health check, no condition
+// is returned rather than one claiming the volume is healthy.
+func getCSIVolumeCondition(
+	ctx context.Context, volumeName string, health *storage.VolumeHealth, err error,
+) *csi.VolumeCondition {
+	if err != nil {
+		Logc(ctx).WithField("volume", volumeName).WithError(err).Debug("Could not determine volume health.")
+		return nil
+	}
+
+	if health == nil {
+		return nil
+	}
+
+	message := health.Message
+	if message == "" {
+		if health.Abnormal {
+			message = "Volume is abnormal."
+		} else {
+			message = "Volume is healthy."
+		}
+	}
+
+	return &csi.VolumeCondition{Abnormal: health.Abnormal, Message: message}
+}
+
+// getCSIVolumeConditionFromState converts a volume's state as cached by Trident into a CSI volume condition.
+// It is used when listing volumes, where querying each volume's backend would be too expensive; the backend's
+// view of a volume's health is reported by ControllerGetVolume.
+func getCSIVolumeConditionFromState(volume *storage.VolumeExternal) *csi.VolumeCondition {
+	switch {
+	case volume.State.IsMissingBackend():
+		return &csi.VolumeCondition{Abnormal: true, Message: "Volume's backend is missing."}
+	case volume.Orphaned:
+		return &csi.VolumeCondition{Abnormal: true, Message: "Volume is not found on its backend."}
+	default:
+		return &csi.VolumeCondition{Abnormal: false, Message: "Volume is known to Trident."}
+	}
+}
+
 // logGRPC is a unary interceptor that logs GRPC requests.
 func logGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
 	error,
@@ -166,11 +229,15 @@ func containsEncryptedCHAP(input map[string]string) bool {
 func getVolumeProtocolFromPublishInfo(publishInfo *utils.VolumePublishInfo) (config.Protocol, error) {
 	nfsIP := publishInfo.VolumeAccessInfo.NfsServerIP
 	iqn := publishInfo.VolumeAccessInfo.IscsiTargetIQN
+	nqn := publishInfo.VolumeAccessInfo.NVMeSubsystemNQN
+	wwnn := publishInfo.VolumeAccessInfo.FCTargetWWNN
 	subvolName := publishInfo.VolumeAccessInfo.SubvolumeName
 	smbPath := publishInfo.SMBPath
 
 	nfsSet := nfsIP != ""
 	iqnSet := iqn != ""
+	nqnSet := nqn != ""
+	wwnnSet := wwnn != ""
 	subvolSet := subvolName != ""
 	smbSet := smbPath != ""
 
@@ -178,20 +245,24 @@ func getVolumeProtocolFromPublishInfo(publishInfo *utils.VolumePublishInfo) (con
 	isNfs := nfsSet && !iqnSet && !smbSet
 	isBof := isNfs && subvolSet
 	isIscsi := iqnSet && !nfsSet && !smbSet
+	isNVMe := nqnSet && !iqnSet && !nfsSet && !smbSet
+	isFCP := wwnnSet && !iqnSet && !nfsSet && !smbSet
 
 	if isSmb || (isNfs && !isBof) {
 		return config.File, nil
 	} else if isBof {
 		return config.BlockOnFile, nil
-	} else if isIscsi {
+	} else if isIscsi || isNVMe || isFCP {
 		return config.Block, nil
 	}
 
 	fields := LogFields{
-		"SMBPath":        smbPath,
-		"SubvolumeName":  subvolName,
-		"IscsiTargetIQN": iqn,
-		"NfsServerIP":    nfsIP,
+		"SMBPath":          smbPath,
+		"SubvolumeName":    subvolName,
+		"IscsiTargetIQN":   iqn,
+		"NVMeSubsystemNQN": nqn,
+		"FCTargetWWNN":     wwnn,
+		"NfsServerIP":      nfsIP,
 	}
 
 	errMsg := "unable to infer volume protocol"
@@ -219,6 +290,20 @@ func performProtocolSpecificReconciliation(ctx context.Context, trackingInfo *ut
 	// Nothing more than checking the staging path needs to be done for NFS, so ignore that case.
 	switch protocol {
 	case config.Block:
+		if trackingInfo.SANType == utils.NVMe {
+			atLeastOneConditionMet, err = utils.ReconcileNVMeVolumeInfo(ctx, trackingInfo)
+			if err != nil {
+				return false, fmt.Errorf("unable to reconcile NVMe volume info: %v", err)
+			}
+			return atLeastOneConditionMet, nil
+		}
+		if trackingInfo.SANType == utils.FCP {
+			atLeastOneConditionMet, err = utils.ReconcileFCPVolumeInfo(ctx, trackingInfo)
+			if err != nil {
+				return false, fmt.Errorf("unable to reconcile FCP volume info: %v", err)
+			}
+			return atLeastOneConditionMet, nil
+		}
 		atLeastOneConditionMet, err = iscsiUtils.ReconcileISCSIVolumeInfo(ctx, trackingInfo)
 		if err != nil {
 			return false, fmt.Errorf("unable to reconcile ISCSI volume info: %v", err)
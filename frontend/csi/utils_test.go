@@ -13,6 +13,7 @@ import (
 	mockControllerAPI "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_api"
 	"github.com/netapp/trident/mocks/mock_utils"
 	"github.com/netapp/trident/mocks/mock_utils/mock_luks"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 )
 
@@ -60,6 +61,17 @@ func TestGetVolumeProtocolFromPublishInfo(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetCSIVolumeConditionFromState(t *testing.T) {
+	volume := &storage.VolumeExternal{State: storage.VolumeStateOnline}
+	assert.False(t, getCSIVolumeConditionFromState(volume).GetAbnormal())
+
+	volume = &storage.VolumeExternal{State: storage.VolumeStateOnline, Orphaned: true}
+	assert.True(t, getCSIVolumeConditionFromState(volume).GetAbnormal())
+
+	volume = &storage.VolumeExternal{State: storage.VolumeStateMissingBackend}
+	assert.True(t, getCSIVolumeConditionFromState(volume).GetAbnormal())
+}
+
 func TestPerformProtocolSpecificReconciliation_BadProtocol(t *testing.T) {
 	trackInfo := &utils.VolumeTrackingInfo{}
 	res, err := performProtocolSpecificReconciliation(context.Background(), trackInfo)
@@ -6,10 +6,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/core"
 	. "github.com/netapp/trident/logging"
 )
 
@@ -35,6 +38,12 @@ func NewMetricsServer(address, port string) *Server {
 	return metricsServer
 }
 
+// EnableVolumeMetrics adds the space used by each volume and its performance to the exported metrics.  The
+// storage backends are queried at most once per interval.
+func (s *Server) EnableVolumeMetrics(orchestrator core.Orchestrator, interval time.Duration) error {
+	return prometheus.Register(newVolumeMetricsCollector(orchestrator, interval))
+}
+
 func (s *Server) Activate() error {
 	go func() {
 		ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowPluginActivate, LogLayerMetricsFrontend)
@@ -0,0 +1,133 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/core"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+const (
+	operationRead  = "read"
+	operationWrite = "write"
+
+	microsecondsPerSecond = 1e6
+)
+
+// volumeLabels identify the volume, backend and PVC to which each per-volume metric belongs
+var volumeLabels = []string{"backend", "volume", "pvc", "namespace"}
+
+var (
+	volumeUsedBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(config.OrchestratorName, "volume", "used_bytes"),
+		"The number of bytes used in a volume, as reported by its backend",
+		volumeLabels, nil,
+	)
+	volumeCapacityBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(config.OrchestratorName, "volume", "capacity_bytes"),
+		"The size of a volume in bytes, as reported by its backend",
+		volumeLabels, nil,
+	)
+	volumeIOPSDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(config.OrchestratorName, "volume", "iops"),
+		"The recent I/O operations per second of a volume",
+		append(volumeLabels, "operation"), nil,
+	)
+	volumeThroughputDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(config.OrchestratorName, "volume", "throughput_bytes_per_second"),
+		"The recent throughput of a volume in bytes per second",
+		append(volumeLabels, "operation"), nil,
+	)
+	volumeLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(config.OrchestratorName, "volume", "latency_seconds"),
+		"The recent average latency of the I/O operations of a volume",
+		append(volumeLabels, "operation"), nil,
+	)
+)
+
+// volumeMetricsCollector exports the space used by each volume and its performance.  Since querying the storage
+// backends may be expensive in a large cluster, they are queried at most once per interval no matter how often
+// the metrics are scraped.  Scrapes in between are served the cached metrics, and concurrent scrapes share a
+// single query.
+type volumeMetricsCollector struct {
+	orchestrator core.Orchestrator
+	interval     time.Duration
+
+	mutex     sync.Mutex
+	collected time.Time
+	metrics   []*storage.VolumeMetricsExternal
+}
+
+func newVolumeMetricsCollector(orchestrator core.Orchestrator, interval time.Duration) *volumeMetricsCollector {
+	return &volumeMetricsCollector{
+		orchestrator: orchestrator,
+		interval:     interval,
+	}
+}
+
+func (c *volumeMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- volumeUsedBytesDesc
+	ch <- volumeCapacityBytesDesc
+	ch <- volumeIOPSDesc
+	ch <- volumeThroughputDesc
+	ch <- volumeLatencyDesc
+}
+
+func (c *volumeMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range c.getVolumeMetrics(time.Now()) {
+		labels := []string{m.Backend, m.Volume, m.RequestName, m.Namespace}
+
+		ch <- prometheus.MustNewConstMetric(volumeUsedBytesDesc, prometheus.GaugeValue, float64(m.UsedBytes),
+			labels...)
+		ch <- prometheus.MustNewConstMetric(volumeCapacityBytesDesc, prometheus.GaugeValue, float64(m.TotalBytes),
+			labels...)
+
+		for operation, values := range map[string][3]int64{
+			operationRead:  {m.ReadIOPS, m.ReadThroughput, m.ReadLatency},
+			operationWrite: {m.WriteIOPS, m.WriteThroughput, m.WriteLatency},
+		} {
+			operationLabels := append(labels[:len(labels):len(labels)], operation)
+			ch <- prometheus.MustNewConstMetric(volumeIOPSDesc, prometheus.GaugeValue, float64(values[0]),
+				operationLabels...)
+			ch <- prometheus.MustNewConstMetric(volumeThroughputDesc, prometheus.GaugeValue, float64(values[1]),
+				operationLabels...)
+			ch <- prometheus.MustNewConstMetric(volumeLatencyDesc, prometheus.GaugeValue,
+				float64(values[2])/microsecondsPerSecond, operationLabels...)
+		}
+	}
+}
+
+// getVolumeMetrics returns the cached volume metrics, first refreshing them from the backends if the interval
+// has passed since they were last collected.  If the refresh fails, the previous metrics are kept until the next
+// interval, so that a failing orchestrator is not queried on every scrape.
+func (c *volumeMetricsCollector) getVolumeMetrics(now time.Time) []*storage.VolumeMetricsExternal {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.collected.IsZero() && now.Sub(c.collected) < c.interval {
+		return c.metrics
+	}
+	c.collected = now
+
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourceInternal, WorkflowVolumeGetStats,
+		LogLayerMetricsFrontend)
+
+	metrics, err := c.orchestrator.GetVolumeMetrics(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Warning("Could not collect volume metrics.")
+		return c.metrics
+	}
+	c.metrics = metrics
+
+	Logc(ctx).WithField("volumes", len(metrics)).Debug("Collected volume metrics.")
+
+	return c.metrics
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	"github.com/netapp/trident/storage"
+)
+
+func TestVolumeMetricsCollector_Collect(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	mockOrchestrator.EXPECT().GetVolumeMetrics(gomock.Any()).Return([]*storage.VolumeMetricsExternal{
+		{
+			Volume:      "pvc-123",
+			Backend:     "ontap",
+			RequestName: "data",
+			Namespace:   "db",
+			VolumeMetrics: storage.VolumeMetrics{
+				UsedBytes:       100,
+				TotalBytes:      1000,
+				ReadIOPS:        20,
+				WriteIOPS:       10,
+				ReadThroughput:  4096,
+				WriteThroughput: 2048,
+				ReadLatency:     250,
+				WriteLatency:    500,
+			},
+		},
+	}, nil)
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(newVolumeMetricsCollector(mockOrchestrator, time.Minute))
+
+	expected := `
+# HELP trident_volume_capacity_bytes The size of a volume in bytes, as reported by its backend
+# TYPE trident_volume_capacity_bytes gauge
+trident_volume_capacity_bytes{backend="ontap",namespace="db",pvc="data",volume="pvc-123"} 1000
+# HELP trident_volume_iops The recent I/O operations per second of a volume
+# TYPE trident_volume_iops gauge
+trident_volume_iops{backend="ontap",namespace="db",operation="read",pvc="data",volume="pvc-123"} 20
+trident_volume_iops{backend="ontap",namespace="db",operation="write",pvc="data",volume="pvc-123"} 10
+# HELP trident_volume_latency_seconds The recent average latency of the I/O operations of a volume
+# TYPE trident_volume_latency_seconds gauge
+trident_volume_latency_seconds{backend="ontap",namespace="db",operation="read",pvc="data",volume="pvc-123"} 0.00025
+trident_volume_latency_seconds{backend="ontap",namespace="db",operation="write",pvc="data",volume="pvc-123"} 0.0005
+# HELP trident_volume_throughput_bytes_per_second The recent throughput of a volume in bytes per second
+# TYPE trident_volume_throughput_bytes_per_second gauge
+trident_volume_throughput_bytes_per_second{backend="ontap",namespace="db",operation="read",pvc="data",volume="pvc-123"} 4096
+trident_volume_throughput_bytes_per_second{backend="ontap",namespace="db",operation="write",pvc="data",volume="pvc-123"} 2048
+# HELP trident_volume_used_bytes The number of bytes used in a volume, as reported by its backend
+# TYPE trident_volume_used_bytes gauge
+trident_volume_used_bytes{backend="ontap",namespace="db",pvc="data",volume="pvc-123"} 100
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected)))
+}
+
+func TestVolumeMetricsCollector_Cache(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	collector := newVolumeMetricsCollector(mockOrchestrator, time.Minute)
+	now := time.Now()
+
+	first := []*storage.VolumeMetricsExternal{{Volume: "vol1"}}
+	second := []*storage.VolumeMetricsExternal{{Volume: "vol1"}, {Volume: "vol2"}}
+
+	// Scrapes within the interval are served from the cache
+	mockOrchestrator.EXPECT().GetVolumeMetrics(gomock.Any()).Return(first, nil).Times(1)
+	assert.Equal(t, first, collector.getVolumeMetrics(now))
+	assert.Equal(t, first, collector.getVolumeMetrics(now.Add(30*time.Second)))
+
+	// The backends are queried again once the interval has passed
+	mockOrchestrator.EXPECT().GetVolumeMetrics(gomock.Any()).Return(second, nil).Times(1)
+	assert.Equal(t, second, collector.getVolumeMetrics(now.Add(time.Minute)))
+
+	// A failed query keeps the previous metrics and is not retried until the next interval
+	mockOrchestrator.EXPECT().GetVolumeMetrics(gomock.Any()).Return(nil, fmt.Errorf("failed")).Times(1)
+	assert.Equal(t, second, collector.getVolumeMetrics(now.Add(2*time.Minute)))
+	assert.Equal(t, second, collector.getVolumeMetrics(now.Add(2*time.Minute+time.Second)))
+}
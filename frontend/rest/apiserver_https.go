@@ -48,6 +48,10 @@ func NewHTTPSServer(
 	if !enableMutualTLS {
 		apiServer.server.Handler = handler
 		apiServer.server.TLSConfig.ClientAuth = tls.NoClientCert
+	} else if authorizationPolicy != nil {
+		// Clients are authenticated by the authorization middleware, by their certificate or by a token
+		apiServer.server.Handler = handler
+		apiServer.server.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
 	}
 
 	if caCertFile != "" {
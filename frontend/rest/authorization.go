@@ -0,0 +1,554 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/gorilla/mux"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// Role determines which REST API calls an identity may make.  Each role may make the calls of the roles below it.
+type Role string
+
+const (
+	// RoleReadOnly may read the state of Trident, but not change it
+	RoleReadOnly = Role("read-only")
+	// RoleVolumeOperator may also create, modify, snapshot, migrate and delete volumes
+	RoleVolumeOperator = Role("volume-operator")
+	// RoleAdmin may make any call, such as managing backends, storage classes and nodes
+	RoleAdmin = Role("admin")
+)
+
+// Validate returns an error if a role is not known.
+func (r Role) Validate() error {
+	switch r {
+	case RoleReadOnly, RoleVolumeOperator, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("invalid role %s; must be one of %s, %s or %s", r, RoleReadOnly, RoleVolumeOperator,
+			RoleAdmin)
+	}
+}
+
+// Allows returns whether a role may make the calls of another role.
+func (r Role) Allows(required Role) bool {
+	return r.rank() >= required.rank()
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleReadOnly:
+		return 1
+	case RoleVolumeOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Identity is a client of the REST API, authenticated by a bearer token or by the common name of its client
+// certificate.  An identity limited to backends or namespaces may only see and act on the volumes in them.
+type Identity struct {
+	Name        string   `json:"name"`
+	Token       string   `json:"token,omitempty"`
+	CertSubject string   `json:"certSubject,omitempty"`
+	Role        Role     `json:"role"`
+	Backends    []string `json:"backends,omitempty"`
+	Namespaces  []string `json:"namespaces,omitempty"`
+}
+
+// IsScoped returns whether an identity is limited to some backends or namespaces.
+func (i *Identity) IsScoped() bool {
+	return len(i.Backends) > 0 || len(i.Namespaces) > 0
+}
+
+// AllowsBackend returns whether an identity may act on a backend.
+func (i *Identity) AllowsBackend(backendName string) bool {
+	return len(i.Backends) == 0 || utils.SliceContainsString(i.Backends, backendName)
+}
+
+// AllowsNamespace returns whether an identity may act on the volumes of a namespace.
+func (i *Identity) AllowsNamespace(namespace string) bool {
+	return len(i.Namespaces) == 0 || utils.SliceContainsString(i.Namespaces, namespace)
+}
+
+// AuthorizationPolicy lists the identities that may use the REST API.
+type AuthorizationPolicy struct {
+	Identities []*Identity `json:"identities"`
+	// LocalRole is the role of calls without credentials from localhost to the HTTP interface, which only listens
+	// there.  It is read-only if empty, so that the liveness and readiness probes of the controller keep working.
+	LocalRole Role `json:"localRole,omitempty"`
+}
+
+// Validate returns an error if a policy is not usable.
+func (p *AuthorizationPolicy) Validate() error {
+	if p.LocalRole != "" {
+		if err := p.LocalRole.Validate(); err != nil {
+			return fmt.Errorf("localRole: %v", err)
+		}
+	}
+
+	names := make(map[string]bool)
+	tokens := make(map[string]bool)
+	subjects := make(map[string]bool)
+	for _, identity := range p.Identities {
+		if identity.Name == "" {
+			return fmt.Errorf("every identity must have a name")
+		}
+		if names[identity.Name] {
+			return fmt.Errorf("identity %s is defined more than once", identity.Name)
+		}
+		names[identity.Name] = true
+
+		if err := identity.Role.Validate(); err != nil {
+			return fmt.Errorf("identity %s: %v", identity.Name, err)
+		}
+
+		if (identity.Token == "") == (identity.CertSubject == "") {
+			return fmt.Errorf("identity %s must have either a token or a certSubject", identity.Name)
+		}
+		if identity.CertSubject == config.ClientCertName {
+			return fmt.Errorf("identity %s may not use the certificate of the Trident nodes", identity.Name)
+		}
+		if tokens[identity.Token] || subjects[identity.CertSubject] {
+			return fmt.Errorf("identity %s shares its credentials with another identity", identity.Name)
+		}
+		if identity.Token != "" {
+			tokens[identity.Token] = true
+		} else {
+			subjects[identity.CertSubject] = true
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorizationPolicy reads an authorization policy from a JSON or YAML file.
+func LoadAuthorizationPolicy(path string) (*AuthorizationPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read authorization policy; %v", err)
+	}
+
+	policy := &AuthorizationPolicy{}
+	if err = yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("could not parse authorization policy; %v", err)
+	}
+	if err = policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy; %v", err)
+	}
+
+	return policy, nil
+}
+
+// authorizationPolicy is enforced on every controller REST API call if it is set
+var authorizationPolicy *AuthorizationPolicy
+
+// EnableAuthorization enforces a policy on the controller REST API.  It must be called before the routers are made.
+func EnableAuthorization(policy *AuthorizationPolicy) {
+	authorizationPolicy = policy
+
+	Log().WithField("identities", len(policy.Identities)).Info("Enabled REST API authorization.")
+}
+
+// nodeIdentity is the identity of the Trident nodes, which authenticate with the client certificate made at install
+var nodeIdentity = &Identity{Name: config.ClientCertName, Role: RoleAdmin}
+
+// identify returns the identity that made a call, or nil if the call has no known credentials.  Plaintext calls
+// without credentials from localhost are given the local role.
+func (p *AuthorizationPolicy) identify(r *http.Request) *Identity {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		token := strings.TrimPrefix(authorization, "Bearer ")
+		if token == authorization || token == "" {
+			return nil
+		}
+		for _, identity := range p.Identities {
+			if identity.Token != "" && subtle.ConstantTimeCompare([]byte(identity.Token), []byte(token)) == 1 {
+				return identity
+			}
+		}
+		return nil
+	}
+
+	if r.TLS != nil {
+		if len(r.TLS.PeerCertificates) == 0 {
+			return nil
+		}
+		subject := r.TLS.PeerCertificates[0].Subject.CommonName
+		if subject == config.ClientCertName {
+			return nodeIdentity
+		}
+		for _, identity := range p.Identities {
+			if identity.CertSubject == subject {
+				return identity
+			}
+		}
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() {
+		return nil
+	}
+	if p.LocalRole != "" {
+		return &Identity{Name: "local", Role: p.LocalRole}
+	}
+	return &Identity{Name: "local", Role: RoleReadOnly}
+}
+
+type identityContextKey struct{}
+
+// identityFromContext returns the identity that made a call, or nil if authorization is not enabled.
+func identityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(*Identity)
+	return identity
+}
+
+// scopeFunc returns whether a scoped identity may make a call
+type scopeFunc func(scope *volumeScope, r *http.Request) bool
+
+// routeAuthorization is the least role that may make a call to a route, and how the call is limited for
+// identities scoped to backends or namespaces.  Calls with no scope name no volume or backend; calls that
+// list volumes or their snapshots are filtered by their handlers.
+type routeAuthorization struct {
+	role  Role
+	scope scopeFunc
+}
+
+var routeAuthorizations = map[string]routeAuthorization{
+	"GetVersion":                      {RoleReadOnly, nil},
+	"AddBackend":                      {RoleAdmin, unscopedOnly},
+	"UpdateBackend":                   {RoleAdmin, backendVarScope},
+	"UpdateBackendState":              {RoleAdmin, backendVarScope},
+	"GetBackend":                      {RoleReadOnly, backendVarScope},
+	"ListBackends":                    {RoleReadOnly, nil},
+	"DeleteBackend":                   {RoleAdmin, backendVarScope},
+	"AddVolume":                       {RoleVolumeOperator, volumeConfigScope},
+	"GetVolume":                       {RoleReadOnly, volumeVarScope},
+	"GetVolumeHealth":                 {RoleReadOnly, volumeVarScope},
+	"UpdateVolumeUsage":               {RoleAdmin, unscopedOnly},
+	"ListVolumes":                     {RoleReadOnly, nil},
+	"DeleteVolume":                    {RoleVolumeOperator, volumeVarScope},
+	"ModifyVolume":                    {RoleVolumeOperator, volumeVarScope},
+	"GetPoolUsage":                    {RoleReadOnly, unscopedOnly},
+	"CheckConsistency":                {RoleReadOnly, unscopedOnly},
+	"RepairConsistency":               {RoleAdmin, unscopedOnly},
+	"WatchChanges":                    {RoleReadOnly, unscopedOnly},
+	"ListVolumeMigrations":            {RoleReadOnly, nil},
+	"GetVolumeMigration":              {RoleReadOnly, volumeVarScope},
+	"MigrateVolume":                   {RoleVolumeOperator, volumeMigrationScope},
+	"UpdateVolume":                    {RoleAdmin, volumeVarScope},
+	"ImportVolume":                    {RoleVolumeOperator, importVolumeScope},
+	"UpgradeVolume":                   {RoleAdmin, volumeVarScope},
+	"AddStorageClass":                 {RoleAdmin, unscopedOnly},
+	"GetStorageClass":                 {RoleReadOnly, nil},
+	"ListStorageClasses":              {RoleReadOnly, nil},
+	"DeleteStorageClass":              {RoleAdmin, unscopedOnly},
+	"AddOrUpdateNode":                 {RoleAdmin, unscopedOnly},
+	"UpdateNode":                      {RoleAdmin, unscopedOnly},
+	"GetNode":                         {RoleReadOnly, nil},
+	"ListNodes":                       {RoleReadOnly, nil},
+	"DeleteNode":                      {RoleAdmin, unscopedOnly},
+	"GetVolumePublication":            {RoleReadOnly, volumeVarScope},
+	"ListVolumePublications":          {RoleReadOnly, nil},
+	"ListVolumePublicationsForVolume": {RoleReadOnly, volumeVarScope},
+	"ListVolumePublicationsForNode":   {RoleReadOnly, nil},
+	"ListSnapshots":                   {RoleReadOnly, nil},
+	"ListSnapshotsForVolume":          {RoleReadOnly, volumeVarScope},
+	"GetSnapshot":                     {RoleReadOnly, volumeVarScope},
+	"AddSnapshot":                     {RoleVolumeOperator, snapshotConfigScope},
+	"DeleteSnapshot":                  {RoleVolumeOperator, volumeVarScope},
+	"RestoreSnapshot":                 {RoleVolumeOperator, volumeVarScope},
+	"ListGroupSnapshots":              {RoleReadOnly, unscopedOnly},
+	"GetGroupSnapshot":                {RoleReadOnly, unscopedOnly},
+	"AddGroupSnapshot":                {RoleVolumeOperator, groupSnapshotConfigScope},
+	"DeleteGroupSnapshot":             {RoleVolumeOperator, unscopedOnly},
+	"ListSnapshotPolicies":            {RoleReadOnly, nil},
+	"GetSnapshotPolicy":               {RoleReadOnly, nil},
+	"AddSnapshotPolicy":               {RoleAdmin, unscopedOnly},
+	"UpdateSnapshotPolicy":            {RoleAdmin, unscopedOnly},
+	"DeleteSnapshotPolicy":            {RoleAdmin, unscopedOnly},
+	"GetCHAP":                         {RoleAdmin, unscopedOnly},
+	"GetCurrentLogLevel":              {RoleReadOnly, nil},
+	"SetLogLevel":                     {RoleAdmin, unscopedOnly},
+	"GetLoggingWorkflows":             {RoleReadOnly, nil},
+	"ListLoggingWorkflows":            {RoleReadOnly, nil},
+	"SetLoggingWorkflows":             {RoleAdmin, unscopedOnly},
+	"GetLogLayers":                    {RoleReadOnly, nil},
+	"ListLogLayers":                   {RoleReadOnly, nil},
+	"SetLoggingLayers":                {RoleAdmin, unscopedOnly},
+}
+
+// getRouteAuthorization returns how calls to a route are authorized.  Routes that are not listed are limited
+// to unscoped administrators.
+func getRouteAuthorization(routeName string) routeAuthorization {
+	if authorization, ok := routeAuthorizations[routeName]; ok {
+		return authorization
+	}
+	return routeAuthorization{RoleAdmin, unscopedOnly}
+}
+
+// authorizer returns middleware that rejects calls to a route by clients that are not authenticated, or whose
+// identity may not make them, and records its decision in the audit log.
+func authorizer(policy *AuthorizationPolicy, routeName string) func(http.Handler) http.Handler {
+	authorization := getRouteAuthorization(routeName)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			logFields := LogFields{
+				"Method":   r.Method,
+				"Route":    routeName,
+				"SourceIP": r.RemoteAddr,
+			}
+
+			identity := policy.identify(r)
+			if identity == nil {
+				Audit().Logf(ctx, AuditRESTAccess, logFields, "REST API call not authenticated.")
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf("Bearer realm=\"%s\"", config.OrchestratorName))
+				writeAuthorizationError(ctx, w, http.StatusUnauthorized, "not authenticated")
+				return
+			}
+
+			logFields["Identity"] = identity.Name
+			logFields["Role"] = identity.Role
+
+			allowed := identity.Role.Allows(authorization.role)
+			if allowed && identity.IsScoped() && authorization.scope != nil {
+				allowed = authorization.scope(newVolumeScope(ctx, identity), r)
+			}
+			if !allowed {
+				Audit().Logf(ctx, AuditRESTAccess, logFields, "REST API call forbidden.")
+				writeAuthorizationError(ctx, w, http.StatusForbidden,
+					fmt.Sprintf("identity %s may not call %s", identity.Name, routeName))
+				return
+			}
+
+			Audit().Logf(ctx, AuditRESTAccess, logFields, "REST API call authorized.")
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, identityContextKey{}, identity)))
+		})
+	}
+}
+
+func writeAuthorizationError(ctx context.Context, w http.ResponseWriter, httpStatusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	writeHTTPResponse(ctx, w, &struct {
+		Error string `json:"error"`
+	}{message}, httpStatusCode)
+}
+
+// volumeScope decides which volumes and backends a call may act on.  It looks up each volume only once, and reads
+// the backends known to the orchestrator only once, when they are first needed.
+type volumeScope struct {
+	ctx          context.Context
+	identity     *Identity
+	volumes      map[string]*storage.VolumeExternal
+	backendNames map[string]string
+}
+
+func newVolumeScope(ctx context.Context, identity *Identity) *volumeScope {
+	return &volumeScope{ctx: ctx, identity: identity}
+}
+
+// newVolumeScopeForRequest returns the scope of a call, or nil if the call may act on any volume.
+func newVolumeScopeForRequest(r *http.Request) *volumeScope {
+	identity := identityFromContext(r.Context())
+	if identity == nil || !identity.IsScoped() {
+		return nil
+	}
+	return newVolumeScope(r.Context(), identity)
+}
+
+// allowsBackend returns whether the call may act on a backend, named by its name or its UUID.
+func (s *volumeScope) allowsBackend(backend string) bool {
+	if s == nil {
+		return true
+	}
+	if IsValidUUID(backend) {
+		s.loadBackends()
+		if name, ok := s.backendNames[backend]; ok {
+			backend = name
+		}
+	}
+	return s.identity.AllowsBackend(backend)
+}
+
+// allowsVolume returns whether the call may act on a volume.
+func (s *volumeScope) allowsVolume(volume *storage.VolumeExternal) bool {
+	if s == nil {
+		return true
+	}
+	if volume == nil || volume.Config == nil || !s.identity.AllowsNamespace(volume.Config.Namespace) {
+		return false
+	}
+	if len(s.identity.Backends) == 0 {
+		return true
+	}
+	s.loadBackends()
+	return s.identity.AllowsBackend(s.backendNames[volume.BackendUUID])
+}
+
+// allowsVolumeName returns whether the call may act on a volume, named by its name.  Volumes that do not exist
+// may not be acted on.
+func (s *volumeScope) allowsVolumeName(volumeName string) bool {
+	if s == nil {
+		return true
+	}
+	if s.volumes == nil {
+		s.volumes = make(map[string]*storage.VolumeExternal)
+	}
+	volume, ok := s.volumes[volumeName]
+	if !ok {
+		var err error
+		if volume, err = orchestrator.GetVolume(s.ctx, volumeName); err != nil {
+			if !utils.IsNotFoundError(err) {
+				Logc(s.ctx).WithError(err).Error("Could not get volume for authorization.")
+			}
+			volume = nil
+		}
+		s.volumes[volumeName] = volume
+	}
+	return s.allowsVolume(volume)
+}
+
+func (s *volumeScope) loadBackends() {
+	if s.backendNames != nil {
+		return
+	}
+	s.backendNames = make(map[string]string)
+	backends, err := orchestrator.ListBackends(s.ctx)
+	if err != nil {
+		Logc(s.ctx).WithError(err).Error("Could not list backends for authorization.")
+	}
+	for _, backend := range backends {
+		s.backendNames[backend.BackendUUID] = backend.Name
+	}
+}
+
+// readRequestBody returns the body of a call, leaving it to be read again by the handler.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, config.MaxRESTRequestSize))
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func unscopedOnly(_ *volumeScope, _ *http.Request) bool {
+	return false
+}
+
+func backendVarScope(scope *volumeScope, r *http.Request) bool {
+	return scope.allowsBackend(mux.Vars(r)["backend"])
+}
+
+func volumeVarScope(scope *volumeScope, r *http.Request) bool {
+	return scope.allowsVolumeName(mux.Vars(r)["volume"])
+}
+
+// volumeConfigScope allows creating volumes in the namespaces of an identity.  Identities limited to backends may
+// not create volumes, as the storage class of a volume, rather than the caller, chooses its backend.
+func volumeConfigScope(scope *volumeScope, r *http.Request) bool {
+	if len(scope.identity.Backends) > 0 {
+		return false
+	}
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+	volumeConfig := &storage.VolumeConfig{}
+	if err = json.Unmarshal(body, volumeConfig); err != nil {
+		return false
+	}
+	return scope.identity.AllowsNamespace(volumeConfig.Namespace)
+}
+
+// importVolumeScope allows importing volumes from the backends of an identity into PVCs in its namespaces.
+func importVolumeScope(scope *volumeScope, r *http.Request) bool {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+	request := &storage.ImportVolumeRequest{}
+	if err = json.Unmarshal(body, request); err != nil {
+		return false
+	}
+	pvcData, err := base64.StdEncoding.DecodeString(request.PVCData)
+	if err != nil {
+		return false
+	}
+	pvc := &struct {
+		Metadata struct {
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+	}{}
+	if err = json.Unmarshal(pvcData, pvc); err != nil {
+		return false
+	}
+	return scope.allowsBackend(request.Backend) && scope.identity.AllowsNamespace(pvc.Metadata.Namespace)
+}
+
+// volumeMigrationScope allows migrating the volumes of an identity to its backends.
+func volumeMigrationScope(scope *volumeScope, r *http.Request) bool {
+	if !volumeVarScope(scope, r) {
+		return false
+	}
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+	request := &storage.VolumeMigrateRequest{}
+	if err = json.Unmarshal(body, request); err != nil {
+		return false
+	}
+	return request.Backend == "" || scope.allowsBackend(request.Backend)
+}
+
+func snapshotConfigScope(scope *volumeScope, r *http.Request) bool {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+	snapshotConfig := &storage.SnapshotConfig{}
+	if err = json.Unmarshal(body, snapshotConfig); err != nil {
+		return false
+	}
+	return scope.allowsVolumeName(snapshotConfig.VolumeName)
+}
+
+func groupSnapshotConfigScope(scope *volumeScope, r *http.Request) bool {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+	groupConfig := &storage.GroupSnapshotConfig{}
+	if err = json.Unmarshal(body, groupConfig); err != nil {
+		return false
+	}
+	for _, volumeName := range groupConfig.VolumeNames {
+		if !scope.allowsVolumeName(volumeName) {
+			return false
+		}
+	}
+	return len(groupConfig.VolumeNames) > 0
+}
@@ -0,0 +1,294 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	backendUUIDA = "a1b2c3d4-0000-4000-8000-00000000000a"
+	backendUUIDB = "a1b2c3d4-0000-4000-8000-00000000000b"
+)
+
+func newTestAuthorizationPolicy() *AuthorizationPolicy {
+	return &AuthorizationPolicy{
+		Identities: []*Identity{
+			{Name: "platform", Token: "admin-token", Role: RoleAdmin},
+			{Name: "auditor", Token: "reader-token", Role: RoleReadOnly},
+			{Name: "team-a", Token: "team-a-token", Role: RoleVolumeOperator, Namespaces: []string{"team-a"}},
+			{Name: "team-b", Token: "team-b-token", Role: RoleVolumeOperator, Backends: []string{"backend-b"}},
+		},
+	}
+}
+
+func newAuthorizedTestServer(t *testing.T, policy *AuthorizationPolicy) (*mockcore.MockOrchestrator, *httptest.Server) {
+	oldOrchestrator := orchestrator
+	oldPolicy := authorizationPolicy
+	t.Cleanup(func() {
+		orchestrator = oldOrchestrator
+		authorizationPolicy = oldPolicy
+	})
+
+	mockOrchestrator := mockcore.NewMockOrchestrator(gomock.NewController(t))
+	orchestrator = mockOrchestrator
+	authorizationPolicy = policy
+
+	server := httptest.NewServer(NewRouter(false))
+	t.Cleanup(server.Close)
+
+	return mockOrchestrator, server
+}
+
+func doAuthorizedRequest(t *testing.T, method, url, token, body string) (int, []byte) {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+
+	responseBody, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+
+	return response.StatusCode, responseBody
+}
+
+func TestRoleAllows(t *testing.T) {
+	assert.True(t, RoleAdmin.Allows(RoleVolumeOperator))
+	assert.True(t, RoleVolumeOperator.Allows(RoleReadOnly))
+	assert.True(t, RoleReadOnly.Allows(RoleReadOnly))
+	assert.False(t, RoleReadOnly.Allows(RoleVolumeOperator))
+	assert.False(t, RoleVolumeOperator.Allows(RoleAdmin))
+	assert.False(t, Role("superuser").Allows(RoleReadOnly))
+}
+
+func TestAuthorizationPolicyValidate(t *testing.T) {
+	assert.NoError(t, newTestAuthorizationPolicy().Validate())
+
+	tests := map[string]*AuthorizationPolicy{
+		"invalid role":   {Identities: []*Identity{{Name: "a", Token: "t", Role: "superuser"}}},
+		"no name":        {Identities: []*Identity{{Token: "t", Role: RoleAdmin}}},
+		"no credentials": {Identities: []*Identity{{Name: "a", Role: RoleAdmin}}},
+		"two credentials": {Identities: []*Identity{
+			{Name: "a", Token: "t", CertSubject: "a", Role: RoleAdmin},
+		}},
+		"duplicate name": {Identities: []*Identity{
+			{Name: "a", Token: "t1", Role: RoleAdmin},
+			{Name: "a", Token: "t2", Role: RoleAdmin},
+		}},
+		"shared token": {Identities: []*Identity{
+			{Name: "a", Token: "t", Role: RoleAdmin},
+			{Name: "b", Token: "t", Role: RoleReadOnly},
+		}},
+		"node certificate":   {Identities: []*Identity{{Name: "a", CertSubject: "trident-node", Role: RoleAdmin}}},
+		"invalid local role": {LocalRole: "superuser"},
+	}
+	for name, policy := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, policy.Validate())
+		})
+	}
+}
+
+func TestLoadAuthorizationPolicy(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "policy.yaml")
+	data := `
+localRole: admin
+identities:
+- name: team-a
+  token: team-a-token
+  role: volume-operator
+  namespaces: [team-a]
+`
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	policy, err := LoadAuthorizationPolicy(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, policy.LocalRole)
+	assert.Equal(t, []*Identity{{
+		Name: "team-a", Token: "team-a-token", Role: RoleVolumeOperator, Namespaces: []string{"team-a"},
+	}}, policy.Identities)
+
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	assert.NoError(t, os.WriteFile(invalidPath, []byte("identities:\n- name: a\n  role: admin\n"), 0o600))
+	_, err = LoadAuthorizationPolicy(invalidPath)
+	assert.Error(t, err)
+
+	_, err = LoadAuthorizationPolicy(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestAuthorizer_Authentication(t *testing.T) {
+	mockOrchestrator, server := newAuthorizedTestServer(t, newTestAuthorizationPolicy())
+	url := server.URL + "/trident/v1/storageclass"
+	mockOrchestrator.EXPECT().ListStorageClasses(gomock.Any()).AnyTimes().Return(nil, nil)
+
+	// Calls with unknown credentials are rejected
+	status, body := doAuthorizedRequest(t, http.MethodGet, url, "unknown-token", "")
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Contains(t, string(body), "not authenticated")
+
+	status, _ = doAuthorizedRequest(t, http.MethodGet, url, "reader-token", "")
+	assert.Equal(t, http.StatusOK, status)
+
+	// Local calls without credentials, such as the probes of the controller, are read-only by default
+	status, _ = doAuthorizedRequest(t, http.MethodGet, url, "", "")
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/backend/backend-a", "", "")
+	assert.Equal(t, http.StatusForbidden, status)
+
+	authorizationPolicy.LocalRole = RoleAdmin
+	server.Config.Handler = NewRouter(false)
+	mockOrchestrator.EXPECT().DeleteBackend(gomock.Any(), "backend-a").Return(nil)
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/backend/backend-a", "", "")
+	assert.Equal(t, http.StatusOK, status)
+
+	// Calls without credentials from elsewhere are rejected
+	request := httptest.NewRequest(http.MethodGet, "/trident/v1/storageclass", nil)
+	request.RemoteAddr = "192.0.2.1:1234"
+	assert.Nil(t, authorizationPolicy.identify(request))
+}
+
+func TestAuthorizer_Roles(t *testing.T) {
+	mockOrchestrator, server := newAuthorizedTestServer(t, newTestAuthorizationPolicy())
+	url := server.URL + "/trident/v1/backend/backend-a"
+	mockOrchestrator.EXPECT().DeleteBackend(gomock.Any(), "backend-a").Return(nil)
+
+	status, body := doAuthorizedRequest(t, http.MethodDelete, url, "reader-token", "")
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Contains(t, string(body), "auditor may not call DeleteBackend")
+
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, url, "team-a-token", "")
+	assert.Equal(t, http.StatusForbidden, status)
+
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, url, "admin-token", "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestAuthorizer_Scopes(t *testing.T) {
+	mockOrchestrator, server := newAuthorizedTestServer(t, newTestAuthorizationPolicy())
+	volumes := []*storage.VolumeExternal{
+		{Config: &storage.VolumeConfig{Name: "vol-a", Namespace: "team-a"}, BackendUUID: backendUUIDA},
+		{Config: &storage.VolumeConfig{Name: "vol-b", Namespace: "team-b"}, BackendUUID: backendUUIDB},
+	}
+	backends := []*storage.BackendExternal{
+		{Name: "backend-a", BackendUUID: backendUUIDA},
+		{Name: "backend-b", BackendUUID: backendUUIDB},
+	}
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).AnyTimes().Return(volumes, nil)
+	mockOrchestrator.EXPECT().ListBackends(gomock.Any()).AnyTimes().Return(backends, nil)
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ interface{}, volumeName string) (*storage.VolumeExternal, error) {
+			for _, volume := range volumes {
+				if volume.Config.Name == volumeName {
+					return volume, nil
+				}
+			}
+			return nil, utils.NotFoundError("volume not found")
+		})
+
+	// Identities may only act on the volumes in their namespaces or backends
+	mockOrchestrator.EXPECT().DeleteVolume(gomock.Any(), "vol-a").Return(nil)
+	status, _ := doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/volume/vol-a", "team-a-token", "")
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/volume/vol-b", "team-a-token", "")
+	assert.Equal(t, http.StatusForbidden, status)
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/volume/vol-a", "team-b-token", "")
+	assert.Equal(t, http.StatusForbidden, status)
+	status, _ = doAuthorizedRequest(t, http.MethodDelete, server.URL+"/trident/v1/volume/missing", "team-a-token",
+		"")
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// Lists only include the volumes and backends of the identity
+	status, body := doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/volume", "team-b-token", "")
+	assert.Equal(t, http.StatusOK, status)
+	listVolumesResponse := &ListVolumesResponse{}
+	assert.NoError(t, json.Unmarshal(body, listVolumesResponse))
+	assert.Equal(t, []string{"vol-b"}, listVolumesResponse.Volumes)
+
+	status, body = doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/backend", "team-b-token", "")
+	assert.Equal(t, http.StatusOK, status)
+	listBackendsResponse := &ListBackendsResponse{}
+	assert.NoError(t, json.Unmarshal(body, listBackendsResponse))
+	assert.Equal(t, []string{"backend-b"}, listBackendsResponse.Backends)
+
+	status, body = doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/volume", "admin-token", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.NoError(t, json.Unmarshal(body, listVolumesResponse))
+	assert.Equal(t, []string{"vol-a", "vol-b"}, listVolumesResponse.Volumes)
+
+	// Backends may be named by their UUIDs
+	mockOrchestrator.EXPECT().GetBackendByBackendUUID(gomock.Any(), backendUUIDB).Return(backends[1], nil)
+	status, _ = doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/backend/"+backendUUIDB, "team-b-token",
+		"")
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/backend/"+backendUUIDA, "team-b-token",
+		"")
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// Volumes may only be created in the namespaces of the identity
+	volumeConfig := `{"name": "vol-c", "size": "1Gi", "namespace": "%s"}`
+	status, _ = doAuthorizedRequest(t, http.MethodPost, server.URL+"/trident/v1/volume", "team-a-token",
+		strings.Replace(volumeConfig, "%s", "team-b", 1))
+	assert.Equal(t, http.StatusForbidden, status)
+
+	mockOrchestrator.EXPECT().AddVolume(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, config *storage.VolumeConfig) (*storage.VolumeExternal, error) {
+			assert.Equal(t, "team-a", config.Namespace, "the handler should read the whole request body")
+			return &storage.VolumeExternal{Config: config, BackendUUID: backendUUIDA}, nil
+		})
+	status, _ = doAuthorizedRequest(t, http.MethodPost, server.URL+"/trident/v1/volume", "team-a-token",
+		strings.Replace(volumeConfig, "%s", "team-a", 1))
+	assert.Equal(t, http.StatusCreated, status)
+
+	// Identities limited to backends cannot choose where volumes are created
+	status, _ = doAuthorizedRequest(t, http.MethodPost, server.URL+"/trident/v1/volume", "team-b-token",
+		strings.Replace(volumeConfig, "%s", "team-b", 1))
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// Volumes may only be migrated to the backends of the identity
+	status, _ = doAuthorizedRequest(t, http.MethodPost, server.URL+"/trident/v1/migration/vol-b", "team-b-token",
+		`{"backend": "backend-a"}`)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	// Cluster-wide changes are reserved for unscoped identities
+	status, _ = doAuthorizedRequest(t, http.MethodGet, server.URL+"/trident/v1/pool/usage", "team-a-token", "")
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+func TestImportVolumeScope(t *testing.T) {
+	policy := newTestAuthorizationPolicy()
+	pvc := base64.StdEncoding.EncodeToString([]byte(`{"metadata": {"name": "pvc", "namespace": "team-a"}}`))
+	body := `{"backend": "backend-a", "internalName": "vol", "pvcData": "` + pvc + `"}`
+
+	request := httptest.NewRequest(http.MethodPost, "/trident/v1/volume/import", strings.NewReader(body))
+	assert.True(t, importVolumeScope(newVolumeScope(request.Context(), policy.Identities[2]), request))
+
+	request = httptest.NewRequest(http.MethodPost, "/trident/v1/volume/import", strings.NewReader(body))
+	assert.False(t, importVolumeScope(newVolumeScope(request.Context(), policy.Identities[3]), request))
+
+	readBody, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, string(readBody), "the request body should be left for the handler")
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// changeStreamDuration is how long a change stream is served before it is ended with a bookmark, from which the
+// client may resume.  It is shorter than the default HTTP write timeout, after which the server would cut the
+// stream off.
+var changeStreamDuration = 60 * time.Second
+
+type WatchChangesResponse struct {
+	Error string `json:"error,omitempty"`
+}
+
+// WatchChanges streams changes to volumes, snapshots, backends, nodes and volume publications as Server-Sent
+// Events.  Each event's ID is its resume token, so a client may resume after the last event it received with the
+// resumeToken query parameter or the Last-Event-ID header.  The stream starts and ends with a bookmark event
+// holding the latest resume token, and may be limited to one type of object with the objectType query parameter.
+func WatchChanges(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), changeStreamDuration)
+	defer cancel()
+
+	resumeToken := r.URL.Query().Get("resumeToken")
+	if resumeToken == "" {
+		resumeToken = r.Header.Get("Last-Event-ID")
+	}
+	objectType := storage.ChangeObjectType(r.URL.Query().Get("objectType"))
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPResponse(ctx, w, &WatchChangesResponse{Error: "streaming is not supported"},
+			http.StatusInternalServerError)
+		return
+	}
+
+	events, latestToken, err := orchestrator.WatchChanges(ctx, resumeToken)
+	if err != nil {
+		writeHTTPResponse(ctx, w, &WatchChangesResponse{Error: err.Error()}, httpStatusCodeForWatch(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	// A resumed stream starts where the client left off
+	if resumeToken != "" {
+		latestToken = resumeToken
+	}
+	bookmark := &storage.ChangeEvent{ResumeToken: latestToken, Type: storage.ChangeBookmark}
+	if err = writeChangeEvent(w, bookmark); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	for event := range events {
+		latestToken = event.ResumeToken
+		if objectType != "" && event.ObjectType != objectType {
+			continue
+		}
+		if err = writeChangeEvent(w, event); err != nil {
+			Logc(ctx).WithError(err).Debug("Could not write change event; ending change stream.")
+			return
+		}
+		flusher.Flush()
+	}
+
+	// The feed is closed when the stream times out or the client falls behind
+	_ = writeChangeEvent(w, &storage.ChangeEvent{ResumeToken: latestToken, Type: storage.ChangeBookmark})
+	flusher.Flush()
+}
+
+// writeChangeEvent writes a change event as a Server-Sent Event.
+func writeChangeEvent(w http.ResponseWriter, event *storage.ChangeEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResumeToken, event.Type, data)
+	return err
+}
+
+func httpStatusCodeForWatch(err error) int {
+	if utils.IsResumeTokenExpiredError(err) {
+		return http.StatusGone
+	}
+	return httpStatusCodeForGetUpdateList(err)
+}
@@ -383,9 +383,12 @@ func ListBackends(w http.ResponseWriter, r *http.Request) {
 				Logc(r.Context()).Errorf("ListBackends: %v", err)
 				response.Error = err.Error()
 			} else if len(backends) > 0 {
+				scope := newVolumeScopeForRequest(r)
 				backendNames = make([]string, 0, len(backends))
 				for _, backend := range backends {
-					backendNames = append(backendNames, backend.Name)
+					if scope.allowsBackend(backend.Name) {
+						backendNames = append(backendNames, backend.Name)
+					}
 				}
 			}
 			response.setList(backendNames)
@@ -529,9 +532,12 @@ func ListVolumes(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(volumes) > 0 {
+				scope := newVolumeScopeForRequest(r)
 				volumeNames = make([]string, 0, len(volumes))
 				for _, volume := range volumes {
-					volumeNames = append(volumeNames, volume.Config.Name)
+					if scope.allowsVolume(volume) {
+						volumeNames = append(volumeNames, volume.Config.Name)
+					}
 				}
 			}
 			response.setList(volumeNames)
@@ -562,6 +568,83 @@ func GetVolume(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+type GetVolumeHealthResponse struct {
+	Health *storage.VolumeHealth `json:"health"`
+	Error  string                `json:"error,omitempty"`
+}
+
+func GetVolumeHealth(w http.ResponseWriter, r *http.Request) {
+	response := &GetVolumeHealthResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeGetHealth, LogLayerRESTFrontend)
+
+			health, err := orchestrator.GetVolumeHealth(ctx, vars["volume"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Health = health
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type UpdateVolumeUsageResponse struct {
+	Volume string `json:"volume"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (r *UpdateVolumeUsageResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *UpdateVolumeUsageResponse) isError() bool {
+	return r.Error != ""
+}
+
+// logSuccess logs at trace level, as nodes report usage every time volume statistics are collected
+func (r *UpdateVolumeUsageResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"volume":  r.Volume,
+		"handler": "UpdateVolumeUsage",
+	}).Trace("Updated volume usage.")
+}
+
+func (r *UpdateVolumeUsageResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"volume":  r.Volume,
+		"handler": "UpdateVolumeUsage",
+	}).Debug(r.Error)
+}
+
+func UpdateVolumeUsage(w http.ResponseWriter, r *http.Request) {
+	response := &UpdateVolumeUsageResponse{}
+	UpdateGeneric(w, r, response,
+		func(w http.ResponseWriter, r *http.Request, response httpResponse, vars map[string]string, body []byte) int {
+			updateResponse, ok := response.(*UpdateVolumeUsageResponse)
+			if !ok {
+				response.setError(fmt.Errorf("response object must be of type UpdateVolumeUsageResponse"))
+				return http.StatusInternalServerError
+			}
+			updateResponse.Volume = vars["volume"]
+
+			usage := new(storage.VolumeUsage)
+			if err := json.Unmarshal(body, usage); err != nil {
+				updateResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForGetUpdateList(err)
+			}
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeGetStats, LogLayerRESTFrontend)
+
+			err := orchestrator.UpdateVolumeUsage(ctx, vars["volume"], usage)
+			if err != nil {
+				updateResponse.setError(err)
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
 func DeleteVolume(w http.ResponseWriter, r *http.Request) {
 	DeleteGeneric(w, r, func(ctx context.Context, vars map[string]string) error {
 		ctx = GenerateRequestContext(r.Context(), "", "", WorkflowVolumeDelete, LogLayerRESTFrontend)
@@ -636,6 +719,225 @@ func UpdateVolumeLUKSPassphraseNames(w http.ResponseWriter, r *http.Request) {
 	UpdateGeneric(w, r, response, volumeLUKSPassphraseNamesUpdater)
 }
 
+func volumeModifier(_ http.ResponseWriter, r *http.Request, response httpResponse, vars map[string]string,
+	body []byte,
+) int {
+	updateResponse, ok := response.(*UpdateVolumeResponse)
+	if !ok {
+		response.setError(fmt.Errorf("response object must be of type UpdateVolumeResponse"))
+		return http.StatusInternalServerError
+	}
+
+	request := &storage.VolumeModifyRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		updateResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+		return http.StatusBadRequest
+	}
+
+	ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeUpdate, LogLayerRESTFrontend)
+
+	volume, err := orchestrator.ModifyVolume(ctx, vars["volume"], request.Parameters)
+	if err != nil {
+		updateResponse.setError(err)
+	} else {
+		updateResponse.Volume = volume
+	}
+	return httpStatusCodeForGetUpdateList(err)
+}
+
+// ModifyVolume changes the modifiable attributes of a volume, given as the parameters of the request body.
+func ModifyVolume(w http.ResponseWriter, r *http.Request) {
+	response := &UpdateVolumeResponse{}
+	UpdateGeneric(w, r, response, volumeModifier)
+}
+
+type GetPoolUsageResponse struct {
+	Report *storage.PoolUsageReport `json:"report"`
+	Error  string                   `json:"error,omitempty"`
+}
+
+// GetPoolUsage reports how full each storage pool is, and which volumes could be migrated to even out their usage.
+func GetPoolUsage(w http.ResponseWriter, r *http.Request) {
+	response := &GetPoolUsageResponse{}
+	GetGeneric(w, r, response,
+		func(_ map[string]string) int {
+			report, err := orchestrator.GetPoolUsage(r.Context())
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Report = report
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ConsistencyResponse struct {
+	Report *storage.ConsistencyReport `json:"report"`
+	Error  string                     `json:"error,omitempty"`
+}
+
+func (r *ConsistencyResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *ConsistencyResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *ConsistencyResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"issues":  len(r.Report.Issues),
+		"handler": "RepairConsistency",
+	}).Info("Repaired inconsistencies.")
+}
+
+func (r *ConsistencyResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "RepairConsistency",
+	}).Error(r.Error)
+}
+
+// CheckConsistency reports where the persistent store, the backends and the container orchestrator have drifted
+// apart, without changing anything.
+func CheckConsistency(w http.ResponseWriter, r *http.Request) {
+	response := &ConsistencyResponse{}
+	GetGeneric(w, r, response,
+		func(_ map[string]string) int {
+			report, err := orchestrator.CheckConsistency(r.Context(), false)
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Report = report
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+// RepairConsistency reports where the persistent store, the backends and the container orchestrator have drifted
+// apart, and applies the safe fixes.
+func RepairConsistency(w http.ResponseWriter, r *http.Request) {
+	response := &ConsistencyResponse{}
+	UpdateGeneric(w, r, response,
+		func(_ http.ResponseWriter, r *http.Request, _ httpResponse, _ map[string]string, _ []byte) int {
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowCoreConsistencyCheck, LogLayerRESTFrontend)
+
+			report, err := orchestrator.CheckConsistency(ctx, true)
+			if err != nil {
+				response.setError(err)
+			} else {
+				response.Report = report
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type MigrateVolumeResponse struct {
+	Migration *storage.VolumeMigrationExternal `json:"migration"`
+	Error     string                           `json:"error,omitempty"`
+}
+
+func (r *MigrateVolumeResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *MigrateVolumeResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *MigrateVolumeResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"volume":        r.Migration.Volume,
+		"targetBackend": r.Migration.TargetBackend,
+		"targetPool":    r.Migration.TargetPool,
+		"handler":       "MigrateVolume",
+	}).Info("Started migrating a volume.")
+}
+
+func (r *MigrateVolumeResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "MigrateVolume",
+	}).Error(r.Error)
+}
+
+// MigrateVolume starts migrating a volume to the backend and pool given in the request body.
+func MigrateVolume(w http.ResponseWriter, r *http.Request) {
+	response := &MigrateVolumeResponse{}
+	UpdateGeneric(w, r, response,
+		func(_ http.ResponseWriter, r *http.Request, _ httpResponse, vars map[string]string, body []byte) int {
+			request := &storage.VolumeMigrateRequest{}
+			if err := json.Unmarshal(body, request); err != nil {
+				response.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return http.StatusBadRequest
+			}
+
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeMigrate, LogLayerRESTFrontend)
+
+			migration, err := orchestrator.MigrateVolume(ctx, vars["volume"], request.Backend, request.Pool)
+			if err != nil {
+				response.setError(err)
+			} else {
+				response.Migration = migration
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type GetVolumeMigrationResponse struct {
+	Migration *storage.VolumeMigrationExternal `json:"migration"`
+	Error     string                           `json:"error,omitempty"`
+}
+
+func GetVolumeMigration(w http.ResponseWriter, r *http.Request) {
+	response := &GetVolumeMigrationResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			migration, err := orchestrator.GetVolumeMigration(r.Context(), vars["volume"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Migration = migration
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ListVolumeMigrationsResponse struct {
+	Volumes []string `json:"volumes"`
+	Error   string   `json:"error,omitempty"`
+}
+
+func (l *ListVolumeMigrationsResponse) setList(payload []string) {
+	l.Volumes = payload
+}
+
+// ListVolumeMigrations lists the names of the volumes that are migrating.
+func ListVolumeMigrations(w http.ResponseWriter, r *http.Request) {
+	response := &ListVolumeMigrationsResponse{}
+	ListGeneric(w, r, response,
+		func(_ map[string]string) int {
+			volumeNames := make([]string, 0)
+			migrations, err := orchestrator.ListVolumeMigrations(r.Context())
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				scope := newVolumeScopeForRequest(r)
+				for _, migration := range migrations {
+					if scope.allowsVolumeName(migration.Volume) {
+						volumeNames = append(volumeNames, migration.Volume)
+					}
+				}
+			}
+			response.setList(volumeNames)
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
 type ImportVolumeResponse struct {
 	Volume *storage.VolumeExternal `json:"volume"`
 	Error  string                  `json:"error,omitempty"`
@@ -1198,13 +1500,29 @@ func ListVolumePublications(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				response.Error = err.Error()
 			} else {
-				response.VolumePublications = pubs
+				response.VolumePublications = filterVolumePublications(newVolumeScopeForRequest(r), pubs)
 			}
 			return httpStatusCodeForGetUpdateList(err)
 		},
 	)
 }
 
+// filterVolumePublications returns the publications of the volumes that a call may act on.
+func filterVolumePublications(
+	scope *volumeScope, pubs []*utils.VolumePublicationExternal,
+) []*utils.VolumePublicationExternal {
+	if scope == nil {
+		return pubs
+	}
+	filtered := make([]*utils.VolumePublicationExternal, 0, len(pubs))
+	for _, pub := range pubs {
+		if scope.allowsVolumeName(pub.VolumeName) {
+			filtered = append(filtered, pub)
+		}
+	}
+	return filtered
+}
+
 func ListVolumePublicationsForVolume(w http.ResponseWriter, r *http.Request) {
 	response := &VolumePublicationsResponse{}
 	GetGeneric(w, r, response,
@@ -1228,7 +1546,7 @@ func ListVolumePublicationsForNode(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				response.Error = err.Error()
 			} else {
-				response.VolumePublications = pubs
+				response.VolumePublications = filterVolumePublications(newVolumeScopeForRequest(r), pubs)
 			}
 			return httpStatusCodeForGetUpdateList(err)
 		},
@@ -1273,9 +1591,12 @@ func ListSnapshots(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(snapshots) > 0 {
+				scope := newVolumeScopeForRequest(r)
 				snapshotIDs = make([]string, 0, len(snapshots))
 				for _, snapshot := range snapshots {
-					snapshotIDs = append(snapshotIDs, snapshot.ID())
+					if scope.allowsVolumeName(snapshot.Config.VolumeName) {
+						snapshotIDs = append(snapshotIDs, snapshot.ID())
+					}
 				}
 			}
 			response.setList(snapshotIDs)
@@ -1362,6 +1683,329 @@ func DeleteSnapshot(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+type GetGroupSnapshotResponse struct {
+	GroupSnapshot *storage.GroupSnapshotExternal `json:"groupSnapshot"`
+	Error         string                         `json:"error,omitempty"`
+}
+
+func GetGroupSnapshot(w http.ResponseWriter, r *http.Request) {
+	response := &GetGroupSnapshotResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			groupSnapshot, err := orchestrator.GetGroupSnapshot(r.Context(), vars["groupSnapshot"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.GroupSnapshot = groupSnapshot
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ListGroupSnapshotsResponse struct {
+	GroupSnapshots []string `json:"groupSnapshots"`
+	Error          string   `json:"error,omitempty"`
+}
+
+func (l *ListGroupSnapshotsResponse) setList(payload []string) {
+	l.GroupSnapshots = payload
+}
+
+func ListGroupSnapshots(w http.ResponseWriter, r *http.Request) {
+	response := &ListGroupSnapshotsResponse{}
+	ListGeneric(w, r, response,
+		func(_ map[string]string) int {
+			groupSnapshotNames := make([]string, 0)
+			groupSnapshots, err := orchestrator.ListGroupSnapshots(r.Context())
+			if err != nil {
+				response.Error = err.Error()
+			} else if len(groupSnapshots) > 0 {
+				groupSnapshotNames = make([]string, 0, len(groupSnapshots))
+				for _, groupSnapshot := range groupSnapshots {
+					groupSnapshotNames = append(groupSnapshotNames, groupSnapshot.ID())
+				}
+			}
+			response.setList(groupSnapshotNames)
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type AddGroupSnapshotResponse struct {
+	GroupSnapshotName string   `json:"groupSnapshotName"`
+	SnapshotIDs       []string `json:"snapshotIDs,omitempty"`
+	Error             string   `json:"error,omitempty"`
+}
+
+func (r *AddGroupSnapshotResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *AddGroupSnapshotResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *AddGroupSnapshotResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"groupSnapshot": r.GroupSnapshotName,
+		"snapshots":     r.SnapshotIDs,
+		"handler":       "AddGroupSnapshot",
+	}).Info("Added a new group snapshot.")
+}
+
+func (r *AddGroupSnapshotResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"groupSnapshot": r.GroupSnapshotName,
+		"handler":       "AddGroupSnapshot",
+	}).Error(r.Error)
+}
+
+func AddGroupSnapshot(w http.ResponseWriter, r *http.Request) {
+	response := &AddGroupSnapshotResponse{}
+	AddGeneric(w, r, response,
+		func(body []byte) int {
+			groupConfig := new(storage.GroupSnapshotConfig)
+			if err := json.Unmarshal(body, groupConfig); err != nil {
+				response.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForAdd(err)
+			}
+			response.GroupSnapshotName = groupConfig.Name
+			if err := groupConfig.Validate(); err != nil {
+				response.setError(err)
+				return httpStatusCodeForAdd(err)
+			}
+			groupSnapshot, err := orchestrator.CreateGroupSnapshot(r.Context(), groupConfig)
+			if err != nil {
+				response.setError(err)
+			}
+			if groupSnapshot != nil {
+				response.GroupSnapshotName = groupSnapshot.ID()
+				for _, snapshot := range groupSnapshot.Snapshots {
+					response.SnapshotIDs = append(response.SnapshotIDs, snapshot.ID())
+				}
+			}
+			return httpStatusCodeForAdd(err)
+		},
+	)
+}
+
+func DeleteGroupSnapshot(w http.ResponseWriter, r *http.Request) {
+	DeleteGeneric(w, r, func(ctx context.Context, vars map[string]string) error {
+		return orchestrator.DeleteGroupSnapshot(r.Context(), vars["groupSnapshot"])
+	})
+}
+
+type GetSnapshotPolicyResponse struct {
+	SnapshotPolicy *storage.SnapshotPolicyExternal `json:"snapshotPolicy"`
+	Error          string                          `json:"error,omitempty"`
+}
+
+func GetSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &GetSnapshotPolicyResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			policy, err := orchestrator.GetSnapshotPolicy(r.Context(), vars["snapshotPolicy"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.SnapshotPolicy = policy
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ListSnapshotPoliciesResponse struct {
+	SnapshotPolicies []string `json:"snapshotPolicies"`
+	Error            string   `json:"error,omitempty"`
+}
+
+func (l *ListSnapshotPoliciesResponse) setList(payload []string) {
+	l.SnapshotPolicies = payload
+}
+
+func ListSnapshotPolicies(w http.ResponseWriter, r *http.Request) {
+	response := &ListSnapshotPoliciesResponse{}
+	ListGeneric(w, r, response,
+		func(_ map[string]string) int {
+			policyNames := make([]string, 0)
+			policies, err := orchestrator.ListSnapshotPolicies(r.Context())
+			if err != nil {
+				response.Error = err.Error()
+			} else if len(policies) > 0 {
+				policyNames = make([]string, 0, len(policies))
+				for _, policy := range policies {
+					policyNames = append(policyNames, policy.ID())
+				}
+			}
+			response.setList(policyNames)
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type AddSnapshotPolicyResponse struct {
+	SnapshotPolicyName string `json:"snapshotPolicyName"`
+	Error              string `json:"error,omitempty"`
+}
+
+func (r *AddSnapshotPolicyResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *AddSnapshotPolicyResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *AddSnapshotPolicyResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": r.SnapshotPolicyName,
+		"handler":        "AddSnapshotPolicy",
+	}).Info("Added a new snapshot policy.")
+}
+
+func (r *AddSnapshotPolicyResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": r.SnapshotPolicyName,
+		"handler":        "AddSnapshotPolicy",
+	}).Error(r.Error)
+}
+
+func AddSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &AddSnapshotPolicyResponse{}
+	AddGeneric(w, r, response,
+		func(body []byte) int {
+			policyConfig := new(storage.SnapshotPolicyConfig)
+			if err := json.Unmarshal(body, policyConfig); err != nil {
+				response.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForAdd(err)
+			}
+			response.SnapshotPolicyName = policyConfig.Name
+			policy, err := orchestrator.AddSnapshotPolicy(r.Context(), policyConfig)
+			if err != nil {
+				response.setError(err)
+			}
+			if policy != nil {
+				response.SnapshotPolicyName = policy.ID()
+			}
+			return httpStatusCodeForAdd(err)
+		},
+	)
+}
+
+type UpdateSnapshotPolicyResponse struct {
+	SnapshotPolicyName string `json:"snapshotPolicyName"`
+	Error              string `json:"error,omitempty"`
+}
+
+func (r *UpdateSnapshotPolicyResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *UpdateSnapshotPolicyResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *UpdateSnapshotPolicyResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": r.SnapshotPolicyName,
+		"handler":        "UpdateSnapshotPolicy",
+	}).Info("Updated a snapshot policy.")
+}
+
+func (r *UpdateSnapshotPolicyResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": r.SnapshotPolicyName,
+		"handler":        "UpdateSnapshotPolicy",
+	}).Error(r.Error)
+}
+
+func UpdateSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &UpdateSnapshotPolicyResponse{}
+	UpdateGeneric(w, r, response,
+		func(w http.ResponseWriter, r *http.Request, response httpResponse, vars map[string]string, body []byte) int {
+			updateResponse, ok := response.(*UpdateSnapshotPolicyResponse)
+			if !ok {
+				response.setError(fmt.Errorf("response object must be of type UpdateSnapshotPolicyResponse"))
+				return http.StatusInternalServerError
+			}
+			updateResponse.SnapshotPolicyName = vars["snapshotPolicy"]
+
+			policyConfig := new(storage.SnapshotPolicyConfig)
+			if err := json.Unmarshal(body, policyConfig); err != nil {
+				updateResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForGetUpdateList(err)
+			}
+			if policyConfig.Name == "" {
+				policyConfig.Name = vars["snapshotPolicy"]
+			} else if policyConfig.Name != vars["snapshotPolicy"] {
+				err := fmt.Errorf("snapshot policy name %s does not match %s", policyConfig.Name,
+					vars["snapshotPolicy"])
+				updateResponse.setError(err)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
+			_, err := orchestrator.UpdateSnapshotPolicy(r.Context(), policyConfig)
+			if err != nil {
+				updateResponse.setError(err)
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+func DeleteSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	DeleteGeneric(w, r, func(ctx context.Context, vars map[string]string) error {
+		return orchestrator.DeleteSnapshotPolicy(r.Context(), vars["snapshotPolicy"])
+	})
+}
+
+type RestoreSnapshotResponse struct {
+	Volume   string `json:"volume"`
+	Snapshot string `json:"snapshot"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (r *RestoreSnapshotResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *RestoreSnapshotResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *RestoreSnapshotResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"volume":   r.Volume,
+		"snapshot": r.Snapshot,
+		"handler":  "RestoreSnapshot",
+	}).Info("Restored a volume from a snapshot.")
+}
+
+func (r *RestoreSnapshotResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"volume":   r.Volume,
+		"snapshot": r.Snapshot,
+		"handler":  "RestoreSnapshot",
+	}).Error(r.Error)
+}
+
+func RestoreSnapshot(w http.ResponseWriter, r *http.Request) {
+	response := &RestoreSnapshotResponse{}
+	UpdateGeneric(w, r, response,
+		func(w http.ResponseWriter, r *http.Request, _ httpResponse, vars map[string]string, _ []byte) int {
+			response.Volume = vars["volume"]
+			response.Snapshot = vars["snapshot"]
+			err := orchestrator.RestoreSnapshot(r.Context(), vars["volume"], vars["snapshot"])
+			if err != nil {
+				response.setError(err)
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
 type GetCHAPResponse struct {
 	CHAP  *utils.IscsiChapInfo `json:"chap"`
 	Error string               `json:"error,omitempty"`
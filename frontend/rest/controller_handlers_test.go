@@ -184,6 +184,49 @@ func TestVolumeLUKSPassphraseNamesUpdater(t *testing.T) {
 	mockCtrl.Finish()
 }
 
+func TestVolumeModifier(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	orchestrator = mockOrchestrator
+	vars := map[string]string{"volume": "test"}
+	volume := &storage.VolumeExternal{Config: &storage.VolumeConfig{Name: "test", SnapshotPolicy: "default"}}
+
+	// The parameters of the request are passed to the orchestrator
+	body := `{"parameters": {"snapshotPolicy": "default"}}`
+	response := &UpdateVolumeResponse{}
+	mockOrchestrator.EXPECT().ModifyVolume(gomock.Any(), "test",
+		storage.VolumeModification{"snapshotPolicy": "default"}).Return(volume, nil)
+
+	rc := volumeModifier(&http_test.TestResponseWriter{}, generateHTTPRequest(http.MethodPatch, body), response,
+		vars, []byte(body))
+
+	assert.Equal(t, http.StatusOK, rc)
+	assert.Equal(t, volume, response.Volume)
+	assert.Equal(t, "", response.Error)
+
+	// Errors from the orchestrator are returned
+	response = &UpdateVolumeResponse{}
+	mockOrchestrator.EXPECT().ModifyVolume(gomock.Any(), "test",
+		storage.VolumeModification{"snapshotPolicy": "default"}).Return(nil, utils.NotFoundError("not found"))
+
+	rc = volumeModifier(&http_test.TestResponseWriter{}, generateHTTPRequest(http.MethodPatch, body), response,
+		vars, []byte(body))
+
+	assert.Equal(t, http.StatusNotFound, rc)
+	assert.Nil(t, response.Volume)
+	assert.NotEmpty(t, response.Error)
+
+	// Invalid JSON is refused
+	body = `{"parameters": ["snapshotPolicy"]}`
+	response = &UpdateVolumeResponse{}
+
+	rc = volumeModifier(&http_test.TestResponseWriter{}, generateHTTPRequest(http.MethodPatch, body), response,
+		vars, []byte(body))
+
+	assert.Equal(t, http.StatusBadRequest, rc)
+	assert.NotEmpty(t, response.Error)
+}
+
 // TestUpdateNodeIsAsync tests that UpdateNode is called when it can get the core lock, after
 // responding to the request, by:
 // 1. Requesting another endpoint (ListNodes) that holds core lock for some time
@@ -352,3 +395,233 @@ func TestGetNode_FailsWithCoreError(t *testing.T) {
 	assert.Nil(t, updateNodeResponse.Node, "expected nil Node value in response")
 	assert.NotEmpty(t, updateNodeResponse.Error, "expected non-empty Error string in response")
 }
+
+func TestMigrateVolume(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	// Set up the mock orchestrator, test server and test values.
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	migration := &storage.VolumeMigrationExternal{
+		Volume:        "vol1",
+		TargetBackend: "backend2",
+		TargetPool:    "aggr2",
+		State:         storage.VolumeMigrationMirroring,
+	}
+	mockOrchestrator.EXPECT().MigrateVolume(gomock.Any(), "vol1", "backend2", "aggr2").Return(migration, nil)
+
+	// The target backend and pool of the request body are passed to the orchestrator.
+	url := server.URL + "/trident/v1/migration/vol1"
+	body := []byte(`{"backend": "backend2", "pool": "aggr2"}`)
+	res, err := http.Post(url, "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	migrateVolumeResponse := MigrateVolumeResponse{}
+	assert.NoError(t, json.Unmarshal(responseBody, &migrateVolumeResponse))
+	assert.Equal(t, migration, migrateVolumeResponse.Migration)
+
+	// Invalid JSON is refused.
+	res, err = http.Post(url, "application/json", bytes.NewBuffer([]byte(`{"backend": 2}`)))
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestGetVolumeMigration(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	// Set up the mock orchestrator, test server and test values.
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	migration := &storage.VolumeMigrationExternal{
+		Volume:          "vol1",
+		State:           storage.VolumeMigrationMoving,
+		PercentComplete: 40,
+	}
+	mockOrchestrator.EXPECT().GetVolumeMigration(gomock.Any(), "vol1").Return(migration, nil)
+	mockOrchestrator.EXPECT().GetVolumeMigration(gomock.Any(), "vol2").Return(nil,
+		utils.NotFoundError("volume vol2 is not migrating"))
+
+	res, err := http.Get(server.URL + "/trident/v1/migration/vol1")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	getMigrationResponse := GetVolumeMigrationResponse{}
+	assert.NoError(t, json.Unmarshal(responseBody, &getMigrationResponse))
+	assert.Equal(t, 40, getMigrationResponse.Migration.PercentComplete)
+
+	// Volumes that are not migrating are not found.
+	res, err = http.Get(server.URL + "/trident/v1/migration/vol2")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestGetPoolUsage(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	// Set up the mock orchestrator, test server and test values.
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	report := &storage.PoolUsageReport{
+		Pools: []*storage.PoolUsage{
+			{Backend: "backend1", Pool: "pool1", TotalBytes: 100, FreeBytes: 10, Volumes: 3},
+			{Backend: "backend1", Pool: "pool2", TotalBytes: 100, FreeBytes: 90, Volumes: 1},
+		},
+		Suggestions: []*storage.PoolRebalanceSuggestion{{
+			Volume:        "vol1",
+			SizeBytes:     40,
+			SourceBackend: "backend1",
+			SourcePool:    "pool1",
+			TargetBackend: "backend1",
+			TargetPool:    "pool2",
+		}},
+	}
+	mockOrchestrator.EXPECT().GetPoolUsage(gomock.Any()).Return(report, nil)
+	mockOrchestrator.EXPECT().GetPoolUsage(gomock.Any()).Return(nil,
+		utils.BootstrapError(errors.New("not ready")))
+
+	res, err := http.Get(server.URL + "/trident/v1/pool/usage")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	getPoolUsageResponse := GetPoolUsageResponse{}
+	assert.NoError(t, json.Unmarshal(responseBody, &getPoolUsageResponse))
+	assert.Equal(t, report, getPoolUsageResponse.Report)
+
+	// Errors from the orchestrator are returned.
+	res, err = http.Get(server.URL + "/trident/v1/pool/usage")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.NotEqual(t, http.StatusOK, res.StatusCode)
+}
+
+func TestCheckConsistency(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	// Set up the mock orchestrator, test server and test values.
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	issue := &storage.ConsistencyIssue{
+		Type:    storage.StalePublicationIssue,
+		Object:  "vol1",
+		Node:    "node1",
+		Message: "the volume is published to the node, but Trident has no such volume",
+		Repair:  "delete the publication",
+	}
+	report := &storage.ConsistencyReport{Issues: []*storage.ConsistencyIssue{issue}}
+	repairedIssue := *issue
+	repairedIssue.Repaired = true
+	repairedReport := &storage.ConsistencyReport{Repair: true, Issues: []*storage.ConsistencyIssue{&repairedIssue}}
+	mockOrchestrator.EXPECT().CheckConsistency(gomock.Any(), false).Return(report, nil)
+	mockOrchestrator.EXPECT().CheckConsistency(gomock.Any(), true).Return(repairedReport, nil)
+
+	res, err := http.Get(server.URL + "/trident/v1/consistency")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	consistencyResponse := ConsistencyResponse{}
+	assert.NoError(t, json.Unmarshal(responseBody, &consistencyResponse))
+	assert.Equal(t, report, consistencyResponse.Report)
+
+	// Repairs are made by POST only.
+	res, err = http.Post(server.URL+"/trident/v1/consistency/repair", "application/json", nil)
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	responseBody, err = io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	consistencyResponse = ConsistencyResponse{}
+	assert.NoError(t, json.Unmarshal(responseBody, &consistencyResponse))
+	assert.Equal(t, repairedReport, consistencyResponse.Report)
+}
+
+func TestWatchChanges(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	// Set up the mock orchestrator, test server and test values.
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	events := make(chan *storage.ChangeEvent, 2)
+	events <- &storage.ChangeEvent{
+		ResumeToken: "epoch.2", Type: storage.ChangeAdded, ObjectType: storage.ChangeObjectBackend, Name: "backend1",
+	}
+	events <- &storage.ChangeEvent{
+		ResumeToken: "epoch.3", Type: storage.ChangeAdded, ObjectType: storage.ChangeObjectVolume, Name: "vol1",
+	}
+	close(events)
+	mockOrchestrator.EXPECT().WatchChanges(gomock.Any(), "").Return(events, "epoch.1", nil)
+	mockOrchestrator.EXPECT().WatchChanges(gomock.Any(), "old").
+		Return(nil, "", utils.ResumeTokenExpiredError("expired"))
+
+	res, err := http.Get(server.URL + "/trident/v1/changes?objectType=volume")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.NoError(t, err, "expected no error")
+	blocks := strings.Split(strings.TrimSpace(string(responseBody)), "\n\n")
+	if assert.Len(t, blocks, 3, "expected a bookmark, the volume event and a closing bookmark") {
+		assert.True(t, strings.HasPrefix(blocks[0], "id: epoch.1\nevent: bookmark\n"))
+		assert.True(t, strings.HasPrefix(blocks[1], "id: epoch.3\nevent: added\n"))
+		assert.Contains(t, blocks[1], `"name":"vol1"`)
+		assert.True(t, strings.HasPrefix(blocks[2], "id: epoch.3\nevent: bookmark\n"))
+	}
+
+	// A stream that can no longer be resumed is gone.
+	res, err = http.Get(server.URL + "/trident/v1/changes?resumeToken=old")
+	assert.NoError(t, err, "expected no error")
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusGone, res.StatusCode)
+}
@@ -100,6 +100,20 @@ var controllerRoutes = Routes{
 		nil,
 		GetVolume,
 	},
+	Route{
+		"GetVolumeHealth",
+		"GET",
+		config.VolumeURL + "/{volume}/health",
+		nil,
+		GetVolumeHealth,
+	},
+	Route{
+		"UpdateVolumeUsage",
+		"PUT",
+		config.VolumeURL + "/{volume}/usage",
+		nil,
+		UpdateVolumeUsage,
+	},
 	Route{
 		"ListVolumes",
 		"GET",
@@ -114,6 +128,62 @@ var controllerRoutes = Routes{
 		nil,
 		DeleteVolume,
 	},
+	Route{
+		"ModifyVolume",
+		"PATCH",
+		config.VolumeURL + "/{volume}",
+		nil,
+		ModifyVolume,
+	},
+	Route{
+		"GetPoolUsage",
+		"GET",
+		config.PoolURL + "/usage",
+		nil,
+		GetPoolUsage,
+	},
+	Route{
+		"CheckConsistency",
+		"GET",
+		config.ConsistencyURL,
+		nil,
+		CheckConsistency,
+	},
+	Route{
+		"RepairConsistency",
+		"POST",
+		config.ConsistencyURL + "/repair",
+		nil,
+		RepairConsistency,
+	},
+	Route{
+		"WatchChanges",
+		"GET",
+		config.ChangesURL,
+		nil,
+		WatchChanges,
+	},
+	Route{
+		"ListVolumeMigrations",
+		"GET",
+		config.MigrationURL,
+		nil,
+		ListVolumeMigrations,
+	},
+	Route{
+		"GetVolumeMigration",
+		"GET",
+		config.MigrationURL + "/{volume}",
+		nil,
+		GetVolumeMigration,
+	},
+	Route{
+		"MigrateVolume",
+		"POST",
+		config.MigrationURL + "/{volume}",
+		nil,
+		MigrateVolume,
+	},
 	Route{
 		"UpdateVolume",
 		"PUT",
@@ -265,6 +335,76 @@ var controllerRoutes = Routes{
 		nil,
 		DeleteSnapshot,
 	},
+	Route{
+		"RestoreSnapshot",
+		"POST",
+		config.SnapshotURL + "/{volume}/{snapshot}/restore",
+		nil,
+		RestoreSnapshot,
+	},
+	Route{
+		"ListGroupSnapshots",
+		"GET",
+		config.GroupSnapshotURL,
+		nil,
+		ListGroupSnapshots,
+	},
+	Route{
+		"GetGroupSnapshot",
+		"GET",
+		config.GroupSnapshotURL + "/{groupSnapshot}",
+		nil,
+		GetGroupSnapshot,
+	},
+	Route{
+		"AddGroupSnapshot",
+		"POST",
+		config.GroupSnapshotURL,
+		nil,
+		AddGroupSnapshot,
+	},
+	Route{
+		"DeleteGroupSnapshot",
+		"DELETE",
+		config.GroupSnapshotURL + "/{groupSnapshot}",
+		nil,
+		DeleteGroupSnapshot,
+	},
+	Route{
+		"ListSnapshotPolicies",
+		"GET",
+		config.SnapshotPolicyURL,
+		nil,
+		ListSnapshotPolicies,
+	},
+	Route{
+		"GetSnapshotPolicy",
+		"GET",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		GetSnapshotPolicy,
+	},
+	Route{
+		"AddSnapshotPolicy",
+		"POST",
+		config.SnapshotPolicyURL,
+		nil,
+		AddSnapshotPolicy,
+	},
+	Route{
+		"UpdateSnapshotPolicy",
+		"PUT",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		UpdateSnapshotPolicy,
+	},
+	Route{
+		"DeleteSnapshotPolicy",
+		"DELETE",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		DeleteSnapshotPolicy,
+	},
 	Route{
 		"GetCHAP",
 		"GET",
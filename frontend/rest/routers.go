@@ -13,15 +13,15 @@ import (
 
 // NewRouter is used to set up HTTP and HTTPS endpoints for the controller
 func NewRouter(https bool) *mux.Router {
-	return newRouter(controllerRoutes, https)
+	return newRouter(controllerRoutes, https, authorizationPolicy)
 }
 
 // NewNodeRouter is used to set up HTTPS liveness and readiness endpoints for the node
 func NewNodeRouter(plugin *csi.Plugin) *mux.Router {
-	return newRouter(nodeRoutes(plugin), true)
+	return newRouter(nodeRoutes(plugin), true, nil)
 }
 
-func newRouter(routes Routes, https bool) *mux.Router {
+func newRouter(routes Routes, https bool, policy *AuthorizationPolicy) *mux.Router {
 	router := mux.NewRouter().StrictSlash(true)
 
 	for _, route := range routes {
@@ -32,6 +32,11 @@ func newRouter(routes Routes, https bool) *mux.Router {
 			handler = route.Middleware[i](handler)
 		}
 
+		// Apply authorization middleware
+		if policy != nil {
+			handler = authorizer(policy, route.Name)(handler)
+		}
+
 		// Apply secure header middleware
 		if https {
 			handler = secureheader.Handler(handler)
@@ -0,0 +1,93 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+)
+
+// waitForLeadership campaigns for the controller leader lease and blocks until this controller holds it, so that
+// only one of several controller replicas runs the orchestrator while the others stand by.  The returned function
+// gives up the lease, so that a standby controller takes over without waiting for the lease to expire; it must be
+// called only after the orchestrator has stopped.  If the lease is lost while the controller is running, Trident
+// exits, so that it restarts as a standby and cannot act alongside the new leader.
+func waitForLeadership(
+	kubeClient kubernetes.Interface, namespace, identity string, leaseDuration, renewDeadline,
+	retryPeriod time.Duration,
+) (release func(), err error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      config.ControllerLeaseName,
+			Namespace: namespace,
+		},
+		Client:     kubeClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+
+	elected := make(chan struct{})
+	stopped := make(chan struct{})
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            config.ControllerLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				close(elected)
+			},
+			OnStoppedLeading: func() {
+				if ctx.Err() != nil {
+					// The lease was given up on shutdown
+					return
+				}
+				Log().WithField("identity", identity).Fatal("Lost the controller leader lease.")
+			},
+			OnNewLeader: func(leader string) {
+				Log().WithFields(LogFields{
+					"leader":   leader,
+					"identity": identity,
+				}).Info("Observed a new controller leader.")
+			},
+		},
+	})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create the leader elector; %v", err)
+	}
+
+	Log().WithFields(LogFields{
+		"lease":     config.ControllerLeaseName,
+		"namespace": namespace,
+		"identity":  identity,
+	}).Info("Standing by until elected controller leader.")
+
+	go func() {
+		defer close(stopped)
+		elector.Run(ctx)
+	}()
+
+	<-elected
+
+	Log().WithField("identity", identity).Info("Elected controller leader.")
+
+	release = func() {
+		cancel()
+		<-stopped
+	}
+	return release, nil
+}
@@ -15,6 +15,8 @@ const (
 	CategoryNode           = WorkflowCategory("node")
 	CategoryBackend        = WorkflowCategory("backend")
 	CategorySnapshot       = WorkflowCategory("snapshot")
+	CategoryGroupSnapshot  = WorkflowCategory("group_snapshot")
+	CategorySnapshotPolicy = WorkflowCategory("snapshot_policy")
 	CategoryController     = WorkflowCategory("controller")
 	CategoryNodeServer     = WorkflowCategory("node_server")
 	CategoryIdentityServer = WorkflowCategory("identity_server")
@@ -28,6 +30,7 @@ const (
 	OpNodeReconcile    = WorkflowOperation("node_reconcile")
 	OpBackendReconcile = WorkflowOperation("backend_reconcile")
 	OpReconcile        = WorkflowOperation("reconcile")
+	OpConsistencyCheck = WorkflowOperation("consistency_check")
 	OpTrace            = WorkflowOperation("trace")
 	OpLogger           = WorkflowOperation("logger")
 	OpActivate         = WorkflowOperation("activate")
@@ -45,9 +48,16 @@ const (
 	OpCloneFrom        = WorkflowOperation("clone_from")
 	OpImport           = WorkflowOperation("import")
 	OpResize           = WorkflowOperation("resize")
+	OpAutogrow         = WorkflowOperation("autogrow")
+	OpMigrate          = WorkflowOperation("migrate")
+	OpRestore          = WorkflowOperation("restore")
+	OpSchedule         = WorkflowOperation("schedule")
+	OpRotate           = WorkflowOperation("rotate")
 	OpMount            = WorkflowOperation("mount")
 	OpUnmount          = WorkflowOperation("unmount")
 	OpGetCapabilties   = WorkflowOperation("get_capabilities")
+	OpGetCapacity      = WorkflowOperation("get_capacity")
+	OpGetHealth        = WorkflowOperation("get_health")
 	OpProbe            = WorkflowOperation("probe")
 	OpGetResponse      = WorkflowOperation("get_response")
 	OpPublish          = WorkflowOperation("publish")
@@ -70,6 +80,7 @@ var (
 	WorkflowCoreInit             = Workflow{CategoryCore, OpInit}
 	WorkflowCoreNodeReconcile    = Workflow{CategoryCore, OpNodeReconcile}
 	WorkflowCoreBackendReconcile = Workflow{CategoryCore, OpBackendReconcile}
+	WorkflowCoreConsistencyCheck = Workflow{CategoryCore, OpConsistencyCheck}
 
 	WorkflowGRPCTrace = Workflow{CategoryGRPC, OpTrace}
 
@@ -100,15 +111,19 @@ var (
 	WorkflowVolumeClone           = Workflow{CategoryVolume, OpClone}
 	WorkflowVolumeImport          = Workflow{CategoryVolume, OpImport}
 	WorkflowVolumeResize          = Workflow{CategoryVolume, OpResize}
+	WorkflowVolumeAutogrow        = Workflow{CategoryVolume, OpAutogrow}
+	WorkflowVolumeMigrate         = Workflow{CategoryVolume, OpMigrate}
 	WorkflowVolumeMount           = Workflow{CategoryVolume, OpMount}
 	WorkflowVolumeUnmount         = Workflow{CategoryVolume, OpUnmount}
 	WorkflowVolumeGetCapabilities = Workflow{CategoryVolume, OpGetCapabilties}
+	WorkflowVolumeGetHealth       = Workflow{CategoryVolume, OpGetHealth}
 
-	WorkflowStorageClassCreate = Workflow{CategoryStorageClass, OpCreate}
-	WorkflowStorageClassGet    = Workflow{CategoryStorageClass, OpGet}
-	WorkflowStorageClassUpdate = Workflow{CategoryStorageClass, OpUpdate}
-	WorkflowStorageClassList   = Workflow{CategoryStorageClass, OpList}
-	WorkflowStorageClassDelete = Workflow{CategoryStorageClass, OpDelete}
+	WorkflowStorageClassCreate      = Workflow{CategoryStorageClass, OpCreate}
+	WorkflowStorageClassGet         = Workflow{CategoryStorageClass, OpGet}
+	WorkflowStorageClassUpdate      = Workflow{CategoryStorageClass, OpUpdate}
+	WorkflowStorageClassList        = Workflow{CategoryStorageClass, OpList}
+	WorkflowStorageClassDelete      = Workflow{CategoryStorageClass, OpDelete}
+	WorkflowStorageClassGetCapacity = Workflow{CategoryStorageClass, OpGetCapacity}
 
 	WorkflowNodeCreate          = Workflow{CategoryNode, OpCreate}
 	WorkflowNodeGet             = Workflow{CategoryNode, OpGet}
@@ -128,6 +143,7 @@ var (
 	WorkflowBackendGet    = Workflow{CategoryBackend, OpGet}
 	WorkflowBackendUpdate = Workflow{CategoryBackend, OpUpdate}
 	WorkflowBackendList   = Workflow{CategoryBackend, OpList}
+	WorkflowBackendRotate = Workflow{CategoryBackend, OpRotate}
 
 	WorkflowSnapshotCreate    = Workflow{CategorySnapshot, OpCreate}
 	WorkflowSnapshotDelete    = Workflow{CategorySnapshot, OpDelete}
@@ -135,6 +151,19 @@ var (
 	WorkflowSnapshotUpdate    = Workflow{CategorySnapshot, OpUpdate}
 	WorkflowSnapshotList      = Workflow{CategorySnapshot, OpList}
 	WorkflowSnapshotCloneFrom = Workflow{CategorySnapshot, OpCloneFrom}
+	WorkflowSnapshotRestore   = Workflow{CategorySnapshot, OpRestore}
+
+	WorkflowGroupSnapshotCreate          = Workflow{CategoryGroupSnapshot, OpCreate}
+	WorkflowGroupSnapshotDelete          = Workflow{CategoryGroupSnapshot, OpDelete}
+	WorkflowGroupSnapshotGet             = Workflow{CategoryGroupSnapshot, OpGet}
+	WorkflowGroupSnapshotGetCapabilities = Workflow{CategoryGroupSnapshot, OpGetCapabilties}
+
+	WorkflowSnapshotPolicyCreate   = Workflow{CategorySnapshotPolicy, OpCreate}
+	WorkflowSnapshotPolicyUpdate   = Workflow{CategorySnapshotPolicy, OpUpdate}
+	WorkflowSnapshotPolicyGet      = Workflow{CategorySnapshotPolicy, OpGet}
+	WorkflowSnapshotPolicyList     = Workflow{CategorySnapshotPolicy, OpList}
+	WorkflowSnapshotPolicyDelete   = Workflow{CategorySnapshotPolicy, OpDelete}
+	WorkflowSnapshotPolicySchedule = Workflow{CategorySnapshotPolicy, OpSchedule}
 
 	WorkflowControllerPublish         = Workflow{CategoryController, OpPublish}
 	WorkflowControllerUnpublish       = Workflow{CategoryController, OpUnpublish}
@@ -175,14 +204,18 @@ var (
 		WorkflowVolumeClone,
 		WorkflowVolumeImport,
 		WorkflowVolumeResize,
+		WorkflowVolumeAutogrow,
+		WorkflowVolumeMigrate,
 		WorkflowVolumeMount,
 		WorkflowVolumeUnmount,
 		WorkflowVolumeGetCapabilities,
+		WorkflowVolumeGetHealth,
 		WorkflowStorageClassCreate,
 		WorkflowStorageClassGet,
 		WorkflowStorageClassUpdate,
 		WorkflowStorageClassList,
 		WorkflowStorageClassDelete,
+		WorkflowStorageClassGetCapacity,
 		WorkflowNodeCreate,
 		WorkflowNodeGet,
 		WorkflowNodeGetInfo,
@@ -196,12 +229,24 @@ var (
 		WorkflowBackendGet,
 		WorkflowBackendUpdate,
 		WorkflowBackendList,
+		WorkflowBackendRotate,
 		WorkflowSnapshotCreate,
 		WorkflowSnapshotDelete,
 		WorkflowSnapshotGet,
 		WorkflowSnapshotUpdate,
 		WorkflowSnapshotList,
 		WorkflowSnapshotCloneFrom,
+		WorkflowSnapshotRestore,
+		WorkflowGroupSnapshotCreate,
+		WorkflowGroupSnapshotDelete,
+		WorkflowGroupSnapshotGet,
+		WorkflowGroupSnapshotGetCapabilities,
+		WorkflowSnapshotPolicyCreate,
+		WorkflowSnapshotPolicyUpdate,
+		WorkflowSnapshotPolicyGet,
+		WorkflowSnapshotPolicyList,
+		WorkflowSnapshotPolicyDelete,
+		WorkflowSnapshotPolicySchedule,
 		WorkflowControllerPublish,
 		WorkflowControllerUnpublish,
 		WorkflowControllerGetCapabilities,
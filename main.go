@@ -16,6 +16,7 @@ import (
 	"syscall"
 	"time"
 
+	k8sclient "github.com/netapp/trident/cli/k8s_client"
 	"github.com/netapp/trident/config"
 	"github.com/netapp/trident/core"
 	"github.com/netapp/trident/frontend"
@@ -32,6 +33,7 @@ import (
 	"github.com/netapp/trident/frontend/rest"
 	. "github.com/netapp/trident/logging"
 	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage/credentials"
 	"github.com/netapp/trident/utils"
 )
 
@@ -69,6 +71,16 @@ var (
 	csiRole     = flag.String("csi_role", "",
 		fmt.Sprintf("CSI role to play: '%s' or '%s'", csi.CSIController, csi.CSINode))
 
+	// Controller high availability
+	leaderElection = flag.Bool("leader_election", false,
+		"Stand by until elected leader through a Kubernetes Lease, so that several controllers may be deployed")
+	leaderElectionLeaseDuration = flag.Duration("leader_election_lease_duration", config.LeaderElectionLeaseDuration,
+		"Duration for which standby controllers wait before taking over the lease of an unresponsive leader")
+	leaderElectionRenewDeadline = flag.Duration("leader_election_renew_deadline", config.LeaderElectionRenewDeadline,
+		"Duration for which the leader retries renewing its lease before it gives up leadership")
+	leaderElectionRetryPeriod = flag.Duration("leader_election_retry_period", config.LeaderElectionRetryPeriod,
+		"Interval at which controllers try to acquire or renew the leader lease")
+
 	csiUnsafeNodeDetach = flag.Bool("csi_unsafe_detach", false, "Prefer to detach successfully rather than safely")
 	enableForceDetach   = new(bool)
 	nodePrep            = flag.Bool("node_prep", true, "Attempt to install required packages on nodes.")
@@ -78,7 +90,9 @@ var (
 		"any metadata.  WILL LOSE TRACK OF VOLUMES ON REBOOT/CRASH.")
 	usePassthrough = flag.Bool("passthrough", false, "Uses the storage backends "+
 		"as the source of truth.  No data is stored anywhere else.")
-	useCRD = flag.Bool("crd_persistence", false, "Uses CRDs for persisting orchestrator state.")
+	useCRD              = flag.Bool("crd_persistence", false, "Uses CRDs for persisting orchestrator state.")
+	filePersistencePath = flag.String("file_persistence", "", "Persists orchestrator state in "+
+		"the specified local file.")
 
 	// HTTP REST interface
 	address            = flag.String("address", "127.0.0.1", "Storage orchestrator HTTP API address")
@@ -97,6 +111,10 @@ var (
 	httpsClientKey  = flag.String("https_client_key", config.ClientKeyPath, "HTTPS client private key")
 	httpsClientCert = flag.String("https_client_cert", config.ClientCertPath, "HTTPS client certificate")
 
+	// REST authorization
+	restAuthorizationPolicy = flag.String("rest_authorization_policy", "",
+		"File of the identities and roles that may use the REST interfaces; all clients have full access if empty")
+
 	aesKey = flag.String("aes_key", config.AESKeyPath, "AES encryption key")
 
 	// HTTP metrics interface
@@ -104,6 +122,11 @@ var (
 	metricsPort    = flag.String("metrics_port", "8001", "Storage orchestrator metrics port")
 	enableMetrics  = flag.Bool("metrics", false, "Enable metrics interface")
 
+	// Per-volume metrics
+	enableVolumeMetrics   = flag.Bool("volume_metrics", false, "Enable per-volume capacity and performance metrics")
+	volumeMetricsInterval = flag.Duration("volume_metrics_interval", config.VolumeMetricsInterval,
+		"Minimum interval between queries of the storage backends for per-volume metrics")
+
 	// iSCSI
 	iSCSISelfHealingInterval = flag.Duration("iscsi_self_healing_interval", config.IscsiSelfHealingInterval,
 		"Interval at which the iSCSI self-healing thread is invoked")
@@ -115,6 +138,18 @@ var (
 	backendStoragePollInterval = flag.Duration("backend_storage_poll_interval", config.BackendStoragePollInterval,
 		"Interval at which core polls backend storage for its state")
 
+	// External credential stores
+	credentialsDir = flag.String("credentials_dir", "",
+		"Directory from which to read backend credentials of type 'file'")
+	credentialsURL = flag.String("credentials_url", "",
+		"Base URL from which to read backend credentials of type 'http'")
+	credentialsTokenFile = flag.String("credentials_token_file", "",
+		"File containing a bearer token for the credentials URL")
+	credentialsCAFile = flag.String("credentials_ca_file", "",
+		"CA certificate with which to verify the credentials URL")
+	credentialRefreshInterval = flag.Duration("credential_refresh_interval", config.CredentialRefreshInterval,
+		"Interval at which backend credentials are reread from external stores")
+
 	storeClient  persistentstore.Client
 	enableDocker bool
 	enableCSI    bool
@@ -165,6 +200,9 @@ func processCmdLineArgs(ctx context.Context) {
 	if *useCRD {
 		storeCount++
 	}
+	if *filePersistencePath != "" {
+		storeCount++
+	}
 	// Infer persistent store type if not explicitly specified
 	if storeCount == 0 && enableDocker {
 		Logc(ctx).Debug("Inferred passthrough persistent store.")
@@ -193,6 +231,13 @@ func processCmdLineArgs(ctx context.Context) {
 		if err != nil {
 			Logc(ctx).Fatalf("Unable to create the Kubernetes store client. %v", err)
 		}
+
+	case *filePersistencePath != "":
+		Logc(ctx).WithField("path", *filePersistencePath).Debug("Trident is configured with a file store client.")
+		storeClient, err = persistentstore.NewFileClient(*filePersistencePath)
+		if err != nil {
+			Logc(ctx).Fatalf("Unable to create the file store client. %v", err)
+		}
 	}
 
 	config.UsingPassthroughStore = storeClient.GetType() == persistentstore.PassthroughStore
@@ -339,17 +384,49 @@ func main() {
 
 	orchestrator := core.NewTridentOrchestrator(storeClient)
 
+	// Add external credential stores
+	if *credentialRefreshInterval <= 0 {
+		Log().Fatal("Credential refresh interval must be a positive duration, cannot continue")
+	}
+	if *credentialsDir != "" {
+		fileProvider, err := credentials.NewFileProvider(*credentialsDir)
+		if err != nil {
+			Log().WithError(err).Fatal("Unable to add the file credential store.")
+		}
+		orchestrator.AddCredentialStore(ctx, fileProvider)
+	}
+	if *credentialsURL != "" {
+		httpProvider, err := credentials.NewHTTPProvider(*credentialsURL, *credentialsTokenFile, *credentialsCAFile)
+		if err != nil {
+			Log().WithError(err).Fatal("Unable to add the HTTP credential store.")
+		}
+		orchestrator.AddCredentialStore(ctx, httpProvider)
+	}
+
 	// Create HTTP metrics frontend
 	if *enableMetrics {
 		if *metricsPort == "" {
 			Log().Warning("HTTP metrics interface will not be available (port not specified).")
 		} else {
 			metricsServer := metrics.NewMetricsServer(*metricsAddress, *metricsPort)
+			if *enableVolumeMetrics {
+				if err = metricsServer.EnableVolumeMetrics(orchestrator, *volumeMetricsInterval); err != nil {
+					Log().WithError(err).Fatal("Unable to enable per-volume metrics.")
+				}
+			}
 			preBootstrapFrontends = append(preBootstrapFrontends, metricsServer)
 			Log().WithFields(LogFields{"name": metricsServer.GetName()}).Info("Added frontend.")
 		}
 	}
 
+	if *restAuthorizationPolicy != "" {
+		policy, err := rest.LoadAuthorizationPolicy(*restAuthorizationPolicy)
+		if err != nil {
+			Log().Fatalf("Unable to enable REST authorization. %v", err)
+		}
+		rest.EnableAuthorization(policy)
+	}
+
 	enableMutualTLS := true
 	handler := rest.NewRouter(true)
 
@@ -501,6 +578,23 @@ func main() {
 		}
 	}
 
+	// Only a controller may stand by for leadership, and only in Kubernetes
+	var releaseLeadership func()
+	if *leaderElection {
+		if config.CurrentDriverContext != config.ContextCSI || *csiRole != csi.CSIController {
+			Log().Fatal("Leader election is only supported by the CSI controller.")
+		}
+		if !*useCRD {
+			Log().Fatal("Leader election requires CRD persistence.")
+		}
+		if *leaderElectionLeaseDuration <= *leaderElectionRenewDeadline ||
+			*leaderElectionRenewDeadline <= *leaderElectionRetryPeriod || *leaderElectionRetryPeriod <= 0 {
+			Log().Fatal("Leader election lease duration must exceed the renew deadline, which must exceed " +
+				"the retry period.")
+		}
+		orchestrator.SetStandby(true)
+	}
+
 	// Bootstrap the orchestrator and start its frontends.  Some frontends, notably REST and Docker, must
 	// start before the core so that the external interfaces are minimally responding while the core is
 	// still initializing.  Other frontends such as legacy Kubernetes and CSI benefit from starting after
@@ -511,6 +605,25 @@ func main() {
 		}
 	}
 
+	// A standby controller waits here, with its REST interface reporting that it is not ready, until the
+	// leader fails.  The CSI frontend is not activated until then, so the CSI sidecars of a standby
+	// controller are idle too.
+	if *leaderElection {
+		clients, err := k8sclient.CreateK8SClients(*k8sAPIServer, *k8sConfigPath, "")
+		if err != nil {
+			Log().Fatalf("Unable to create the Kubernetes client for leader election. %v", err)
+		}
+		identity, err := os.Hostname()
+		if err != nil {
+			Log().Fatalf("Unable to determine the leader election identity. %v", err)
+		}
+		releaseLeadership, err = waitForLeadership(clients.KubeClient, clients.Namespace, identity,
+			*leaderElectionLeaseDuration, *leaderElectionRenewDeadline, *leaderElectionRetryPeriod)
+		if err != nil {
+			Log().Fatalf("Unable to elect the controller leader. %v", err)
+		}
+	}
+
 	if err = orchestrator.Bootstrap(txnMonitor); err != nil {
 		Log().Error(err.Error())
 	}
@@ -529,6 +642,17 @@ func main() {
 	}
 	go orchestrator.PeriodicallyReconcileBackendState(*backendStoragePollInterval)
 
+	// Snapshot policies create and delete snapshots, autogrow resizes volumes, migrations move volumes, and
+	// credential refresh updates backends, so only the controller may run them
+	if config.CurrentDriverContext == config.ContextCSI && (*csiRole == csi.CSIController || *csiRole == csi.CSIAllInOne) {
+		go orchestrator.PeriodicallyRunSnapshotPolicies()
+		go orchestrator.PeriodicallyAutogrowVolumes()
+		go orchestrator.PeriodicallyMigrateVolumes()
+		if *credentialsDir != "" || *credentialsURL != "" {
+			go orchestrator.PeriodicallyRefreshBackendCredentials(*credentialRefreshInterval)
+		}
+	}
+
 	// Register and wait for a shutdown signal
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
@@ -548,4 +672,7 @@ func main() {
 	if err = storeClient.Stop(); err != nil {
 		Log().Error(err)
 	}
+	if releaseLeadership != nil {
+		releaseLeadership()
+	}
 }
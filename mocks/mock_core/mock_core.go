@@ -13,6 +13,7 @@ import (
 	core "github.com/netapp/trident/core"
 	frontend "github.com/netapp/trident/frontend"
 	storage "github.com/netapp/trident/storage"
+	credentials "github.com/netapp/trident/storage/credentials"
 	storageclass "github.com/netapp/trident/storage_class"
 	utils "github.com/netapp/trident/utils"
 )
@@ -55,6 +56,18 @@ func (mr *MockOrchestratorMockRecorder) AddBackend(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackend", reflect.TypeOf((*MockOrchestrator)(nil).AddBackend), arg0, arg1, arg2)
 }
 
+// AddCredentialStore mocks base method.
+func (m *MockOrchestrator) AddCredentialStore(arg0 context.Context, arg1 credentials.Provider) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddCredentialStore", arg0, arg1)
+}
+
+// AddCredentialStore indicates an expected call of AddCredentialStore.
+func (mr *MockOrchestratorMockRecorder) AddCredentialStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCredentialStore", reflect.TypeOf((*MockOrchestrator)(nil).AddCredentialStore), arg0, arg1)
+}
+
 // AddFrontend mocks base method.
 func (m *MockOrchestrator) AddFrontend(arg0 context.Context, arg1 frontend.Plugin) {
 	m.ctrl.T.Helper()
@@ -81,6 +94,21 @@ func (mr *MockOrchestratorMockRecorder) AddNode(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNode", reflect.TypeOf((*MockOrchestrator)(nil).AddNode), arg0, arg1, arg2)
 }
 
+// AddSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) AddSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicyConfig) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSnapshotPolicy indicates an expected call of AddSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) AddSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).AddSnapshotPolicy), arg0, arg1)
+}
+
 // AddStorageClass mocks base method.
 func (m *MockOrchestrator) AddStorageClass(arg0 context.Context, arg1 *storageclass.Config) (*storageclass.External, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +196,21 @@ func (mr *MockOrchestratorMockRecorder) CanBackendMirror(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanBackendMirror", reflect.TypeOf((*MockOrchestrator)(nil).CanBackendMirror), arg0, arg1)
 }
 
+// CheckConsistency mocks base method.
+func (m *MockOrchestrator) CheckConsistency(arg0 context.Context, arg1 bool) (*storage.ConsistencyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckConsistency", arg0, arg1)
+	ret0, _ := ret[0].(*storage.ConsistencyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckConsistency indicates an expected call of CheckConsistency.
+func (mr *MockOrchestratorMockRecorder) CheckConsistency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConsistency", reflect.TypeOf((*MockOrchestrator)(nil).CheckConsistency), arg0, arg1)
+}
+
 // CloneVolume mocks base method.
 func (m *MockOrchestrator) CloneVolume(arg0 context.Context, arg1 *storage.VolumeConfig) (*storage.VolumeExternal, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +226,21 @@ func (mr *MockOrchestratorMockRecorder) CloneVolume(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVolume", reflect.TypeOf((*MockOrchestrator)(nil).CloneVolume), arg0, arg1)
 }
 
+// CreateGroupSnapshot mocks base method.
+func (m *MockOrchestrator) CreateGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshotConfig) (*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupSnapshot indicates an expected call of CreateGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) CreateGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).CreateGroupSnapshot), arg0, arg1)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockOrchestrator) CreateSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig) (*storage.SnapshotExternal, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +284,20 @@ func (mr *MockOrchestratorMockRecorder) DeleteBackendByBackendUUID(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackendByBackendUUID", reflect.TypeOf((*MockOrchestrator)(nil).DeleteBackendByBackendUUID), arg0, arg1, arg2)
 }
 
+// DeleteGroupSnapshot mocks base method.
+func (m *MockOrchestrator) DeleteGroupSnapshot(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupSnapshot indicates an expected call of DeleteGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) DeleteGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).DeleteGroupSnapshot), arg0, arg1)
+}
+
 // DeleteNode mocks base method.
 func (m *MockOrchestrator) DeleteNode(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -254,6 +326,20 @@ func (mr *MockOrchestratorMockRecorder) DeleteSnapshot(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).DeleteSnapshot), arg0, arg1, arg2)
 }
 
+// DeleteSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) DeleteSnapshotPolicy(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshotPolicy indicates an expected call of DeleteSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) DeleteSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).DeleteSnapshotPolicy), arg0, arg1)
+}
+
 // DeleteStorageClass mocks base method.
 func (m *MockOrchestrator) DeleteStorageClass(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -383,6 +469,21 @@ func (mr *MockOrchestratorMockRecorder) GetCHAP(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCHAP", reflect.TypeOf((*MockOrchestrator)(nil).GetCHAP), arg0, arg1, arg2)
 }
 
+// GetCapacity mocks base method.
+func (m *MockOrchestrator) GetCapacity(arg0 context.Context, arg1 string, arg2 map[string]string) (*storageclass.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*storageclass.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacity indicates an expected call of GetCapacity.
+func (mr *MockOrchestratorMockRecorder) GetCapacity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockOrchestrator)(nil).GetCapacity), arg0, arg1, arg2)
+}
+
 // GetFrontend mocks base method.
 func (m *MockOrchestrator) GetFrontend(arg0 context.Context, arg1 string) (frontend.Plugin, error) {
 	m.ctrl.T.Helper()
@@ -398,6 +499,21 @@ func (mr *MockOrchestratorMockRecorder) GetFrontend(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontend", reflect.TypeOf((*MockOrchestrator)(nil).GetFrontend), arg0, arg1)
 }
 
+// GetGroupSnapshot mocks base method.
+func (m *MockOrchestrator) GetGroupSnapshot(arg0 context.Context, arg1 string) (*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupSnapshot indicates an expected call of GetGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) GetGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).GetGroupSnapshot), arg0, arg1)
+}
+
 // GetLogLevel mocks base method.
 func (m *MockOrchestrator) GetLogLevel(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -443,6 +559,21 @@ func (mr *MockOrchestratorMockRecorder) GetNode(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNode", reflect.TypeOf((*MockOrchestrator)(nil).GetNode), arg0, arg1)
 }
 
+// GetPoolUsage mocks base method.
+func (m *MockOrchestrator) GetPoolUsage(arg0 context.Context) (*storage.PoolUsageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolUsage", arg0)
+	ret0, _ := ret[0].(*storage.PoolUsageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolUsage indicates an expected call of GetPoolUsage.
+func (mr *MockOrchestratorMockRecorder) GetPoolUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolUsage", reflect.TypeOf((*MockOrchestrator)(nil).GetPoolUsage), arg0)
+}
+
 // GetReplicationDetails mocks base method.
 func (m *MockOrchestrator) GetReplicationDetails(arg0 context.Context, arg1, arg2, arg3 string) (string, string, string, error) {
 	m.ctrl.T.Helper()
@@ -505,6 +636,21 @@ func (mr *MockOrchestratorMockRecorder) GetSnapshot(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).GetSnapshot), arg0, arg1, arg2)
 }
 
+// GetSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) GetSnapshotPolicy(arg0 context.Context, arg1 string) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotPolicy indicates an expected call of GetSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) GetSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).GetSnapshotPolicy), arg0, arg1)
+}
+
 // GetStorageClass mocks base method.
 func (m *MockOrchestrator) GetStorageClass(arg0 context.Context, arg1 string) (*storageclass.External, error) {
 	m.ctrl.T.Helper()
@@ -595,6 +741,51 @@ func (mr *MockOrchestratorMockRecorder) GetVolumeExternal(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeExternal", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeExternal), arg0, arg1, arg2)
 }
 
+// GetVolumeHealth mocks base method.
+func (m *MockOrchestrator) GetVolumeHealth(arg0 context.Context, arg1 string) (*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeHealth indicates an expected call of GetVolumeHealth.
+func (mr *MockOrchestratorMockRecorder) GetVolumeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeHealth", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeHealth), arg0, arg1)
+}
+
+// GetVolumeMetrics mocks base method.
+func (m *MockOrchestrator) GetVolumeMetrics(arg0 context.Context) ([]*storage.VolumeMetricsExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeMetrics", arg0)
+	ret0, _ := ret[0].([]*storage.VolumeMetricsExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeMetrics indicates an expected call of GetVolumeMetrics.
+func (mr *MockOrchestratorMockRecorder) GetVolumeMetrics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeMetrics", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeMetrics), arg0)
+}
+
+// GetVolumeMigration mocks base method.
+func (m *MockOrchestrator) GetVolumeMigration(arg0 context.Context, arg1 string) (*storage.VolumeMigrationExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeMigration", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeMigrationExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeMigration indicates an expected call of GetVolumeMigration.
+func (mr *MockOrchestratorMockRecorder) GetVolumeMigration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeMigration", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeMigration), arg0, arg1)
+}
+
 // GetVolumePublication mocks base method.
 func (m *MockOrchestrator) GetVolumePublication(arg0 context.Context, arg1, arg2 string) (*utils.VolumePublication, error) {
 	m.ctrl.T.Helper()
@@ -670,6 +861,21 @@ func (mr *MockOrchestratorMockRecorder) ListBackends(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackends", reflect.TypeOf((*MockOrchestrator)(nil).ListBackends), arg0)
 }
 
+// ListGroupSnapshots mocks base method.
+func (m *MockOrchestrator) ListGroupSnapshots(arg0 context.Context) ([]*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupSnapshots", arg0)
+	ret0, _ := ret[0].([]*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupSnapshots indicates an expected call of ListGroupSnapshots.
+func (mr *MockOrchestratorMockRecorder) ListGroupSnapshots(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupSnapshots", reflect.TypeOf((*MockOrchestrator)(nil).ListGroupSnapshots), arg0)
+}
+
 // ListLogLayers mocks base method.
 func (m *MockOrchestrator) ListLogLayers(arg0 context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -715,6 +921,21 @@ func (mr *MockOrchestratorMockRecorder) ListNodes(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockOrchestrator)(nil).ListNodes), arg0)
 }
 
+// ListSnapshotPolicies mocks base method.
+func (m *MockOrchestrator) ListSnapshotPolicies(arg0 context.Context) ([]*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotPolicies", arg0)
+	ret0, _ := ret[0].([]*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotPolicies indicates an expected call of ListSnapshotPolicies.
+func (mr *MockOrchestratorMockRecorder) ListSnapshotPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPolicies", reflect.TypeOf((*MockOrchestrator)(nil).ListSnapshotPolicies), arg0)
+}
+
 // ListSnapshots mocks base method.
 func (m *MockOrchestrator) ListSnapshots(arg0 context.Context) ([]*storage.SnapshotExternal, error) {
 	m.ctrl.T.Helper()
@@ -790,6 +1011,21 @@ func (mr *MockOrchestratorMockRecorder) ListSubordinateVolumes(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubordinateVolumes", reflect.TypeOf((*MockOrchestrator)(nil).ListSubordinateVolumes), arg0, arg1)
 }
 
+// ListVolumeMigrations mocks base method.
+func (m *MockOrchestrator) ListVolumeMigrations(arg0 context.Context) ([]*storage.VolumeMigrationExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeMigrations", arg0)
+	ret0, _ := ret[0].([]*storage.VolumeMigrationExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeMigrations indicates an expected call of ListVolumeMigrations.
+func (mr *MockOrchestratorMockRecorder) ListVolumeMigrations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeMigrations", reflect.TypeOf((*MockOrchestrator)(nil).ListVolumeMigrations), arg0)
+}
+
 // ListVolumePublications mocks base method.
 func (m *MockOrchestrator) ListVolumePublications(arg0 context.Context) ([]*utils.VolumePublicationExternal, error) {
 	m.ctrl.T.Helper()
@@ -850,6 +1086,60 @@ func (mr *MockOrchestratorMockRecorder) ListVolumes(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockOrchestrator)(nil).ListVolumes), arg0)
 }
 
+// MigrateVolume mocks base method.
+func (m *MockOrchestrator) MigrateVolume(arg0 context.Context, arg1, arg2, arg3 string) (*storage.VolumeMigrationExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVolume", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*storage.VolumeMigrationExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVolume indicates an expected call of MigrateVolume.
+func (mr *MockOrchestratorMockRecorder) MigrateVolume(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolume", reflect.TypeOf((*MockOrchestrator)(nil).MigrateVolume), arg0, arg1, arg2, arg3)
+}
+
+// ModifyVolume mocks base method.
+func (m *MockOrchestrator) ModifyVolume(arg0 context.Context, arg1 string, arg2 storage.VolumeModification) (*storage.VolumeExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*storage.VolumeExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVolume indicates an expected call of ModifyVolume.
+func (mr *MockOrchestratorMockRecorder) ModifyVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolume", reflect.TypeOf((*MockOrchestrator)(nil).ModifyVolume), arg0, arg1, arg2)
+}
+
+// PeriodicallyAutogrowVolumes mocks base method.
+func (m *MockOrchestrator) PeriodicallyAutogrowVolumes() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyAutogrowVolumes")
+}
+
+// PeriodicallyAutogrowVolumes indicates an expected call of PeriodicallyAutogrowVolumes.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyAutogrowVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyAutogrowVolumes", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyAutogrowVolumes))
+}
+
+// PeriodicallyMigrateVolumes mocks base method.
+func (m *MockOrchestrator) PeriodicallyMigrateVolumes() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyMigrateVolumes")
+}
+
+// PeriodicallyMigrateVolumes indicates an expected call of PeriodicallyMigrateVolumes.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyMigrateVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyMigrateVolumes", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyMigrateVolumes))
+}
+
 // PeriodicallyReconcileBackendState mocks base method.
 func (m *MockOrchestrator) PeriodicallyReconcileBackendState(arg0 time.Duration) {
 	m.ctrl.T.Helper()
@@ -874,6 +1164,30 @@ func (mr *MockOrchestratorMockRecorder) PeriodicallyReconcileNodeAccessOnBackend
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyReconcileNodeAccessOnBackends", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyReconcileNodeAccessOnBackends))
 }
 
+// PeriodicallyRefreshBackendCredentials mocks base method.
+func (m *MockOrchestrator) PeriodicallyRefreshBackendCredentials(arg0 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyRefreshBackendCredentials", arg0)
+}
+
+// PeriodicallyRefreshBackendCredentials indicates an expected call of PeriodicallyRefreshBackendCredentials.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyRefreshBackendCredentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyRefreshBackendCredentials", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyRefreshBackendCredentials), arg0)
+}
+
+// PeriodicallyRunSnapshotPolicies mocks base method.
+func (m *MockOrchestrator) PeriodicallyRunSnapshotPolicies() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyRunSnapshotPolicies")
+}
+
+// PeriodicallyRunSnapshotPolicies indicates an expected call of PeriodicallyRunSnapshotPolicies.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyRunSnapshotPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyRunSnapshotPolicies", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyRunSnapshotPolicies))
+}
+
 // PromoteMirror mocks base method.
 func (m *MockOrchestrator) PromoteMirror(arg0 context.Context, arg1, arg2, arg3, arg4 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1002,6 +1316,20 @@ func (mr *MockOrchestratorMockRecorder) ResizeVolume(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockOrchestrator)(nil).ResizeVolume), arg0, arg1, arg2)
 }
 
+// RestoreSnapshot mocks base method.
+func (m *MockOrchestrator) RestoreSnapshot(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockOrchestratorMockRecorder) RestoreSnapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).RestoreSnapshot), arg0, arg1, arg2)
+}
+
 // SetLogLayers mocks base method.
 func (m *MockOrchestrator) SetLogLayers(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1131,6 +1459,21 @@ func (mr *MockOrchestratorMockRecorder) UpdateNode(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNode", reflect.TypeOf((*MockOrchestrator)(nil).UpdateNode), arg0, arg1, arg2)
 }
 
+// UpdateSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) UpdateSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicyConfig) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshotPolicy indicates an expected call of UpdateSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) UpdateSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).UpdateSnapshotPolicy), arg0, arg1)
+}
+
 // UpdateVolume mocks base method.
 func (m *MockOrchestrator) UpdateVolume(arg0 context.Context, arg1 string, arg2 *[]string) error {
 	m.ctrl.T.Helper()
@@ -1144,3 +1487,33 @@ func (mr *MockOrchestratorMockRecorder) UpdateVolume(arg0, arg1, arg2 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockOrchestrator)(nil).UpdateVolume), arg0, arg1, arg2)
 }
+
+// UpdateVolumeUsage mocks base method.
+func (m *MockOrchestrator) UpdateVolumeUsage(arg0 context.Context, arg1 string, arg2 *storage.VolumeUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolumeUsage indicates an expected call of UpdateVolumeUsage.
+func (mr *MockOrchestratorMockRecorder) UpdateVolumeUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeUsage", reflect.TypeOf((*MockOrchestrator)(nil).UpdateVolumeUsage), arg0, arg1, arg2)
+}
+
+// WatchChanges mocks base method.
+func (m *MockOrchestrator) WatchChanges(arg0 context.Context, arg1 string) (<-chan *storage.ChangeEvent, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchChanges", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *storage.ChangeEvent)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchChanges indicates an expected call of WatchChanges.
+func (mr *MockOrchestratorMockRecorder) WatchChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChanges", reflect.TypeOf((*MockOrchestrator)(nil).WatchChanges), arg0, arg1)
+}
@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	controllerAPI "github.com/netapp/trident/frontend/csi/controller_api"
+	storage "github.com/netapp/trident/storage"
 	utils "github.com/netapp/trident/utils"
 )
 
@@ -111,6 +112,21 @@ func (mr *MockTridentControllerMockRecorder) GetNodes(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodes", reflect.TypeOf((*MockTridentController)(nil).GetNodes), arg0)
 }
 
+// GetVolumeHealth mocks base method.
+func (m *MockTridentController) GetVolumeHealth(arg0 context.Context, arg1 string) (*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeHealth indicates an expected call of GetVolumeHealth.
+func (mr *MockTridentControllerMockRecorder) GetVolumeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeHealth", reflect.TypeOf((*MockTridentController)(nil).GetVolumeHealth), arg0, arg1)
+}
+
 // InvokeAPI mocks base method.
 func (m *MockTridentController) InvokeAPI(arg0 context.Context, arg1 []byte, arg2, arg3 string, arg4, arg5 bool) (*http.Response, []byte, error) {
 	m.ctrl.T.Helper()
@@ -169,3 +185,17 @@ func (mr *MockTridentControllerMockRecorder) UpdateVolumeLUKSPassphraseNames(arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeLUKSPassphraseNames", reflect.TypeOf((*MockTridentController)(nil).UpdateVolumeLUKSPassphraseNames), arg0, arg1, arg2)
 }
+
+// UpdateVolumeUsage mocks base method.
+func (m *MockTridentController) UpdateVolumeUsage(arg0 context.Context, arg1 string, arg2 *storage.VolumeUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolumeUsage indicates an expected call of UpdateVolumeUsage.
+func (mr *MockTridentControllerMockRecorder) UpdateVolumeUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeUsage", reflect.TypeOf((*MockTridentController)(nil).UpdateVolumeUsage), arg0, arg1, arg2)
+}
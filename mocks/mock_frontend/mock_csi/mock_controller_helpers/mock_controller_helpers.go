@@ -83,6 +83,21 @@ func (mr *MockControllerHelperMockRecorder) GetSnapshotConfig(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotConfig", reflect.TypeOf((*MockControllerHelper)(nil).GetSnapshotConfig), arg0, arg1)
 }
 
+// GetStorageClassName mocks base method.
+func (m *MockControllerHelper) GetStorageClassName(arg0 context.Context, arg1 map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageClassName", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageClassName indicates an expected call of GetStorageClassName.
+func (mr *MockControllerHelperMockRecorder) GetStorageClassName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageClassName", reflect.TypeOf((*MockControllerHelper)(nil).GetStorageClassName), arg0, arg1)
+}
+
 // GetVolumeConfig mocks base method.
 func (m *MockControllerHelper) GetVolumeConfig(arg0 context.Context, arg1 string, arg2 int64, arg3 map[string]string, arg4 config.Protocol, arg5 []config.AccessMode, arg6 config.VolumeMode, arg7 string, arg8, arg9, arg10 []map[string]string) (*storage.VolumeConfig, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +151,20 @@ func (mr *MockControllerHelperMockRecorder) SupportsFeature(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsFeature", reflect.TypeOf((*MockControllerHelper)(nil).SupportsFeature), arg0, arg1)
 }
 
+// UpdateVolumeSize mocks base method.
+func (m *MockControllerHelper) UpdateVolumeSize(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeSize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolumeSize indicates an expected call of UpdateVolumeSize.
+func (mr *MockControllerHelperMockRecorder) UpdateVolumeSize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeSize", reflect.TypeOf((*MockControllerHelper)(nil).UpdateVolumeSize), arg0, arg1, arg2)
+}
+
 // Version mocks base method.
 func (m *MockControllerHelper) Version() string {
 	m.ctrl.T.Helper()
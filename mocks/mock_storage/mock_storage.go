@@ -80,6 +80,20 @@ func (mr *MockBackendMockRecorder) BackendUUID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackendUUID", reflect.TypeOf((*MockBackend)(nil).BackendUUID))
 }
 
+// CanCheckVolumeHealth mocks base method.
+func (m *MockBackend) CanCheckVolumeHealth() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanCheckVolumeHealth")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanCheckVolumeHealth indicates an expected call of CanCheckVolumeHealth.
+func (mr *MockBackendMockRecorder) CanCheckVolumeHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanCheckVolumeHealth", reflect.TypeOf((*MockBackend)(nil).CanCheckVolumeHealth))
+}
+
 // CanEnablePublishEnforcement mocks base method.
 func (m *MockBackend) CanEnablePublishEnforcement() bool {
 	m.ctrl.T.Helper()
@@ -122,6 +136,62 @@ func (mr *MockBackendMockRecorder) CanMirror() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanMirror", reflect.TypeOf((*MockBackend)(nil).CanMirror))
 }
 
+// CanModifyVolumes mocks base method.
+func (m *MockBackend) CanModifyVolumes() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanModifyVolumes")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanModifyVolumes indicates an expected call of CanModifyVolumes.
+func (mr *MockBackendMockRecorder) CanModifyVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanModifyVolumes", reflect.TypeOf((*MockBackend)(nil).CanModifyVolumes))
+}
+
+// CanMoveVolumes mocks base method.
+func (m *MockBackend) CanMoveVolumes() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanMoveVolumes")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanMoveVolumes indicates an expected call of CanMoveVolumes.
+func (mr *MockBackendMockRecorder) CanMoveVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanMoveVolumes", reflect.TypeOf((*MockBackend)(nil).CanMoveVolumes))
+}
+
+// CanReportCapacity mocks base method.
+func (m *MockBackend) CanReportCapacity() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanReportCapacity")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanReportCapacity indicates an expected call of CanReportCapacity.
+func (mr *MockBackendMockRecorder) CanReportCapacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanReportCapacity", reflect.TypeOf((*MockBackend)(nil).CanReportCapacity))
+}
+
+// CanReportVolumeMetrics mocks base method.
+func (m *MockBackend) CanReportVolumeMetrics() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanReportVolumeMetrics")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanReportVolumeMetrics indicates an expected call of CanReportVolumeMetrics.
+func (mr *MockBackendMockRecorder) CanReportVolumeMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanReportVolumeMetrics", reflect.TypeOf((*MockBackend)(nil).CanReportVolumeMetrics))
+}
+
 // CanSnapshot mocks base method.
 func (m *MockBackend) CanSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig, arg2 *storage.VolumeConfig) error {
 	m.ctrl.T.Helper()
@@ -193,6 +263,21 @@ func (mr *MockBackendMockRecorder) ConstructPersistent(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstructPersistent", reflect.TypeOf((*MockBackend)(nil).ConstructPersistent), arg0)
 }
 
+// CreateGroupSnapshot mocks base method.
+func (m *MockBackend) CreateGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshotConfig, arg2 []*storage.SnapshotConfig, arg3 []*storage.VolumeConfig) ([]*storage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*storage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupSnapshot indicates an expected call of CreateGroupSnapshot.
+func (mr *MockBackendMockRecorder) CreateGroupSnapshot(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupSnapshot", reflect.TypeOf((*MockBackend)(nil).CreateGroupSnapshot), arg0, arg1, arg2, arg3)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockBackend) CreateSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig, arg2 *storage.VolumeConfig) (*storage.Snapshot, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +407,21 @@ func (mr *MockBackendMockRecorder) GetPhysicalPoolNames(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalPoolNames", reflect.TypeOf((*MockBackend)(nil).GetPhysicalPoolNames), arg0)
 }
 
+// GetPoolCapacity mocks base method.
+func (m *MockBackend) GetPoolCapacity(arg0 context.Context, arg1 storage.Pool) (*storage.PoolCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolCapacity", arg0, arg1)
+	ret0, _ := ret[0].(*storage.PoolCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolCapacity indicates an expected call of GetPoolCapacity.
+func (mr *MockBackendMockRecorder) GetPoolCapacity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolCapacity", reflect.TypeOf((*MockBackend)(nil).GetPoolCapacity), arg0, arg1)
+}
+
 // GetProtocol mocks base method.
 func (m *MockBackend) GetProtocol(arg0 context.Context) config.Protocol {
 	m.ctrl.T.Helper()
@@ -395,6 +495,36 @@ func (mr *MockBackendMockRecorder) GetVolumeExternal(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeExternal", reflect.TypeOf((*MockBackend)(nil).GetVolumeExternal), arg0, arg1)
 }
 
+// GetVolumeHealth mocks base method.
+func (m *MockBackend) GetVolumeHealth(arg0 context.Context, arg1 *storage.VolumeConfig) (*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeHealth indicates an expected call of GetVolumeHealth.
+func (mr *MockBackendMockRecorder) GetVolumeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeHealth", reflect.TypeOf((*MockBackend)(nil).GetVolumeHealth), arg0, arg1)
+}
+
+// GetVolumeMetrics mocks base method.
+func (m *MockBackend) GetVolumeMetrics(arg0 context.Context, arg1 []*storage.VolumeConfig) (map[string]*storage.VolumeMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeMetrics", arg0, arg1)
+	ret0, _ := ret[0].(map[string]*storage.VolumeMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeMetrics indicates an expected call of GetVolumeMetrics.
+func (mr *MockBackendMockRecorder) GetVolumeMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeMetrics", reflect.TypeOf((*MockBackend)(nil).GetVolumeMetrics), arg0, arg1)
+}
+
 // HasVolumes mocks base method.
 func (m *MockBackend) HasVolumes() bool {
 	m.ctrl.T.Helper()
@@ -450,6 +580,36 @@ func (mr *MockBackendMockRecorder) IsCredentialsFieldSet(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCredentialsFieldSet", reflect.TypeOf((*MockBackend)(nil).IsCredentialsFieldSet), arg0)
 }
 
+// ModifyVolume mocks base method.
+func (m *MockBackend) ModifyVolume(arg0 context.Context, arg1 *storage.VolumeConfig, arg2 storage.VolumeModification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyVolume indicates an expected call of ModifyVolume.
+func (mr *MockBackendMockRecorder) ModifyVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolume", reflect.TypeOf((*MockBackend)(nil).ModifyVolume), arg0, arg1, arg2)
+}
+
+// MoveVolume mocks base method.
+func (m *MockBackend) MoveVolume(arg0 context.Context, arg1 *storage.VolumeConfig, arg2 storage.Pool, arg3 bool) (bool, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVolume", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveVolume indicates an expected call of MoveVolume.
+func (mr *MockBackendMockRecorder) MoveVolume(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVolume", reflect.TypeOf((*MockBackend)(nil).MoveVolume), arg0, arg1, arg2, arg3)
+}
+
 // Name mocks base method.
 func (m *MockBackend) Name() string {
 	m.ctrl.T.Helper()
@@ -560,6 +720,20 @@ func (mr *MockBackendMockRecorder) ResizeVolume(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockBackend)(nil).ResizeVolume), arg0, arg1, arg2)
 }
 
+// RestoreRequiresNewestSnapshot mocks base method.
+func (m *MockBackend) RestoreRequiresNewestSnapshot() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRequiresNewestSnapshot")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RestoreRequiresNewestSnapshot indicates an expected call of RestoreRequiresNewestSnapshot.
+func (mr *MockBackendMockRecorder) RestoreRequiresNewestSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRequiresNewestSnapshot", reflect.TypeOf((*MockBackend)(nil).RestoreRequiresNewestSnapshot))
+}
+
 // RestoreSnapshot mocks base method.
 func (m *MockBackend) RestoreSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig, arg2 *storage.VolumeConfig) error {
 	m.ctrl.T.Helper()
@@ -712,6 +886,20 @@ func (mr *MockBackendMockRecorder) Storage() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storage", reflect.TypeOf((*MockBackend)(nil).Storage))
 }
 
+// SupportsGroupSnapshots mocks base method.
+func (m *MockBackend) SupportsGroupSnapshots() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsGroupSnapshots")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsGroupSnapshots indicates an expected call of SupportsGroupSnapshots.
+func (mr *MockBackendMockRecorder) SupportsGroupSnapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsGroupSnapshots", reflect.TypeOf((*MockBackend)(nil).SupportsGroupSnapshots))
+}
+
 // Terminate mocks base method.
 func (m *MockBackend) Terminate(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -37,6 +37,34 @@ func (m *MockAzure) EXPECT() *MockAzureMockRecorder {
 	return m.recorder
 }
 
+// AuthorizeReplication mocks base method.
+func (m *MockAzure) AuthorizeReplication(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeReplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeReplication indicates an expected call of AuthorizeReplication.
+func (mr *MockAzureMockRecorder) AuthorizeReplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeReplication", reflect.TypeOf((*MockAzure)(nil).AuthorizeReplication), arg0, arg1, arg2)
+}
+
+// BreakReplication mocks base method.
+func (m *MockAzure) BreakReplication(arg0 context.Context, arg1 *api.FileSystem, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BreakReplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BreakReplication indicates an expected call of BreakReplication.
+func (mr *MockAzureMockRecorder) BreakReplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BreakReplication", reflect.TypeOf((*MockAzure)(nil).BreakReplication), arg0, arg1, arg2)
+}
+
 // CapacityPools mocks base method.
 func (m *MockAzure) CapacityPools() *[]*api.CapacityPool {
 	m.ctrl.T.Helper()
@@ -125,6 +153,20 @@ func (mr *MockAzureMockRecorder) CreateVolume(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockAzure)(nil).CreateVolume), arg0, arg1)
 }
 
+// DeleteReplication mocks base method.
+func (m *MockAzure) DeleteReplication(arg0 context.Context, arg1 *api.FileSystem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReplication indicates an expected call of DeleteReplication.
+func (mr *MockAzureMockRecorder) DeleteReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplication", reflect.TypeOf((*MockAzure)(nil).DeleteReplication), arg0, arg1)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockAzure) DeleteSnapshot(arg0 context.Context, arg1 *api.FileSystem, arg2 *api.Snapshot) error {
 	m.ctrl.T.Helper()
@@ -299,6 +341,20 @@ func (mr *MockAzureMockRecorder) RandomSubnetForStoragePool(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RandomSubnetForStoragePool", reflect.TypeOf((*MockAzure)(nil).RandomSubnetForStoragePool), arg0, arg1)
 }
 
+// ReestablishReplication mocks base method.
+func (m *MockAzure) ReestablishReplication(arg0 context.Context, arg1 *api.FileSystem, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReestablishReplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReestablishReplication indicates an expected call of ReestablishReplication.
+func (mr *MockAzureMockRecorder) ReestablishReplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReestablishReplication", reflect.TypeOf((*MockAzure)(nil).ReestablishReplication), arg0, arg1, arg2)
+}
+
 // RefreshAzureResources mocks base method.
 func (m *MockAzure) RefreshAzureResources(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -313,6 +369,21 @@ func (mr *MockAzureMockRecorder) RefreshAzureResources(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshAzureResources", reflect.TypeOf((*MockAzure)(nil).RefreshAzureResources), arg0)
 }
 
+// ReplicationStatus mocks base method.
+func (m *MockAzure) ReplicationStatus(arg0 context.Context, arg1 *api.FileSystem) (*api.ReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*api.ReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicationStatus indicates an expected call of ReplicationStatus.
+func (mr *MockAzureMockRecorder) ReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicationStatus", reflect.TypeOf((*MockAzure)(nil).ReplicationStatus), arg0, arg1)
+}
+
 // ResizeSubvolume mocks base method.
 func (m *MockAzure) ResizeSubvolume(arg0 context.Context, arg1 *api.Subvolume, arg2 int64) error {
 	m.ctrl.T.Helper()
@@ -341,6 +412,20 @@ func (mr *MockAzureMockRecorder) ResizeVolume(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockAzure)(nil).ResizeVolume), arg0, arg1, arg2)
 }
 
+// ResyncReplication mocks base method.
+func (m *MockAzure) ResyncReplication(arg0 context.Context, arg1 *api.FileSystem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncReplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResyncReplication indicates an expected call of ResyncReplication.
+func (mr *MockAzureMockRecorder) ResyncReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncReplication", reflect.TypeOf((*MockAzure)(nil).ResyncReplication), arg0, arg1)
+}
+
 // SnapshotForVolume mocks base method.
 func (m *MockAzure) SnapshotForVolume(arg0 context.Context, arg1 *api.FileSystem, arg2 string) (*api.Snapshot, error) {
 	m.ctrl.T.Helper()
@@ -135,17 +135,17 @@ func (mr *MockOntapAPIMockRecorder) ExportPolicyExists(arg0, arg1 interface{}) *
 }
 
 // ExportRuleCreate mocks base method.
-func (m *MockOntapAPI) ExportRuleCreate(arg0 context.Context, arg1, arg2, arg3 string) error {
+func (m *MockOntapAPI) ExportRuleCreate(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportRuleCreate", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ExportRuleCreate", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ExportRuleCreate indicates an expected call of ExportRuleCreate.
-func (mr *MockOntapAPIMockRecorder) ExportRuleCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockOntapAPIMockRecorder) ExportRuleCreate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRuleCreate", reflect.TypeOf((*MockOntapAPI)(nil).ExportRuleCreate), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRuleCreate", reflect.TypeOf((*MockOntapAPI)(nil).ExportRuleCreate), arg0, arg1, arg2, arg3, arg4)
 }
 
 // ExportRuleDestroy mocks base method.
@@ -163,10 +163,10 @@ func (mr *MockOntapAPIMockRecorder) ExportRuleDestroy(arg0, arg1, arg2 interface
 }
 
 // ExportRuleList mocks base method.
-func (m *MockOntapAPI) ExportRuleList(arg0 context.Context, arg1 string) (map[string]int, error) {
+func (m *MockOntapAPI) ExportRuleList(arg0 context.Context, arg1 string) (map[string]api.ExportRule, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExportRuleList", arg0, arg1)
-	ret0, _ := ret[0].(map[string]int)
+	ret0, _ := ret[0].(map[string]api.ExportRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -177,6 +177,36 @@ func (mr *MockOntapAPIMockRecorder) ExportRuleList(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRuleList", reflect.TypeOf((*MockOntapAPI)(nil).ExportRuleList), arg0, arg1)
 }
 
+// FcpInterfaceGet mocks base method.
+func (m *MockOntapAPI) FcpInterfaceGet(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FcpInterfaceGet", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FcpInterfaceGet indicates an expected call of FcpInterfaceGet.
+func (mr *MockOntapAPIMockRecorder) FcpInterfaceGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FcpInterfaceGet", reflect.TypeOf((*MockOntapAPI)(nil).FcpInterfaceGet), arg0, arg1)
+}
+
+// FcpNodeGetNameRequest mocks base method.
+func (m *MockOntapAPI) FcpNodeGetNameRequest(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FcpNodeGetNameRequest", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FcpNodeGetNameRequest indicates an expected call of FcpNodeGetNameRequest.
+func (mr *MockOntapAPIMockRecorder) FcpNodeGetNameRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FcpNodeGetNameRequest", reflect.TypeOf((*MockOntapAPI)(nil).FcpNodeGetNameRequest), arg0)
+}
+
 // FlexgroupCloneSplitStart mocks base method.
 func (m *MockOntapAPI) FlexgroupCloneSplitStart(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -191,6 +221,21 @@ func (mr *MockOntapAPIMockRecorder) FlexgroupCloneSplitStart(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexgroupCloneSplitStart", reflect.TypeOf((*MockOntapAPI)(nil).FlexgroupCloneSplitStart), arg0, arg1)
 }
 
+// FlexgroupConstituentCount mocks base method.
+func (m *MockOntapAPI) FlexgroupConstituentCount(arg0 context.Context, arg1 string, arg2 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlexgroupConstituentCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlexgroupConstituentCount indicates an expected call of FlexgroupConstituentCount.
+func (mr *MockOntapAPIMockRecorder) FlexgroupConstituentCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexgroupConstituentCount", reflect.TypeOf((*MockOntapAPI)(nil).FlexgroupConstituentCount), arg0, arg1, arg2)
+}
+
 // FlexgroupCreate mocks base method.
 func (m *MockOntapAPI) FlexgroupCreate(arg0 context.Context, arg1 api.Volume) error {
 	m.ctrl.T.Helper()
@@ -846,6 +891,21 @@ func (mr *MockOntapAPIMockRecorder) LunListIgroupsMapped(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunListIgroupsMapped", reflect.TypeOf((*MockOntapAPI)(nil).LunListIgroupsMapped), arg0, arg1)
 }
 
+// LunListMetrics mocks base method.
+func (m *MockOntapAPI) LunListMetrics(arg0 context.Context, arg1 string) (map[string]*api.Metrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LunListMetrics", arg0, arg1)
+	ret0, _ := ret[0].(map[string]*api.Metrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LunListMetrics indicates an expected call of LunListMetrics.
+func (mr *MockOntapAPIMockRecorder) LunListMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunListMetrics", reflect.TypeOf((*MockOntapAPI)(nil).LunListMetrics), arg0, arg1)
+}
+
 // LunMapGetReportingNodes mocks base method.
 func (m *MockOntapAPI) LunMapGetReportingNodes(arg0 context.Context, arg1, arg2 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -962,6 +1022,195 @@ func (mr *MockOntapAPIMockRecorder) LunUnmap(arg0, arg1, arg2 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunUnmap", reflect.TypeOf((*MockOntapAPI)(nil).LunUnmap), arg0, arg1, arg2)
 }
 
+// NVMeAddHostToSubsystem mocks base method.
+func (m *MockOntapAPI) NVMeAddHostToSubsystem(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeAddHostToSubsystem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeAddHostToSubsystem indicates an expected call of NVMeAddHostToSubsystem.
+func (mr *MockOntapAPIMockRecorder) NVMeAddHostToSubsystem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeAddHostToSubsystem", reflect.TypeOf((*MockOntapAPI)(nil).NVMeAddHostToSubsystem), arg0, arg1, arg2)
+}
+
+// NVMeEnsureNamespaceMapped mocks base method.
+func (m *MockOntapAPI) NVMeEnsureNamespaceMapped(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeEnsureNamespaceMapped", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeEnsureNamespaceMapped indicates an expected call of NVMeEnsureNamespaceMapped.
+func (mr *MockOntapAPIMockRecorder) NVMeEnsureNamespaceMapped(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeEnsureNamespaceMapped", reflect.TypeOf((*MockOntapAPI)(nil).NVMeEnsureNamespaceMapped), arg0, arg1, arg2)
+}
+
+// NVMeEnsureNamespaceUnmapped mocks base method.
+func (m *MockOntapAPI) NVMeEnsureNamespaceUnmapped(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeEnsureNamespaceUnmapped", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeEnsureNamespaceUnmapped indicates an expected call of NVMeEnsureNamespaceUnmapped.
+func (mr *MockOntapAPIMockRecorder) NVMeEnsureNamespaceUnmapped(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeEnsureNamespaceUnmapped", reflect.TypeOf((*MockOntapAPI)(nil).NVMeEnsureNamespaceUnmapped), arg0, arg1, arg2)
+}
+
+// NVMeGetHostsOfSubsystem mocks base method.
+func (m *MockOntapAPI) NVMeGetHostsOfSubsystem(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeGetHostsOfSubsystem", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeGetHostsOfSubsystem indicates an expected call of NVMeGetHostsOfSubsystem.
+func (mr *MockOntapAPIMockRecorder) NVMeGetHostsOfSubsystem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeGetHostsOfSubsystem", reflect.TypeOf((*MockOntapAPI)(nil).NVMeGetHostsOfSubsystem), arg0, arg1)
+}
+
+// NVMeIsNamespaceMapped mocks base method.
+func (m *MockOntapAPI) NVMeIsNamespaceMapped(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeIsNamespaceMapped", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeIsNamespaceMapped indicates an expected call of NVMeIsNamespaceMapped.
+func (mr *MockOntapAPIMockRecorder) NVMeIsNamespaceMapped(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeIsNamespaceMapped", reflect.TypeOf((*MockOntapAPI)(nil).NVMeIsNamespaceMapped), arg0, arg1, arg2)
+}
+
+// NVMeNamespaceCreate mocks base method.
+func (m *MockOntapAPI) NVMeNamespaceCreate(arg0 context.Context, arg1 api.NVMeNamespace) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceCreate indicates an expected call of NVMeNamespaceCreate.
+func (mr *MockOntapAPIMockRecorder) NVMeNamespaceCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceCreate", reflect.TypeOf((*MockOntapAPI)(nil).NVMeNamespaceCreate), arg0, arg1)
+}
+
+// NVMeNamespaceGetByName mocks base method.
+func (m *MockOntapAPI) NVMeNamespaceGetByName(arg0 context.Context, arg1 string) (*api.NVMeNamespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceGetByName", arg0, arg1)
+	ret0, _ := ret[0].(*api.NVMeNamespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceGetByName indicates an expected call of NVMeNamespaceGetByName.
+func (mr *MockOntapAPIMockRecorder) NVMeNamespaceGetByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceGetByName", reflect.TypeOf((*MockOntapAPI)(nil).NVMeNamespaceGetByName), arg0, arg1)
+}
+
+// NVMeNamespaceGetSubsystems mocks base method.
+func (m *MockOntapAPI) NVMeNamespaceGetSubsystems(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceGetSubsystems", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceGetSubsystems indicates an expected call of NVMeNamespaceGetSubsystems.
+func (mr *MockOntapAPIMockRecorder) NVMeNamespaceGetSubsystems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceGetSubsystems", reflect.TypeOf((*MockOntapAPI)(nil).NVMeNamespaceGetSubsystems), arg0, arg1)
+}
+
+// NVMeNamespaceList mocks base method.
+func (m *MockOntapAPI) NVMeNamespaceList(arg0 context.Context, arg1 string) (api.NVMeNamespaces, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceList", arg0, arg1)
+	ret0, _ := ret[0].(api.NVMeNamespaces)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceList indicates an expected call of NVMeNamespaceList.
+func (mr *MockOntapAPIMockRecorder) NVMeNamespaceList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceList", reflect.TypeOf((*MockOntapAPI)(nil).NVMeNamespaceList), arg0, arg1)
+}
+
+// NVMeNamespaceSetSize mocks base method.
+func (m *MockOntapAPI) NVMeNamespaceSetSize(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceSetSize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeNamespaceSetSize indicates an expected call of NVMeNamespaceSetSize.
+func (mr *MockOntapAPIMockRecorder) NVMeNamespaceSetSize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceSetSize", reflect.TypeOf((*MockOntapAPI)(nil).NVMeNamespaceSetSize), arg0, arg1, arg2)
+}
+
+// NVMeRemoveHostFromSubsystem mocks base method.
+func (m *MockOntapAPI) NVMeRemoveHostFromSubsystem(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeRemoveHostFromSubsystem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeRemoveHostFromSubsystem indicates an expected call of NVMeRemoveHostFromSubsystem.
+func (mr *MockOntapAPIMockRecorder) NVMeRemoveHostFromSubsystem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeRemoveHostFromSubsystem", reflect.TypeOf((*MockOntapAPI)(nil).NVMeRemoveHostFromSubsystem), arg0, arg1, arg2)
+}
+
+// NVMeSubsystemCreate mocks base method.
+func (m *MockOntapAPI) NVMeSubsystemCreate(arg0 context.Context, arg1 string) (*api.NVMeSubsystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemCreate", arg0, arg1)
+	ret0, _ := ret[0].(*api.NVMeSubsystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeSubsystemCreate indicates an expected call of NVMeSubsystemCreate.
+func (mr *MockOntapAPIMockRecorder) NVMeSubsystemCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemCreate", reflect.TypeOf((*MockOntapAPI)(nil).NVMeSubsystemCreate), arg0, arg1)
+}
+
+// NVMeSubsystemDelete mocks base method.
+func (m *MockOntapAPI) NVMeSubsystemDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeSubsystemDelete indicates an expected call of NVMeSubsystemDelete.
+func (mr *MockOntapAPIMockRecorder) NVMeSubsystemDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemDelete", reflect.TypeOf((*MockOntapAPI)(nil).NVMeSubsystemDelete), arg0, arg1)
+}
+
 // NetInterfaceGetDataLIFs mocks base method.
 func (m *MockOntapAPI) NetInterfaceGetDataLIFs(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -992,6 +1241,20 @@ func (mr *MockOntapAPIMockRecorder) NodeListSerialNumbers(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeListSerialNumbers", reflect.TypeOf((*MockOntapAPI)(nil).NodeListSerialNumbers), arg0)
 }
 
+// QtreeCloneFromSnapshot mocks base method.
+func (m *MockOntapAPI) QtreeCloneFromSnapshot(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QtreeCloneFromSnapshot", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QtreeCloneFromSnapshot indicates an expected call of QtreeCloneFromSnapshot.
+func (mr *MockOntapAPIMockRecorder) QtreeCloneFromSnapshot(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeCloneFromSnapshot", reflect.TypeOf((*MockOntapAPI)(nil).QtreeCloneFromSnapshot), arg0, arg1, arg2, arg3, arg4)
+}
+
 // QtreeCount mocks base method.
 func (m *MockOntapAPI) QtreeCount(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -1423,6 +1686,20 @@ func (mr *MockOntapAPIMockRecorder) SnapmirrorResync(arg0, arg1, arg2, arg3, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorResync", reflect.TypeOf((*MockOntapAPI)(nil).SnapmirrorResync), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SnapmirrorUpdate mocks base method.
+func (m *MockOntapAPI) SnapmirrorUpdate(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapmirrorUpdate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SnapmirrorUpdate indicates an expected call of SnapmirrorUpdate.
+func (mr *MockOntapAPIMockRecorder) SnapmirrorUpdate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorUpdate", reflect.TypeOf((*MockOntapAPI)(nil).SnapmirrorUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // SnapshotRestoreFlexgroup mocks base method.
 func (m *MockOntapAPI) SnapshotRestoreFlexgroup(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1578,6 +1855,20 @@ func (mr *MockOntapAPIMockRecorder) VolumeExists(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeExists", reflect.TypeOf((*MockOntapAPI)(nil).VolumeExists), arg0, arg1)
 }
 
+// VolumeGroupSnapshotCreate mocks base method.
+func (m *MockOntapAPI) VolumeGroupSnapshotCreate(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeGroupSnapshotCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeGroupSnapshotCreate indicates an expected call of VolumeGroupSnapshotCreate.
+func (mr *MockOntapAPIMockRecorder) VolumeGroupSnapshotCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeGroupSnapshotCreate", reflect.TypeOf((*MockOntapAPI)(nil).VolumeGroupSnapshotCreate), arg0, arg1, arg2)
+}
+
 // VolumeInfo mocks base method.
 func (m *MockOntapAPI) VolumeInfo(arg0 context.Context, arg1 string) (*api.Volume, error) {
 	m.ctrl.T.Helper()
@@ -1638,6 +1929,21 @@ func (mr *MockOntapAPIMockRecorder) VolumeListBySnapshotParent(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeListBySnapshotParent", reflect.TypeOf((*MockOntapAPI)(nil).VolumeListBySnapshotParent), arg0, arg1, arg2)
 }
 
+// VolumeListMetrics mocks base method.
+func (m *MockOntapAPI) VolumeListMetrics(arg0 context.Context, arg1 string) (map[string]*api.Metrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeListMetrics", arg0, arg1)
+	ret0, _ := ret[0].(map[string]*api.Metrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeListMetrics indicates an expected call of VolumeListMetrics.
+func (mr *MockOntapAPIMockRecorder) VolumeListMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeListMetrics", reflect.TypeOf((*MockOntapAPI)(nil).VolumeListMetrics), arg0, arg1)
+}
+
 // VolumeModifyExportPolicy mocks base method.
 func (m *MockOntapAPI) VolumeModifyExportPolicy(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1652,6 +1958,48 @@ func (mr *MockOntapAPIMockRecorder) VolumeModifyExportPolicy(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyExportPolicy", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifyExportPolicy), arg0, arg1, arg2)
 }
 
+// VolumeModifySnapshotDirectoryAccess mocks base method.
+func (m *MockOntapAPI) VolumeModifySnapshotDirectoryAccess(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotDirectoryAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotDirectoryAccess indicates an expected call of VolumeModifySnapshotDirectoryAccess.
+func (mr *MockOntapAPIMockRecorder) VolumeModifySnapshotDirectoryAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1, arg2)
+}
+
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockOntapAPI) VolumeModifySnapshotPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockOntapAPIMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifySnapshotPolicy), arg0, arg1, arg2)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockOntapAPI) VolumeModifyTieringPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockOntapAPIMockRecorder) VolumeModifyTieringPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifyTieringPolicy), arg0, arg1, arg2)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockOntapAPI) VolumeModifyUnixPermissions(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -1680,6 +2028,35 @@ func (mr *MockOntapAPIMockRecorder) VolumeMount(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMount", reflect.TypeOf((*MockOntapAPI)(nil).VolumeMount), arg0, arg1, arg2)
 }
 
+// VolumeMoveInfo mocks base method.
+func (m *MockOntapAPI) VolumeMoveInfo(arg0 context.Context, arg1 string) (*api.VolumeMove, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveInfo", arg0, arg1)
+	ret0, _ := ret[0].(*api.VolumeMove)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeMoveInfo indicates an expected call of VolumeMoveInfo.
+func (mr *MockOntapAPIMockRecorder) VolumeMoveInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveInfo", reflect.TypeOf((*MockOntapAPI)(nil).VolumeMoveInfo), arg0, arg1)
+}
+
+// VolumeMoveStart mocks base method.
+func (m *MockOntapAPI) VolumeMoveStart(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveStart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeMoveStart indicates an expected call of VolumeMoveStart.
+func (mr *MockOntapAPIMockRecorder) VolumeMoveStart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveStart", reflect.TypeOf((*MockOntapAPI)(nil).VolumeMoveStart), arg0, arg1, arg2)
+}
+
 // VolumeRename mocks base method.
 func (m *MockOntapAPI) VolumeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1794,6 +2171,20 @@ func (mr *MockOntapAPIMockRecorder) VolumeSnapshotList(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotList", reflect.TypeOf((*MockOntapAPI)(nil).VolumeSnapshotList), arg0, arg1)
 }
 
+// VolumeUnmount mocks base method.
+func (m *MockOntapAPI) VolumeUnmount(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeUnmount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeUnmount indicates an expected call of VolumeUnmount.
+func (mr *MockOntapAPIMockRecorder) VolumeUnmount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeUnmount", reflect.TypeOf((*MockOntapAPI)(nil).VolumeUnmount), arg0, arg1, arg2)
+}
+
 // VolumeUsedSize mocks base method.
 func (m *MockOntapAPI) VolumeUsedSize(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
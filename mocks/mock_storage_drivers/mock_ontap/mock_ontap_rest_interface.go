@@ -12,6 +12,7 @@ import (
 	api "github.com/netapp/trident/storage_drivers/ontap/api"
 	cluster "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/cluster"
 	n_a_s "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_a_s"
+	n_v_me "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_v_me"
 	networking "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/networking"
 	s_a_n "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/s_a_n"
 	snapmirror "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/snapmirror"
@@ -87,6 +88,49 @@ func (mr *MockRestClientInterfaceMockRecorder) ClusterInfo(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterInfo", reflect.TypeOf((*MockRestClientInterface)(nil).ClusterInfo), arg0)
 }
 
+// ConsistencyGroupCreateAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupCreateAndWait(arg0 context.Context, arg1 string, arg2 []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupCreateAndWait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsistencyGroupCreateAndWait indicates an expected call of ConsistencyGroupCreateAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupCreateAndWait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupCreateAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupCreateAndWait), arg0, arg1, arg2)
+}
+
+// ConsistencyGroupDeleteAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupDeleteAndWait(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupDeleteAndWait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupDeleteAndWait indicates an expected call of ConsistencyGroupDeleteAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupDeleteAndWait(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupDeleteAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupDeleteAndWait), arg0, arg1)
+}
+
+// ConsistencyGroupSnapshotCreateAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupSnapshotCreateAndWait(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupSnapshotCreateAndWait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupSnapshotCreateAndWait indicates an expected call of ConsistencyGroupSnapshotCreateAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupSnapshotCreateAndWait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupSnapshotCreateAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupSnapshotCreateAndWait), arg0, arg1, arg2)
+}
+
 // EmsAutosupportLog mocks base method.
 func (m *MockRestClientInterface) EmsAutosupportLog(arg0 context.Context, arg1 string, arg2 bool, arg3, arg4, arg5 string, arg6 int, arg7 string, arg8 int) error {
 	m.ctrl.T.Helper()
@@ -221,18 +265,106 @@ func (mr *MockRestClientInterfaceMockRecorder) ExportRuleList(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRuleList", reflect.TypeOf((*MockRestClientInterface)(nil).ExportRuleList), arg0, arg1)
 }
 
+// FileClone mocks base method.
+func (m *MockRestClientInterface) FileClone(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileClone", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FileClone indicates an expected call of FileClone.
+func (mr *MockRestClientInterfaceMockRecorder) FileClone(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileClone", reflect.TypeOf((*MockRestClientInterface)(nil).FileClone), arg0, arg1, arg2, arg3)
+}
+
+// FileCreate mocks base method.
+func (m *MockRestClientInterface) FileCreate(arg0 context.Context, arg1, arg2 string, arg3 *models.FileInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FileCreate indicates an expected call of FileCreate.
+func (mr *MockRestClientInterfaceMockRecorder) FileCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileCreate", reflect.TypeOf((*MockRestClientInterface)(nil).FileCreate), arg0, arg1, arg2, arg3)
+}
+
+// FileList mocks base method.
+func (m *MockRestClientInterface) FileList(arg0 context.Context, arg1, arg2 string) ([]*models.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileList", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileList indicates an expected call of FileList.
+func (mr *MockRestClientInterfaceMockRecorder) FileList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileList", reflect.TypeOf((*MockRestClientInterface)(nil).FileList), arg0, arg1, arg2)
+}
+
+// FcpInterfaceGet mocks base method.
+func (m *MockRestClientInterface) FcpInterfaceGet(arg0 context.Context) (*networking.FcInterfaceCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FcpInterfaceGet", arg0)
+	ret0, _ := ret[0].(*networking.FcInterfaceCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FcpInterfaceGet indicates an expected call of FcpInterfaceGet.
+func (mr *MockRestClientInterfaceMockRecorder) FcpInterfaceGet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FcpInterfaceGet", reflect.TypeOf((*MockRestClientInterface)(nil).FcpInterfaceGet), arg0)
+}
+
+// FcpNodeGetName mocks base method.
+func (m *MockRestClientInterface) FcpNodeGetName(arg0 context.Context) (*s_a_n.FcpServiceGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FcpNodeGetName", arg0)
+	ret0, _ := ret[0].(*s_a_n.FcpServiceGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FcpNodeGetName indicates an expected call of FcpNodeGetName.
+func (mr *MockRestClientInterfaceMockRecorder) FcpNodeGetName(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FcpNodeGetName", reflect.TypeOf((*MockRestClientInterface)(nil).FcpNodeGetName), arg0)
+}
+
+// FlexGroupConstituentCount mocks base method.
+func (m *MockRestClientInterface) FlexGroupConstituentCount(arg0 context.Context, arg1 string, arg2 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlexGroupConstituentCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlexGroupConstituentCount indicates an expected call of FlexGroupConstituentCount.
+func (mr *MockRestClientInterfaceMockRecorder) FlexGroupConstituentCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupConstituentCount", reflect.TypeOf((*MockRestClientInterface)(nil).FlexGroupConstituentCount), arg0, arg1, arg2)
+}
+
 // FlexGroupCreate mocks base method.
-func (m *MockRestClientInterface) FlexGroupCreate(arg0 context.Context, arg1 string, arg2 int, arg3 []string, arg4, arg5, arg6, arg7, arg8, arg9, arg10 string, arg11 api.QosPolicyGroup, arg12 *bool, arg13 int) error {
+func (m *MockRestClientInterface) FlexGroupCreate(arg0 context.Context, arg1 string, arg2 int, arg3 []string, arg4, arg5, arg6, arg7, arg8, arg9, arg10 string, arg11 api.QosPolicyGroup, arg12 *bool, arg13, arg14 int, arg15 bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FlexGroupCreate", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13)
+	ret := m.ctrl.Call(m, "FlexGroupCreate", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // FlexGroupCreate indicates an expected call of FlexGroupCreate.
-func (mr *MockRestClientInterfaceMockRecorder) FlexGroupCreate(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13 interface{}) *gomock.Call {
+func (mr *MockRestClientInterfaceMockRecorder) FlexGroupCreate(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupCreate", reflect.TypeOf((*MockRestClientInterface)(nil).FlexGroupCreate), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupCreate", reflect.TypeOf((*MockRestClientInterface)(nil).FlexGroupCreate), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15)
 }
 
 // FlexGroupDestroy mocks base method.
@@ -847,6 +979,21 @@ func (mr *MockRestClientInterfaceMockRecorder) LunList(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunList", reflect.TypeOf((*MockRestClientInterface)(nil).LunList), arg0, arg1)
 }
 
+// LunListMetrics mocks base method.
+func (m *MockRestClientInterface) LunListMetrics(arg0 context.Context, arg1 string) (*s_a_n.LunCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LunListMetrics", arg0, arg1)
+	ret0, _ := ret[0].(*s_a_n.LunCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LunListMetrics indicates an expected call of LunListMetrics.
+func (mr *MockRestClientInterfaceMockRecorder) LunListMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunListMetrics", reflect.TypeOf((*MockRestClientInterface)(nil).LunListMetrics), arg0, arg1)
+}
+
 // LunMap mocks base method.
 func (m *MockRestClientInterface) LunMap(arg0 context.Context, arg1, arg2 string, arg3 int) (*s_a_n.LunMapCreateCreated, error) {
 	m.ctrl.T.Helper()
@@ -1022,6 +1169,210 @@ func (mr *MockRestClientInterfaceMockRecorder) LunUnmap(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LunUnmap", reflect.TypeOf((*MockRestClientInterface)(nil).LunUnmap), arg0, arg1, arg2)
 }
 
+// NVMeAddHostNqnToSubsystem mocks base method.
+func (m *MockRestClientInterface) NVMeAddHostNqnToSubsystem(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeAddHostNqnToSubsystem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeAddHostNqnToSubsystem indicates an expected call of NVMeAddHostNqnToSubsystem.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeAddHostNqnToSubsystem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeAddHostNqnToSubsystem", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeAddHostNqnToSubsystem), arg0, arg1, arg2)
+}
+
+// NVMeGetHostsOfSubsystem mocks base method.
+func (m *MockRestClientInterface) NVMeGetHostsOfSubsystem(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeGetHostsOfSubsystem", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeGetHostsOfSubsystem indicates an expected call of NVMeGetHostsOfSubsystem.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeGetHostsOfSubsystem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeGetHostsOfSubsystem", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeGetHostsOfSubsystem), arg0, arg1)
+}
+
+// NVMeNamespaceCreate mocks base method.
+func (m *MockRestClientInterface) NVMeNamespaceCreate(arg0 context.Context, arg1 api.NVMeNamespace) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceCreate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceCreate indicates an expected call of NVMeNamespaceCreate.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeNamespaceCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceCreate", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeNamespaceCreate), arg0, arg1)
+}
+
+// NVMeNamespaceGetByName mocks base method.
+func (m *MockRestClientInterface) NVMeNamespaceGetByName(arg0 context.Context, arg1 string) (*models.NvmeNamespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceGetByName", arg0, arg1)
+	ret0, _ := ret[0].(*models.NvmeNamespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceGetByName indicates an expected call of NVMeNamespaceGetByName.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeNamespaceGetByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceGetByName", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeNamespaceGetByName), arg0, arg1)
+}
+
+// NVMeNamespaceList mocks base method.
+func (m *MockRestClientInterface) NVMeNamespaceList(arg0 context.Context, arg1 string) (*n_v_me.NvmeNamespaceCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceList", arg0, arg1)
+	ret0, _ := ret[0].(*n_v_me.NvmeNamespaceCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeNamespaceList indicates an expected call of NVMeNamespaceList.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeNamespaceList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceList", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeNamespaceList), arg0, arg1)
+}
+
+// NVMeNamespaceSetSize mocks base method.
+func (m *MockRestClientInterface) NVMeNamespaceSetSize(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeNamespaceSetSize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeNamespaceSetSize indicates an expected call of NVMeNamespaceSetSize.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeNamespaceSetSize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeNamespaceSetSize", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeNamespaceSetSize), arg0, arg1, arg2)
+}
+
+// NVMeRemoveHostFromSubsystem mocks base method.
+func (m *MockRestClientInterface) NVMeRemoveHostFromSubsystem(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeRemoveHostFromSubsystem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeRemoveHostFromSubsystem indicates an expected call of NVMeRemoveHostFromSubsystem.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeRemoveHostFromSubsystem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeRemoveHostFromSubsystem", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeRemoveHostFromSubsystem), arg0, arg1, arg2)
+}
+
+// NVMeSubsystemAddNamespace mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemAddNamespace(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemAddNamespace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeSubsystemAddNamespace indicates an expected call of NVMeSubsystemAddNamespace.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemAddNamespace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemAddNamespace", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemAddNamespace), arg0, arg1, arg2)
+}
+
+// NVMeSubsystemCreate mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemCreate(arg0 context.Context, arg1 string) (*models.NvmeSubsystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemCreate", arg0, arg1)
+	ret0, _ := ret[0].(*models.NvmeSubsystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeSubsystemCreate indicates an expected call of NVMeSubsystemCreate.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemCreate", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemCreate), arg0, arg1)
+}
+
+// NVMeSubsystemDelete mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemDelete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemDelete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeSubsystemDelete indicates an expected call of NVMeSubsystemDelete.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemDelete", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemDelete), arg0, arg1)
+}
+
+// NVMeSubsystemGetByName mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemGetByName(arg0 context.Context, arg1 string) (*models.NvmeSubsystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemGetByName", arg0, arg1)
+	ret0, _ := ret[0].(*models.NvmeSubsystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeSubsystemGetByName indicates an expected call of NVMeSubsystemGetByName.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemGetByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemGetByName", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemGetByName), arg0, arg1)
+}
+
+// NVMeSubsystemList mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemList(arg0 context.Context, arg1 string) (*n_v_me.NvmeSubsystemCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemList", arg0, arg1)
+	ret0, _ := ret[0].(*n_v_me.NvmeSubsystemCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeSubsystemList indicates an expected call of NVMeSubsystemList.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemList", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemList), arg0, arg1)
+}
+
+// NVMeSubsystemMapList mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemMapList(arg0 context.Context, arg1, arg2 string) (*n_v_me.NvmeSubsystemMapCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemMapList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*n_v_me.NvmeSubsystemMapCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NVMeSubsystemMapList indicates an expected call of NVMeSubsystemMapList.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemMapList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemMapList", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemMapList), arg0, arg1, arg2)
+}
+
+// NVMeSubsystemRemoveNamespace mocks base method.
+func (m *MockRestClientInterface) NVMeSubsystemRemoveNamespace(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NVMeSubsystemRemoveNamespace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NVMeSubsystemRemoveNamespace indicates an expected call of NVMeSubsystemRemoveNamespace.
+func (mr *MockRestClientInterfaceMockRecorder) NVMeSubsystemRemoveNamespace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NVMeSubsystemRemoveNamespace", reflect.TypeOf((*MockRestClientInterface)(nil).NVMeSubsystemRemoveNamespace), arg0, arg1, arg2)
+}
+
 // NetInterfaceGetDataLIFs mocks base method.
 func (m *MockRestClientInterface) NetInterfaceGetDataLIFs(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1640,6 +1991,20 @@ func (mr *MockRestClientInterfaceMockRecorder) SnapmirrorResync(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorResync", reflect.TypeOf((*MockRestClientInterface)(nil).SnapmirrorResync), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SnapmirrorUpdate mocks base method.
+func (m *MockRestClientInterface) SnapmirrorUpdate(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapmirrorUpdate", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SnapmirrorUpdate indicates an expected call of SnapmirrorUpdate.
+func (mr *MockRestClientInterfaceMockRecorder) SnapmirrorUpdate(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorUpdate", reflect.TypeOf((*MockRestClientInterface)(nil).SnapmirrorUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // SnapshotCreate mocks base method.
 func (m *MockRestClientInterface) SnapshotCreate(arg0 context.Context, arg1, arg2 string) (*storage.SnapshotCreateAccepted, error) {
 	m.ctrl.T.Helper()
@@ -2020,6 +2385,21 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeListByAttrs(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeListByAttrs", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeListByAttrs), arg0, arg1)
 }
 
+// VolumeListMetrics mocks base method.
+func (m *MockRestClientInterface) VolumeListMetrics(arg0 context.Context, arg1 string) (*storage.VolumeCollectionGetOK, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeListMetrics", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeCollectionGetOK)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeListMetrics indicates an expected call of VolumeListMetrics.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeListMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeListMetrics", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeListMetrics), arg0, arg1)
+}
+
 // VolumeModifyExportPolicy mocks base method.
 func (m *MockRestClientInterface) VolumeModifyExportPolicy(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -2034,6 +2414,48 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeModifyExportPolicy(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyExportPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifyExportPolicy), arg0, arg1, arg2)
 }
 
+// VolumeModifySnapshotDirectoryAccess mocks base method.
+func (m *MockRestClientInterface) VolumeModifySnapshotDirectoryAccess(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotDirectoryAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotDirectoryAccess indicates an expected call of VolumeModifySnapshotDirectoryAccess.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeModifySnapshotDirectoryAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1, arg2)
+}
+
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockRestClientInterface) VolumeModifySnapshotPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifySnapshotPolicy), arg0, arg1, arg2)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockRestClientInterface) VolumeModifyTieringPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeModifyTieringPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifyTieringPolicy), arg0, arg1, arg2)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockRestClientInterface) VolumeModifyUnixPermissions(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -2062,6 +2484,35 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeMount(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMount", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeMount), arg0, arg1, arg2)
 }
 
+// VolumeMoveInfo mocks base method.
+func (m *MockRestClientInterface) VolumeMoveInfo(arg0 context.Context, arg1 string) (*models.VolumeInlineMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveInfo", arg0, arg1)
+	ret0, _ := ret[0].(*models.VolumeInlineMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeMoveInfo indicates an expected call of VolumeMoveInfo.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeMoveInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveInfo", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeMoveInfo), arg0, arg1)
+}
+
+// VolumeMoveStart mocks base method.
+func (m *MockRestClientInterface) VolumeMoveStart(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveStart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeMoveStart indicates an expected call of VolumeMoveStart.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeMoveStart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveStart", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeMoveStart), arg0, arg1, arg2)
+}
+
 // VolumeRename mocks base method.
 func (m *MockRestClientInterface) VolumeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -2133,6 +2584,20 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeSize(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSize", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeSize), arg0, arg1)
 }
 
+// VolumeUnmount mocks base method.
+func (m *MockRestClientInterface) VolumeUnmount(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeUnmount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeUnmount indicates an expected call of VolumeUnmount.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeUnmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeUnmount", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeUnmount), arg0, arg1)
+}
+
 // VolumeUsedSize mocks base method.
 func (m *MockRestClientInterface) VolumeUsedSize(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -186,19 +186,34 @@ func (mr *MockZapiClientInterfaceMockRecorder) ExportRuleGetIterRequest(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRuleGetIterRequest", reflect.TypeOf((*MockZapiClientInterface)(nil).ExportRuleGetIterRequest), arg0)
 }
 
+// FlexGroupConstituentList mocks base method.
+func (m *MockZapiClientInterface) FlexGroupConstituentList(arg0 string, arg1 string) (*azgo.VolumeGetIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlexGroupConstituentList", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeGetIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlexGroupConstituentList indicates an expected call of FlexGroupConstituentList.
+func (mr *MockZapiClientInterfaceMockRecorder) FlexGroupConstituentList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupConstituentList", reflect.TypeOf((*MockZapiClientInterface)(nil).FlexGroupConstituentList), arg0, arg1)
+}
+
 // FlexGroupCreate mocks base method.
-func (m *MockZapiClientInterface) FlexGroupCreate(arg0 context.Context, arg1 string, arg2 int, arg3 []string, arg4, arg5, arg6, arg7, arg8, arg9, arg10 string, arg11 api.QosPolicyGroup, arg12 *bool, arg13 int) (*azgo.VolumeCreateAsyncResponse, error) {
+func (m *MockZapiClientInterface) FlexGroupCreate(arg0 context.Context, arg1 string, arg2 int, arg3 []string, arg4, arg5, arg6, arg7, arg8, arg9, arg10 string, arg11 api.QosPolicyGroup, arg12 *bool, arg13, arg14 int, arg15 bool) (*azgo.VolumeCreateAsyncResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FlexGroupCreate", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13)
+	ret := m.ctrl.Call(m, "FlexGroupCreate", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15)
 	ret0, _ := ret[0].(*azgo.VolumeCreateAsyncResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FlexGroupCreate indicates an expected call of FlexGroupCreate.
-func (mr *MockZapiClientInterfaceMockRecorder) FlexGroupCreate(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13 interface{}) *gomock.Call {
+func (mr *MockZapiClientInterfaceMockRecorder) FlexGroupCreate(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupCreate", reflect.TypeOf((*MockZapiClientInterface)(nil).FlexGroupCreate), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlexGroupCreate", reflect.TypeOf((*MockZapiClientInterface)(nil).FlexGroupCreate), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15)
 }
 
 // FlexGroupDestroy mocks base method.
@@ -1765,6 +1780,21 @@ func (mr *MockZapiClientInterfaceMockRecorder) SnapmirrorResync(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorResync", reflect.TypeOf((*MockZapiClientInterface)(nil).SnapmirrorResync), arg0, arg1, arg2, arg3)
 }
 
+// SnapmirrorUpdate mocks base method.
+func (m *MockZapiClientInterface) SnapmirrorUpdate(arg0, arg1, arg2, arg3, arg4 string) (*azgo.SnapmirrorUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapmirrorUpdate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*azgo.SnapmirrorUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapmirrorUpdate indicates an expected call of SnapmirrorUpdate.
+func (mr *MockZapiClientInterfaceMockRecorder) SnapmirrorUpdate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapmirrorUpdate", reflect.TypeOf((*MockZapiClientInterface)(nil).SnapmirrorUpdate), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SnapshotCreate mocks base method.
 func (m *MockZapiClientInterface) SnapshotCreate(arg0, arg1 string) (*azgo.SnapshotCreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2093,6 +2123,51 @@ func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifyExportPolicy(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyExportPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifyExportPolicy), arg0, arg1)
 }
 
+// VolumeModifySnapshotDirectoryAccess mocks base method.
+func (m *MockZapiClientInterface) VolumeModifySnapshotDirectoryAccess(arg0 string, arg1 bool) (*azgo.VolumeModifyIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotDirectoryAccess", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeModifyIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeModifySnapshotDirectoryAccess indicates an expected call of VolumeModifySnapshotDirectoryAccess.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifySnapshotDirectoryAccess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1)
+}
+
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockZapiClientInterface) VolumeModifySnapshotPolicy(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeModifyIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifySnapshotPolicy), arg0, arg1)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockZapiClientInterface) VolumeModifyTieringPolicy(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeModifyIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifyTieringPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifyTieringPolicy), arg0, arg1)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockZapiClientInterface) VolumeModifyUnixPermissions(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,12 +56,14 @@ type TridentOrchestratorSpec struct {
 	KubeletDir                   string            `json:"kubeletDir,omitempty"`
 	Wipeout                      []string          `json:"wipeout,omitempty"`
 	ImagePullSecrets             []string          `json:"imagePullSecrets,omitempty"`
+	ControllerReplicas           int               `json:"controllerReplicas,omitempty"`
 	ControllerPluginNodeSelector map[string]string `json:"controllerPluginNodeSelector,omitempty"`
 	ControllerPluginTolerations  []Toleration      `json:"controllerPluginTolerations,omitempty"`
 	NodePluginNodeSelector       map[string]string `json:"nodePluginNodeSelector,omitempty"`
 	NodePluginTolerations        []Toleration      `json:"nodePluginTolerations,omitempty"`
 	Windows                      bool              `json:"windows,omitempty"`
 	ImagePullPolicy              string            `json:"imagePullPolicy,omitempty"`
+	RESTAuthorizationSecret      string            `json:"restAuthorizationPolicySecret,omitempty"`
 }
 
 // Toleration
@@ -128,6 +130,8 @@ type TridentOrchestratorSpecValues struct {
 	TridentImage            string            `json:"tridentImage"`
 	ImageRegistry           string            `json:"imageRegistry"`
 	KubeletDir              string            `json:"kubeletDir"`
+	ControllerReplicas      string            `json:"controllerReplicas"`
+	RESTAuthorizationSecret string            `json:"restAuthorizationPolicySecret"`
 	ImagePullSecrets        []string          `json:"imagePullSecrets"`
 	NodePluginNodeSelector  map[string]string `json:"nodePluginNodeSelector,omitempty"`
 	NodePluginTolerations   []Toleration      `json:"nodePluginTolerations,omitempty"`
@@ -90,4 +90,7 @@ const (
 
 	// DefaultImagePullPolicy is the trident image pull policy.
 	DefaultImagePullPolicy = string(v1.PullIfNotPresent)
+
+	// DefaultControllerReplicas is the number of Trident controllers, of which all but the leader stand by.
+	DefaultControllerReplicas = 1
 )
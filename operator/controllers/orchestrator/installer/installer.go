@@ -29,18 +29,21 @@ import (
 
 const (
 	// CRD names
-	BackendCRDName            = "tridentbackends.trident.netapp.io"
-	BackendConfigCRDName      = "tridentbackendconfigs.trident.netapp.io"
-	MirrorRelationshipCRDName = "tridentmirrorrelationships.trident.netapp.io"
-	SnapshotInfoCRDName       = "tridentsnapshotinfos.trident.netapp.io"
-	NodeCRDName               = "tridentnodes.trident.netapp.io"
-	StorageClassCRDName       = "tridentstorageclasses.trident.netapp.io"
-	TransactionCRDName        = "tridenttransactions.trident.netapp.io"
-	VersionCRDName            = "tridentversions.trident.netapp.io"
-	VolumeCRDName             = "tridentvolumes.trident.netapp.io"
-	VolumePublicationCRDName  = "tridentvolumepublications.trident.netapp.io"
-	SnapshotCRDName           = "tridentsnapshots.trident.netapp.io"
-	VolumeReferenceCRDName    = "tridentvolumereferences.trident.netapp.io"
+	BackendCRDName               = "tridentbackends.trident.netapp.io"
+	BackendConfigCRDName         = "tridentbackendconfigs.trident.netapp.io"
+	MirrorRelationshipCRDName    = "tridentmirrorrelationships.trident.netapp.io"
+	SnapshotInfoCRDName          = "tridentsnapshotinfos.trident.netapp.io"
+	NodeCRDName                  = "tridentnodes.trident.netapp.io"
+	StorageClassCRDName          = "tridentstorageclasses.trident.netapp.io"
+	TransactionCRDName           = "tridenttransactions.trident.netapp.io"
+	VersionCRDName               = "tridentversions.trident.netapp.io"
+	VolumeCRDName                = "tridentvolumes.trident.netapp.io"
+	VolumePublicationCRDName     = "tridentvolumepublications.trident.netapp.io"
+	SnapshotCRDName              = "tridentsnapshots.trident.netapp.io"
+	GroupSnapshotCRDName         = "tridentgroupsnapshots.trident.netapp.io"
+	SnapshotPolicyCRDName        = "tridentsnapshotpolicies.trident.netapp.io"
+	VolumeReferenceCRDName       = "tridentvolumereferences.trident.netapp.io"
+	ActionSnapshotRestoreCRDName = "tridentactionsnapshotrestores.trident.netapp.io"
 
 	VolumeSnapshotCRDName        = "volumesnapshots.snapshot.storage.k8s.io"
 	VolumeSnapshotClassCRDName   = "volumesnapshotclasses.snapshot.storage.k8s.io"
@@ -76,6 +79,10 @@ var (
 
 	imagePullSecrets []string
 
+	controllerReplicas int
+
+	restAuthorizationSecret string
+
 	k8sTimeout  time.Duration
 	httpTimeout string
 
@@ -99,8 +106,11 @@ var (
 		VersionCRDName,
 		VolumeCRDName,
 		SnapshotCRDName,
+		GroupSnapshotCRDName,
+		SnapshotPolicyCRDName,
 		VolumeReferenceCRDName,
 		VolumePublicationCRDName,
+		ActionSnapshotRestoreCRDName,
 	}
 
 	AlphaCRDNames = []string{
@@ -288,6 +298,8 @@ func (i *Installer) setInstallationParams(
 	autosupportImage = commonconfig.DefaultAutosupportImage
 	httpTimeout = commonconfig.HTTPTimeoutString
 	imagePullPolicy = DefaultImagePullPolicy
+	controllerReplicas = DefaultControllerReplicas
+	restAuthorizationSecret = ""
 
 	imagePullSecrets = []string{}
 
@@ -395,6 +407,15 @@ func (i *Installer) setInstallationParams(
 	if cr.Spec.ImagePullPolicy != "" {
 		imagePullPolicy = cr.Spec.ImagePullPolicy
 	}
+	if cr.Spec.ControllerReplicas < 0 {
+		return nil, nil, false, fmt.Errorf("'%d' is not a valid number of controller replicas",
+			cr.Spec.ControllerReplicas)
+	} else if cr.Spec.ControllerReplicas > 0 {
+		controllerReplicas = cr.Spec.ControllerReplicas
+	}
+	if cr.Spec.RESTAuthorizationSecret != "" {
+		restAuthorizationSecret = cr.Spec.RESTAuthorizationSecret
+	}
 
 	// Owner Reference details set on each of the Trident object created by the operator
 	controllingCRDetails := make(map[string]string)
@@ -611,6 +632,8 @@ func (i *Installer) InstallOrPatchTrident(
 		AutosupportSerialNumber: autosupportSerialNumber,
 		AutosupportHostname:     autosupportHostname,
 		KubeletDir:              kubeletDir,
+		ControllerReplicas:      strconv.Itoa(controllerReplicas),
+		RESTAuthorizationSecret: restAuthorizationSecret,
 		K8sTimeout:              strconv.Itoa(int(k8sTimeout.Seconds())),
 		HTTPRequestTimeout:      httpTimeout,
 		ImagePullSecrets:        imagePullSecrets,
@@ -660,9 +683,19 @@ func (i *Installer) createCRDs(performOperationOnce bool) error {
 	if err = i.CreateOrPatchCRD(SnapshotCRDName, k8sclient.GetSnapshotCRDYAML(), false); err != nil {
 		return err
 	}
+	if err = i.CreateOrPatchCRD(GroupSnapshotCRDName, k8sclient.GetGroupSnapshotCRDYAML(), false); err != nil {
+		return err
+	}
+	if err = i.CreateOrPatchCRD(SnapshotPolicyCRDName, k8sclient.GetSnapshotPolicyCRDYAML(), false); err != nil {
+		return err
+	}
 	if err = i.CreateOrPatchCRD(VolumeReferenceCRDName, k8sclient.GetVolumeReferenceCRDYAML(), false); err != nil {
 		return err
 	}
+	if err = i.CreateOrPatchCRD(ActionSnapshotRestoreCRDName, k8sclient.GetActionSnapshotRestoreCRDYAML(),
+		false); err != nil {
+		return err
+	}
 	if err = i.CreateOrPatchCRD(MirrorRelationshipCRDName, k8sclient.GetMirrorRelationshipCRDYAML(),
 		performOperationOnce); err != nil {
 		return err
@@ -1408,6 +1441,8 @@ func (i *Installer) createOrPatchTridentDeployment(
 		ServiceAccountName:      serviceAccName,
 		ImagePullPolicy:         imagePullPolicy,
 		EnableForceDetach:       enableForceDetach,
+		Replicas:                controllerReplicas,
+		RESTAuthorizationSecret: restAuthorizationSecret,
 	}
 
 	newDeploymentYAML := k8sclient.GetCSIDeploymentYAML(deploymentArgs)
@@ -1525,8 +1560,13 @@ func (i *Installer) waitForTridentPod() (*v1.Pod, error) {
 	time.Sleep(waitTime)
 
 	checkPodRunning := func() error {
+		// Several controllers may be deployed, of which only the elected leader is ready
+		var pods []v1.Pod
 		var podError error
-		pod, podError = i.client.GetPodByLabel(appLabel, false)
+		pod = nil
+		if pods, podError = i.client.GetPodsByLabel(appLabel, false); podError == nil {
+			pod, podError = k8sclient.SelectControllerPod(pods)
+		}
 		if podError != nil || pod.Status.Phase != v1.PodRunning {
 
 			// Try to identify the reason for container not running, it could be a
@@ -0,0 +1,85 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// TridentActionStateInProgress implies the action has been accepted and is being carried out
+	TridentActionStateInProgress = "In progress"
+	// TridentActionStateSucceeded implies the action completed successfully
+	TridentActionStateSucceeded = "Succeeded"
+	// TridentActionStateFailed implies the action could not be completed
+	TridentActionStateFailed = "Failed"
+
+	ActionSnapshotRestoreInvalid   = "invalid"
+	ActionSnapshotRestoreFailed    = "restoreFailed"
+	ActionSnapshotRestoreSucceeded = "restoreSucceeded"
+)
+
+func (in *TridentActionSnapshotRestore) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentActionSnapshotRestore) GetKind() string {
+	return "TridentActionSnapshotRestore"
+}
+
+func (in *TridentActionSnapshotRestore) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentActionSnapshotRestore) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentActionSnapshotRestore) AddTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if !utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			in.ObjectMeta.Finalizers = append(in.ObjectMeta.Finalizers, finalizerName)
+		}
+	}
+}
+
+func (in *TridentActionSnapshotRestore) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
+
+// IsValid returns whether the TridentActionSnapshotRestore CR provided has its fields set to valid value
+// combinations and any reason it is invalid as a string
+func (in *TridentActionSnapshotRestore) IsValid() (isValid bool, reason string) {
+	if strings.TrimSpace(in.Spec.PVCName) == "" {
+		return false, "pvcName must be set"
+	}
+	if strings.TrimSpace(in.Spec.VolumeSnapshotName) == "" {
+		return false, "volumeSnapshotName must be set"
+	}
+	return true, ""
+}
+
+// IsComplete returns whether the restore action has run to completion, whether successfully or not.
+// Actions are never repeated once complete.
+func (in *TridentActionSnapshotRestore) IsComplete() bool {
+	return in.Status.State == TridentActionStateSucceeded || in.Status.State == TridentActionStateFailed
+}
+
+// IsInProgress returns whether the restore action has been started but has not yet completed.
+func (in *TridentActionSnapshotRestore) IsInProgress() bool {
+	return in.Status.State == TridentActionStateInProgress
+}
@@ -39,6 +39,8 @@ func (in *TridentNode) Apply(persistent *utils.Node) error {
 
 	in.Name = persistent.Name
 	in.IQN = persistent.IQN
+	in.NQN = persistent.NQN
+	in.WWPNs = persistent.WWPNs
 	in.IPs = persistent.IPs
 	in.Deleted = persistent.Deleted
 	in.PublicationState = string(persistent.PublicationState)
@@ -68,6 +70,8 @@ func (in *TridentNode) Persistent() (*utils.Node, error) {
 	persistent := &utils.Node{
 		Name:             in.Name,
 		IQN:              in.IQN,
+		NQN:              in.NQN,
+		WWPNs:            in.WWPNs,
 		IPs:              in.IPs,
 		NodePrep:         &utils.NodePrep{},
 		HostInfo:         &utils.HostSystem{},
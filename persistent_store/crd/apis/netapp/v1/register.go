@@ -34,6 +34,8 @@ var (
 // Adds the list of known types to the given scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&TridentActionSnapshotRestore{},
+		&TridentActionSnapshotRestoreList{},
 		&TridentBackend{},
 		&TridentBackendList{},
 		&TridentMirrorRelationship{},
@@ -56,6 +58,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TridentVersionList{},
 		&TridentSnapshot{},
 		&TridentSnapshotList{},
+		&TridentGroupSnapshot{},
+		&TridentGroupSnapshotList{},
+		&TridentSnapshotPolicy{},
+		&TridentSnapshotPolicyList{},
 		&TridentVolumeReference{},
 		&TridentVolumeReferenceList{},
 	)
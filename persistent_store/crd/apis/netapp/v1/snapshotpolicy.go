@@ -0,0 +1,91 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// NewTridentSnapshotPolicy creates a new snapshot policy CRD object from an internal SnapshotPolicyPersistent object
+func NewTridentSnapshotPolicy(persistent *storage.SnapshotPolicyPersistent) (*TridentSnapshotPolicy, error) {
+	tsp := &TridentSnapshotPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentSnapshotPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       NameFix(persistent.ID()),
+			Finalizers: GetTridentFinalizers(),
+		},
+	}
+
+	if err := tsp.Apply(persistent); err != nil {
+		return nil, err
+	}
+
+	return tsp, nil
+}
+
+// Apply applies changes from an internal SnapshotPolicyPersistent object to its Kubernetes CRD equivalent
+func (in *TridentSnapshotPolicy) Apply(persistent *storage.SnapshotPolicyPersistent) error {
+	if NameFix(persistent.ID()) != in.ObjectMeta.Name {
+		return ErrNamesDontMatch
+	}
+
+	config, err := json.Marshal(persistent.Config)
+	if err != nil {
+		return err
+	}
+
+	in.Spec.Raw = config
+	in.Created = persistent.Created
+	in.LastRun = persistent.LastRun
+
+	return nil
+}
+
+// Persistent converts a Kubernetes CRD object into its internal SnapshotPolicyPersistent equivalent
+func (in *TridentSnapshotPolicy) Persistent() (*storage.SnapshotPolicyPersistent, error) {
+	persistent := &storage.SnapshotPolicyPersistent{}
+
+	persistent.Config = &storage.SnapshotPolicyConfig{}
+	persistent.Created = in.Created
+	persistent.LastRun = in.LastRun
+
+	return persistent, json.Unmarshal(in.Spec.Raw, persistent.Config)
+}
+
+func (in *TridentSnapshotPolicy) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentSnapshotPolicy) GetKind() string {
+	return "TridentSnapshotPolicy"
+}
+
+func (in *TridentSnapshotPolicy) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentSnapshotPolicy) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentSnapshotPolicy) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
@@ -91,6 +91,45 @@ type TridentSnapshotInfoStatus struct {
 	ObservedGeneration int    `json:"observedGeneration"`
 }
 
+// TridentActionSnapshotRestore is a request to restore a PVC in place from one of its snapshots.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentActionSnapshotRestore struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Input spec for the restore action
+	Spec   TridentActionSnapshotRestoreSpec   `json:"spec"`
+	Status TridentActionSnapshotRestoreStatus `json:"status"`
+}
+
+// TridentActionSnapshotRestoreList is a list of TridentActionSnapshotRestore objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentActionSnapshotRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TridentActionSnapshotRestore objects
+	Items []*TridentActionSnapshotRestore `json:"items"`
+}
+
+// TridentActionSnapshotRestoreSpec defines the desired state of TridentActionSnapshotRestore
+type TridentActionSnapshotRestoreSpec struct {
+	PVCName            string `json:"pvcName"`
+	VolumeSnapshotName string `json:"volumeSnapshotName"`
+}
+
+// TridentActionSnapshotRestoreStatus defines the observed state of TridentActionSnapshotRestore
+type TridentActionSnapshotRestoreStatus struct {
+	State          string       `json:"state"`
+	Message        string       `json:"message"`
+	StartTime      *metav1.Time `json:"startTime"`
+	CompletionTime *metav1.Time `json:"completionTime"`
+}
+
 // TridentMirrorRelationship defines a Trident Mirror relationship.
 // +genclient
 // +k8s:openapi-gen=true
@@ -311,6 +350,10 @@ type TridentNode struct {
 	NodeName string `json:"name"`
 	// IQN is the iqn of the node
 	IQN string `json:"iqn,omitempty"`
+	// NQN is the NVMe qualified name of the node
+	NQN string `json:"nqn,omitempty"`
+	// WWPNs are the Fibre Channel port names of the node
+	WWPNs []string `json:"wwpns,omitempty"`
 	// IPs is a list of IP addresses for the TridentNode
 	IPs []string `json:"ips,omitempty"`
 	// NodePrep is the current status of node preparation for this node
@@ -390,6 +433,56 @@ type TridentSnapshotList struct {
 	Items []*TridentSnapshot `json:"items"`
 }
 
+// TridentGroupSnapshot defines a set of Trident snapshots taken of several volumes at a single point in time.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentGroupSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the group snapshot
+	Spec runtime.RawExtension `json:"spec"`
+	// The UTC time that the group snapshot was created, in RFC3339 format
+	Created string `json:"dateCreated"`
+}
+
+// TridentGroupSnapshotList is a list of TridentGroupSnapshot objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of TridentGroupSnapshot objects
+	Items []*TridentGroupSnapshot `json:"items"`
+}
+
+// TridentSnapshotPolicy defines a Trident-managed schedule for creating and pruning volume snapshots.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the snapshot policy
+	Spec runtime.RawExtension `json:"spec"`
+	// The UTC time that the snapshot policy was created, in RFC3339 format
+	Created string `json:"dateCreated"`
+	// The UTC time of the last scheduled run of the snapshot policy, in RFC3339 format
+	LastRun string `json:"lastRun,omitempty"`
+}
+
+// TridentSnapshotPolicyList is a list of TridentSnapshotPolicy objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of TridentSnapshotPolicy objects
+	Items []*TridentSnapshotPolicy `json:"items"`
+}
+
 // TridentVolumeReference defines a PVC whose backing volume Trident may share to other namespaces.
 // +genclient
 // +k8s:openapi-gen=true
@@ -11,6 +11,111 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionSnapshotRestore) DeepCopyInto(out *TridentActionSnapshotRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionSnapshotRestore.
+func (in *TridentActionSnapshotRestore) DeepCopy() *TridentActionSnapshotRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionSnapshotRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentActionSnapshotRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionSnapshotRestoreList) DeepCopyInto(out *TridentActionSnapshotRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentActionSnapshotRestore, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentActionSnapshotRestore)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionSnapshotRestoreList.
+func (in *TridentActionSnapshotRestoreList) DeepCopy() *TridentActionSnapshotRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionSnapshotRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentActionSnapshotRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionSnapshotRestoreSpec) DeepCopyInto(out *TridentActionSnapshotRestoreSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionSnapshotRestoreSpec.
+func (in *TridentActionSnapshotRestoreSpec) DeepCopy() *TridentActionSnapshotRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionSnapshotRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionSnapshotRestoreStatus) DeepCopyInto(out *TridentActionSnapshotRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionSnapshotRestoreStatus.
+func (in *TridentActionSnapshotRestoreStatus) DeepCopy() *TridentActionSnapshotRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionSnapshotRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentBackend) DeepCopyInto(out *TridentBackend) {
 	*out = *in
@@ -190,6 +295,70 @@ func (in *TridentBackendList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentGroupSnapshot) DeepCopyInto(out *TridentGroupSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentGroupSnapshot.
+func (in *TridentGroupSnapshot) DeepCopy() *TridentGroupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentGroupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentGroupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentGroupSnapshotList) DeepCopyInto(out *TridentGroupSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentGroupSnapshot, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentGroupSnapshot)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentGroupSnapshotList.
+func (in *TridentGroupSnapshotList) DeepCopy() *TridentGroupSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentGroupSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentGroupSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentMirrorRelationship) DeepCopyInto(out *TridentMirrorRelationship) {
 	*out = *in
@@ -346,6 +515,11 @@ func (in *TridentNode) DeepCopyInto(out *TridentNode) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.WWPNs != nil {
+		in, out := &in.WWPNs, &out.WWPNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.IPs != nil {
 		in, out := &in.IPs, &out.IPs
 		*out = make([]string, len(*in))
@@ -572,6 +746,70 @@ func (in *TridentSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicy) DeepCopyInto(out *TridentSnapshotPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicy.
+func (in *TridentSnapshotPolicy) DeepCopy() *TridentSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyList) DeepCopyInto(out *TridentSnapshotPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentSnapshotPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentSnapshotPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyList.
+func (in *TridentSnapshotPolicyList) DeepCopy() *TridentSnapshotPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentStorageClass) DeepCopyInto(out *TridentStorageClass) {
 	*out = *in
@@ -14,6 +14,10 @@ type FakeTridentV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeTridentV1) TridentActionSnapshotRestores(namespace string) v1.TridentActionSnapshotRestoreInterface {
+	return &FakeTridentActionSnapshotRestores{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentBackends(namespace string) v1.TridentBackendInterface {
 	return &FakeTridentBackends{c, namespace}
 }
@@ -22,6 +26,10 @@ func (c *FakeTridentV1) TridentBackendConfigs(namespace string) v1.TridentBacken
 	return &FakeTridentBackendConfigs{c, namespace}
 }
 
+func (c *FakeTridentV1) TridentGroupSnapshots(namespace string) v1.TridentGroupSnapshotInterface {
+	return &FakeTridentGroupSnapshots{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentMirrorRelationships(namespace string) v1.TridentMirrorRelationshipInterface {
 	return &FakeTridentMirrorRelationships{c, namespace}
 }
@@ -38,6 +46,10 @@ func (c *FakeTridentV1) TridentSnapshotInfos(namespace string) v1.TridentSnapsho
 	return &FakeTridentSnapshotInfos{c, namespace}
 }
 
+func (c *FakeTridentV1) TridentSnapshotPolicies(namespace string) v1.TridentSnapshotPolicyInterface {
+	return &FakeTridentSnapshotPolicies{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentStorageClasses(namespace string) v1.TridentStorageClassInterface {
 	return &FakeTridentStorageClasses{c, namespace}
 }
@@ -4,10 +4,14 @@
 
 package v1
 
+type TridentActionSnapshotRestoreExpansion interface{}
+
 type TridentBackendExpansion interface{}
 
 type TridentBackendConfigExpansion interface{}
 
+type TridentGroupSnapshotExpansion interface{}
+
 type TridentMirrorRelationshipExpansion interface{}
 
 type TridentNodeExpansion interface{}
@@ -16,6 +20,8 @@ type TridentSnapshotExpansion interface{}
 
 type TridentSnapshotInfoExpansion interface{}
 
+type TridentSnapshotPolicyExpansion interface{}
+
 type TridentStorageClassExpansion interface{}
 
 type TridentTransactionExpansion interface{}
@@ -12,12 +12,15 @@ import (
 
 type TridentV1Interface interface {
 	RESTClient() rest.Interface
+	TridentActionSnapshotRestoresGetter
 	TridentBackendsGetter
 	TridentBackendConfigsGetter
+	TridentGroupSnapshotsGetter
 	TridentMirrorRelationshipsGetter
 	TridentNodesGetter
 	TridentSnapshotsGetter
 	TridentSnapshotInfosGetter
+	TridentSnapshotPoliciesGetter
 	TridentStorageClassesGetter
 	TridentTransactionsGetter
 	TridentVersionsGetter
@@ -31,6 +34,10 @@ type TridentV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *TridentV1Client) TridentActionSnapshotRestores(namespace string) TridentActionSnapshotRestoreInterface {
+	return newTridentActionSnapshotRestores(c, namespace)
+}
+
 func (c *TridentV1Client) TridentBackends(namespace string) TridentBackendInterface {
 	return newTridentBackends(c, namespace)
 }
@@ -39,6 +46,10 @@ func (c *TridentV1Client) TridentBackendConfigs(namespace string) TridentBackend
 	return newTridentBackendConfigs(c, namespace)
 }
 
+func (c *TridentV1Client) TridentGroupSnapshots(namespace string) TridentGroupSnapshotInterface {
+	return newTridentGroupSnapshots(c, namespace)
+}
+
 func (c *TridentV1Client) TridentMirrorRelationships(namespace string) TridentMirrorRelationshipInterface {
 	return newTridentMirrorRelationships(c, namespace)
 }
@@ -55,6 +66,10 @@ func (c *TridentV1Client) TridentSnapshotInfos(namespace string) TridentSnapshot
 	return newTridentSnapshotInfos(c, namespace)
 }
 
+func (c *TridentV1Client) TridentSnapshotPolicies(namespace string) TridentSnapshotPolicyInterface {
+	return newTridentSnapshotPolicies(c, namespace)
+}
+
 func (c *TridentV1Client) TridentStorageClasses(namespace string) TridentStorageClassInterface {
 	return newTridentStorageClasses(c, namespace)
 }
@@ -39,10 +39,14 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=trident.netapp.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("tridentactionsnapshotrestores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentActionSnapshotRestores().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentbackends"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentBackends().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentbackendconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentBackendConfigs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentgroupsnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentGroupSnapshots().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentmirrorrelationships"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentMirrorRelationships().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentnodes"):
@@ -51,6 +55,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshots().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentsnapshotinfos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshotInfos().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentsnapshotpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshotPolicies().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentstorageclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentStorageClasses().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridenttransactions"):
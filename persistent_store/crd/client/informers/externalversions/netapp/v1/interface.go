@@ -10,10 +10,14 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// TridentActionSnapshotRestores returns a TridentActionSnapshotRestoreInformer.
+	TridentActionSnapshotRestores() TridentActionSnapshotRestoreInformer
 	// TridentBackends returns a TridentBackendInformer.
 	TridentBackends() TridentBackendInformer
 	// TridentBackendConfigs returns a TridentBackendConfigInformer.
 	TridentBackendConfigs() TridentBackendConfigInformer
+	// TridentGroupSnapshots returns a TridentGroupSnapshotInformer.
+	TridentGroupSnapshots() TridentGroupSnapshotInformer
 	// TridentMirrorRelationships returns a TridentMirrorRelationshipInformer.
 	TridentMirrorRelationships() TridentMirrorRelationshipInformer
 	// TridentNodes returns a TridentNodeInformer.
@@ -22,6 +26,8 @@ type Interface interface {
 	TridentSnapshots() TridentSnapshotInformer
 	// TridentSnapshotInfos returns a TridentSnapshotInfoInformer.
 	TridentSnapshotInfos() TridentSnapshotInfoInformer
+	// TridentSnapshotPolicies returns a TridentSnapshotPolicyInformer.
+	TridentSnapshotPolicies() TridentSnapshotPolicyInformer
 	// TridentStorageClasses returns a TridentStorageClassInformer.
 	TridentStorageClasses() TridentStorageClassInformer
 	// TridentTransactions returns a TridentTransactionInformer.
@@ -47,6 +53,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// TridentActionSnapshotRestores returns a TridentActionSnapshotRestoreInformer.
+func (v *version) TridentActionSnapshotRestores() TridentActionSnapshotRestoreInformer {
+	return &tridentActionSnapshotRestoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentBackends returns a TridentBackendInformer.
 func (v *version) TridentBackends() TridentBackendInformer {
 	return &tridentBackendInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -57,6 +68,11 @@ func (v *version) TridentBackendConfigs() TridentBackendConfigInformer {
 	return &tridentBackendConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TridentGroupSnapshots returns a TridentGroupSnapshotInformer.
+func (v *version) TridentGroupSnapshots() TridentGroupSnapshotInformer {
+	return &tridentGroupSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentMirrorRelationships returns a TridentMirrorRelationshipInformer.
 func (v *version) TridentMirrorRelationships() TridentMirrorRelationshipInformer {
 	return &tridentMirrorRelationshipInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -77,6 +93,11 @@ func (v *version) TridentSnapshotInfos() TridentSnapshotInfoInformer {
 	return &tridentSnapshotInfoInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TridentSnapshotPolicies returns a TridentSnapshotPolicyInformer.
+func (v *version) TridentSnapshotPolicies() TridentSnapshotPolicyInformer {
+	return &tridentSnapshotPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentStorageClasses returns a TridentStorageClassInformer.
 func (v *version) TridentStorageClasses() TridentStorageClassInformer {
 	return &tridentStorageClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
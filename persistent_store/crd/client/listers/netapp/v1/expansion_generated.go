@@ -4,6 +4,14 @@
 
 package v1
 
+// TridentActionSnapshotRestoreListerExpansion allows custom methods to be added to
+// TridentActionSnapshotRestoreLister.
+type TridentActionSnapshotRestoreListerExpansion interface{}
+
+// TridentActionSnapshotRestoreNamespaceListerExpansion allows custom methods to be added to
+// TridentActionSnapshotRestoreNamespaceLister.
+type TridentActionSnapshotRestoreNamespaceListerExpansion interface{}
+
 // TridentBackendListerExpansion allows custom methods to be added to
 // TridentBackendLister.
 type TridentBackendListerExpansion interface{}
@@ -20,6 +28,14 @@ type TridentBackendConfigListerExpansion interface{}
 // TridentBackendConfigNamespaceLister.
 type TridentBackendConfigNamespaceListerExpansion interface{}
 
+// TridentGroupSnapshotListerExpansion allows custom methods to be added to
+// TridentGroupSnapshotLister.
+type TridentGroupSnapshotListerExpansion interface{}
+
+// TridentGroupSnapshotNamespaceListerExpansion allows custom methods to be added to
+// TridentGroupSnapshotNamespaceLister.
+type TridentGroupSnapshotNamespaceListerExpansion interface{}
+
 // TridentMirrorRelationshipListerExpansion allows custom methods to be added to
 // TridentMirrorRelationshipLister.
 type TridentMirrorRelationshipListerExpansion interface{}
@@ -52,6 +68,14 @@ type TridentSnapshotInfoListerExpansion interface{}
 // TridentSnapshotInfoNamespaceLister.
 type TridentSnapshotInfoNamespaceListerExpansion interface{}
 
+// TridentSnapshotPolicyListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyLister.
+type TridentSnapshotPolicyListerExpansion interface{}
+
+// TridentSnapshotPolicyNamespaceListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyNamespaceLister.
+type TridentSnapshotPolicyNamespaceListerExpansion interface{}
+
 // TridentStorageClassListerExpansion allows custom methods to be added to
 // TridentStorageClassLister.
 type TridentStorageClassListerExpansion interface{}
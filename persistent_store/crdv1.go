@@ -18,6 +18,7 @@ import (
 	tridentv1clientset "github.com/netapp/trident/persistent_store/crd/client/clientset/versioned"
 	"github.com/netapp/trident/storage"
 	storageclass "github.com/netapp/trident/storage_class"
+	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/utils"
 )
 
@@ -61,6 +62,12 @@ func NewCRDClientV1(masterURL, kubeConfigPath string) (*CRDClientV1, error) {
 		"tridentNamespace": clients.Namespace,
 	}).Trace("Created CRDv1 persistence client.")
 
+	return NewCRDClientV1FromClients(clients), nil
+}
+
+// NewCRDClientV1FromClients returns a CRDv1 persistent store client that uses existing Kubernetes clients, such as
+// those of tridentctl, which may be working with Trident in another namespace.
+func NewCRDClientV1FromClients(clients *clik8sclient.Clients) *CRDClientV1 {
 	return &CRDClientV1{
 		crdClient: clients.TridentClient,
 		k8sClient: clients.K8SClient,
@@ -69,7 +76,7 @@ func NewCRDClientV1(masterURL, kubeConfigPath string) (*CRDClientV1, error) {
 			OrchestratorAPIVersion: config.OrchestratorAPIVersion,
 		},
 		namespace: clients.Namespace,
-	}, err
+	}
 }
 
 func (k *CRDClientV1) GetTridentUUID(ctx context.Context) (string, error) {
@@ -175,6 +182,14 @@ func (k *CRDClientV1) AddBackend(ctx context.Context, backend storage.Backend) e
 	return k.addBackendPersistent(ctx, backend.ConstructPersistent(ctx))
 }
 
+// AddBackendPersistent accepts a backend in its persistent form, such as one read from another store, and persists
+// it in the same way as AddBackend.
+func (k *CRDClientV1) AddBackendPersistent(ctx context.Context, backendPersistent *storage.BackendPersistent) error {
+	Logc(ctx).WithField("backend.Name", backendPersistent.Name).Debug("AddBackendPersistent.")
+
+	return k.addBackendPersistent(ctx, backendPersistent)
+}
+
 // addBackendPersistent is the internal method shared by AddBackend and AddBackendPersistent.
 func (k *CRDClientV1) addBackendPersistent(ctx context.Context, backendPersistent *storage.BackendPersistent) error {
 	// Ensure the backend doesn't already exist
@@ -381,16 +396,19 @@ func (k *CRDClientV1) addSecretToBackend(
 		"handler":                       "Bootstrap",
 	}
 
-	var secretName string
+	var secretName, secretType string
 	var err error
 
 	// Check if user-provided credentials are in use
-	if secretName, _, err = backendPersistent.GetBackendCredentials(); err != nil {
+	if secretName, secretType, err = backendPersistent.GetBackendCredentials(); err != nil {
 		Logc(ctx).WithFields(logFields).Errorf("Could determined if credentials field exist; %v", err)
 		return nil, err
 	} else if secretName == "" {
 		// Credentials field not set, use the default backend secret name
 		secretName = k.backendSecretName(backendPersistent.BackendUUID)
+	} else if secretType != string(drivers.CredentialStoreK8sSecret) {
+		// Credentials kept in an external store are read by the orchestrator when it creates the backend
+		return backendPersistent, nil
 	}
 
 	// Before retrieving the secret, ensure it exists.  If we find the secret does not exist, we
@@ -1551,3 +1569,180 @@ func (k *CRDClientV1) DeleteSnapshots(ctx context.Context) error {
 
 	return nil
 }
+
+// AddGroupSnapshot accepts a group snapshot, converts it to its persistent form, and writes it to the database.
+// As with AddSnapshot, an existing record left behind by an earlier attempt is replaced.
+func (k *CRDClientV1) AddGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	persistentGroupSnapshot, err := v1.NewTridentGroupSnapshot(groupSnapshot.ConstructPersistent())
+	if err != nil {
+		return err
+	}
+
+	_, err = k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Create(ctx, persistentGroupSnapshot,
+		createOpts)
+	if err == nil || !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	tgs, getErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Get(ctx,
+		persistentGroupSnapshot.Name, getOpts)
+	if getErr != nil {
+		if !errors.IsNotFound(getErr) {
+			return getErr
+		}
+	} else {
+		tgs = tgs.DeepCopy()
+		tgs.RemoveTridentFinalizers()
+		_, updateErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Update(ctx, tgs, updateOpts)
+		if updateErr != nil {
+			Logc(ctx).Errorf("Could not remove group snapshot finalizers; %v", updateErr)
+			return updateErr
+		}
+
+		deleteErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Delete(ctx, tgs.Name,
+			k.deleteOpts())
+		if deleteErr != nil {
+			Logc(ctx).Errorf("Could not delete group snapshot; %v", deleteErr)
+			return deleteErr
+		}
+	}
+
+	_, err = k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Create(ctx, persistentGroupSnapshot,
+		createOpts)
+	return err
+}
+
+func (k *CRDClientV1) GetGroupSnapshot(ctx context.Context, groupSnapshotName string) (
+	*storage.GroupSnapshotPersistent, error,
+) {
+	groupSnapshot, err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Get(ctx,
+		v1.NameFix(groupSnapshotName), getOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupSnapshot.Persistent()
+}
+
+func (k *CRDClientV1) GetGroupSnapshots(ctx context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	groupSnapshotList, err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*storage.GroupSnapshotPersistent, 0)
+
+	for _, item := range groupSnapshotList.Items {
+		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
+			Logc(ctx).WithFields(LogFields{
+				"Name":              item.Name,
+				"DeletionTimestamp": item.DeletionTimestamp,
+			}).Debug("GetGroupSnapshots skipping deleted GroupSnapshot")
+			continue
+		}
+
+		persistentGroupSnapshot, err := item.Persistent()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, persistentGroupSnapshot)
+	}
+
+	return results, nil
+}
+
+func (k *CRDClientV1) DeleteGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Delete(ctx, v1.NameFix(groupSnapshot.ID()),
+		k.deleteOpts())
+
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (k *CRDClientV1) AddSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	persistentPolicy, err := v1.NewTridentSnapshotPolicy(policy.ConstructPersistent())
+	if err != nil {
+		return err
+	}
+
+	_, err = k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).Create(ctx, persistentPolicy, createOpts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k *CRDClientV1) GetSnapshotPolicy(ctx context.Context, policyName string) (
+	*storage.SnapshotPolicyPersistent, error,
+) {
+	policy, err := k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).Get(ctx, v1.NameFix(policyName),
+		getOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.Persistent()
+}
+
+func (k *CRDClientV1) GetSnapshotPolicies(ctx context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	policyList, err := k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*storage.SnapshotPolicyPersistent, 0)
+
+	for _, item := range policyList.Items {
+		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
+			Logc(ctx).WithFields(LogFields{
+				"Name":              item.Name,
+				"DeletionTimestamp": item.DeletionTimestamp,
+			}).Debug("GetSnapshotPolicies skipping deleted SnapshotPolicy")
+			continue
+		}
+
+		persistentPolicy, err := item.Persistent()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, persistentPolicy)
+	}
+
+	return results, nil
+}
+
+func (k *CRDClientV1) UpdateSnapshotPolicy(ctx context.Context, update *storage.SnapshotPolicy) error {
+	policy, err := k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).Get(ctx, v1.NameFix(update.ID()),
+		getOpts)
+	if err != nil {
+		return err
+	}
+
+	if err = policy.Apply(update.ConstructPersistent()); err != nil {
+		return err
+	}
+
+	_, err = k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).Update(ctx, policy, updateOpts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k *CRDClientV1) DeleteSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	err := k.crdClient.TridentV1().TridentSnapshotPolicies(k.namespace).Delete(ctx, v1.NameFix(policy.ID()),
+		k.deleteOpts())
+
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -3,7 +3,12 @@
 package persistentstore
 
 import (
+	"context"
+	"fmt"
+
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 )
 
 type DataMigrator struct {
@@ -20,9 +25,350 @@ func NewDataMigrator(SourceClient, DestClient Client, dryRun bool) *DataMigrator
 	}
 }
 
+// Run copies all of the orchestrator state from the source store to the destination store, which must not already
+// hold any of the objects being copied.  The source store is not changed, and the destination keeps its own Trident
+// UUID.  In a dry run, the objects that would be copied are only logged.
 func (m *DataMigrator) Run() error {
-	// Determine if this is a supported data migration.
-	Log().Debug("DataMigrator does not currently support any migrations.")
+	ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowStorageClientCreate,
+		LogLayerPersistentStore)
+
+	sourceType, destType := m.SourceClient.GetType(), m.DestClient.GetType()
+	logFields := LogFields{
+		"source":      sourceType,
+		"destination": destType,
+		"dryRun":      m.dryRun,
+	}
+
+	// Only stores that keep all of the orchestrator state may take part in a migration
+	for _, storeType := range []StoreType{sourceType, destType} {
+		if storeType != CRDV1Store && storeType != FileStore && storeType != MemoryStore {
+			return fmt.Errorf("data migration is not supported for the %s store", storeType)
+		}
+	}
+	if sourceType == destType {
+		return fmt.Errorf("data migration requires different source and destination stores")
+	}
+
+	backendAdder, ok := m.DestClient.(BackendPersistentAdder)
+	if !ok {
+		return fmt.Errorf("the %s store cannot accept migrated backends", destType)
+	}
+
+	if err := m.checkDestinationConflicts(ctx); err != nil {
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Info("Migrating persistent state.")
+
+	if err := m.migrateBackends(ctx, backendAdder); err != nil {
+		return err
+	}
+	if err := m.migrateStorageClasses(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateVolumes(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateVolumeTransactions(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateNodes(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateVolumePublications(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateSnapshots(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateGroupSnapshots(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateSnapshotPolicies(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateVersion(ctx); err != nil {
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Info("Migrated persistent state.")
+
+	return nil
+}
+
+// checkDestinationConflicts ensures the destination store holds none of the objects in the source store, so that a
+// migration cannot overwrite state the destination already has.  Like the orchestrator, the migrator treats a
+// missing key as an empty list.
+func (m *DataMigrator) checkDestinationConflicts(ctx context.Context) error {
+	sourceNames, err := getStoreObjectNames(ctx, m.SourceClient)
+	if err != nil {
+		return fmt.Errorf("could not read the source store; %v", err)
+	}
+	destNames, err := getStoreObjectNames(ctx, m.DestClient)
+	if err != nil {
+		return fmt.Errorf("could not read the destination store; %v", err)
+	}
+
+	for kind, names := range sourceNames {
+		existing := make(map[string]bool, len(destNames[kind]))
+		for _, name := range destNames[kind] {
+			existing[name] = true
+		}
+		for _, name := range names {
+			if existing[name] {
+				return fmt.Errorf("the destination %s store already has %s %s", m.DestClient.GetType(), kind, name)
+			}
+		}
+	}
+	return nil
+}
+
+// getStoreObjectNames returns the names of the objects in a store that a migration copies, by kind.
+func getStoreObjectNames(ctx context.Context, client Client) (map[string][]string, error) {
+	names := make(map[string][]string)
+
+	backends, err := client.GetBackends(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, backend := range backends {
+		names["backend"] = append(names["backend"], backend.Name)
+	}
+
+	storageClasses, err := client.GetStorageClasses(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, storageClass := range storageClasses {
+		names["storage class"] = append(names["storage class"], storageClass.GetName())
+	}
+
+	volumes, err := client.GetVolumes(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		names["volume"] = append(names["volume"], volume.Config.Name)
+	}
+
+	txns, err := client.GetVolumeTransactions(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, txn := range txns {
+		names["volume transaction"] = append(names["volume transaction"], txn.Name())
+	}
+
+	publications, err := client.GetVolumePublications(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, publication := range publications {
+		names["volume publication"] = append(names["volume publication"], publication.Name)
+	}
+
+	snapshots, err := client.GetSnapshots(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		names["snapshot"] = append(names["snapshot"], snapshot.ID())
+	}
 
-	return nil // crdDataMigrator.Run()
+	groupSnapshots, err := client.GetGroupSnapshots(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, groupSnapshot := range groupSnapshots {
+		names["group snapshot"] = append(names["group snapshot"], groupSnapshot.ID())
+	}
+
+	policies, err := client.GetSnapshotPolicies(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	for _, policy := range policies {
+		names["snapshot policy"] = append(names["snapshot policy"], policy.ID())
+	}
+
+	return names, nil
+}
+
+// migrateBackends copies the backends, including any credentials the source store keeps apart from them.
+func (m *DataMigrator) migrateBackends(ctx context.Context, backendAdder BackendPersistentAdder) error {
+	backends, err := m.SourceClient.GetBackends(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read backends from the source store; %v", err)
+	}
+	for _, backend := range backends {
+		Logc(ctx).WithField("backend", backend.Name).Debug("Migrating backend.")
+		if m.dryRun {
+			continue
+		}
+
+		if err = backendAdder.AddBackendPersistent(ctx, backend); err != nil {
+			return fmt.Errorf("could not migrate backend %s; %v", backend.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateStorageClasses(ctx context.Context) error {
+	storageClasses, err := m.SourceClient.GetStorageClasses(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read storage classes from the source store; %v", err)
+	}
+	for _, persistent := range storageClasses {
+		Logc(ctx).WithField("storageClass", persistent.GetName()).Debug("Migrating storage class.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddStorageClass(ctx, storageclass.NewFromPersistent(persistent)); err != nil {
+			return fmt.Errorf("could not migrate storage class %s; %v", persistent.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateVolumes(ctx context.Context) error {
+	volumes, err := m.SourceClient.GetVolumes(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read volumes from the source store; %v", err)
+	}
+	for _, external := range volumes {
+		Logc(ctx).WithField("volume", external.Config.Name).Debug("Migrating volume.")
+		if m.dryRun {
+			continue
+		}
+		volume := storage.NewVolume(external.Config, external.BackendUUID, external.Pool, external.Orphaned,
+			external.State)
+		if err = m.DestClient.AddVolume(ctx, volume); err != nil {
+			return fmt.Errorf("could not migrate volume %s; %v", external.Config.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateVolumeTransactions(ctx context.Context) error {
+	txns, err := m.SourceClient.GetVolumeTransactions(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read volume transactions from the source store; %v", err)
+	}
+	for _, txn := range txns {
+		Logc(ctx).WithField("transaction", txn.Name()).Debug("Migrating volume transaction.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddVolumeTransaction(ctx, txn); err != nil {
+			return fmt.Errorf("could not migrate volume transaction %s; %v", txn.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateNodes(ctx context.Context) error {
+	nodes, err := m.SourceClient.GetNodes(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read nodes from the source store; %v", err)
+	}
+	for _, node := range nodes {
+		Logc(ctx).WithField("node", node.Name).Debug("Migrating node.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddOrUpdateNode(ctx, node); err != nil {
+			return fmt.Errorf("could not migrate node %s; %v", node.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateVolumePublications(ctx context.Context) error {
+	publications, err := m.SourceClient.GetVolumePublications(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read volume publications from the source store; %v", err)
+	}
+	for _, publication := range publications {
+		Logc(ctx).WithField("publication", publication.Name).Debug("Migrating volume publication.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddVolumePublication(ctx, publication); err != nil {
+			return fmt.Errorf("could not migrate volume publication %s; %v", publication.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateSnapshots(ctx context.Context) error {
+	snapshots, err := m.SourceClient.GetSnapshots(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read snapshots from the source store; %v", err)
+	}
+	for _, persistent := range snapshots {
+		Logc(ctx).WithField("snapshot", persistent.ID()).Debug("Migrating snapshot.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddSnapshot(ctx, &persistent.Snapshot); err != nil {
+			return fmt.Errorf("could not migrate snapshot %s; %v", persistent.ID(), err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateGroupSnapshots(ctx context.Context) error {
+	groupSnapshots, err := m.SourceClient.GetGroupSnapshots(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read group snapshots from the source store; %v", err)
+	}
+	for _, persistent := range groupSnapshots {
+		Logc(ctx).WithField("groupSnapshot", persistent.ID()).Debug("Migrating group snapshot.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddGroupSnapshot(ctx, &persistent.GroupSnapshot); err != nil {
+			return fmt.Errorf("could not migrate group snapshot %s; %v", persistent.ID(), err)
+		}
+	}
+	return nil
+}
+
+func (m *DataMigrator) migrateSnapshotPolicies(ctx context.Context) error {
+	policies, err := m.SourceClient.GetSnapshotPolicies(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read snapshot policies from the source store; %v", err)
+	}
+	for _, persistent := range policies {
+		Logc(ctx).WithField("snapshotPolicy", persistent.ID()).Debug("Migrating snapshot policy.")
+		if m.dryRun {
+			continue
+		}
+		if err = m.DestClient.AddSnapshotPolicy(ctx, &persistent.SnapshotPolicy); err != nil {
+			return fmt.Errorf("could not migrate snapshot policy %s; %v", persistent.ID(), err)
+		}
+	}
+	return nil
+}
+
+// migrateVersion copies the persistent state version, which records whether publications have been synced, and
+// marks it as belonging to the destination store.
+func (m *DataMigrator) migrateVersion(ctx context.Context) error {
+	version, err := m.SourceClient.GetVersion(ctx)
+	if err != nil {
+		if MatchKeyNotFoundErr(err) {
+			return nil
+		}
+		return fmt.Errorf("could not read the persistent state version from the source store; %v", err)
+	}
+	if m.dryRun {
+		return nil
+	}
+
+	versionCopy := *version
+	versionCopy.PersistentStoreVersion = string(m.DestClient.GetType())
+	if err = m.DestClient.SetVersion(ctx, &versionCopy); err != nil {
+		return fmt.Errorf("could not migrate the persistent state version; %v", err)
+	}
+	return nil
 }
@@ -0,0 +1,951 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// FileStoreSchemaVersion is the layout of the file written by the file store.  Files written with an older
+	// schema are upgraded when they are opened; files written with a newer schema are refused.
+	FileStoreSchemaVersion = 1
+
+	// The log is compacted once it holds at least fileStoreCompactionMinRecords records, and more than
+	// fileStoreCompactionRatio records for every live key.
+	fileStoreCompactionMinRecords = 1000
+	fileStoreCompactionRatio      = 2
+
+	fileStoreUUIDKey                = "uuid"
+	fileStoreVersionKey             = "version"
+	fileStoreBackendsPrefix         = "backends/"
+	fileStoreVolumesPrefix          = "volumes/"
+	fileStoreTransactionsPrefix     = "transactions/"
+	fileStoreStorageClassesPrefix   = "storageClasses/"
+	fileStoreNodesPrefix            = "nodes/"
+	fileStorePublicationsPrefix     = "publications/"
+	fileStoreSnapshotsPrefix        = "snapshots/"
+	fileStoreGroupSnapshotsPrefix   = "groupSnapshots/"
+	fileStoreSnapshotPoliciesPrefix = "snapshotPolicies/"
+)
+
+// fileStoreHeader is the first line of the file store.
+type fileStoreHeader struct {
+	Schema int `json:"schema"`
+}
+
+// fileStoreRecord is one line of the file store, which atomically deletes and then sets any number of keys.
+type fileStoreRecord struct {
+	Delete []string                   `json:"delete,omitempty"`
+	Set    map[string]json.RawMessage `json:"set,omitempty"`
+}
+
+// FileClient is a persistent store kept in a local file, for running Trident with all of its state outside of
+// Kubernetes.  The file is a log of JSON records, each of which changes one or more keys, after a header that holds
+// the schema version.  Every change is appended and synced to disk before it is acknowledged, and a record left
+// incomplete by a crash is discarded when the file is opened.  Once the log holds many more records than live keys,
+// it is compacted by writing the live keys to a new file that atomically replaces the old one.  Only one process may
+// open the store at a time, which is enforced with an exclusive lock on a file beside it, as compaction replaces the
+// store itself.
+type FileClient struct {
+	path     string
+	file     *os.File
+	lockFile *os.File
+	data     map[string]json.RawMessage
+	records  int
+	mutex    sync.Mutex
+}
+
+// NewFileClient returns a store kept in the file at the specified path, which is created if it does not exist.  It
+// fails if another process has the store open.
+func NewFileClient(path string) (*FileClient, error) {
+	ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowStorageClientCreate,
+		LogLayerPersistentStore)
+
+	if path == "" {
+		return nil, fmt.Errorf("the file store requires a path")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("could not create the file store directory; %v", err)
+	}
+
+	c := &FileClient{
+		path: path,
+		data: make(map[string]json.RawMessage),
+	}
+
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	if err := c.open(ctx); err != nil {
+		_ = c.Stop()
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"path": path,
+		"keys": len(c.data),
+	}).Debug("Opened file store.")
+
+	return c, nil
+}
+
+// lock takes the exclusive lock on the store, without waiting for another process to release it.
+func (c *FileClient) lock() error {
+	lockPath := c.path + ".lock"
+	lockFile, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open the file store lock %s; %v", lockPath, err)
+	}
+	if err = lockFileExclusive(lockFile); err != nil {
+		_ = lockFile.Close()
+		return fmt.Errorf("the file store %s is in use by another process; %v", c.path, err)
+	}
+	c.lockFile = lockFile
+	return nil
+}
+
+// open reads the store into memory and opens it for appending, rewriting it first if needed.
+func (c *FileClient) open(ctx context.Context) error {
+	contents, err := os.ReadFile(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("could not read the file store %s; %v", c.path, err)
+	}
+
+	rewrite := true
+	if err == nil {
+		if rewrite, err = c.load(ctx, contents); err != nil {
+			return err
+		}
+	}
+
+	// A new store is given its UUID when it is created
+	if _, ok := c.data[fileStoreUUIDKey]; !ok {
+		rawUUID, _ := json.Marshal(uuid.NewString())
+		c.data[fileStoreUUIDKey] = rawUUID
+		rewrite = true
+	}
+
+	if rewrite {
+		return c.compact(ctx)
+	}
+	if c.file, err = os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND, 0o600); err != nil {
+		return fmt.Errorf("could not open the file store %s; %v", c.path, err)
+	}
+	return nil
+}
+
+// load replays the records of the file store into memory, and returns whether the file should be rewritten, which
+// is the case if it held an incomplete record or was written with an older schema.
+func (c *FileClient) load(ctx context.Context, contents []byte) (bool, error) {
+	// Anything after the last newline is an incomplete record, which was never acknowledged
+	rewrite := false
+	if end := bytes.LastIndexByte(contents, '\n'); end+1 < len(contents) {
+		Logc(ctx).WithField("path", c.path).Warning("Discarding an incomplete record at the end of the file store.")
+		contents = contents[:end+1]
+		rewrite = true
+	}
+
+	lines := bytes.Split(bytes.TrimSuffix(contents, []byte("\n")), []byte("\n"))
+	if len(lines[0]) == 0 {
+		// The store was created, but not even its header was written
+		return true, nil
+	}
+
+	var header fileStoreHeader
+	if err := json.Unmarshal(lines[0], &header); err != nil {
+		return false, fmt.Errorf("could not read the header of the file store %s; %v", c.path, err)
+	}
+	if header.Schema > FileStoreSchemaVersion {
+		return false, fmt.Errorf("the file store %s has schema %d, but this Trident supports schema %d or older",
+			c.path, header.Schema, FileStoreSchemaVersion)
+	} else if header.Schema < 1 {
+		return false, fmt.Errorf("the file store %s has an invalid schema %d", c.path, header.Schema)
+	}
+
+	for i, line := range lines[1:] {
+		var record fileStoreRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return false, fmt.Errorf("the file store %s is corrupt at line %d; %v", c.path, i+2, err)
+		}
+		c.apply(&record)
+		c.records++
+	}
+
+	if header.Schema < FileStoreSchemaVersion {
+		Logc(ctx).WithFields(LogFields{
+			"path":   c.path,
+			"schema": header.Schema,
+		}).Info("Upgrading the file store schema.")
+		rewrite = true
+	}
+
+	return rewrite, nil
+}
+
+// apply makes the changes of a record to the keys held in memory.
+func (c *FileClient) apply(record *fileStoreRecord) {
+	for _, key := range record.Delete {
+		delete(c.data, key)
+	}
+	for key, value := range record.Set {
+		c.data[key] = value
+	}
+}
+
+// compact writes the live keys to a new file, which replaces the store.  The caller should hold the mutex.
+func (c *FileClient) compact(ctx context.Context) error {
+	tempPath := c.path + ".tmp"
+	tempFile, err := os.OpenFile(tempPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not create the file store %s; %v", tempPath, err)
+	}
+
+	writeErr := func() error {
+		encoder := json.NewEncoder(tempFile)
+		if err := encoder.Encode(&fileStoreHeader{Schema: FileStoreSchemaVersion}); err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(c.data))
+		for key := range c.data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := encoder.Encode(&fileStoreRecord{Set: map[string]json.RawMessage{key: c.data[key]}}); err != nil {
+				return err
+			}
+		}
+		return tempFile.Sync()
+	}()
+	if closeErr := tempFile.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("could not write the file store %s; %v", tempPath, writeErr)
+	}
+
+	if err = os.Rename(tempPath, c.path); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("could not replace the file store %s; %v", c.path, err)
+	}
+	c.syncDir(ctx)
+
+	file, err := os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open the file store %s; %v", c.path, err)
+	}
+	if c.file != nil {
+		_ = c.file.Close()
+	}
+	c.file = file
+	c.records = len(c.data)
+
+	Logc(ctx).WithFields(LogFields{
+		"path": c.path,
+		"keys": len(c.data),
+	}).Debug("Compacted file store.")
+
+	return nil
+}
+
+// syncDir syncs the directory of the store, so that the rename of a compacted file survives a crash.
+func (c *FileClient) syncDir(ctx context.Context) {
+	dir, err := os.Open(filepath.Dir(c.path))
+	if err != nil {
+		Logc(ctx).WithError(err).Warning("Could not open the file store directory.")
+		return
+	}
+	defer func() { _ = dir.Close() }()
+	if err = dir.Sync(); err != nil {
+		Logc(ctx).WithError(err).Debug("Could not sync the file store directory.")
+	}
+}
+
+// commit durably appends a record to the store before applying it to the keys held in memory.  The caller should
+// hold the mutex.
+func (c *FileClient) commit(ctx context.Context, record *fileStoreRecord) error {
+	if c.file == nil {
+		return fmt.Errorf("the file store %s is closed", c.path)
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if _, err = c.file.Write(line); err == nil {
+		err = c.file.Sync()
+	}
+	if err != nil {
+		// Rewrite the store, so that later records do not follow one that may be incomplete
+		if compactErr := c.compact(ctx); compactErr != nil {
+			Logc(ctx).WithError(compactErr).Error("Could not repair the file store.")
+		}
+		return fmt.Errorf("could not write to the file store %s; %v", c.path, err)
+	}
+
+	c.apply(record)
+	c.records++
+
+	if c.records >= fileStoreCompactionMinRecords && c.records > fileStoreCompactionRatio*len(c.data) {
+		if err = c.compact(ctx); err != nil {
+			// The record is durable, so only the compaction failed
+			Logc(ctx).WithError(err).Warning("Could not compact the file store.")
+		}
+	}
+
+	return nil
+}
+
+// set durably stores a value under a key.  The caller should hold the mutex.
+func (c *FileClient) set(ctx context.Context, key string, value interface{}) error {
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.commit(ctx, &fileStoreRecord{Set: map[string]json.RawMessage{key: rawValue}})
+}
+
+// delete durably removes keys.  Keys that do not exist are ignored.  The caller should hold the mutex.
+func (c *FileClient) delete(ctx context.Context, keys ...string) error {
+	existingKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := c.data[key]; ok {
+			existingKeys = append(existingKeys, key)
+		}
+	}
+	if len(existingKeys) == 0 {
+		return nil
+	}
+	return c.commit(ctx, &fileStoreRecord{Delete: existingKeys})
+}
+
+// get reads the value of a key into value, and returns whether the key exists.  The caller should hold the mutex.
+func (c *FileClient) get(key string, value interface{}) (bool, error) {
+	rawValue, ok := c.data[key]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(rawValue, value); err != nil {
+		return true, fmt.Errorf("could not read %s from the file store; %v", key, err)
+	}
+	return true, nil
+}
+
+// keys returns the keys with a prefix, in order.  The caller should hold the mutex.
+func (c *FileClient) keys(prefix string) []string {
+	keys := make([]string, 0)
+	for key := range c.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *FileClient) GetType() StoreType {
+	return FileStore
+}
+
+// Stop closes the store and releases its lock, after which it may not be used.
+func (c *FileClient) Stop() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var err error
+	if c.file != nil {
+		err = c.file.Close()
+		c.file = nil
+	}
+	if c.lockFile != nil {
+		if closeErr := c.lockFile.Close(); err == nil {
+			err = closeErr
+		}
+		c.lockFile = nil
+	}
+	return err
+}
+
+func (c *FileClient) GetConfig() *ClientConfig {
+	return &ClientConfig{}
+}
+
+func (c *FileClient) GetTridentUUID(context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var tridentUUID string
+	if ok, err := c.get(fileStoreUUIDKey, &tridentUUID); err != nil {
+		return "", err
+	} else if !ok {
+		return "", NewPersistentStoreError(KeyNotFoundErr, fileStoreUUIDKey)
+	}
+	return tridentUUID, nil
+}
+
+func (c *FileClient) GetVersion(context.Context) (*config.PersistentStateVersion, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	version := &config.PersistentStateVersion{}
+	if ok, err := c.get(fileStoreVersionKey, version); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, fileStoreVersionKey)
+	}
+	return version, nil
+}
+
+func (c *FileClient) SetVersion(ctx context.Context, version *config.PersistentStateVersion) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.set(ctx, fileStoreVersionKey, version)
+}
+
+func (c *FileClient) AddBackend(ctx context.Context, b storage.Backend) error {
+	return c.AddBackendPersistent(ctx, b.ConstructPersistent(ctx))
+}
+
+// AddBackendPersistent adds a backend in its persistent form, such as one read from another store.
+func (c *FileClient) AddBackendPersistent(ctx context.Context, backend *storage.BackendPersistent) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreBackendsPrefix+backend.Name]; ok {
+		return fmt.Errorf("backend %s already exists", backend.Name)
+	}
+	if backend.BackendUUID == "" {
+		return fmt.Errorf("backend %s does not have a UUID set", backend.Name)
+	}
+	return c.set(ctx, fileStoreBackendsPrefix+backend.Name, backend)
+}
+
+func (c *FileClient) GetBackend(_ context.Context, backendName string) (*storage.BackendPersistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backend := &storage.BackendPersistent{}
+	if ok, err := c.get(fileStoreBackendsPrefix+backendName, backend); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, backendName)
+	}
+	return backend, nil
+}
+
+// GetBackendSecret returns nothing, because the file store keeps the credentials of a backend with the backend.
+func (c *FileClient) GetBackendSecret(context.Context, string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (c *FileClient) UpdateBackend(ctx context.Context, b storage.Backend) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreBackendsPrefix+b.Name()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, b.Name())
+	}
+	return c.set(ctx, fileStoreBackendsPrefix+b.Name(), b.ConstructPersistent(ctx))
+}
+
+func (c *FileClient) DeleteBackend(ctx context.Context, b storage.Backend) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreBackendsPrefix+b.Name())
+}
+
+// IsBackendDeleting returns false, because backends in the file store are deleted at once.
+func (c *FileClient) IsBackendDeleting(context.Context, storage.Backend) bool {
+	return false
+}
+
+func (c *FileClient) GetBackends(context.Context) ([]*storage.BackendPersistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	backends := make([]*storage.BackendPersistent, 0)
+	for _, key := range c.keys(fileStoreBackendsPrefix) {
+		backend := &storage.BackendPersistent{}
+		if _, err := c.get(key, backend); err != nil {
+			return nil, err
+		}
+		backends = append(backends, backend)
+	}
+	return backends, nil
+}
+
+func (c *FileClient) DeleteBackends(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, c.keys(fileStoreBackendsPrefix)...)
+}
+
+// ReplaceBackendAndUpdateVolumes replaces a backend, which may have been renamed, in a single record.  Volumes refer
+// to their backend by UUID, so they need no update.
+func (c *FileClient) ReplaceBackendAndUpdateVolumes(
+	ctx context.Context, origBackend, newBackend storage.Backend,
+) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreBackendsPrefix+origBackend.Name()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, origBackend.Name())
+	}
+
+	rawBackend, err := json.Marshal(newBackend.ConstructPersistent(ctx))
+	if err != nil {
+		return err
+	}
+	return c.commit(ctx, &fileStoreRecord{
+		Delete: []string{fileStoreBackendsPrefix + origBackend.Name()},
+		Set:    map[string]json.RawMessage{fileStoreBackendsPrefix + newBackend.Name(): rawBackend},
+	})
+}
+
+// AddVolume writes a volume to the store, replacing any record of a volume with the same name.
+func (c *FileClient) AddVolume(ctx context.Context, vol *storage.Volume) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.set(ctx, fileStoreVolumesPrefix+vol.Config.Name, vol.ConstructExternal())
+}
+
+func (c *FileClient) GetVolume(_ context.Context, volName string) (*storage.VolumeExternal, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	volume := &storage.VolumeExternal{}
+	if ok, err := c.get(fileStoreVolumesPrefix+volName, volume); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, volName)
+	}
+	return volume, nil
+}
+
+func (c *FileClient) GetVolumes(context.Context) ([]*storage.VolumeExternal, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	volumes := make([]*storage.VolumeExternal, 0)
+	for _, key := range c.keys(fileStoreVolumesPrefix) {
+		volume := &storage.VolumeExternal{}
+		if _, err := c.get(key, volume); err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+func (c *FileClient) UpdateVolume(ctx context.Context, vol *storage.Volume) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreVolumesPrefix+vol.Config.Name]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, vol.Config.Name)
+	}
+	return c.set(ctx, fileStoreVolumesPrefix+vol.Config.Name, vol.ConstructExternal())
+}
+
+func (c *FileClient) DeleteVolume(ctx context.Context, vol *storage.Volume) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreVolumesPrefix+vol.Config.Name)
+}
+
+func (c *FileClient) DeleteVolumes(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, c.keys(fileStoreVolumesPrefix)...)
+}
+
+func (c *FileClient) AddVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreTransactionsPrefix+volTxn.Name()]; ok {
+		return NewAlreadyExistsError("volume transaction", volTxn.Name())
+	}
+	return c.set(ctx, fileStoreTransactionsPrefix+volTxn.Name(), volTxn)
+}
+
+func (c *FileClient) GetVolumeTransaction(
+	_ context.Context, volTxn *storage.VolumeTransaction,
+) (*storage.VolumeTransaction, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	txn := &storage.VolumeTransaction{}
+	if ok, err := c.get(fileStoreTransactionsPrefix+volTxn.Name(), txn); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, nil
+	}
+	return txn, nil
+}
+
+func (c *FileClient) GetVolumeTransactions(context.Context) ([]*storage.VolumeTransaction, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	txns := make([]*storage.VolumeTransaction, 0)
+	for _, key := range c.keys(fileStoreTransactionsPrefix) {
+		txn := &storage.VolumeTransaction{}
+		if _, err := c.get(key, txn); err != nil {
+			return nil, err
+		}
+		txns = append(txns, txn)
+	}
+	return txns, nil
+}
+
+func (c *FileClient) UpdateVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreTransactionsPrefix+volTxn.Name()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, volTxn.Name())
+	}
+	return c.set(ctx, fileStoreTransactionsPrefix+volTxn.Name(), volTxn)
+}
+
+func (c *FileClient) DeleteVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreTransactionsPrefix+volTxn.Name())
+}
+
+func (c *FileClient) AddStorageClass(ctx context.Context, s *sc.StorageClass) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreStorageClassesPrefix+s.GetName()]; ok {
+		return fmt.Errorf("storage class %s already exists", s.GetName())
+	}
+	return c.set(ctx, fileStoreStorageClassesPrefix+s.GetName(), s.ConstructPersistent())
+}
+
+func (c *FileClient) GetStorageClass(_ context.Context, scName string) (*sc.Persistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	storageClass := &sc.Persistent{}
+	if ok, err := c.get(fileStoreStorageClassesPrefix+scName, storageClass); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, scName)
+	}
+	return storageClass, nil
+}
+
+func (c *FileClient) GetStorageClasses(context.Context) ([]*sc.Persistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	storageClasses := make([]*sc.Persistent, 0)
+	for _, key := range c.keys(fileStoreStorageClassesPrefix) {
+		storageClass := &sc.Persistent{}
+		if _, err := c.get(key, storageClass); err != nil {
+			return nil, err
+		}
+		storageClasses = append(storageClasses, storageClass)
+	}
+	return storageClasses, nil
+}
+
+func (c *FileClient) DeleteStorageClass(ctx context.Context, s *sc.StorageClass) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreStorageClassesPrefix+s.GetName())
+}
+
+func (c *FileClient) AddOrUpdateNode(ctx context.Context, n *utils.Node) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.set(ctx, fileStoreNodesPrefix+n.Name, n)
+}
+
+func (c *FileClient) GetNode(_ context.Context, nName string) (*utils.Node, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	node := &utils.Node{}
+	if ok, err := c.get(fileStoreNodesPrefix+nName, node); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, nName)
+	}
+	return node, nil
+}
+
+func (c *FileClient) GetNodes(context.Context) ([]*utils.Node, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	nodes := make([]*utils.Node, 0)
+	for _, key := range c.keys(fileStoreNodesPrefix) {
+		node := &utils.Node{}
+		if _, err := c.get(key, node); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (c *FileClient) DeleteNode(ctx context.Context, n *utils.Node) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreNodesPrefix+n.Name)
+}
+
+func (c *FileClient) AddVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStorePublicationsPrefix+vp.Name]; ok {
+		return NewAlreadyExistsError("volume publication", vp.Name)
+	}
+	return c.set(ctx, fileStorePublicationsPrefix+vp.Name, vp)
+}
+
+func (c *FileClient) UpdateVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStorePublicationsPrefix+vp.Name]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, vp.Name)
+	}
+	return c.set(ctx, fileStorePublicationsPrefix+vp.Name, vp)
+}
+
+func (c *FileClient) GetVolumePublication(_ context.Context, vpName string) (*utils.VolumePublication, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	publication := &utils.VolumePublication{}
+	if ok, err := c.get(fileStorePublicationsPrefix+vpName, publication); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, vpName)
+	}
+	return publication, nil
+}
+
+func (c *FileClient) GetVolumePublications(context.Context) ([]*utils.VolumePublication, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	publications := make([]*utils.VolumePublication, 0)
+	for _, key := range c.keys(fileStorePublicationsPrefix) {
+		publication := &utils.VolumePublication{}
+		if _, err := c.get(key, publication); err != nil {
+			return nil, err
+		}
+		publications = append(publications, publication)
+	}
+	return publications, nil
+}
+
+func (c *FileClient) DeleteVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStorePublicationsPrefix+vp.Name)
+}
+
+// AddSnapshot writes a snapshot to the store, replacing any record of a snapshot with the same ID.
+func (c *FileClient) AddSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.set(ctx, fileStoreSnapshotsPrefix+snapshot.ID(), snapshot.ConstructPersistent())
+}
+
+func (c *FileClient) GetSnapshot(_ context.Context, volumeName, snapshotName string) (
+	*storage.SnapshotPersistent, error,
+) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	snapshot := &storage.SnapshotPersistent{}
+	if ok, err := c.get(fileStoreSnapshotsPrefix+storage.MakeSnapshotID(volumeName, snapshotName),
+		snapshot); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, snapshotName)
+	}
+	return snapshot, nil
+}
+
+func (c *FileClient) GetSnapshots(context.Context) ([]*storage.SnapshotPersistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	snapshots := make([]*storage.SnapshotPersistent, 0)
+	for _, key := range c.keys(fileStoreSnapshotsPrefix) {
+		snapshot := &storage.SnapshotPersistent{}
+		if _, err := c.get(key, snapshot); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (c *FileClient) UpdateSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreSnapshotsPrefix+snapshot.ID()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, snapshot.Config.Name)
+	}
+	return c.set(ctx, fileStoreSnapshotsPrefix+snapshot.ID(), snapshot.ConstructPersistent())
+}
+
+func (c *FileClient) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreSnapshotsPrefix+snapshot.ID())
+}
+
+func (c *FileClient) DeleteSnapshots(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, c.keys(fileStoreSnapshotsPrefix)...)
+}
+
+// AddGroupSnapshot writes a group snapshot to the store, replacing any record of a group snapshot with the same ID.
+func (c *FileClient) AddGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.set(ctx, fileStoreGroupSnapshotsPrefix+groupSnapshot.ID(), groupSnapshot.ConstructPersistent())
+}
+
+func (c *FileClient) GetGroupSnapshot(_ context.Context, groupSnapshotName string) (
+	*storage.GroupSnapshotPersistent, error,
+) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	groupSnapshot := &storage.GroupSnapshotPersistent{}
+	if ok, err := c.get(fileStoreGroupSnapshotsPrefix+groupSnapshotName, groupSnapshot); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, groupSnapshotName)
+	}
+	return groupSnapshot, nil
+}
+
+func (c *FileClient) GetGroupSnapshots(context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	groupSnapshots := make([]*storage.GroupSnapshotPersistent, 0)
+	for _, key := range c.keys(fileStoreGroupSnapshotsPrefix) {
+		groupSnapshot := &storage.GroupSnapshotPersistent{}
+		if _, err := c.get(key, groupSnapshot); err != nil {
+			return nil, err
+		}
+		groupSnapshots = append(groupSnapshots, groupSnapshot)
+	}
+	return groupSnapshots, nil
+}
+
+func (c *FileClient) DeleteGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreGroupSnapshotsPrefix+groupSnapshot.ID())
+}
+
+func (c *FileClient) AddSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreSnapshotPoliciesPrefix+policy.ID()]; ok {
+		return fmt.Errorf("snapshot policy %s already exists", policy.ID())
+	}
+	return c.set(ctx, fileStoreSnapshotPoliciesPrefix+policy.ID(), policy.ConstructPersistent())
+}
+
+func (c *FileClient) GetSnapshotPolicy(_ context.Context, policyName string) (
+	*storage.SnapshotPolicyPersistent, error,
+) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	policy := &storage.SnapshotPolicyPersistent{}
+	if ok, err := c.get(fileStoreSnapshotPoliciesPrefix+policyName, policy); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, policyName)
+	}
+	return policy, nil
+}
+
+func (c *FileClient) GetSnapshotPolicies(context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	policies := make([]*storage.SnapshotPolicyPersistent, 0)
+	for _, key := range c.keys(fileStoreSnapshotPoliciesPrefix) {
+		policy := &storage.SnapshotPolicyPersistent{}
+		if _, err := c.get(key, policy); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (c *FileClient) UpdateSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.data[fileStoreSnapshotPoliciesPrefix+policy.ID()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, policy.ID())
+	}
+	return c.set(ctx, fileStoreSnapshotPoliciesPrefix+policy.ID(), policy.ConstructPersistent())
+}
+
+func (c *FileClient) DeleteSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.delete(ctx, fileStoreSnapshotPoliciesPrefix+policy.ID())
+}
@@ -0,0 +1,16 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+//go:build !windows
+
+package persistentstore
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFileExclusive takes an exclusive lock on the file, failing at once if another process holds it.  The lock is
+// released when the file is closed.
+func lockFileExclusive(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+}
@@ -0,0 +1,16 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockFileExclusive takes an exclusive lock on the file, failing at once if another process holds it.  The lock is
+// released when the file is closed.
+func lockFileExclusive(file *os.File) error {
+	return windows.LockFileEx(windows.Handle(file.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, &windows.Overlapped{})
+}
@@ -0,0 +1,318 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+func newTestFileClient(t *testing.T) (*FileClient, string) {
+	path := filepath.Join(t.TempDir(), "trident", "state.db")
+	c, err := NewFileClient(path)
+	if err != nil {
+		t.Fatalf("Could not create file client; %v", err)
+	}
+	t.Cleanup(func() { _ = c.Stop() })
+	return c, path
+}
+
+func getFakeBackendWithUUID(name string) *storage.StorageBackend {
+	backend := getFakeBackendWithName(name)
+	backend.SetBackendUUID(uuid.NewString())
+	return backend
+}
+
+func TestFileClient_NewFileClient(t *testing.T) {
+	c, path := newTestFileClient(t)
+
+	assert.Equal(t, FileStore, c.GetType())
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	tridentUUID, err := c.GetTridentUUID(ctx())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tridentUUID)
+
+	_, err = c.GetVersion(ctx())
+	assert.True(t, MatchKeyNotFoundErr(err), "expected key not found error")
+
+	_, err = NewFileClient("")
+	assert.Error(t, err)
+}
+
+func TestFileClient_Reopen(t *testing.T) {
+	c, path := newTestFileClient(t)
+
+	backend := getFakeBackendWithUUID("backend1")
+	volume := getFakeVolume(backend)
+	storageClass := getFakeStorageClass()
+	node := getFakeNode()
+	snapshot := getFakeSnapshot()
+	txn := getFakeVolumeTransaction()
+	publication := &utils.VolumePublication{Name: "vol1/node1", VolumeName: "vol1", NodeName: "node1"}
+	version := &config.PersistentStateVersion{
+		PersistentStoreVersion: string(FileStore),
+		OrchestratorAPIVersion: config.OrchestratorAPIVersion,
+		PublicationsSynced:     true,
+	}
+
+	assert.NoError(t, c.AddBackend(ctx(), backend))
+	assert.NoError(t, c.AddVolume(ctx(), volume))
+	assert.NoError(t, c.AddStorageClass(ctx(), storageClass))
+	assert.NoError(t, c.AddOrUpdateNode(ctx(), node))
+	assert.NoError(t, c.AddSnapshot(ctx(), snapshot))
+	assert.NoError(t, c.AddVolumeTransaction(ctx(), txn))
+	assert.NoError(t, c.AddVolumePublication(ctx(), publication))
+	assert.NoError(t, c.SetVersion(ctx(), version))
+
+	tridentUUID, _ := c.GetTridentUUID(ctx())
+	assert.NoError(t, c.Stop())
+
+	_, err := c.GetBackends(ctx())
+	assert.NoError(t, err, "reads should not need the file")
+	assert.Error(t, c.AddOrUpdateNode(ctx(), node), "writes should fail after stop")
+
+	c, err = NewFileClient(path)
+	assert.NoError(t, err)
+	defer func() { _ = c.Stop() }()
+
+	reopenedUUID, err := c.GetTridentUUID(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, tridentUUID, reopenedUUID)
+
+	reopenedVersion, err := c.GetVersion(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, version, reopenedVersion)
+
+	reopenedBackend, err := c.GetBackend(ctx(), backend.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, backend.BackendUUID(), reopenedBackend.BackendUUID)
+
+	reopenedVolume, err := c.GetVolume(ctx(), volume.Config.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, volume.ConstructExternal(), reopenedVolume)
+
+	reopenedStorageClass, err := c.GetStorageClass(ctx(), storageClass.GetName())
+	assert.NoError(t, err)
+	assert.Equal(t, storageClass.GetName(), reopenedStorageClass.GetName())
+
+	reopenedNode, err := c.GetNode(ctx(), node.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, node, reopenedNode)
+
+	reopenedSnapshot, err := c.GetSnapshot(ctx(), snapshot.Config.VolumeName, snapshot.Config.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot.ConstructPersistent(), reopenedSnapshot)
+
+	reopenedTxn, err := c.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.Equal(t, txn.Name(), reopenedTxn.Name())
+
+	reopenedPublication, err := c.GetVolumePublication(ctx(), publication.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, publication, reopenedPublication)
+}
+
+func TestFileClient_Lock(t *testing.T) {
+	c, path := newTestFileClient(t)
+
+	_, err := NewFileClient(path)
+	assert.Error(t, err, "a store that is in use should be refused")
+
+	assert.NoError(t, c.Stop())
+
+	c, err = NewFileClient(path)
+	assert.NoError(t, err, "a stopped store should release its lock")
+	defer func() { _ = c.Stop() }()
+}
+
+func TestFileClient_Backends(t *testing.T) {
+	c, _ := newTestFileClient(t)
+
+	backend := getFakeBackendWithUUID("backend1")
+
+	noUUIDBackend := getFakeBackendWithName("backend2")
+	assert.Error(t, c.AddBackend(ctx(), noUUIDBackend), "a backend without a UUID should be refused")
+
+	_, err := c.GetBackend(ctx(), backend.Name())
+	assert.True(t, MatchKeyNotFoundErr(err), "expected key not found error")
+	assert.True(t, MatchKeyNotFoundErr(c.UpdateBackend(ctx(), backend)), "expected key not found error")
+	assert.NoError(t, c.DeleteBackend(ctx(), backend))
+
+	assert.NoError(t, c.AddBackend(ctx(), backend))
+	assert.Error(t, c.AddBackend(ctx(), backend), "a backend should not be added twice")
+	assert.NoError(t, c.UpdateBackend(ctx(), backend))
+	assert.False(t, c.IsBackendDeleting(ctx(), backend))
+
+	renamedBackend := getFakeBackendWithName("backend3")
+	renamedBackend.SetBackendUUID(backend.BackendUUID())
+	assert.NoError(t, c.ReplaceBackendAndUpdateVolumes(ctx(), backend, renamedBackend))
+	assert.True(t, MatchKeyNotFoundErr(c.ReplaceBackendAndUpdateVolumes(ctx(), backend, renamedBackend)),
+		"expected key not found error")
+
+	backends, err := c.GetBackends(ctx())
+	assert.NoError(t, err)
+	if assert.Len(t, backends, 1) {
+		assert.Equal(t, renamedBackend.Name(), backends[0].Name)
+	}
+
+	assert.NoError(t, c.DeleteBackends(ctx()))
+	backends, err = c.GetBackends(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, backends)
+}
+
+func TestFileClient_VolumeTransactions(t *testing.T) {
+	c, _ := newTestFileClient(t)
+
+	txn := getFakeVolumeTransaction()
+
+	result, err := c.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.True(t, MatchKeyNotFoundErr(c.UpdateVolumeTransaction(ctx(), txn)), "expected key not found error")
+
+	assert.NoError(t, c.AddVolumeTransaction(ctx(), txn))
+	assert.True(t, IsAlreadyExistsError(c.AddVolumeTransaction(ctx(), txn)), "expected already exists error")
+	assert.NoError(t, c.UpdateVolumeTransaction(ctx(), txn))
+
+	txns, err := c.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 1)
+
+	assert.NoError(t, c.DeleteVolumeTransaction(ctx(), txn))
+	txns, err = c.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, txns)
+}
+
+func TestFileClient_IncompleteRecord(t *testing.T) {
+	c, path := newTestFileClient(t)
+
+	backend := getFakeBackendWithUUID("backend1")
+	assert.NoError(t, c.AddBackend(ctx(), backend))
+	assert.NoError(t, c.Stop())
+
+	// Simulate a crash partway through writing a record
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	assert.NoError(t, err)
+	_, err = file.WriteString(`{"set":{"nodes/node1":{"na`)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	c, err = NewFileClient(path)
+	assert.NoError(t, err)
+	defer func() { _ = c.Stop() }()
+
+	_, err = c.GetBackend(ctx(), backend.Name())
+	assert.NoError(t, err)
+	nodes, err := c.GetNodes(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, nodes)
+
+	// The incomplete record should have been removed, so that new records may follow
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(string(contents), "\n"))
+}
+
+func TestFileClient_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.db")
+
+	assert.NoError(t, os.WriteFile(path, []byte("{\"schema\":1}\nnot json\n{}\n"), 0o600))
+	_, err := NewFileClient(path)
+	assert.Error(t, err, "a corrupt record should be refused")
+
+	assert.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o600))
+	_, err = NewFileClient(path)
+	assert.Error(t, err, "a corrupt header should be refused")
+
+	assert.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("{\"schema\":%d}\n", FileStoreSchemaVersion+1)),
+		0o600))
+	_, err = NewFileClient(path)
+	assert.Error(t, err, "a newer schema should be refused")
+}
+
+func TestFileClient_Compaction(t *testing.T) {
+	c, path := newTestFileClient(t)
+
+	node := getFakeNode()
+	for i := 0; i < fileStoreCompactionMinRecords+10; i++ {
+		assert.NoError(t, c.AddOrUpdateNode(ctx(), node))
+	}
+
+	// Only the node and the UUID should remain, along with the records written since the compaction
+	assert.Less(t, c.records, fileStoreCompactionMinRecords)
+
+	assert.NoError(t, c.Stop())
+	_, err := os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err), "the temporary file should have been removed")
+
+	c, err = NewFileClient(path)
+	assert.NoError(t, err)
+	defer func() { _ = c.Stop() }()
+
+	result, err := c.GetNode(ctx(), node.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, node, result)
+}
+
+func TestDataMigrator_Run(t *testing.T) {
+	source := NewInMemoryClient()
+	dest, _ := newTestFileClient(t)
+
+	backend := getFakeBackendWithUUID("backend1")
+	volume := getFakeVolume(backend)
+	assert.NoError(t, source.AddBackend(ctx(), backend))
+	assert.NoError(t, source.AddVolume(ctx(), volume))
+	assert.NoError(t, source.AddStorageClass(ctx(), getFakeStorageClass()))
+	assert.NoError(t, source.AddOrUpdateNode(ctx(), getFakeNode()))
+	assert.NoError(t, source.AddSnapshot(ctx(), getFakeSnapshot()))
+
+	// A dry run should not change the destination
+	assert.NoError(t, NewDataMigrator(source, dest, true).Run())
+	backends, err := dest.GetBackends(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, backends)
+
+	assert.NoError(t, NewDataMigrator(source, dest, false).Run())
+
+	backends, err = dest.GetBackends(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, backends, 1)
+	volumes, err := dest.GetVolumes(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.VolumeExternal{volume.ConstructExternal()}, volumes)
+	storageClasses, err := dest.GetStorageClasses(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, storageClasses, 1)
+	nodes, err := dest.GetNodes(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 1)
+	snapshots, err := dest.GetSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+
+	version, err := dest.GetVersion(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, string(FileStore), version.PersistentStoreVersion)
+
+	// The destination is no longer empty
+	assert.Error(t, NewDataMigrator(source, dest, false).Run())
+
+	// Passthrough stores do not keep all of the orchestrator state
+	assert.Error(t, NewDataMigrator(newPassthroughClient(), NewInMemoryClient(), false).Run())
+}
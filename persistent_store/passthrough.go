@@ -460,3 +460,45 @@ func (c *PassthroughClient) DeleteSnapshot(context.Context, *storage.Snapshot) e
 func (c *PassthroughClient) DeleteSnapshots(context.Context) error {
 	return nil
 }
+
+func (c *PassthroughClient) AddGroupSnapshot(context.Context, *storage.GroupSnapshot) error {
+	return nil
+}
+
+func (c *PassthroughClient) GetGroupSnapshot(
+	_ context.Context, groupSnapshotName string,
+) (*storage.GroupSnapshotPersistent, error) {
+	return nil, NewPersistentStoreError(KeyNotFoundErr, groupSnapshotName)
+}
+
+// GetGroupSnapshots retrieves all group snapshots
+func (c *PassthroughClient) GetGroupSnapshots(context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	return make([]*storage.GroupSnapshotPersistent, 0), nil
+}
+
+func (c *PassthroughClient) DeleteGroupSnapshot(context.Context, *storage.GroupSnapshot) error {
+	return nil
+}
+
+func (c *PassthroughClient) AddSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
+
+func (c *PassthroughClient) GetSnapshotPolicy(
+	_ context.Context, policyName string,
+) (*storage.SnapshotPolicyPersistent, error) {
+	return nil, NewPersistentStoreError(KeyNotFoundErr, policyName)
+}
+
+// GetSnapshotPolicies retrieves all snapshot policies
+func (c *PassthroughClient) GetSnapshotPolicies(context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	return make([]*storage.SnapshotPolicyPersistent, 0), nil
+}
+
+func (c *PassthroughClient) UpdateSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
+
+func (c *PassthroughClient) DeleteSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+)
+
+// StateArchiveVersion is the format of the state archives written by this Trident.  Archives with a newer format
+// are refused.
+const StateArchiveVersion = 1
+
+// StateArchive is a backup of the orchestrator state, from which a new Trident installation may be restored.
+// Backends are archived with their credentials.  Volume transactions and nodes are not archived, because they
+// belong to the running orchestrator and its nodes, which recreate them as needed.
+type StateArchive struct {
+	ArchiveVersion      int                                 `json:"archiveVersion"`
+	TridentVersion      string                              `json:"tridentVersion"`
+	TridentUUID         string                              `json:"tridentUUID"`
+	Created             string                              `json:"created"`
+	Backends            []*storage.BackendPersistent        `json:"backends"`
+	StorageClasses      []*sc.Persistent                    `json:"storageClasses"`
+	Volumes             []*storage.VolumeExternal           `json:"volumes"`
+	Snapshots           []*storage.SnapshotPersistent       `json:"snapshots"`
+	GroupSnapshots      []*storage.GroupSnapshotPersistent  `json:"groupSnapshots"`
+	SnapshotPolicies    []*storage.SnapshotPolicyPersistent `json:"snapshotPolicies"`
+	VolumePublications  []*utils.VolumePublication          `json:"volumePublications"`
+	MirrorRelationships []*v1.TridentMirrorRelationship     `json:"mirrorRelationships"`
+}
+
+// StateRestorePlan describes how the contents of a state archive map onto a live Trident installation.
+type StateRestorePlan struct {
+	// BackendUUIDs maps the UUID of each archived backend that already exists in the live installation to the
+	// UUID of the live backend, to which the volumes of the archived backend are restored.
+	BackendUUIDs map[string]string
+	// MissingVolumes lists the archived volumes that their live backends no longer have, which are restored as
+	// orphaned.
+	MissingVolumes []string
+	// UnverifiedVolumes lists the archived volumes that could not be checked against their backends, because the
+	// backends are restored rather than live, or could not be listed.
+	UnverifiedVolumes []string
+	// SkippedPublications lists the archived volume publications that are not restored, because their nodes are
+	// not in the live installation.
+	SkippedPublications []string
+	// Source is a store holding the objects to restore, with their backend UUIDs remapped, which may be migrated
+	// to the live installation with a DataMigrator.
+	Source *InMemoryClient
+}
+
+// NewStateArchive reads the orchestrator state from a store into a new archive.  Mirror relationships are not
+// kept in the store, so they must be added by the caller.
+func NewStateArchive(ctx context.Context, client Client) (*StateArchive, error) {
+	archive := &StateArchive{
+		ArchiveVersion:      StateArchiveVersion,
+		TridentVersion:      config.OrchestratorVersion.String(),
+		Created:             time.Now().UTC().Format(time.RFC3339),
+		MirrorRelationships: make([]*v1.TridentMirrorRelationship, 0),
+	}
+
+	var err error
+	if archive.TridentUUID, err = client.GetTridentUUID(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read the Trident UUID; %v", err)
+	}
+	if archive.Backends, err = client.GetBackends(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read backends; %v", err)
+	}
+	if archive.StorageClasses, err = client.GetStorageClasses(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read storage classes; %v", err)
+	}
+	if archive.Volumes, err = client.GetVolumes(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volumes; %v", err)
+	}
+	if archive.Snapshots, err = client.GetSnapshots(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read snapshots; %v", err)
+	}
+	if archive.GroupSnapshots, err = client.GetGroupSnapshots(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read group snapshots; %v", err)
+	}
+	if archive.SnapshotPolicies, err = client.GetSnapshotPolicies(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read snapshot policies; %v", err)
+	}
+	if archive.VolumePublications, err = client.GetVolumePublications(ctx); err != nil &&
+		!MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volume publications; %v", err)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"backends": len(archive.Backends),
+		"volumes":  len(archive.Volumes),
+	}).Debug("Archived orchestrator state.")
+
+	return archive, nil
+}
+
+// Write writes the archive as JSON.
+func (a *StateArchive) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(a)
+}
+
+// ReadStateArchive reads an archive written by Write, and ensures this Trident supports its format.
+func ReadStateArchive(r io.Reader) (*StateArchive, error) {
+	archive := &StateArchive{}
+	if err := json.NewDecoder(r).Decode(archive); err != nil {
+		return nil, fmt.Errorf("could not read the state archive; %v", err)
+	}
+
+	if archive.ArchiveVersion < 1 {
+		return nil, fmt.Errorf("the state archive has an invalid version %d", archive.ArchiveVersion)
+	} else if archive.ArchiveVersion > StateArchiveVersion {
+		return nil, fmt.Errorf("the state archive has version %d, but this Trident supports version %d or older",
+			archive.ArchiveVersion, StateArchiveVersion)
+	}
+
+	return archive, nil
+}
+
+// PlanRestore validates the archive against the backends of a live installation, and returns the objects to
+// restore into it.  An archived backend with the same name as a live backend is not restored, as long as both use
+// the same storage driver; instead, its volumes and snapshots are restored to the live backend under its UUID.
+// Other backends are restored under their archived UUIDs, without any reference to a backend config, so that a
+// TridentBackendConfig may be bound to them again.
+//
+// The volumes restored to live backends are checked against liveBackendVolumes, which holds the internal names of
+// the volumes found on each live backend that the live installation doesn't track, by backend name; a backend that
+// could not be listed is absent.  Volumes their backends no longer have are restored as orphaned.  Publications are
+// restored only for nodes in the live installation.
+func (a *StateArchive) PlanRestore(
+	ctx context.Context, live Client, liveBackendVolumes map[string]map[string]bool,
+) (*StateRestorePlan, error) {
+	liveBackends, err := live.GetBackends(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read the live backends; %v", err)
+	}
+	liveBackendsByName := make(map[string]*storage.BackendPersistent, len(liveBackends))
+	for _, backend := range liveBackends {
+		liveBackendsByName[backend.Name] = backend
+	}
+
+	liveVolumes, err := live.GetVolumes(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read the live volumes; %v", err)
+	}
+	liveNodes, err := live.GetNodes(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read the live nodes; %v", err)
+	}
+
+	plan := &StateRestorePlan{
+		BackendUUIDs:        make(map[string]string),
+		MissingVolumes:      make([]string, 0),
+		UnverifiedVolumes:   make([]string, 0),
+		SkippedPublications: make([]string, 0),
+		Source:              NewInMemoryClient(),
+	}
+
+	// The live backends that were listed have the volumes found on them, along with those already tracked
+	backendVolumes := make(map[string]map[string]bool)
+	for _, backend := range liveBackends {
+		if names, ok := liveBackendVolumes[backend.Name]; ok {
+			backendVolumes[backend.BackendUUID] = make(map[string]bool, len(names))
+			for name := range names {
+				backendVolumes[backend.BackendUUID][name] = true
+			}
+		}
+	}
+	for _, volume := range liveVolumes {
+		if names, ok := backendVolumes[volume.BackendUUID]; ok {
+			names[volume.Config.InternalName] = true
+		}
+	}
+
+	// The UUIDs of all backends that will exist once the archive is restored
+	backendUUIDs := make(map[string]bool)
+	for _, backend := range liveBackends {
+		backendUUIDs[backend.BackendUUID] = true
+	}
+
+	for _, backend := range a.Backends {
+		if liveBackend, ok := liveBackendsByName[backend.Name]; ok {
+			archivedDriver, err := getBackendDriverName(backend)
+			if err != nil {
+				return nil, fmt.Errorf("could not read the config of archived backend %s; %v", backend.Name, err)
+			}
+			liveDriver, err := getBackendDriverName(liveBackend)
+			if err != nil {
+				return nil, fmt.Errorf("could not read the config of live backend %s; %v", backend.Name, err)
+			}
+			if archivedDriver != liveDriver {
+				return nil, fmt.Errorf("archived backend %s uses the %s driver, but the live backend uses the "+
+					"%s driver", backend.Name, archivedDriver, liveDriver)
+			}
+
+			plan.BackendUUIDs[backend.BackendUUID] = liveBackend.BackendUUID
+
+			Logc(ctx).WithFields(LogFields{
+				"backend":     backend.Name,
+				"archiveUUID": backend.BackendUUID,
+				"liveUUID":    liveBackend.BackendUUID,
+			}).Debug("Archived backend exists in the live installation.")
+			continue
+		}
+
+		if backendUUIDs[backend.BackendUUID] {
+			return nil, fmt.Errorf("archived backend %s has the UUID %s of a live backend with another name",
+				backend.Name, backend.BackendUUID)
+		}
+		backendUUIDs[backend.BackendUUID] = true
+
+		backendCopy := *backend
+		backendCopy.ConfigRef = ""
+		if err = plan.Source.AddBackendPersistent(ctx, &backendCopy); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, storageClass := range a.StorageClasses {
+		if err = plan.Source.AddStorageClass(ctx, sc.NewFromPersistent(storageClass)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, external := range a.Volumes {
+		backendUUID := external.BackendUUID
+		orphaned := external.Orphaned
+		if liveUUID, ok := plan.BackendUUIDs[backendUUID]; ok {
+			backendUUID = liveUUID
+
+			if !orphaned {
+				// Unmanaged imports keep their original names, so drivers don't list them
+				names, listed := backendVolumes[backendUUID]
+				if !listed || external.Config.ImportNotManaged {
+					plan.UnverifiedVolumes = append(plan.UnverifiedVolumes, external.Config.Name)
+				} else if !names[external.Config.InternalName] {
+					plan.MissingVolumes = append(plan.MissingVolumes, external.Config.Name)
+					orphaned = true
+				}
+			}
+		} else if !backendUUIDs[backendUUID] && !orphaned {
+			return nil, fmt.Errorf("volume %s belongs to backend %s, which is neither archived nor live",
+				external.Config.Name, backendUUID)
+		} else if !orphaned {
+			plan.UnverifiedVolumes = append(plan.UnverifiedVolumes, external.Config.Name)
+		}
+
+		volume := storage.NewVolume(external.Config, backendUUID, external.Pool, orphaned, external.State)
+		if err = plan.Source.AddVolume(ctx, volume); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, snapshot := range a.Snapshots {
+		if err = plan.Source.AddSnapshot(ctx, &snapshot.Snapshot); err != nil {
+			return nil, err
+		}
+	}
+	for _, groupSnapshot := range a.GroupSnapshots {
+		if err = plan.Source.AddGroupSnapshot(ctx, &groupSnapshot.GroupSnapshot); err != nil {
+			return nil, err
+		}
+	}
+	for _, policy := range a.SnapshotPolicies {
+		if err = plan.Source.AddSnapshotPolicy(ctx, &policy.SnapshotPolicy); err != nil {
+			return nil, err
+		}
+	}
+	nodeNames := make(map[string]bool, len(liveNodes))
+	for _, node := range liveNodes {
+		nodeNames[node.Name] = true
+	}
+	for _, publication := range a.VolumePublications {
+		if !nodeNames[publication.NodeName] {
+			plan.SkippedPublications = append(plan.SkippedPublications, publication.Name)
+			continue
+		}
+		if err = plan.Source.AddVolumePublication(ctx, publication); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// getBackendDriverName returns the name of the storage driver used by a backend.
+func getBackendDriverName(backend *storage.BackendPersistent) (string, error) {
+	configJSON, err := backend.MarshalConfig()
+	if err != nil {
+		return "", err
+	}
+	var commonConfig struct {
+		StorageDriverName string `json:"storageDriverName"`
+	}
+	if err = json.Unmarshal([]byte(configJSON), &commonConfig); err != nil {
+		return "", err
+	}
+	return commonConfig.StorageDriverName, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	drivers "github.com/netapp/trident/storage_drivers"
+	"github.com/netapp/trident/utils"
+)
+
+func newTestStateArchive(t *testing.T) (*StateArchive, *storage.StorageBackend, *storage.StorageBackend) {
+	source := NewInMemoryClient()
+
+	backend1 := getFakeBackendWithUUID("backend1")
+	backend2 := getFakeBackendWithUUID("backend2")
+	assert.NoError(t, source.AddBackend(ctx(), backend1))
+	assert.NoError(t, source.AddBackend(ctx(), backend2))
+	assert.NoError(t, source.AddVolume(ctx(), getFakeVolumeWithName("vol1", backend1)))
+	assert.NoError(t, source.AddVolume(ctx(), getFakeVolumeWithName("vol2", backend2)))
+	assert.NoError(t, source.AddStorageClass(ctx(), getFakeStorageClass()))
+	assert.NoError(t, source.AddSnapshot(ctx(), getFakeSnapshot()))
+	assert.NoError(t, source.AddVolumePublication(ctx(), &utils.VolumePublication{
+		Name: "vol1/node1", VolumeName: "vol1", NodeName: "node1",
+	}))
+
+	archive, err := NewStateArchive(ctx(), source)
+	if err != nil {
+		t.Fatalf("Could not create state archive; %v", err)
+	}
+	return archive, backend1, backend2
+}
+
+func TestStateArchive_WriteRead(t *testing.T) {
+	archive, _, _ := newTestStateArchive(t)
+	archive.MirrorRelationships = append(archive.MirrorRelationships, &v1.TridentMirrorRelationship{})
+
+	assert.Equal(t, StateArchiveVersion, archive.ArchiveVersion)
+	assert.NotEmpty(t, archive.TridentUUID)
+	assert.Len(t, archive.Backends, 2)
+	assert.Len(t, archive.Volumes, 2)
+	assert.Len(t, archive.StorageClasses, 1)
+	assert.Len(t, archive.Snapshots, 1)
+	assert.Len(t, archive.VolumePublications, 1)
+	assert.Empty(t, archive.GroupSnapshots)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, archive.Write(&buffer))
+
+	result, err := ReadStateArchive(&buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, archive.TridentUUID, result.TridentUUID)
+	assert.ElementsMatch(t, archive.Volumes, result.Volumes)
+	assert.Len(t, result.Backends, 2)
+	assert.Len(t, result.MirrorRelationships, 1)
+}
+
+func TestReadStateArchive_Version(t *testing.T) {
+	_, err := ReadStateArchive(bytes.NewBufferString(`{"archiveVersion": 2}`))
+	assert.Error(t, err, "a newer archive should be refused")
+
+	_, err = ReadStateArchive(bytes.NewBufferString(`{}`))
+	assert.Error(t, err, "an archive without a version should be refused")
+
+	_, err = ReadStateArchive(bytes.NewBufferString(`not json`))
+	assert.Error(t, err, "an invalid archive should be refused")
+}
+
+func TestStateArchive_PlanRestore(t *testing.T) {
+	archive, backend1, backend2 := newTestStateArchive(t)
+
+	// The live installation already has backend1, under a new UUID, and the node of the archived publication
+	live, _ := newTestFileClient(t)
+	liveBackend1 := getFakeBackendWithUUID(backend1.Name())
+	assert.NoError(t, live.AddBackend(ctx(), liveBackend1))
+	assert.NoError(t, live.AddOrUpdateNode(ctx(), &utils.Node{Name: "node1"}))
+
+	liveBackendVolumes := map[string]map[string]bool{backend1.Name(): {"vol1_internal": true}}
+	plan, err := archive.PlanRestore(ctx(), live, liveBackendVolumes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{backend1.BackendUUID(): liveBackend1.BackendUUID()}, plan.BackendUUIDs)
+	assert.Empty(t, plan.MissingVolumes)
+	assert.Equal(t, []string{"vol2"}, plan.UnverifiedVolumes, "volumes of restored backends can't be checked")
+	assert.Empty(t, plan.SkippedPublications)
+
+	assert.NoError(t, NewDataMigrator(plan.Source, live, true).Run())
+	assert.NoError(t, NewDataMigrator(plan.Source, live, false).Run())
+
+	backends, err := live.GetBackends(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, backends, 2)
+
+	vol1, err := live.GetVolume(ctx(), "vol1")
+	assert.NoError(t, err)
+	assert.Equal(t, liveBackend1.BackendUUID(), vol1.BackendUUID, "volume should be remapped to the live backend")
+	assert.False(t, vol1.Orphaned)
+
+	_, err = live.GetVolumePublication(ctx(), "vol1/node1")
+	assert.NoError(t, err)
+
+	vol2, err := live.GetVolume(ctx(), "vol2")
+	assert.NoError(t, err)
+	assert.Equal(t, backend2.BackendUUID(), vol2.BackendUUID, "volume should keep its restored backend")
+
+	// Restoring again would overwrite the restored volumes
+	assert.Error(t, NewDataMigrator(plan.Source, live, true).Run())
+}
+
+func TestStateArchive_PlanRestoreUnverified(t *testing.T) {
+	archive, backend1, _ := newTestStateArchive(t)
+
+	live := NewInMemoryClient()
+	liveBackend1 := getFakeBackendWithUUID(backend1.Name())
+	assert.NoError(t, live.AddBackend(ctx(), liveBackend1))
+
+	// Without a listing of the live backend, its volumes can't be checked
+	plan, err := archive.PlanRestore(ctx(), live, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.MissingVolumes)
+	assert.ElementsMatch(t, []string{"vol1", "vol2"}, plan.UnverifiedVolumes)
+
+	// A volume the live backend no longer has is restored as orphaned
+	plan, err = archive.PlanRestore(ctx(), live, map[string]map[string]bool{backend1.Name(): {}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol1"}, plan.MissingVolumes)
+	assert.Equal(t, []string{"vol2"}, plan.UnverifiedVolumes)
+	vol1, err := plan.Source.GetVolume(ctx(), "vol1")
+	assert.NoError(t, err)
+	assert.True(t, vol1.Orphaned)
+
+	// A publication whose node is not in the live installation is skipped
+	assert.Equal(t, []string{"vol1/node1"}, plan.SkippedPublications)
+	publications, err := plan.Source.GetVolumePublications(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, publications)
+}
+
+func TestStateArchive_PlanRestoreInvalid(t *testing.T) {
+	archive, backend1, _ := newTestStateArchive(t)
+
+	// A live backend with the same name must use the same driver
+	live := NewInMemoryClient()
+	assert.NoError(t, live.AddBackendPersistent(ctx(), &storage.BackendPersistent{
+		Name:        backend1.Name(),
+		BackendUUID: "live-uuid",
+		Config: storage.PersistentStorageBackendConfig{
+			OntapConfig: &drivers.OntapStorageDriverConfig{
+				CommonStorageDriverConfig: &drivers.CommonStorageDriverConfig{StorageDriverName: "ontap-nas"},
+			},
+		},
+	}))
+	_, err := archive.PlanRestore(ctx(), live, nil)
+	assert.Error(t, err)
+
+	// Every volume must belong to an archived or live backend
+	archive, _, _ = newTestStateArchive(t)
+	archive.Backends = archive.Backends[:0]
+	_, err = archive.PlanRestore(ctx(), NewInMemoryClient(), nil)
+	assert.Error(t, err)
+}
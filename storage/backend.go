@@ -92,11 +92,74 @@ type Mirrorer interface {
 	GetReplicationDetails(ctx context.Context, localInternalVolumeName, remoteVolumeHandle string) (string, string, string, error)
 }
 
+// MirrorUpdater provides a common interface for mirroring backends that can transfer a snapshot of the remote
+// volume to a mirror destination on demand, rather than waiting for the replication schedule.
+type MirrorUpdater interface {
+	UpdateMirror(ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string) error
+}
+
 // StateGetter provides a common interface for backends that support polling backend for state information.
 type StateGetter interface {
 	GetBackendState(ctx context.Context) (string, *roaring.Bitmap)
 }
 
+// CapacityReporter provides a common interface for backends that can report the space available in their pools
+type CapacityReporter interface {
+	GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error)
+}
+
+// VolumeHealthChecker provides a common interface for backends that can report the condition of their volumes
+type VolumeHealthChecker interface {
+	GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error)
+}
+
+// VolumeMetricsReporter provides a common interface for backends that can report the space used by their volumes
+// and their performance.  Metrics are returned for many volumes at once, keyed by volume name, so that a backend
+// may collect them with as few requests as possible; volumes not found on the backend are left out.
+type VolumeMetricsReporter interface {
+	GetVolumeMetrics(ctx context.Context, volConfigs []*VolumeConfig) (map[string]*VolumeMetrics, error)
+}
+
+// VolumeModifier provides a common interface for backends that can change the attributes of existing volumes.
+// The volume config already holds the new values, and the modification names the attributes that changed; a
+// driver should reject any attribute it cannot change.
+type VolumeModifier interface {
+	ModifyVolume(ctx context.Context, volConfig *VolumeConfig, modification VolumeModification) error
+}
+
+// VolumeMover provides a common interface for backends that can move a volume to another of their pools without
+// disrupting its clients.  MoveVolume starts the move if needed and reports whether it is still running and how far
+// it has progressed, so it may be called repeatedly until the volume is in the target pool.  If the last move of the
+// volume to the target pool failed, a VolumeMoveFailedError is returned; the move is started again only if
+// restartFailed is set, in which case it is reported as running.
+type VolumeMover interface {
+	MoveVolume(ctx context.Context, volConfig *VolumeConfig, targetPool Pool, restartFailed bool) (bool, int, error)
+}
+
+// SnapshotRestoreLimiter provides a common interface for backends that may only restore a volume from its newest
+// snapshot.  Some storage systems, such as ONTAP and Cloud Volumes Service, delete any snapshots newer than the one a
+// volume is restored from, which would leave Trident's records of those snapshots dangling, so restores from older
+// snapshots are refused.
+type SnapshotRestoreLimiter interface {
+	RestoreRequiresNewestSnapshot() bool
+}
+
+// VolumeQuiescer provides a common interface for backends that can hold off I/O to a set of volumes while
+// they are snapshotted one at a time.  Every successful QuiesceVolumes call is followed by ReleaseVolumes.
+type VolumeQuiescer interface {
+	QuiesceVolumes(ctx context.Context, volConfigs []*VolumeConfig) error
+	ReleaseVolumes(ctx context.Context, volConfigs []*VolumeConfig) error
+}
+
+// GroupSnapshotter provides a common interface for backends that can snapshot a group of volumes atomically
+type GroupSnapshotter interface {
+	SupportsGroupSnapshots() bool
+	CreateGroupSnapshot(
+		ctx context.Context, groupConfig *GroupSnapshotConfig, snapConfigs []*SnapshotConfig,
+		volConfigs []*VolumeConfig,
+	) ([]*Snapshot, error)
+}
+
 type StorageBackend struct {
 	driver             Driver
 	name               string
@@ -644,6 +707,71 @@ func (b *StorageBackend) ResizeVolume(ctx context.Context, volConfig *VolumeConf
 	return b.driver.Resize(ctx, volConfig, newSizeBytes)
 }
 
+func (b *StorageBackend) CanModifyVolumes() bool {
+	_, ok := b.driver.(VolumeModifier)
+	return ok
+}
+
+// ModifyVolume asks the storage driver to change the attributes of a volume to those in its config.
+func (b *StorageBackend) ModifyVolume(
+	ctx context.Context, volConfig *VolumeConfig, modification VolumeModification,
+) error {
+	// Ensure volume is managed
+	if volConfig.ImportNotManaged {
+		return &NotManagedError{volConfig.InternalName}
+	}
+
+	modifyDriver, ok := b.driver.(VolumeModifier)
+	if !ok {
+		return utils.UnsupportedError(fmt.Sprintf(
+			"volume modification is not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if err := b.ensureOnline(ctx); err != nil {
+		return err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"backend":      b.name,
+		"volume":       volConfig.InternalName,
+		"modification": modification,
+	}).Debug("Attempting volume modification.")
+	return modifyDriver.ModifyVolume(ctx, volConfig, modification)
+}
+
+func (b *StorageBackend) CanMoveVolumes() bool {
+	_, ok := b.driver.(VolumeMover)
+	return ok
+}
+
+// MoveVolume asks the storage driver to move a volume to another pool of this backend, returning whether the move
+// is still running and its percentage complete.
+func (b *StorageBackend) MoveVolume(
+	ctx context.Context, volConfig *VolumeConfig, targetPool Pool, restartFailed bool,
+) (bool, int, error) {
+	// Ensure volume is managed
+	if volConfig.ImportNotManaged {
+		return false, 0, &NotManagedError{volConfig.InternalName}
+	}
+
+	moveDriver, ok := b.driver.(VolumeMover)
+	if !ok {
+		return false, 0, utils.UnsupportedError(fmt.Sprintf(
+			"volume moves are not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if err := b.ensureOnline(ctx); err != nil {
+		return false, 0, err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"backend":    b.name,
+		"volume":     volConfig.InternalName,
+		"targetPool": targetPool.Name(),
+	}).Debug("Attempting volume move.")
+	return moveDriver.MoveVolume(ctx, volConfig, targetPool, restartFailed)
+}
+
 func (b *StorageBackend) RenameVolume(ctx context.Context, volConfig *VolumeConfig, newName string) error {
 	oldName := volConfig.InternalName
 
@@ -796,6 +924,122 @@ func (b *StorageBackend) CreateSnapshot(
 	return b.driver.CreateSnapshot(ctx, snapConfig, volConfig)
 }
 
+// SupportsGroupSnapshots indicates whether the storage driver can create crash-consistent group snapshots, either
+// by snapshotting the volumes atomically or by quiescing I/O to them while they are snapshotted one at a time.
+func (b *StorageBackend) SupportsGroupSnapshots() bool {
+	if _, ok := b.driver.(VolumeQuiescer); ok {
+		return true
+	}
+	return b.snapshotsGroupsAtomically()
+}
+
+// snapshotsGroupsAtomically indicates whether the storage driver can snapshot a group of volumes atomically.
+func (b *StorageBackend) snapshotsGroupsAtomically() bool {
+	if snapshotter, ok := b.driver.(GroupSnapshotter); ok {
+		return snapshotter.SupportsGroupSnapshots()
+	}
+	return false
+}
+
+// CreateGroupSnapshot snapshots a group of volumes on this backend.  Drivers that support group snapshots cut
+// all the snapshots at a single point in time.  For drivers that can quiesce I/O, the volumes are quiesced,
+// snapshotted one at a time, and then released.  Other drivers are refused, since snapshotting the volumes one at a
+// time while they are in use would not yield a crash-consistent group.  The caller is expected to have fenced the
+// volumes against other operations.  Either way, the group snapshot is all or nothing; any snapshots already
+// created are deleted if one of them fails.
+func (b *StorageBackend) CreateGroupSnapshot(
+	ctx context.Context, groupConfig *GroupSnapshotConfig, snapConfigs []*SnapshotConfig, volConfigs []*VolumeConfig,
+) ([]*Snapshot, error) {
+	Logc(ctx).WithFields(LogFields{
+		"backend":       b.name,
+		"groupSnapshot": groupConfig.Name,
+		"volumes":       groupConfig.VolumeNames,
+	}).Debug("Attempting group snapshot create.")
+
+	if !b.SupportsGroupSnapshots() {
+		return nil, utils.UnsupportedError(fmt.Sprintf("backend %s can neither snapshot volumes at a single "+
+			"point in time nor quiesce them, so group snapshot %s would not be crash-consistent", b.name,
+			groupConfig.Name))
+	}
+
+	if len(snapConfigs) != len(volConfigs) {
+		return nil, fmt.Errorf("group snapshot %s has %d snapshots for %d volumes", groupConfig.Name,
+			len(snapConfigs), len(volConfigs))
+	}
+
+	// Ensure volumes are managed
+	for _, volConfig := range volConfigs {
+		if volConfig.ImportNotManaged {
+			return nil, &NotManagedError{volConfig.InternalName}
+		}
+	}
+
+	// Ensure backend is ready
+	if err := b.ensureOnline(ctx); err != nil {
+		return nil, err
+	}
+
+	// Implement idempotency by checking for the snapshots first
+	existingSnapshots := make([]*Snapshot, 0, len(snapConfigs))
+	for i, snapConfig := range snapConfigs {
+		snapConfig.InternalName = groupConfig.InternalName
+		existingSnapshot, err := b.driver.GetSnapshot(ctx, snapConfig, volConfigs[i])
+		if err != nil {
+			return nil, err
+		} else if existingSnapshot != nil {
+			existingSnapshots = append(existingSnapshots, existingSnapshot)
+		}
+	}
+	if len(existingSnapshots) == len(snapConfigs) {
+		Logc(ctx).WithFields(LogFields{
+			"backend":       b.name,
+			"groupSnapshot": groupConfig.Name,
+		}).Warning("Group snapshot already exists.")
+
+		return existingSnapshots, nil
+	} else if len(existingSnapshots) > 0 {
+		return nil, fmt.Errorf("group snapshot %s already exists for some of its volumes", groupConfig.Name)
+	}
+
+	if b.snapshotsGroupsAtomically() {
+		return b.driver.(GroupSnapshotter).CreateGroupSnapshot(ctx, groupConfig, snapConfigs, volConfigs)
+	}
+
+	quiescer := b.driver.(VolumeQuiescer)
+	if err := quiescer.QuiesceVolumes(ctx, volConfigs); err != nil {
+		return nil, fmt.Errorf("could not quiesce I/O to the volumes of group snapshot %s; %v",
+			groupConfig.Name, err)
+	}
+	defer func() {
+		if err := quiescer.ReleaseVolumes(ctx, volConfigs); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"backend":       b.name,
+				"groupSnapshot": groupConfig.Name,
+			}).WithError(err).Error("Could not release I/O to the volumes of group snapshot.")
+		}
+	}()
+
+	snapshots := make([]*Snapshot, 0, len(snapConfigs))
+	for i, snapConfig := range snapConfigs {
+		snapshot, err := b.driver.CreateSnapshot(ctx, snapConfig, volConfigs[i])
+		if err != nil {
+			for j, created := range snapshots {
+				if deleteErr := b.driver.DeleteSnapshot(ctx, created.Config, volConfigs[j]); deleteErr != nil {
+					Logc(ctx).WithFields(LogFields{
+						"backend":  b.name,
+						"snapshot": created.Config.ID(),
+					}).WithError(deleteErr).Error("Could not delete snapshot of failed group snapshot.")
+				}
+			}
+			return nil, fmt.Errorf("failed to create snapshot of volume %s for group snapshot %s; %v",
+				snapConfig.VolumeName, groupConfig.Name, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
 func (b *StorageBackend) RestoreSnapshot(
 	ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig,
 ) error {
@@ -820,6 +1064,15 @@ func (b *StorageBackend) RestoreSnapshot(
 	return b.driver.RestoreSnapshot(ctx, snapConfig, volConfig)
 }
 
+// RestoreRequiresNewestSnapshot indicates whether the storage driver can only restore a volume
+// from the most recent of its snapshots.
+func (b *StorageBackend) RestoreRequiresNewestSnapshot() bool {
+	if limiter, ok := b.driver.(SnapshotRestoreLimiter); ok {
+		return limiter.RestoreRequiresNewestSnapshot()
+	}
+	return false
+}
+
 func (b *StorageBackend) DeleteSnapshot(
 	ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig,
 ) error {
@@ -970,6 +1223,68 @@ func (b *StorageBackend) GetBackendState(ctx context.Context) (string, *roaring.
 	return reason, changeMap
 }
 
+func (b *StorageBackend) CanReportCapacity() bool {
+	_, ok := b.driver.(CapacityReporter)
+	return ok
+}
+
+// GetPoolCapacity returns the total and free space of one of this backend's storage pools.
+func (b *StorageBackend) GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error) {
+	capacityDriver, ok := b.driver.(CapacityReporter)
+	if !ok {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"capacity reporting is not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if err := b.ensureOnline(ctx); err != nil {
+		return nil, err
+	}
+
+	return capacityDriver.GetPoolCapacity(ctx, pool)
+}
+
+func (b *StorageBackend) CanCheckVolumeHealth() bool {
+	_, ok := b.driver.(VolumeHealthChecker)
+	return ok
+}
+
+// GetVolumeHealth asks the storage driver whether a volume is in an abnormal condition.
+func (b *StorageBackend) GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error) {
+	healthDriver, ok := b.driver.(VolumeHealthChecker)
+	if !ok {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"volume health checks are not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if err := b.ensureOnline(ctx); err != nil {
+		return nil, err
+	}
+
+	return healthDriver.GetVolumeHealth(ctx, volConfig)
+}
+
+func (b *StorageBackend) CanReportVolumeMetrics() bool {
+	_, ok := b.driver.(VolumeMetricsReporter)
+	return ok
+}
+
+// GetVolumeMetrics asks the storage driver for the space used by a set of volumes and their performance.
+func (b *StorageBackend) GetVolumeMetrics(
+	ctx context.Context, volConfigs []*VolumeConfig,
+) (map[string]*VolumeMetrics, error) {
+	metricsDriver, ok := b.driver.(VolumeMetricsReporter)
+	if !ok {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"volume metrics are not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if err := b.ensureOnline(ctx); err != nil {
+		return nil, err
+	}
+
+	return metricsDriver.GetVolumeMetrics(ctx, volConfigs)
+}
+
 func (b *StorageBackend) ensureOnline(ctx context.Context) error {
 	if b.state != Online {
 		Logc(ctx).WithFields(LogFields{
@@ -1245,6 +1560,18 @@ func (b *StorageBackend) GetMirrorStatus(
 	return mirrorDriver.GetMirrorStatus(ctx, localInternalVolumeName, remoteVolumeHandle)
 }
 
+func (b *StorageBackend) UpdateMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) error {
+	updateDriver, ok := b.driver.(MirrorUpdater)
+	if !ok {
+		return utils.UnsupportedError(
+			fmt.Sprintf("mirror updates are not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	return updateDriver.UpdateMirror(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotName)
+}
+
 func (b *StorageBackend) CanMirror() bool {
 	_, ok := b.driver.(Mirrorer)
 	return ok
@@ -1288,6 +1615,6 @@ func (b *StorageBackend) EnablePublishEnforcement(ctx context.Context, volume *V
 }
 
 func (b *StorageBackend) CanEnablePublishEnforcement() bool {
-	_, ok := b.driver.(PublishEnforceable)
-	return ok
+	driver, ok := b.driver.(PublishEnforceable)
+	return ok && driver.CanEnablePublishEnforcement()
 }
@@ -0,0 +1,38 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+// ChangeEventType is the kind of change reported by a change event.
+type ChangeEventType string
+
+const (
+	ChangeAdded   = ChangeEventType("added")
+	ChangeUpdated = ChangeEventType("updated")
+	ChangeDeleted = ChangeEventType("deleted")
+	// ChangeBookmark marks a position in the change feed, from which a watch may be resumed, without reporting
+	// a change
+	ChangeBookmark = ChangeEventType("bookmark")
+)
+
+// ChangeObjectType is the kind of object changed.
+type ChangeObjectType string
+
+const (
+	ChangeObjectVolume      = ChangeObjectType("volume")
+	ChangeObjectSnapshot    = ChangeObjectType("snapshot")
+	ChangeObjectBackend     = ChangeObjectType("backend")
+	ChangeObjectNode        = ChangeObjectType("node")
+	ChangeObjectPublication = ChangeObjectType("publication")
+)
+
+// ChangeEvent reports a change to an object in Trident.  Object holds the external form of the object after an
+// add or update, and before a delete.  ResumeToken identifies the position of the event in the change feed, so
+// that a watch may be resumed after it.
+type ChangeEvent struct {
+	ResumeToken string           `json:"resumeToken"`
+	Time        string           `json:"time"`
+	Type        ChangeEventType  `json:"type"`
+	ObjectType  ChangeObjectType `json:"objectType,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Object      interface{}      `json:"object,omitempty"`
+}
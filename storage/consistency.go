@@ -0,0 +1,47 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+// ConsistencyIssueType identifies a kind of drift between the persistent store, the storage backends and the
+// container orchestrator.
+type ConsistencyIssueType string
+
+const (
+	// OrphanedVolumeIssue is a volume marked as orphaned, which may have reappeared on its backend
+	OrphanedVolumeIssue = ConsistencyIssueType("orphanedVolume")
+	// MissingBackendVolumeIssue is a volume that its backend no longer reports
+	MissingBackendVolumeIssue = ConsistencyIssueType("missingBackendVolume")
+	// UntrackedBackendVolumeIssue is a volume on a backend that Trident does not track
+	UntrackedBackendVolumeIssue = ConsistencyIssueType("untrackedBackendVolume")
+	// StalePublicationIssue is a volume publication whose volume no longer exists
+	StalePublicationIssue = ConsistencyIssueType("stalePublication")
+	// UnattachedPublicationIssue is a volume publication without a matching volume attachment
+	UnattachedPublicationIssue = ConsistencyIssueType("unattachedPublication")
+	// DanglingTransactionIssue is a volume transaction left behind by an operation that did not finish
+	DanglingTransactionIssue = ConsistencyIssueType("danglingTransaction")
+	// MissingVolumeIssue is a persistent volume whose Trident volume no longer exists
+	MissingVolumeIssue = ConsistencyIssueType("missingVolume")
+)
+
+// ConsistencyIssue describes one inconsistency found by a consistency check.  Repair describes the fix that a
+// repairing check applies, if the inconsistency may be fixed safely; Repaired and RepairError report its outcome.
+type ConsistencyIssue struct {
+	Type        ConsistencyIssueType `json:"type"`
+	Object      string               `json:"object"`
+	Backend     string               `json:"backend,omitempty"`
+	Node        string               `json:"node,omitempty"`
+	Message     string               `json:"message"`
+	Repair      string               `json:"repair,omitempty"`
+	Repaired    bool                 `json:"repaired,omitempty"`
+	RepairError string               `json:"repairError,omitempty"`
+}
+
+// ConsistencyReport reports the inconsistencies found between the persistent store, the storage backends and
+// the container orchestrator.  Skipped lists the checks that could not be made, with the reason, and
+// ListedBackends names the backends whose volumes were listed.
+type ConsistencyReport struct {
+	Repair         bool                `json:"repair"`
+	Skipped        []string            `json:"skipped,omitempty"`
+	ListedBackends []string            `json:"listedBackends,omitempty"`
+	Issues         []*ConsistencyIssue `json:"issues"`
+}
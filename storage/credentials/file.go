@@ -0,0 +1,78 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/netapp/trident/logging"
+	drivers "github.com/netapp/trident/storage_drivers"
+	"github.com/netapp/trident/utils"
+)
+
+// FileProvider reads credentials from a directory, typically one mounted into the Trident controller by a
+// secrets store driver.  Each set of credentials is a subdirectory holding one file per key, whose contents are
+// the value; this is the layout of a mounted Kubernetes Secret or of a secrets store CSI volume.
+type FileProvider struct {
+	directory string
+}
+
+// NewFileProvider returns a provider that reads the credentials below the given directory.
+func NewFileProvider(directory string) (*FileProvider, error) {
+	info, err := os.Stat(directory)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials directory; %v", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("credentials path %s is not a directory", directory)
+	}
+	return &FileProvider{directory: directory}, nil
+}
+
+func (p *FileProvider) Type() string {
+	return string(drivers.CredentialStoreFile)
+}
+
+func (p *FileProvider) GetCredentials(ctx context.Context, name string) (map[string]string, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	credentialsDir := filepath.Join(p.directory, name)
+	entries, err := os.ReadDir(credentialsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, utils.NotFoundError(fmt.Sprintf("credentials %s not found", name))
+		}
+		return nil, fmt.Errorf("could not read credentials %s; %v", name, err)
+	}
+
+	credentials := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		// Mounted volumes keep their contents in hidden, timestamped directories behind symlinks
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(credentialsDir, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		value, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read credentials %s; %v", name, err)
+		}
+		credentials[entry.Name()] = strings.TrimRight(string(value), "\r\n")
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"credentials": name,
+		"keys":        len(credentials),
+	}).Debug("Read credentials from directory.")
+
+	return normalizeKeys(credentials), nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/utils"
+)
+
+func TestNewFileProvider(t *testing.T) {
+	directory := t.TempDir()
+
+	provider, err := NewFileProvider(directory)
+	assert.NoError(t, err)
+	assert.Equal(t, "file", provider.Type())
+
+	_, err = NewFileProvider(filepath.Join(directory, "missing"))
+	assert.Error(t, err, "expected error for missing directory")
+
+	file := filepath.Join(directory, "file")
+	assert.NoError(t, os.WriteFile(file, []byte("x"), 0o600))
+	_, err = NewFileProvider(file)
+	assert.Error(t, err, "expected error for regular file")
+}
+
+func TestFileProviderGetCredentials(t *testing.T) {
+	directory := t.TempDir()
+	credentialsDir := filepath.Join(directory, "svm1")
+	assert.NoError(t, os.MkdirAll(filepath.Join(credentialsDir, "..2023_05_01"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(credentialsDir, "Username"), []byte("admin\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(credentialsDir, "password"), []byte("p@ss word"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(credentialsDir, "..data"), []byte("hidden"), 0o600))
+
+	provider, err := NewFileProvider(directory)
+	assert.NoError(t, err)
+
+	credentials, err := provider.GetCredentials(context.Background(), "svm1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "admin", "password": "p@ss word"}, credentials)
+
+	_, err = provider.GetCredentials(context.Background(), "svm2")
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+
+	for _, name := range []string{"", "..", "../svm1", "svm1/password", ".hidden"} {
+		_, err = provider.GetCredentials(context.Background(), name)
+		assert.Error(t, err, "expected error for name '%s'", name)
+		assert.False(t, utils.IsNotFoundError(err))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package credentials
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	drivers "github.com/netapp/trident/storage_drivers"
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// HTTPProviderTimeout bounds each request to the credentials service
+	HTTPProviderTimeout = 30 * time.Second
+	// maxCredentialsResponseSize bounds the response read from the credentials service
+	maxCredentialsResponseSize = 1 << 20
+)
+
+// HTTPProvider reads credentials from a generic HTTP service, such as a proxy in front of a key management
+// system.  The credentials with a given name are read with a GET request to <url>/<name>, which must return a
+// JSON object whose values are all strings.
+type HTTPProvider struct {
+	url        string
+	tokenFile  string
+	httpClient *http.Client
+}
+
+// NewHTTPProvider returns a provider that reads credentials from the service at the given URL.  If a token file
+// is given, its contents are sent as a bearer token, and the file is read again on every request so that the
+// token may be rotated.  If a CA file is given, the service certificate is verified against it instead of the
+// system roots.
+func NewHTTPProvider(serviceURL, tokenFile, caFile string) (*HTTPProvider, error) {
+	parsedURL, err := url.Parse(serviceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials URL; %v", err)
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf("credentials URL %s must use http or https", serviceURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: config.MinClientTLSVersion}
+	if caFile != "" {
+		caCert, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read credentials CA file; %v", err)
+		}
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("credentials CA file %s contains no certificates", caFile)
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	return &HTTPProvider{
+		url:       strings.TrimRight(serviceURL, "/"),
+		tokenFile: tokenFile,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+			Timeout: HTTPProviderTimeout,
+		},
+	}, nil
+}
+
+func (p *HTTPProvider) Type() string {
+	return string(drivers.CredentialStoreHTTP)
+}
+
+func (p *HTTPProvider) GetCredentials(ctx context.Context, name string) (map[string]string, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+	if p.tokenFile != "" {
+		token, err := os.ReadFile(p.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read credentials token file; %v", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials %s; %v", name, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxCredentialsResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("could not read credentials %s; %v", name, err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, utils.NotFoundError(fmt.Sprintf("credentials %s not found", name))
+	default:
+		// The response body is not logged, since it could contain credentials
+		return nil, fmt.Errorf("could not read credentials %s; credentials service returned %s", name,
+			response.Status)
+	}
+
+	credentials := make(map[string]string)
+	if err = json.Unmarshal(body, &credentials); err != nil {
+		return nil, fmt.Errorf("could not parse credentials %s; the credentials service must return a JSON "+
+			"object of strings", name)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"credentials": name,
+		"keys":        len(credentials),
+	}).Debug("Read credentials from credentials service.")
+
+	return normalizeKeys(credentials), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/utils"
+)
+
+// newCredentialsServer returns a stub credentials service that requires the given bearer token.
+func newCredentialsServer(t *testing.T, token string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/creds/svm1":
+			_, _ = w.Write([]byte(`{"Username": "admin", "password": "secret"}`))
+		case "/v1/creds/invalid":
+			_, _ = w.Write([]byte(`{"username": ["admin"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewHTTPProvider(t *testing.T) {
+	provider, err := NewHTTPProvider("https://kms.example.com/v1/creds/", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "http", provider.Type())
+	assert.Equal(t, "https://kms.example.com/v1/creds", provider.url)
+
+	_, err = NewHTTPProvider("ftp://kms.example.com", "", "")
+	assert.Error(t, err, "expected error for unsupported scheme")
+
+	_, err = NewHTTPProvider("https://kms.example.com", "", filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err, "expected error for missing CA file")
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	assert.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	_, err = NewHTTPProvider("https://kms.example.com", "", caFile)
+	assert.Error(t, err, "expected error for invalid CA file")
+}
+
+func TestHTTPProviderGetCredentials(t *testing.T) {
+	server := newCredentialsServer(t, "token1")
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("token1\n"), 0o600))
+
+	provider, err := NewHTTPProvider(server.URL+"/v1/creds", tokenFile, "")
+	assert.NoError(t, err)
+
+	credentials, err := provider.GetCredentials(context.Background(), "svm1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "admin", "password": "secret"}, credentials)
+
+	_, err = provider.GetCredentials(context.Background(), "svm2")
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+
+	_, err = provider.GetCredentials(context.Background(), "invalid")
+	assert.Error(t, err, "expected error for invalid response")
+
+	_, err = provider.GetCredentials(context.Background(), "../svm1")
+	assert.Error(t, err, "expected error for invalid name")
+
+	// The token file is read on every request
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("token2"), 0o600))
+	_, err = provider.GetCredentials(context.Background(), "svm1")
+	assert.Error(t, err, "expected error for rejected token")
+	assert.False(t, utils.IsNotFoundError(err))
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+// Package credentials provides the external stores from which backend credentials may be read in place of a
+// Kubernetes Secret.  A backend config selects a store with the type in its credentials field, for example
+// "credentials": {"name": "ontap-svm1", "type": "file"}.
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Provider reads named sets of backend credentials from an external store.
+type Provider interface {
+	// Type returns the value of the credentials type field that selects this provider.
+	Type() string
+	// GetCredentials returns the credentials with the given name, with every key in lower case as the storage
+	// drivers expect.  A NotFoundError is returned if the store has no credentials by that name.
+	GetCredentials(ctx context.Context, name string) (map[string]string, error)
+}
+
+// validateName ensures a credentials name can be used as a single path element by the providers.
+func validateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("credentials name cannot be empty")
+	}
+	if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid credentials name '%s'", name)
+	}
+	return nil
+}
+
+// normalizeKeys returns a copy of a credentials map with every key in lower case.
+func normalizeKeys(credentials map[string]string) map[string]string {
+	normalized := make(map[string]string, len(credentials))
+	for key, value := range credentials {
+		normalized[strings.ToLower(key)] = value
+	}
+	return normalized
+}
@@ -9,14 +9,17 @@ import (
 type StoragePool struct {
 	Attrs map[string]sa.Offer `json:"attributes"`
 	Bytes uint64              `json:"sizeBytes"`
+	// TotalBytes is the capacity reported for the pool.  If unset, the initial value of Bytes is used.
+	TotalBytes uint64 `json:"totalBytes,omitempty"`
 }
 
 // UnmarshalJSON implements json.Unmarshaler and allows FakeStoragePool
 // to be unmarshaled with the Attrs map correctly defined.
 func (p *StoragePool) UnmarshalJSON(data []byte) error {
 	var tmp struct {
-		Attrs json.RawMessage `json:"attributes"`
-		Bytes uint64          `json:"sizeBytes"`
+		Attrs      json.RawMessage `json:"attributes"`
+		Bytes      uint64          `json:"sizeBytes"`
+		TotalBytes uint64          `json:"totalBytes,omitempty"`
 	}
 
 	err := json.Unmarshal(data, &tmp)
@@ -28,12 +31,14 @@ func (p *StoragePool) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	p.Bytes = tmp.Bytes
+	p.TotalBytes = tmp.TotalBytes
 	return nil
 }
 
 func (p *StoragePool) ConstructClone() *StoragePool {
 	return &StoragePool{
-		Attrs: p.Attrs,
-		Bytes: p.Bytes,
+		Attrs:      p.Attrs,
+		Bytes:      p.Bytes,
+		TotalBytes: p.TotalBytes,
 	}
 }
@@ -5,6 +5,9 @@ type Volume struct {
 	RequestedPool string `json:"requestedPool"`
 	PhysicalPool  string
 	SizeBytes     uint64 `json:"size"`
+	// Abnormal and HealthMessage let tests simulate a volume with a problem on the storage backend
+	Abnormal      bool   `json:"abnormal,omitempty"`
+	HealthMessage string `json:"healthMessage,omitempty"`
 }
 
 type CreatingVolume struct {
@@ -0,0 +1,101 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+)
+
+// GroupSnapshotConfig describes a set of volumes that are snapshotted together at a single point in time.
+// Each member snapshot is named after the group, so a member's ID is formed from its volume and the group name.
+type GroupSnapshotConfig struct {
+	Version      string   `json:"version,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	InternalName string   `json:"internalName,omitempty"`
+	VolumeNames  []string `json:"volumeNames,omitempty"`
+}
+
+func (c *GroupSnapshotConfig) Validate() error {
+	if c.Name == "" || len(c.VolumeNames) == 0 {
+		return fmt.Errorf("the following fields for \"GroupSnapshot\" are mandatory: name and volumeNames")
+	}
+	seen := make(map[string]struct{}, len(c.VolumeNames))
+	for _, volumeName := range c.VolumeNames {
+		if _, ok := seen[volumeName]; ok {
+			return fmt.Errorf("volume %s appears more than once in group snapshot %s", volumeName, c.Name)
+		}
+		seen[volumeName] = struct{}{}
+	}
+	return nil
+}
+
+// SnapshotIDs returns the IDs of the member snapshots of a group snapshot.
+func (c *GroupSnapshotConfig) SnapshotIDs() []string {
+	ids := make([]string, 0, len(c.VolumeNames))
+	for _, volumeName := range c.VolumeNames {
+		ids = append(ids, MakeSnapshotID(volumeName, c.Name))
+	}
+	return ids
+}
+
+type GroupSnapshot struct {
+	Config  *GroupSnapshotConfig
+	Created string `json:"dateCreated"` // The UTC time that the group snapshot was created, in RFC3339 format
+}
+
+type GroupSnapshotExternal struct {
+	GroupSnapshot
+	Snapshots []*SnapshotExternal `json:"snapshots"`
+}
+
+type GroupSnapshotPersistent struct {
+	GroupSnapshot
+}
+
+func NewGroupSnapshot(config *GroupSnapshotConfig, created string) *GroupSnapshot {
+	return &GroupSnapshot{
+		Config:  config,
+		Created: created,
+	}
+}
+
+func (g *GroupSnapshot) ID() string {
+	return g.Config.Name
+}
+
+// ConstructExternal returns the external form of a group snapshot, which includes its member snapshots.
+func (g *GroupSnapshot) ConstructExternal(snapshots []*Snapshot) *GroupSnapshotExternal {
+	clone := g.ConstructClone()
+	external := &GroupSnapshotExternal{
+		GroupSnapshot: *clone,
+		Snapshots:     make([]*SnapshotExternal, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		external.Snapshots = append(external.Snapshots, snapshot.ConstructExternal())
+	}
+	return external
+}
+
+func (g *GroupSnapshot) ConstructPersistent() *GroupSnapshotPersistent {
+	clone := g.ConstructClone()
+	return &GroupSnapshotPersistent{GroupSnapshot: *clone}
+}
+
+func (g *GroupSnapshot) ConstructClone() *GroupSnapshot {
+	volumeNames := make([]string, len(g.Config.VolumeNames))
+	copy(volumeNames, g.Config.VolumeNames)
+
+	return &GroupSnapshot{
+		Config: &GroupSnapshotConfig{
+			Version:      g.Config.Version,
+			Name:         g.Config.Name,
+			InternalName: g.Config.InternalName,
+			VolumeNames:  volumeNames,
+		},
+		Created: g.Created,
+	}
+}
+
+func (g *GroupSnapshotPersistent) ConstructExternal(snapshots []*Snapshot) *GroupSnapshotExternal {
+	return g.GroupSnapshot.ConstructExternal(snapshots)
+}
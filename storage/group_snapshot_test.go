@@ -0,0 +1,57 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupSnapshotConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		config      *GroupSnapshotConfig
+		expectError bool
+	}{
+		"Valid":            {config: &GroupSnapshotConfig{Name: "group", VolumeNames: []string{"vol1", "vol2"}}},
+		"No name":          {config: &GroupSnapshotConfig{VolumeNames: []string{"vol1"}}, expectError: true},
+		"No volumes":       {config: &GroupSnapshotConfig{Name: "group"}, expectError: true},
+		"Duplicate volume": {config: &GroupSnapshotConfig{Name: "group", VolumeNames: []string{"vol1", "vol1"}}, expectError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGroupSnapshotConstruct(t *testing.T) {
+	groupSnapshot := NewGroupSnapshot(&GroupSnapshotConfig{
+		Name:         "group",
+		InternalName: "group",
+		VolumeNames:  []string{"vol1", "vol2"},
+	}, "2023-05-01T10:00:00Z")
+
+	assert.Equal(t, "group", groupSnapshot.ID())
+	assert.Equal(t, []string{"vol1/group", "vol2/group"}, groupSnapshot.Config.SnapshotIDs())
+
+	persistent := groupSnapshot.ConstructPersistent()
+	assert.Equal(t, *groupSnapshot.Config, *persistent.Config)
+	assert.Equal(t, groupSnapshot.Created, persistent.Created)
+
+	// The persistent copy must not share state with the original
+	persistent.Config.VolumeNames[0] = "vol3"
+	assert.Equal(t, "vol1", groupSnapshot.Config.VolumeNames[0])
+
+	snapshot := NewSnapshot(&SnapshotConfig{Name: "group", VolumeName: "vol1"}, "2023-05-01T10:00:00Z", 1024,
+		SnapshotStateOnline)
+	external := groupSnapshot.ConstructExternal([]*Snapshot{snapshot})
+	assert.Equal(t, "group", external.ID())
+	assert.Len(t, external.Snapshots, 1)
+	assert.Equal(t, "vol1/group", external.Snapshots[0].ID())
+}
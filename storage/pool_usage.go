@@ -0,0 +1,99 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"context"
+)
+
+// PoolUsage reports how full a storage pool is, using the capacity reported by its backend's driver, and how
+// many Trident volumes it holds.  A pool whose capacity could not be measured has a zero TotalBytes and an Error.
+type PoolUsage struct {
+	Backend       string   `json:"backend"`
+	BackendUUID   string   `json:"backendUUID"`
+	Pool          string   `json:"pool"`
+	TotalBytes    uint64   `json:"totalBytes"`
+	FreeBytes     uint64   `json:"freeBytes"`
+	Volumes       int      `json:"volumes"`
+	PhysicalPools []string `json:"physicalPools,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// HasCapacity returns whether the capacity of a pool was measured.
+func (u *PoolUsage) HasCapacity() bool {
+	return u.TotalBytes > 0
+}
+
+// UsedFraction returns the fraction of a pool's space in use, or 1 if its capacity is unknown.
+func (u *PoolUsage) UsedFraction() float64 {
+	if !u.HasCapacity() {
+		return 1
+	}
+	if u.FreeBytes >= u.TotalBytes {
+		return 0
+	}
+	return float64(u.TotalBytes-u.FreeBytes) / float64(u.TotalBytes)
+}
+
+// IsPhysical returns whether a pool draws only from its own physical storage, so that moving a volume into
+// or out of it changes its usage alone.
+func (u *PoolUsage) IsPhysical() bool {
+	return len(u.PhysicalPools) == 0 || (len(u.PhysicalPools) == 1 && u.PhysicalPools[0] == u.Pool)
+}
+
+// GetPoolUsage measures a storage pool.  The volumes in the pool are counted from its backend's cache, while its
+// capacity is requested from the backend's driver, if the driver can report it.
+func GetPoolUsage(ctx context.Context, pool Pool) *PoolUsage {
+	backend := pool.Backend()
+	usage := &PoolUsage{
+		Backend:     backend.Name(),
+		BackendUUID: backend.BackendUUID(),
+		Pool:        pool.Name(),
+	}
+
+	usage.Volumes = CountPoolVolumes(pool)
+
+	if !backend.CanReportCapacity() {
+		usage.Error = "backend cannot report capacity"
+		return usage
+	}
+	capacity, err := backend.GetPoolCapacity(ctx, pool)
+	if err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	usage.TotalBytes = capacity.TotalBytes
+	usage.FreeBytes = capacity.FreeBytes
+	usage.PhysicalPools = capacity.PhysicalPools
+
+	return usage
+}
+
+// CountPoolVolumes returns the number of volumes in a storage pool, as counted from its backend's cache.
+func CountPoolVolumes(pool Pool) int {
+	count := 0
+	for _, volume := range pool.Backend().Volumes() {
+		if volume.Pool == pool.Name() {
+			count++
+		}
+	}
+	return count
+}
+
+// PoolRebalanceSuggestion names a volume that could be migrated from a fuller pool to an emptier one, so as to
+// even out the usage of the two.
+type PoolRebalanceSuggestion struct {
+	Volume        string `json:"volume"`
+	SizeBytes     uint64 `json:"sizeBytes"`
+	SourceBackend string `json:"sourceBackend"`
+	SourcePool    string `json:"sourcePool"`
+	TargetBackend string `json:"targetBackend"`
+	TargetPool    string `json:"targetPool"`
+}
+
+// PoolUsageReport reports the usage of all storage pools, together with any volume migrations that would even
+// out the usage of the pools.
+type PoolUsageReport struct {
+	Pools       []*PoolUsage               `json:"pools"`
+	Suggestions []*PoolRebalanceSuggestion `json:"suggestions,omitempty"`
+}
@@ -21,6 +21,8 @@ type SnapshotConfig struct {
 	VolumeName          string   `json:"volumeName,omitempty"`
 	VolumeInternalName  string   `json:"volumeInternalName,omitempty"`
 	LUKSPassphraseNames []string `json:"luksPassphraseNames,omitempty"`
+	// PolicyName is the Trident snapshot policy that created this snapshot, if any
+	PolicyName string `json:"policyName,omitempty"`
 }
 
 func (c *SnapshotConfig) ID() string {
@@ -111,6 +113,7 @@ func (s *Snapshot) ConstructClone() *Snapshot {
 			VolumeName:          s.Config.VolumeName,
 			VolumeInternalName:  s.Config.VolumeInternalName,
 			LUKSPassphraseNames: s.Config.LUKSPassphraseNames,
+			PolicyName:          s.Config.PolicyName,
 		},
 		Created:   s.Created,
 		SizeBytes: s.SizeBytes,
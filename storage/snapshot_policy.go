@@ -0,0 +1,197 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// snapshotPolicyTimeFormat is used in the names of scheduled snapshots, which must be valid on every backend
+	snapshotPolicyTimeFormat = "20060102-150405"
+	// maxSnapshotPolicyNameLength leaves room for the timestamp within the shortest backend snapshot name limit
+	maxSnapshotPolicyNameLength = 40
+)
+
+var snapshotPolicyNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// SnapshotPolicyConfig describes a Trident-managed snapshot schedule.  On each activation of the cron schedule,
+// a snapshot is created of every volume selected by the policy, and snapshots created by the policy are pruned
+// once they exceed the retention count or age.  A volume is selected when its labels match every entry in the
+// volume selector and its storage class is one of the listed storage classes; an empty criterion matches all.
+type SnapshotPolicyConfig struct {
+	Version        string            `json:"version,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Schedule       string            `json:"schedule,omitempty"`
+	RetentionCount int               `json:"retentionCount,omitempty"`
+	RetentionAge   string            `json:"retentionAge,omitempty"`
+	VolumeSelector map[string]string `json:"volumeSelector,omitempty"`
+	StorageClasses []string          `json:"storageClasses,omitempty"`
+}
+
+func (c *SnapshotPolicyConfig) Validate() error {
+	if c.Name == "" || c.Schedule == "" {
+		return fmt.Errorf("the following fields for \"SnapshotPolicy\" are mandatory: name and schedule")
+	}
+	if len(c.Name) > maxSnapshotPolicyNameLength || !snapshotPolicyNameRegex.MatchString(c.Name) {
+		return fmt.Errorf("snapshot policy name %s must consist of at most %d lower case alphanumeric "+
+			"characters or '-', and must start and end with an alphanumeric character", c.Name,
+			maxSnapshotPolicyNameLength)
+	}
+
+	schedule, err := utils.ParseCronSchedule(c.Schedule)
+	if err != nil {
+		return err
+	}
+	if schedule.Next(time.Now().UTC()).IsZero() {
+		return fmt.Errorf("cron schedule '%s' never fires", c.Schedule)
+	}
+
+	if c.RetentionCount < 0 {
+		return fmt.Errorf("retentionCount must not be negative")
+	}
+	retentionAge, err := c.GetRetentionAge()
+	if err != nil {
+		return err
+	}
+	if c.RetentionCount == 0 && retentionAge == 0 {
+		return fmt.Errorf("snapshot policy %s must specify retentionCount, retentionAge or both", c.Name)
+	}
+
+	if len(c.VolumeSelector) == 0 && len(c.StorageClasses) == 0 {
+		return fmt.Errorf("snapshot policy %s must specify volumeSelector, storageClasses or both", c.Name)
+	}
+
+	return nil
+}
+
+// GetRetentionAge returns the parsed retention age, or zero if none is set.
+func (c *SnapshotPolicyConfig) GetRetentionAge() (time.Duration, error) {
+	if c.RetentionAge == "" {
+		return 0, nil
+	}
+	retentionAge, err := time.ParseDuration(c.RetentionAge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retentionAge %s; %v", c.RetentionAge, err)
+	}
+	if retentionAge < 0 {
+		return 0, fmt.Errorf("retentionAge must not be negative")
+	}
+	return retentionAge, nil
+}
+
+// SelectsVolume returns whether the policy applies to a volume.
+func (c *SnapshotPolicyConfig) SelectsVolume(volumeConfig *VolumeConfig) bool {
+	for key, value := range c.VolumeSelector {
+		if labelValue, ok := volumeConfig.Labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	if len(c.StorageClasses) > 0 && !utils.SliceContainsString(c.StorageClasses, volumeConfig.StorageClass) {
+		return false
+	}
+	return true
+}
+
+// SnapshotName returns the name of the snapshot the policy creates for a scheduled run.
+func (c *SnapshotPolicyConfig) SnapshotName(runTime time.Time) string {
+	return fmt.Sprintf("%s-%s", c.Name, runTime.UTC().Format(snapshotPolicyTimeFormat))
+}
+
+type SnapshotPolicy struct {
+	Config  *SnapshotPolicyConfig
+	Created string `json:"dateCreated"`       // The UTC time that the policy was created, in RFC3339 format
+	LastRun string `json:"lastRun,omitempty"` // The UTC time of the last scheduled run, in RFC3339 format
+}
+
+type SnapshotPolicyExternal struct {
+	SnapshotPolicy
+	NextRun string `json:"nextRun,omitempty"` // The UTC time of the next scheduled run, in RFC3339 format
+}
+
+type SnapshotPolicyPersistent struct {
+	SnapshotPolicy
+}
+
+func NewSnapshotPolicy(config *SnapshotPolicyConfig, created string) *SnapshotPolicy {
+	return &SnapshotPolicy{
+		Config:  config,
+		Created: created,
+	}
+}
+
+func (p *SnapshotPolicy) ID() string {
+	return p.Config.Name
+}
+
+// NextRun returns the time of the first scheduled run after the last one, or after the policy was created if it
+// has never run.  The zero time is returned if the schedule or the timestamps cannot be parsed.
+func (p *SnapshotPolicy) NextRun() time.Time {
+	schedule, err := utils.ParseCronSchedule(p.Config.Schedule)
+	if err != nil {
+		return time.Time{}
+	}
+
+	since := p.LastRun
+	if since == "" {
+		since = p.Created
+	}
+	sinceTime, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return schedule.Next(sinceTime.UTC())
+}
+
+func (p *SnapshotPolicy) ConstructExternal() *SnapshotPolicyExternal {
+	clone := p.ConstructClone()
+	external := &SnapshotPolicyExternal{SnapshotPolicy: *clone}
+	if nextRun := p.NextRun(); !nextRun.IsZero() {
+		external.NextRun = nextRun.Format(time.RFC3339)
+	}
+	return external
+}
+
+func (p *SnapshotPolicy) ConstructPersistent() *SnapshotPolicyPersistent {
+	clone := p.ConstructClone()
+	return &SnapshotPolicyPersistent{SnapshotPolicy: *clone}
+}
+
+func (p *SnapshotPolicy) ConstructClone() *SnapshotPolicy {
+	var volumeSelector map[string]string
+	if p.Config.VolumeSelector != nil {
+		volumeSelector = make(map[string]string, len(p.Config.VolumeSelector))
+		for k, v := range p.Config.VolumeSelector {
+			volumeSelector[k] = v
+		}
+	}
+
+	var storageClasses []string
+	if p.Config.StorageClasses != nil {
+		storageClasses = make([]string, len(p.Config.StorageClasses))
+		copy(storageClasses, p.Config.StorageClasses)
+	}
+
+	return &SnapshotPolicy{
+		Config: &SnapshotPolicyConfig{
+			Version:        p.Config.Version,
+			Name:           p.Config.Name,
+			Schedule:       p.Config.Schedule,
+			RetentionCount: p.Config.RetentionCount,
+			RetentionAge:   p.Config.RetentionAge,
+			VolumeSelector: volumeSelector,
+			StorageClasses: storageClasses,
+		},
+		Created: p.Created,
+		LastRun: p.LastRun,
+	}
+}
+
+func (p *SnapshotPolicyPersistent) ConstructExternal() *SnapshotPolicyExternal {
+	return p.SnapshotPolicy.ConstructExternal()
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotPolicyConfigValidate(t *testing.T) {
+	valid := func() *SnapshotPolicyConfig {
+		return &SnapshotPolicyConfig{
+			Name:           "nightly",
+			Schedule:       "0 2 * * *",
+			RetentionCount: 7,
+			StorageClasses: []string{"gold"},
+		}
+	}
+
+	tests := map[string]struct {
+		modify      func(*SnapshotPolicyConfig)
+		expectError bool
+	}{
+		"Valid":               {modify: func(c *SnapshotPolicyConfig) {}},
+		"Valid with age only": {modify: func(c *SnapshotPolicyConfig) { c.RetentionCount = 0; c.RetentionAge = "168h" }},
+		"Valid with selector": {modify: func(c *SnapshotPolicyConfig) {
+			c.StorageClasses = nil
+			c.VolumeSelector = map[string]string{"app": "db"}
+		}},
+		"No name":               {modify: func(c *SnapshotPolicyConfig) { c.Name = "" }, expectError: true},
+		"Invalid name":          {modify: func(c *SnapshotPolicyConfig) { c.Name = "Nightly_Backup" }, expectError: true},
+		"Name too long":         {modify: func(c *SnapshotPolicyConfig) { c.Name = strings.Repeat("a", 41) }, expectError: true},
+		"No schedule":           {modify: func(c *SnapshotPolicyConfig) { c.Schedule = "" }, expectError: true},
+		"Invalid schedule":      {modify: func(c *SnapshotPolicyConfig) { c.Schedule = "0 25 * * *" }, expectError: true},
+		"Schedule never fires":  {modify: func(c *SnapshotPolicyConfig) { c.Schedule = "0 0 31 4 *" }, expectError: true},
+		"Negative count":        {modify: func(c *SnapshotPolicyConfig) { c.RetentionCount = -1 }, expectError: true},
+		"Invalid age":           {modify: func(c *SnapshotPolicyConfig) { c.RetentionAge = "1 week" }, expectError: true},
+		"Negative age":          {modify: func(c *SnapshotPolicyConfig) { c.RetentionAge = "-1h" }, expectError: true},
+		"No retention":          {modify: func(c *SnapshotPolicyConfig) { c.RetentionCount = 0 }, expectError: true},
+		"No selection criteria": {modify: func(c *SnapshotPolicyConfig) { c.StorageClasses = nil }, expectError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := valid()
+			test.modify(config)
+			err := config.Validate()
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSnapshotPolicyConfigSelectsVolume(t *testing.T) {
+	policyConfig := &SnapshotPolicyConfig{
+		VolumeSelector: map[string]string{"app": "db"},
+		StorageClasses: []string{"gold", "silver"},
+	}
+
+	tests := map[string]struct {
+		volumeConfig *VolumeConfig
+		expected     bool
+	}{
+		"Matches": {
+			volumeConfig: &VolumeConfig{StorageClass: "gold", Labels: map[string]string{"app": "db", "tier": "1"}},
+			expected:     true,
+		},
+		"Wrong label value": {
+			volumeConfig: &VolumeConfig{StorageClass: "gold", Labels: map[string]string{"app": "web"}},
+		},
+		"No labels": {
+			volumeConfig: &VolumeConfig{StorageClass: "gold"},
+		},
+		"Wrong storage class": {
+			volumeConfig: &VolumeConfig{StorageClass: "bronze", Labels: map[string]string{"app": "db"}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, policyConfig.SelectsVolume(test.volumeConfig))
+		})
+	}
+}
+
+func TestSnapshotPolicyNextRun(t *testing.T) {
+	policy := NewSnapshotPolicy(&SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly"}, "2023-05-01T10:30:00Z")
+	assert.Equal(t, time.Date(2023, 5, 1, 11, 0, 0, 0, time.UTC), policy.NextRun())
+
+	policy.LastRun = "2023-05-01T11:00:00Z"
+	assert.Equal(t, time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC), policy.NextRun())
+	assert.Equal(t, "2023-05-01T12:00:00Z", policy.ConstructExternal().NextRun)
+
+	policy.LastRun = "not a time"
+	assert.True(t, policy.NextRun().IsZero())
+	assert.Empty(t, policy.ConstructExternal().NextRun)
+
+	assert.Equal(t, "hourly-20230501-110000",
+		policy.Config.SnapshotName(time.Date(2023, 5, 1, 11, 0, 0, 0, time.UTC)))
+}
+
+func TestSnapshotPolicyConstruct(t *testing.T) {
+	policy := NewSnapshotPolicy(&SnapshotPolicyConfig{
+		Name:           "nightly",
+		Schedule:       "@daily",
+		RetentionCount: 3,
+		VolumeSelector: map[string]string{"app": "db"},
+		StorageClasses: []string{"gold"},
+	}, "2023-05-01T10:00:00Z")
+	policy.LastRun = "2023-05-02T00:00:00Z"
+
+	persistent := policy.ConstructPersistent()
+	assert.Equal(t, *policy.Config, *persistent.Config)
+	assert.Equal(t, policy.Created, persistent.Created)
+	assert.Equal(t, policy.LastRun, persistent.LastRun)
+
+	// The persistent copy must not share state with the original
+	persistent.Config.VolumeSelector["app"] = "web"
+	persistent.Config.StorageClasses[0] = "silver"
+	assert.Equal(t, "db", policy.Config.VolumeSelector["app"])
+	assert.Equal(t, "gold", policy.Config.StorageClasses[0])
+
+	external := persistent.ConstructExternal()
+	assert.Equal(t, "nightly", external.ID())
+	assert.Equal(t, "2023-05-03T00:00:00Z", external.NextRun)
+}
@@ -105,6 +105,15 @@ func (p *StoragePool) RemoveStorageClass(class string) bool {
 	return found
 }
 
+// PoolCapacity describes the space in a storage pool.  Pools that draw from shared physical
+// storage, such as virtual pools, list the physical pools they span so that callers adding
+// up the capacity of several pools may count shared space only once.
+type PoolCapacity struct {
+	TotalBytes    uint64   `json:"totalBytes"`
+	FreeBytes     uint64   `json:"freeBytes"`
+	PhysicalPools []string `json:"physicalPools,omitempty"`
+}
+
 type PoolExternal struct {
 	Name           string   `json:"name"`
 	StorageClasses []string `json:"storageClasses"`
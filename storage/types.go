@@ -52,6 +52,10 @@ type Backend interface {
 	GetVolumeExternal(ctx context.Context, volumeName string) (*VolumeExternal, error)
 	ImportVolume(ctx context.Context, volConfig *VolumeConfig) (*Volume, error)
 	ResizeVolume(ctx context.Context, volConfig *VolumeConfig, newSize string) error
+	CanModifyVolumes() bool
+	ModifyVolume(ctx context.Context, volConfig *VolumeConfig, modification VolumeModification) error
+	CanMoveVolumes() bool
+	MoveVolume(ctx context.Context, volConfig *VolumeConfig, targetPool Pool, restartFailed bool) (bool, int, error)
 	RenameVolume(ctx context.Context, volConfig *VolumeConfig, newName string) error
 	RemoveVolume(ctx context.Context, volConfig *VolumeConfig) error
 	RemoveCachedVolume(volumeName string)
@@ -60,6 +64,12 @@ type Backend interface {
 	GetSnapshots(ctx context.Context, volConfig *VolumeConfig) ([]*Snapshot, error)
 	CreateSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) (*Snapshot, error)
 	RestoreSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) error
+	RestoreRequiresNewestSnapshot() bool
+	SupportsGroupSnapshots() bool
+	CreateGroupSnapshot(
+		ctx context.Context, groupConfig *GroupSnapshotConfig, snapConfigs []*SnapshotConfig,
+		volConfigs []*VolumeConfig,
+	) ([]*Snapshot, error)
 	DeleteSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) error
 	GetUpdateType(ctx context.Context, origBackend Backend) *roaring.Bitmap
 	HasVolumes() bool
@@ -68,6 +78,12 @@ type Backend interface {
 	ReconcileNodeAccess(ctx context.Context, nodes []*utils.Node, tridentUUID string) error
 	CanGetState() bool
 	GetBackendState(ctx context.Context) (string, *roaring.Bitmap)
+	CanReportCapacity() bool
+	GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error)
+	CanCheckVolumeHealth() bool
+	GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error)
+	CanReportVolumeMetrics() bool
+	GetVolumeMetrics(ctx context.Context, volConfigs []*VolumeConfig) (map[string]*VolumeMetrics, error)
 	ConstructExternal(ctx context.Context) *BackendExternal
 	ConstructPersistent(ctx context.Context) *BackendPersistent
 	CanMirror() bool
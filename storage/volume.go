@@ -55,6 +55,20 @@ type VolumeConfig struct {
 	PreferredTopologies       []map[string]string    `json:"preferredTopologies,omitempty"`
 	AllowedTopologies         []map[string]string    `json:"allowedTopologies,omitempty"`
 	LUKSPassphraseNames       []string               `json:"luksPassphraseNames,omitempty"`
+	// Labels are the container orchestrator labels of the volume at the time it was created
+	Labels map[string]string `json:"labels,omitempty"`
+	// RequestName is the name of the container orchestrator's request for the volume, such as a PVC
+	RequestName string `json:"requestName,omitempty"`
+	// Namespace is the container orchestrator namespace of the request for the volume
+	Namespace string `json:"namespace,omitempty"`
+	// AutogrowThreshold is the percentage of used space at which Trident grows the volume; autogrow is off if empty
+	AutogrowThreshold string `json:"autogrowThreshold,omitempty"`
+	// AutogrowStep is the amount by which autogrow grows the volume, as a size or a percentage of the current size
+	AutogrowStep string `json:"autogrowStep,omitempty"`
+	// AutogrowMaxSize is the size beyond which autogrow does not grow the volume
+	AutogrowMaxSize string `json:"autogrowMaxSize,omitempty"`
+	// TieringPolicy is the FabricPool tiering policy of the volume, on backends that tier volumes
+	TieringPolicy string `json:"tieringPolicy,omitempty"`
 	// IsMirrorDestination is whether the volume is currently the destination in a mirror relationship
 	IsMirrorDestination bool `json:"mirrorDestination,omitempty"`
 	// PeerVolumeHandle is the internal volume handle for the source volume if this volume is a mirror destination
@@ -202,6 +216,36 @@ func (v *Volume) IsSubordinate() bool {
 	return v.State.IsSubordinate()
 }
 
+// VolumeHealth describes the condition of a volume on its storage backend.  A volume is abnormal if
+// the backend reports a problem that may affect its use, such as it being offline, missing or full.
+type VolumeHealth struct {
+	Abnormal bool   `json:"abnormal"`
+	Message  string `json:"message,omitempty"`
+}
+
+// VolumeMetrics describes the space used by a volume and its recent performance, as reported by its storage
+// backend.  Rates are averaged by the backend over a short sample period.
+type VolumeMetrics struct {
+	UsedBytes       int64 `json:"usedBytes"`
+	TotalBytes      int64 `json:"totalBytes"`
+	ReadIOPS        int64 `json:"readIOPS"`
+	WriteIOPS       int64 `json:"writeIOPS"`
+	ReadThroughput  int64 `json:"readThroughput"`  // Bytes per second
+	WriteThroughput int64 `json:"writeThroughput"` // Bytes per second
+	ReadLatency     int64 `json:"readLatency"`     // Microseconds
+	WriteLatency    int64 `json:"writeLatency"`    // Microseconds
+}
+
+// VolumeMetricsExternal identifies the volume, backend and container orchestrator request to which a set of
+// volume metrics belongs.
+type VolumeMetricsExternal struct {
+	Volume      string `json:"volume"`
+	Backend     string `json:"backend"`
+	RequestName string `json:"requestName,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	VolumeMetrics
+}
+
 // VolumeExternalWrapper is used to return volumes and errors via channels between goroutines
 type VolumeExternalWrapper struct {
 	Volume *VolumeExternal
@@ -0,0 +1,143 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/netapp/trident/utils"
+)
+
+// DefaultAutogrowStep is used when a volume's autogrow threshold is set without a step
+const DefaultAutogrowStep = "10%"
+
+// VolumeUsage is the space used in a volume's filesystem, as last reported by a node on which it is mounted.
+type VolumeUsage struct {
+	UsedBytes  int64  `json:"usedBytes"`
+	TotalBytes int64  `json:"totalBytes"`
+	Reported   string `json:"reported,omitempty"` // The UTC time the usage was reported, in RFC3339 format
+}
+
+// AutogrowEnabled returns whether Trident grows the volume automatically as it fills.
+func (c *VolumeConfig) AutogrowEnabled() bool {
+	return c.AutogrowThreshold != ""
+}
+
+// ValidateAutogrow checks the volume's autogrow settings, if any.
+func (c *VolumeConfig) ValidateAutogrow() error {
+	if !c.AutogrowEnabled() {
+		if c.AutogrowStep != "" || c.AutogrowMaxSize != "" {
+			return fmt.Errorf("autogrowStep and autogrowMaxSize require autogrowThreshold")
+		}
+		return nil
+	}
+	if _, err := c.getAutogrowThreshold(); err != nil {
+		return err
+	}
+	if _, _, err := c.getAutogrowStep(); err != nil {
+		return err
+	}
+	if _, err := c.getAutogrowMaxSize(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AutogrowSize returns the size in bytes to which the volume should be grown, given its reported usage, or
+// zero if the volume is below its autogrow threshold or has already reached its maximum size.
+func (c *VolumeConfig) AutogrowSize(usage *VolumeUsage) (uint64, error) {
+	if !c.AutogrowEnabled() || usage == nil || usage.TotalBytes <= 0 {
+		return 0, nil
+	}
+
+	threshold, err := c.getAutogrowThreshold()
+	if err != nil {
+		return 0, err
+	}
+	if usage.UsedBytes*100 < threshold*usage.TotalBytes {
+		return 0, nil
+	}
+
+	sizeBytes, err := utils.ConvertSizeToBytes(c.Size)
+	if err != nil {
+		return 0, err
+	}
+	currentSize, err := strconv.ParseUint(sizeBytes, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid volume size %s; %v", c.Size, err)
+	}
+
+	stepBytes, stepPercent, err := c.getAutogrowStep()
+	if err != nil {
+		return 0, err
+	}
+	newSize := currentSize + stepBytes
+	if stepPercent > 0 {
+		newSize = currentSize + currentSize*stepPercent/100
+	}
+
+	maxSize, err := c.getAutogrowMaxSize()
+	if err != nil {
+		return 0, err
+	}
+	if maxSize > 0 && newSize > maxSize {
+		newSize = maxSize
+	}
+	if newSize <= currentSize {
+		return 0, nil
+	}
+	return newSize, nil
+}
+
+// getAutogrowThreshold returns the percentage of used space at which the volume is grown.
+func (c *VolumeConfig) getAutogrowThreshold() (int64, error) {
+	threshold, err := strconv.ParseInt(strings.TrimSuffix(c.AutogrowThreshold, "%"), 10, 64)
+	if err != nil || threshold < 1 || threshold > 99 {
+		return 0, fmt.Errorf("autogrowThreshold %s must be a percentage from 1 to 99", c.AutogrowThreshold)
+	}
+	return threshold, nil
+}
+
+// getAutogrowStep returns the amount by which the volume is grown, either in bytes or as a percentage of its
+// current size.
+func (c *VolumeConfig) getAutogrowStep() (stepBytes, stepPercent uint64, err error) {
+	step := c.AutogrowStep
+	if step == "" {
+		step = DefaultAutogrowStep
+	}
+
+	if strings.HasSuffix(step, "%") {
+		stepPercent, err = strconv.ParseUint(strings.TrimSuffix(step, "%"), 10, 64)
+		if err != nil || stepPercent == 0 || stepPercent > 1000 {
+			return 0, 0, fmt.Errorf("autogrowStep %s must be a size or a percentage from 1%% to 1000%%", step)
+		}
+		return 0, stepPercent, nil
+	}
+
+	sizeBytes, err := utils.ConvertSizeToBytes(step)
+	if err == nil {
+		stepBytes, err = strconv.ParseUint(sizeBytes, 10, 64)
+	}
+	if err != nil || stepBytes == 0 {
+		return 0, 0, fmt.Errorf("autogrowStep %s must be a size or a percentage from 1%% to 1000%%", step)
+	}
+	return stepBytes, 0, nil
+}
+
+// getAutogrowMaxSize returns the size in bytes beyond which the volume is not grown, or zero if there is no limit.
+func (c *VolumeConfig) getAutogrowMaxSize() (uint64, error) {
+	if c.AutogrowMaxSize == "" {
+		return 0, nil
+	}
+	sizeBytes, err := utils.ConvertSizeToBytes(c.AutogrowMaxSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid autogrowMaxSize %s; %v", c.AutogrowMaxSize, err)
+	}
+	maxSize, err := strconv.ParseUint(sizeBytes, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid autogrowMaxSize %s; %v", c.AutogrowMaxSize, err)
+	}
+	return maxSize, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeConfigValidateAutogrow(t *testing.T) {
+	tests := map[string]struct {
+		config      *VolumeConfig
+		expectError bool
+	}{
+		"Disabled":            {config: &VolumeConfig{}},
+		"Threshold only":      {config: &VolumeConfig{AutogrowThreshold: "80"}},
+		"Percent threshold":   {config: &VolumeConfig{AutogrowThreshold: "80%"}},
+		"Size step and limit": {config: &VolumeConfig{AutogrowThreshold: "90", AutogrowStep: "5Gi", AutogrowMaxSize: "1Ti"}},
+		"Percent step":        {config: &VolumeConfig{AutogrowThreshold: "90", AutogrowStep: "25%"}},
+		"Step without threshold": {
+			config: &VolumeConfig{AutogrowStep: "5Gi"}, expectError: true,
+		},
+		"Invalid threshold":  {config: &VolumeConfig{AutogrowThreshold: "high"}, expectError: true},
+		"Threshold too high": {config: &VolumeConfig{AutogrowThreshold: "100"}, expectError: true},
+		"Invalid step":       {config: &VolumeConfig{AutogrowThreshold: "80", AutogrowStep: "more"}, expectError: true},
+		"Zero step":          {config: &VolumeConfig{AutogrowThreshold: "80", AutogrowStep: "0%"}, expectError: true},
+		"Invalid max size":   {config: &VolumeConfig{AutogrowThreshold: "80", AutogrowMaxSize: "big"}, expectError: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.config.ValidateAutogrow()
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVolumeConfigAutogrowSize(t *testing.T) {
+	const gib = 1024 * 1024 * 1024
+
+	tests := map[string]struct {
+		config   *VolumeConfig
+		usage    *VolumeUsage
+		expected uint64
+	}{
+		"Disabled": {
+			config: &VolumeConfig{Size: "10737418240"},
+			usage:  &VolumeUsage{UsedBytes: 10 * gib, TotalBytes: 10 * gib},
+		},
+		"No usage": {
+			config: &VolumeConfig{Size: "10737418240", AutogrowThreshold: "80"},
+		},
+		"Below threshold": {
+			config: &VolumeConfig{Size: "10737418240", AutogrowThreshold: "80"},
+			usage:  &VolumeUsage{UsedBytes: 7 * gib, TotalBytes: 10 * gib},
+		},
+		"Default step": {
+			config:   &VolumeConfig{Size: "10737418240", AutogrowThreshold: "80"},
+			usage:    &VolumeUsage{UsedBytes: 8 * gib, TotalBytes: 10 * gib},
+			expected: 11 * gib,
+		},
+		"Size step": {
+			config:   &VolumeConfig{Size: "10737418240", AutogrowThreshold: "80", AutogrowStep: "5Gi"},
+			usage:    &VolumeUsage{UsedBytes: 9 * gib, TotalBytes: 10 * gib},
+			expected: 15 * gib,
+		},
+		"Capped at max size": {
+			config: &VolumeConfig{
+				Size: "10737418240", AutogrowThreshold: "80", AutogrowStep: "100%", AutogrowMaxSize: "12Gi",
+			},
+			usage:    &VolumeUsage{UsedBytes: 9 * gib, TotalBytes: 10 * gib},
+			expected: 12 * gib,
+		},
+		"At max size": {
+			config:   &VolumeConfig{Size: "10737418240", AutogrowThreshold: "80", AutogrowMaxSize: "10Gi"},
+			usage:    &VolumeUsage{UsedBytes: 9 * gib, TotalBytes: 10 * gib},
+			expected: 0,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			size, err := test.config.AutogrowSize(test.usage)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, size)
+		})
+	}
+}
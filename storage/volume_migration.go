@@ -0,0 +1,73 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"time"
+)
+
+// VolumeMigrationState is the phase of a volume migration.  A migration between pools of one backend only moves
+// the volume, while a migration between backends mirrors it to a new volume and then cuts over to that volume.  A
+// migration whose volume repeatedly failed to move is failed; the volume stays in its original pool, and is no
+// longer considered to be migrating.  A failed migration is only kept in memory, and is reported until its volume
+// is migrated again or deleted.
+type VolumeMigrationState string
+
+const (
+	VolumeMigrationMoving          = VolumeMigrationState("moving")
+	VolumeMigrationMirroring       = VolumeMigrationState("mirroring")
+	VolumeMigrationAwaitingCutover = VolumeMigrationState("awaitingCutover")
+	VolumeMigrationCuttingOver     = VolumeMigrationState("cuttingOver")
+	VolumeMigrationCleaningUp      = VolumeMigrationState("cleaningUp")
+	VolumeMigrationFailed          = VolumeMigrationState("failed")
+)
+
+// IsCuttingOver returns whether a migration has begun the final transfer to its new volume, after which the
+// volume may not be published until the migration completes.
+func (s VolumeMigrationState) IsCuttingOver() bool {
+	return s == VolumeMigrationCuttingOver || s == VolumeMigrationCleaningUp
+}
+
+// VolumeMigrationConfig records the progress of a long-running volume migration, so that it may be resumed
+// after a restart.
+type VolumeMigrationConfig struct {
+	StartTime         time.Time            `json:"startTime"`
+	SourceBackendUUID string               `json:"sourceBackendUUID"`
+	SourcePool        string               `json:"sourcePool"`
+	TargetBackendUUID string               `json:"targetBackendUUID"`
+	TargetPool        string               `json:"targetPool"`
+	State             VolumeMigrationState `json:"state"`
+	PercentComplete   int                  `json:"percentComplete,omitempty"`
+	// MoveFailures counts the moves of the volume between pools of its backend that have failed
+	MoveFailures int `json:"moveFailures,omitempty"`
+	// Message explains why the migration is not progressing, if it is not, or why it failed
+	Message string `json:"message,omitempty"`
+	// TargetConfig is the config of the new volume when migrating between backends
+	TargetConfig *VolumeConfig `json:"targetConfig,omitempty"`
+	// CutoverSnapshot is the ID of the snapshot whose transfer to the new volume completes a cutover
+	CutoverSnapshot string `json:"cutoverSnapshot,omitempty"`
+}
+
+// IsBetweenBackends returns whether a migration moves a volume to another backend.
+func (c *VolumeMigrationConfig) IsBetweenBackends() bool {
+	return c.SourceBackendUUID != c.TargetBackendUUID
+}
+
+// VolumeMigrateRequest is the body of a request to migrate a volume to another backend or pool.
+type VolumeMigrateRequest struct {
+	Backend string `json:"backend"`
+	Pool    string `json:"pool"`
+}
+
+// VolumeMigrationExternal reports the progress of a volume migration.
+type VolumeMigrationExternal struct {
+	Volume          string               `json:"volume"`
+	SourceBackend   string               `json:"sourceBackend"`
+	SourcePool      string               `json:"sourcePool"`
+	TargetBackend   string               `json:"targetBackend"`
+	TargetPool      string               `json:"targetPool"`
+	State           VolumeMigrationState `json:"state"`
+	PercentComplete int                  `json:"percentComplete"`
+	StartTime       time.Time            `json:"startTime"`
+	Message         string               `json:"message,omitempty"`
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/netapp/trident/utils"
+)
+
+// Names of the volume attributes that may be changed after a volume is created.  They match the JSON names of the
+// corresponding VolumeConfig fields, so they may be used unchanged as the parameters of a Kubernetes
+// VolumeAttributesClass.
+const (
+	ModifiableQosPolicy         = "qosPolicy"
+	ModifiableAdaptiveQosPolicy = "adaptiveQosPolicy"
+	ModifiableSnapshotPolicy    = "snapshotPolicy"
+	ModifiableSnapshotDir       = "snapshotDirectory"
+	ModifiableTieringPolicy     = "tieringPolicy"
+	ModifiableExportPolicy      = "exportPolicy"
+	ModifiableUnixPermissions   = "unixPermissions"
+)
+
+var modifiableVolumeAttributes = map[string]func(*VolumeConfig) *string{
+	ModifiableQosPolicy:         func(c *VolumeConfig) *string { return &c.QosPolicy },
+	ModifiableAdaptiveQosPolicy: func(c *VolumeConfig) *string { return &c.AdaptiveQosPolicy },
+	ModifiableSnapshotPolicy:    func(c *VolumeConfig) *string { return &c.SnapshotPolicy },
+	ModifiableSnapshotDir:       func(c *VolumeConfig) *string { return &c.SnapshotDir },
+	ModifiableTieringPolicy:     func(c *VolumeConfig) *string { return &c.TieringPolicy },
+	ModifiableExportPolicy:      func(c *VolumeConfig) *string { return &c.ExportPolicy },
+	ModifiableUnixPermissions:   func(c *VolumeConfig) *string { return &c.UnixPermissions },
+}
+
+// VolumeModification is a set of new values for the modifiable attributes of a volume, keyed by attribute name.
+// Attributes not named are left unchanged.
+type VolumeModification map[string]string
+
+// VolumeModifyRequest is the body of a request to modify a volume, shaped like a VolumeAttributesClass.
+type VolumeModifyRequest struct {
+	Parameters VolumeModification `json:"parameters"`
+}
+
+// Validate checks that a modification only names modifiable attributes, and that their values are well-formed.
+// Whether a backend accepts the values is left to its driver.
+func (m VolumeModification) Validate() error {
+	if len(m) == 0 {
+		return fmt.Errorf("no volume attributes to modify")
+	}
+
+	for name, value := range m {
+		if _, ok := modifiableVolumeAttributes[name]; !ok {
+			return fmt.Errorf("volume attribute %s cannot be modified; modifiable attributes are %s", name,
+				strings.Join(ModifiableVolumeAttributes(), ", "))
+		}
+
+		switch name {
+		case ModifiableSnapshotDir:
+			if _, err := strconv.ParseBool(value); err != nil {
+				return fmt.Errorf("invalid boolean value for %s: %s", name, value)
+			}
+		case ModifiableUnixPermissions:
+			if err := utils.ValidateOctalUnixPermissions(value); err != nil {
+				return fmt.Errorf("invalid value for %s; %v", name, err)
+			}
+		case ModifiableExportPolicy, ModifiableSnapshotPolicy:
+			if value == "" {
+				return fmt.Errorf("%s cannot be empty", name)
+			}
+		}
+	}
+
+	if m[ModifiableQosPolicy] != "" && m[ModifiableAdaptiveQosPolicy] != "" {
+		return fmt.Errorf("only one kind of QoS policy group may be defined")
+	}
+
+	return nil
+}
+
+// Changes returns the attributes of a modification whose values differ from those of the volume.  Setting
+// either kind of QoS policy group also clears the other kind.
+func (m VolumeModification) Changes(volConfig *VolumeConfig) VolumeModification {
+	changes := make(VolumeModification)
+	for name, value := range m {
+		if *modifiableVolumeAttributes[name](volConfig) != value {
+			changes[name] = value
+		}
+	}
+
+	if changes[ModifiableQosPolicy] != "" && volConfig.AdaptiveQosPolicy != "" {
+		changes[ModifiableAdaptiveQosPolicy] = ""
+	}
+	if changes[ModifiableAdaptiveQosPolicy] != "" && volConfig.QosPolicy != "" {
+		changes[ModifiableQosPolicy] = ""
+	}
+
+	return changes
+}
+
+// Apply sets the modified attributes in a volume config.
+func (m VolumeModification) Apply(volConfig *VolumeConfig) {
+	for name, value := range m {
+		if attribute, ok := modifiableVolumeAttributes[name]; ok {
+			*attribute(volConfig) = value
+		}
+	}
+}
+
+// ModifiableVolumeAttributes returns the sorted names of the volume attributes that may be modified.
+func ModifiableVolumeAttributes() []string {
+	names := make([]string, 0, len(modifiableVolumeAttributes))
+	for name := range modifiableVolumeAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
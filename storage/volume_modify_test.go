@@ -0,0 +1,63 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeModificationValidate(t *testing.T) {
+	tests := map[string]struct {
+		modification VolumeModification
+		valid        bool
+	}{
+		"Empty":                {VolumeModification{}, false},
+		"Unknown attribute":    {VolumeModification{"size": "1Gi"}, false},
+		"Snapshot policy":      {VolumeModification{ModifiableSnapshotPolicy: "default"}, true},
+		"Empty export policy":  {VolumeModification{ModifiableExportPolicy: ""}, false},
+		"Snapshot directory":   {VolumeModification{ModifiableSnapshotDir: "true"}, true},
+		"Invalid snapshot dir": {VolumeModification{ModifiableSnapshotDir: "sometimes"}, false},
+		"Unix permissions":     {VolumeModification{ModifiableUnixPermissions: "0750"}, true},
+		"Invalid permissions":  {VolumeModification{ModifiableUnixPermissions: "0999"}, false},
+		"Tiering policy":       {VolumeModification{ModifiableTieringPolicy: "auto"}, true},
+		"Both QoS kinds": {
+			VolumeModification{ModifiableQosPolicy: "gold", ModifiableAdaptiveQosPolicy: "silver"}, false,
+		},
+		"Clear one QoS kind": {
+			VolumeModification{ModifiableQosPolicy: "gold", ModifiableAdaptiveQosPolicy: ""}, true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.modification.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVolumeModificationChanges(t *testing.T) {
+	volConfig := &VolumeConfig{
+		SnapshotPolicy:    "default",
+		SnapshotDir:       "false",
+		AdaptiveQosPolicy: "silver",
+	}
+
+	// Unchanged attributes are left out
+	changes := VolumeModification{ModifiableSnapshotPolicy: "default", ModifiableSnapshotDir: "true"}.Changes(volConfig)
+	assert.Equal(t, VolumeModification{ModifiableSnapshotDir: "true"}, changes)
+
+	// Setting one kind of QoS policy group clears the other
+	changes = VolumeModification{ModifiableQosPolicy: "gold"}.Changes(volConfig)
+	assert.Equal(t, VolumeModification{ModifiableQosPolicy: "gold", ModifiableAdaptiveQosPolicy: ""}, changes)
+
+	changes.Apply(volConfig)
+	assert.Equal(t, "gold", volConfig.QosPolicy)
+	assert.Equal(t, "", volConfig.AdaptiveQosPolicy)
+	assert.Equal(t, "default", volConfig.SnapshotPolicy)
+}
@@ -10,24 +10,27 @@ type VolumeOperation string
 
 const (
 	// Transactions for synchronous operations
-	AddVolume      VolumeOperation = "addVolume"
-	DeleteVolume   VolumeOperation = "deleteVolume"
-	ImportVolume   VolumeOperation = "importVolume"
-	ResizeVolume   VolumeOperation = "resizeVolume"
-	UpgradeVolume  VolumeOperation = "upgradeVolume"
-	AddSnapshot    VolumeOperation = "addSnapshot"
-	DeleteSnapshot VolumeOperation = "deleteSnapshot"
+	AddVolume       VolumeOperation = "addVolume"
+	DeleteVolume    VolumeOperation = "deleteVolume"
+	ImportVolume    VolumeOperation = "importVolume"
+	ResizeVolume    VolumeOperation = "resizeVolume"
+	UpgradeVolume   VolumeOperation = "upgradeVolume"
+	AddSnapshot     VolumeOperation = "addSnapshot"
+	DeleteSnapshot  VolumeOperation = "deleteSnapshot"
+	RestoreSnapshot VolumeOperation = "restoreSnapshot"
 
 	// Transactions for long-running operations
 	VolumeCreating VolumeOperation = "volumeCreating"
+	MigrateVolume  VolumeOperation = "migrateVolume"
 )
 
 type VolumeTransaction struct {
-	Config               *VolumeConfig
-	VolumeCreatingConfig *VolumeCreatingConfig
-	SnapshotConfig       *SnapshotConfig
-	PVUpgradeConfig      *PVUpgradeConfig
-	Op                   VolumeOperation
+	Config                *VolumeConfig
+	VolumeCreatingConfig  *VolumeCreatingConfig
+	VolumeMigrationConfig *VolumeMigrationConfig
+	SnapshotConfig        *SnapshotConfig
+	PVUpgradeConfig       *PVUpgradeConfig
+	Op                    VolumeOperation
 }
 
 type PVUpgradeConfig struct {
@@ -42,7 +45,7 @@ type PVUpgradeConfig struct {
 // dangling; an add transaction should overwrite this.
 func (t *VolumeTransaction) Name() string {
 	switch t.Op {
-	case AddSnapshot, DeleteSnapshot:
+	case AddSnapshot, DeleteSnapshot, RestoreSnapshot:
 		return t.SnapshotConfig.ID()
 	case VolumeCreating:
 		return t.VolumeCreatingConfig.Name
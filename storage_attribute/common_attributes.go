@@ -19,6 +19,7 @@ const (
 	Region           = "region"
 	Zone             = "zone"
 	NASType          = "nasType"
+	NFSSecurity      = "nfsSecurity"
 
 	// Constants for label attributes
 	Labels   = "labels"
@@ -43,10 +44,16 @@ const (
 	NFS = "nfs"
 	SMB = "smb"
 
+	// Values for SAN protocol
+	ISCSI = "iscsi"
+	NVMe  = "nvme"
+	FCP   = "fcp"
+
 	RequiredStorage        = "requiredStorage" // deprecated, use additionalStoragePools
 	StoragePools           = "storagePools"
 	AdditionalStoragePools = "additionalStoragePools"
 	ExcludeStoragePools    = "excludeStoragePools"
+	PlacementPolicy        = "placementPolicy"
 )
 
 var attrTypes = map[string]Type{
@@ -67,4 +74,5 @@ var attrTypes = map[string]Type{
 	NonexistentBool:  boolType,
 	Replication:      boolType,
 	NASType:          stringType,
+	NFSSecurity:      stringType,
 }
@@ -18,6 +18,7 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 		RequiredStorage map[string][]string `json:"requiredStorage,omitempty"`
 		AdditionalPools map[string][]string `json:"additionalStoragePools,omitempty"`
 		ExcludePools    map[string][]string `json:"excludeStoragePools,omitempty"`
+		PlacementPolicy PlacementPolicy     `json:"placementPolicy,omitempty"`
 	}
 	err := json.Unmarshal(data, &tmp)
 	if err != nil {
@@ -36,6 +37,7 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 	}
 
 	c.ExcludePools = tmp.ExcludePools
+	c.PlacementPolicy = tmp.PlacementPolicy
 
 	return err
 }
@@ -49,12 +51,14 @@ func (c *Config) MarshalJSON() ([]byte, error) {
 		Pools           map[string][]string `json:"storagePools,omitempty"`
 		AdditionalPools map[string][]string `json:"additionalStoragePools,omitempty"`
 		ExcludePools    map[string][]string `json:"excludeStoragePools,omitempty"`
+		PlacementPolicy PlacementPolicy     `json:"placementPolicy,omitempty"`
 	}
 	tmp.Version = c.Version
 	tmp.Name = c.Name
 	tmp.Pools = c.Pools
 	tmp.AdditionalPools = c.AdditionalPools
 	tmp.ExcludePools = c.ExcludePools
+	tmp.PlacementPolicy = c.PlacementPolicy
 	// TODO (agagan): The below function MarshalRequestMap always return a positive response.
 	//  The negative use case is not covered in the unit test.
 	attrs, err := storageattribute.MarshalRequestMap(c.Attributes)
@@ -0,0 +1,159 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// PlacementPolicy determines the order in which the pools of a storage class are tried when creating a volume.
+type PlacementPolicy string
+
+const (
+	// PlacementRandom tries pools in a random order, which is the default
+	PlacementRandom = PlacementPolicy("random")
+	// PlacementLeastUsed tries the pools with the smallest fraction of their space in use first
+	PlacementLeastUsed = PlacementPolicy("leastUsed")
+	// PlacementFewestVolumes tries the pools holding the fewest Trident volumes first
+	PlacementFewestVolumes = PlacementPolicy("fewestVolumes")
+	// PlacementWeighted tries pools in a random order, in which pools with more free space are likely to come first
+	PlacementWeighted = PlacementPolicy("weighted")
+)
+
+// Validate returns an error if a placement policy is not known.  An empty policy is the default.
+func (p PlacementPolicy) Validate() error {
+	switch p {
+	case "", PlacementRandom, PlacementLeastUsed, PlacementFewestVolumes, PlacementWeighted:
+		return nil
+	default:
+		return fmt.Errorf("invalid placement policy %s; must be one of %s, %s, %s or %s", p, PlacementRandom,
+			PlacementLeastUsed, PlacementFewestVolumes, PlacementWeighted)
+	}
+}
+
+// IsRandom returns whether a placement policy leaves the pools in a random order.
+func (p PlacementPolicy) IsRandom() bool {
+	return p == "" || p == PlacementRandom
+}
+
+// poolUsageCacheTTL is how long the measured usage of a pool is reused when ordering pools.  Placement only needs
+// a rough measure, and measuring a pool queries its backend on every volume create.
+const poolUsageCacheTTL = 10 * time.Second
+
+type cachedPoolUsage struct {
+	usage    *storage.PoolUsage
+	measured time.Time
+}
+
+// poolUsageCache holds the most recent usage measured for each pool, keyed by backend UUID and pool name.
+var (
+	poolUsageCache      = make(map[string]cachedPoolUsage)
+	poolUsageCacheMutex sync.Mutex
+)
+
+// getCachedPoolUsage returns the usage of a pool, measuring it only if it was last measured over poolUsageCacheTTL
+// ago.
+func getCachedPoolUsage(ctx context.Context, pool storage.Pool) *storage.PoolUsage {
+	key := pool.Backend().BackendUUID() + "/" + pool.Name()
+
+	poolUsageCacheMutex.Lock()
+	cached, ok := poolUsageCache[key]
+	poolUsageCacheMutex.Unlock()
+
+	if !ok || time.Since(cached.measured) > poolUsageCacheTTL {
+		cached = cachedPoolUsage{usage: storage.GetPoolUsage(ctx, pool), measured: time.Now()}
+
+		poolUsageCacheMutex.Lock()
+		poolUsageCache[key] = cached
+		poolUsageCacheMutex.Unlock()
+	}
+
+	return cached.usage
+}
+
+// SortPoolsByPlacementPolicy orders pools by a placement policy, measuring each pool with the capacity reported by
+// its backend.  Pools are expected to be ordered by preferred topology already, and that order is kept, so the
+// policy only orders the pools that support the same preferred topology.  Pools whose usage cannot be measured
+// are tried after those that can.
+func SortPoolsByPlacementPolicy(
+	ctx context.Context, pools []storage.Pool, policy PlacementPolicy, preferredTopologies []map[string]string,
+) []storage.Pool {
+	if policy.IsRandom() || len(pools) < 2 {
+		return pools
+	}
+
+	type rankedPool struct {
+		pool     storage.Pool
+		rank     int
+		key      float64
+		measured bool
+	}
+
+	rankedPools := make([]rankedPool, 0, len(pools))
+	for _, pool := range pools {
+		ranked := rankedPool{pool: pool, rank: len(preferredTopologies)}
+		for i, preferred := range preferredTopologies {
+			if isTopologySupportedByPool(ctx, pool, preferred) {
+				ranked.rank = i
+				break
+			}
+		}
+
+		if policy == PlacementFewestVolumes {
+			// Volumes are counted from Trident's own cache, so the backend needn't be queried
+			ranked.key, ranked.measured = float64(storage.CountPoolVolumes(pool)), true
+			rankedPools = append(rankedPools, ranked)
+			continue
+		}
+
+		usage := getCachedPoolUsage(ctx, pool)
+		switch policy {
+		case PlacementLeastUsed:
+			ranked.key, ranked.measured = usage.UsedFraction(), usage.HasCapacity()
+		case PlacementWeighted:
+			// Weighted random order by free space: a pool keyed by -log(u)/weight for a uniform u comes first in
+			// proportion to its weight
+			if usage.HasCapacity() && usage.FreeBytes > 0 {
+				ranked.key, ranked.measured = -math.Log(1-rand.Float64())/float64(usage.FreeBytes), true
+			}
+		}
+		if usage.Error != "" {
+			Logc(ctx).WithFields(LogFields{
+				"backend": usage.Backend,
+				"pool":    usage.Pool,
+				"error":   usage.Error,
+			}).Debug("Could not measure pool for placement.")
+		}
+
+		rankedPools = append(rankedPools, ranked)
+	}
+
+	// The sort is stable, so pools that tie keep their random order
+	sort.SliceStable(rankedPools, func(i, j int) bool {
+		if rankedPools[i].rank != rankedPools[j].rank {
+			return rankedPools[i].rank < rankedPools[j].rank
+		}
+		if rankedPools[i].measured != rankedPools[j].measured {
+			return rankedPools[i].measured
+		}
+		return rankedPools[i].key < rankedPools[j].key
+	})
+
+	orderedPools := make([]storage.Pool, 0, len(rankedPools))
+	for _, ranked := range rankedPools {
+		orderedPools = append(orderedPools, ranked.pool)
+	}
+
+	Logc(ctx).WithField("placementPolicy", policy).Debugf("Ordered %d storage pools.", len(orderedPools))
+
+	return orderedPools
+}
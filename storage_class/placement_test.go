@@ -0,0 +1,207 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+)
+
+// getPlacementPool returns a mock pool on its own backend, holding the given number of volumes and reporting the
+// given capacity.  A nil capacity means the backend cannot report capacity.
+func getPlacementPool(
+	mockCtrl *gomock.Controller, poolName string, volumes int, capacity *storage.PoolCapacity,
+	supportedTopologies []map[string]string,
+) storage.Pool {
+	pool := mockstorage.NewMockPool(mockCtrl)
+	backend := mockstorage.NewMockBackend(mockCtrl)
+	pool.EXPECT().Name().Return(poolName).AnyTimes()
+	pool.EXPECT().Backend().Return(backend).AnyTimes()
+	pool.EXPECT().SupportedTopologies().Return(supportedTopologies).AnyTimes()
+
+	backendVolumes := make(map[string]*storage.Volume)
+	for i := 0; i < volumes; i++ {
+		name := poolName + "-vol" + string(rune('a'+i))
+		backendVolumes[name] = &storage.Volume{Config: &storage.VolumeConfig{Name: name}, Pool: poolName}
+	}
+	backend.EXPECT().Name().Return("backend-" + poolName).AnyTimes()
+	backend.EXPECT().BackendUUID().Return("uuid-" + poolName).AnyTimes()
+	backend.EXPECT().Volumes().Return(backendVolumes).AnyTimes()
+	backend.EXPECT().CanReportCapacity().Return(capacity != nil).AnyTimes()
+	if capacity != nil {
+		backend.EXPECT().GetPoolCapacity(gomock.Any(), pool).Return(capacity, nil).AnyTimes()
+	}
+
+	return pool
+}
+
+// clearPoolUsageCache forgets all measured pool usage, so that tests reusing pool names don't see stale capacity.
+func clearPoolUsageCache() {
+	poolUsageCacheMutex.Lock()
+	defer poolUsageCacheMutex.Unlock()
+	poolUsageCache = make(map[string]cachedPoolUsage)
+}
+
+func getPoolNames(pools []storage.Pool) []string {
+	names := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		names = append(names, pool.Name())
+	}
+	return names
+}
+
+func TestPlacementPolicyValidate(t *testing.T) {
+	for _, policy := range []PlacementPolicy{
+		"", PlacementRandom, PlacementLeastUsed, PlacementFewestVolumes, PlacementWeighted,
+	} {
+		assert.NoError(t, policy.Validate(), "expected policy %s to be valid", policy)
+	}
+	assert.Error(t, PlacementPolicy("mostUsed").Validate(), "expected invalid policy")
+	assert.True(t, PlacementPolicy("").IsRandom())
+	assert.True(t, PlacementRandom.IsRandom())
+	assert.False(t, PlacementLeastUsed.IsRandom())
+}
+
+func TestPlacementPolicyJSON(t *testing.T) {
+	conf := &Config{Name: "gold", PlacementPolicy: PlacementLeastUsed}
+
+	bytes, err := json.Marshal(conf)
+	assert.NoError(t, err)
+	assert.Contains(t, string(bytes), `"placementPolicy":"leastUsed"`)
+
+	unmarshalled := &Config{}
+	assert.NoError(t, json.Unmarshal(bytes, unmarshalled))
+	assert.Equal(t, PlacementLeastUsed, unmarshalled.PlacementPolicy)
+	assert.Equal(t, PlacementLeastUsed, New(unmarshalled).GetPlacementPolicy())
+}
+
+func TestSortPoolsByPlacementPolicy(t *testing.T) {
+	clearPoolUsageCache()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	full := getPlacementPool(mockCtrl, "full", 1, &storage.PoolCapacity{TotalBytes: 100, FreeBytes: 10}, nil)
+	half := getPlacementPool(mockCtrl, "half", 5, &storage.PoolCapacity{TotalBytes: 100, FreeBytes: 50}, nil)
+	empty := getPlacementPool(mockCtrl, "empty", 3, &storage.PoolCapacity{TotalBytes: 1000, FreeBytes: 990}, nil)
+	unknown := getPlacementPool(mockCtrl, "unknown", 0, nil, nil)
+	pools := []storage.Pool{unknown, full, half, empty}
+
+	tests := map[string]struct {
+		policy   PlacementPolicy
+		expected []string
+	}{
+		"Random":        {PlacementRandom, []string{"unknown", "full", "half", "empty"}},
+		"Default":       {"", []string{"unknown", "full", "half", "empty"}},
+		"LeastUsed":     {PlacementLeastUsed, []string{"empty", "half", "full", "unknown"}},
+		"FewestVolumes": {PlacementFewestVolumes, []string{"unknown", "full", "empty", "half"}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ordered := SortPoolsByPlacementPolicy(ctx, pools, test.policy, nil)
+			assert.Equal(t, test.expected, getPoolNames(ordered))
+		})
+	}
+
+	// A weighted order is random, but always tries pools whose free space is unknown last
+	ordered := SortPoolsByPlacementPolicy(ctx, pools, PlacementWeighted, nil)
+	assert.Len(t, ordered, 4)
+	assert.Equal(t, "unknown", ordered[3].Name())
+}
+
+func TestSortPoolsByPlacementPolicyKeepsPreferredTopologies(t *testing.T) {
+	clearPoolUsageCache()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	zone1 := []map[string]string{{"topology.kubernetes.io/zone": "Z1"}}
+	zone2 := []map[string]string{{"topology.kubernetes.io/zone": "Z2"}}
+	fullZone1 := getPlacementPool(mockCtrl, "fullZone1", 0,
+		&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 10}, zone1)
+	emptyZone1 := getPlacementPool(mockCtrl, "emptyZone1", 0,
+		&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 90}, zone1)
+	emptyZone2 := getPlacementPool(mockCtrl, "emptyZone2", 0,
+		&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 100}, zone2)
+
+	ordered := SortPoolsByPlacementPolicy(ctx, []storage.Pool{fullZone1, emptyZone1, emptyZone2},
+		PlacementLeastUsed, zone1)
+	assert.Equal(t, []string{"emptyZone1", "fullZone1", "emptyZone2"}, getPoolNames(ordered))
+}
+
+func TestSortPoolsByPlacementPolicyCachesUsage(t *testing.T) {
+	clearPoolUsageCache()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	pools := make([]storage.Pool, 0, 2)
+	backends := make([]*mockstorage.MockBackend, 0, 2)
+	for _, name := range []string{"pool1", "pool2"} {
+		pool := mockstorage.NewMockPool(mockCtrl)
+		backend := mockstorage.NewMockBackend(mockCtrl)
+		pool.EXPECT().Name().Return(name).AnyTimes()
+		pool.EXPECT().Backend().Return(backend).AnyTimes()
+		pool.EXPECT().SupportedTopologies().Return(nil).AnyTimes()
+		backend.EXPECT().Name().Return("backend-" + name).AnyTimes()
+		backend.EXPECT().BackendUUID().Return("uuid-" + name).AnyTimes()
+		backend.EXPECT().Volumes().Return(map[string]*storage.Volume{}).AnyTimes()
+		backend.EXPECT().CanReportCapacity().Return(true).AnyTimes()
+		pools = append(pools, pool)
+		backends = append(backends, backend)
+	}
+
+	// Each backend is queried once while its usage is cached
+	backends[0].EXPECT().GetPoolCapacity(gomock.Any(), pools[0]).
+		Return(&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 10}, nil).Times(1)
+	backends[1].EXPECT().GetPoolCapacity(gomock.Any(), pools[1]).
+		Return(&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 90}, nil).Times(1)
+
+	for i := 0; i < 3; i++ {
+		ordered := SortPoolsByPlacementPolicy(ctx, pools, PlacementLeastUsed, nil)
+		assert.Equal(t, []string{"pool2", "pool1"}, getPoolNames(ordered))
+	}
+
+	// Once the cached usage expires, the backends are queried again
+	poolUsageCacheMutex.Lock()
+	for key, cached := range poolUsageCache {
+		cached.measured = cached.measured.Add(-2 * poolUsageCacheTTL)
+		poolUsageCache[key] = cached
+	}
+	poolUsageCacheMutex.Unlock()
+
+	backends[0].EXPECT().GetPoolCapacity(gomock.Any(), pools[0]).
+		Return(&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 95}, nil).Times(1)
+	backends[1].EXPECT().GetPoolCapacity(gomock.Any(), pools[1]).
+		Return(&storage.PoolCapacity{TotalBytes: 100, FreeBytes: 90}, nil).Times(1)
+
+	ordered := SortPoolsByPlacementPolicy(ctx, pools, PlacementLeastUsed, nil)
+	assert.Equal(t, []string{"pool1", "pool2"}, getPoolNames(ordered))
+
+	// Ordering by volume count never queries the backends
+	ordered = SortPoolsByPlacementPolicy(ctx, pools, PlacementFewestVolumes, nil)
+	assert.Len(t, ordered, 2)
+}
+
+func TestGetPoolUsageCapacityError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	pool := mockstorage.NewMockPool(mockCtrl)
+	backend := mockstorage.NewMockBackend(mockCtrl)
+	pool.EXPECT().Name().Return("pool").AnyTimes()
+	pool.EXPECT().Backend().Return(backend).AnyTimes()
+	backend.EXPECT().Name().Return("backend").AnyTimes()
+	backend.EXPECT().BackendUUID().Return("uuid").AnyTimes()
+	backend.EXPECT().Volumes().Return(map[string]*storage.Volume{}).AnyTimes()
+	backend.EXPECT().CanReportCapacity().Return(true).AnyTimes()
+	backend.EXPECT().GetPoolCapacity(gomock.Any(), pool).Return(nil, errors.New("failed")).AnyTimes()
+
+	usage := storage.GetPoolUsage(context.Background(), pool)
+	assert.False(t, usage.HasCapacity())
+	assert.Equal(t, 1.0, usage.UsedFraction())
+	assert.Equal(t, "failed", usage.Error)
+}
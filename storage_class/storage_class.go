@@ -280,6 +280,10 @@ func (s *StorageClass) GetStoragePools() map[string][]string {
 	return s.config.Pools
 }
 
+func (s *StorageClass) GetPlacementPolicy() PlacementPolicy {
+	return s.config.PlacementPolicy
+}
+
 func (s *StorageClass) GetAdditionalStoragePools() map[string][]string {
 	return s.config.AdditionalPools
 }
@@ -443,7 +447,8 @@ func SortPoolsByPreferredTopologies(
 }
 
 // GetStoragePoolsForProtocolByBackend returns an ordered list of pools, where
-// each pool matches the supplied protocol.
+// each pool matches the supplied protocol.  Pools are ordered by preferred
+// topology, and then by the storage class's placement policy.
 func (s *StorageClass) GetStoragePoolsForProtocolByBackend(
 	ctx context.Context, p config.Protocol, requisiteTopologies, preferredTopologies []map[string]string,
 	accessMode config.AccessMode,
@@ -464,6 +469,7 @@ func (s *StorageClass) GetStoragePoolsForProtocolByBackend(
 		Logc(ctx).Info("no backend pools found for given NASType")
 	}
 	pools = SortPoolsByPreferredTopologies(ctx, pools, preferredTopologies)
+	pools = SortPoolsByPlacementPolicy(ctx, pools, s.config.PlacementPolicy, preferredTopologies)
 
 	Logc(ctx).Debugf("Finally got %d storage pools", len(pools))
 
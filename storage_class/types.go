@@ -21,6 +21,7 @@ type Config struct {
 	Pools           map[string][]string                 `json:"storagePools,omitempty"`
 	AdditionalPools map[string][]string                 `json:"additionalStoragePools,omitempty"`
 	ExcludePools    map[string][]string                 `json:"excludeStoragePools,omitempty"`
+	PlacementPolicy PlacementPolicy                     `json:"placementPolicy,omitempty"`
 }
 
 type External struct {
@@ -35,3 +36,11 @@ type External struct {
 type Persistent struct {
 	Config *Config `json:"config"`
 }
+
+// Capacity describes the space available for new volumes in a storage class,
+// as summed across the storage pools that satisfy it.
+type Capacity struct {
+	TotalBytes         uint64 `json:"totalBytes"`
+	AvailableBytes     uint64 `json:"availableBytes"`
+	MaximumVolumeBytes uint64 `json:"maximumVolumeBytes"`
+}
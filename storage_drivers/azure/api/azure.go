@@ -598,6 +598,8 @@ func (c Client) newFileSystemFromVolume(ctx context.Context, vol *netapp.Volume)
 		MountTargets:      c.getMountTargetsFromVolume(ctx, vol),
 		SubvolumesEnabled: c.getSubvolumesEnabledFromVolume(vol.Properties.EnableSubvolumes),
 		NetworkFeatures:   DerefNetworkFeatures(vol.Properties.NetworkFeatures),
+		VolumeType:        DerefString(vol.Properties.VolumeType),
+		Replication:       c.getReplicationFromVolume(vol),
 	}, nil
 }
 
@@ -609,6 +611,27 @@ func (c Client) getSubvolumesEnabledFromVolume(value *netapp.EnableSubvolumes) b
 	return true
 }
 
+// getReplicationFromVolume extracts the cross-region replication details from an SDK volume, if it has any.
+func (c Client) getReplicationFromVolume(vol *netapp.Volume) *Replication {
+	if vol.Properties.DataProtection == nil || vol.Properties.DataProtection.Replication == nil {
+		return nil
+	}
+	anfReplication := vol.Properties.DataProtection.Replication
+
+	replication := &Replication{
+		RemoteVolumeID:     DerefString(anfReplication.RemoteVolumeResourceID),
+		RemoteVolumeRegion: DerefString(anfReplication.RemoteVolumeRegion),
+	}
+	if anfReplication.EndpointType != nil {
+		replication.EndpointType = string(*anfReplication.EndpointType)
+	}
+	if anfReplication.ReplicationSchedule != nil {
+		replication.ReplicationSchedule = string(*anfReplication.ReplicationSchedule)
+	}
+
+	return replication
+}
+
 // getMountTargetsFromVolume extracts the mount targets from an SDK volume.
 func (c Client) getMountTargetsFromVolume(ctx context.Context, vol *netapp.Volume) []MountTarget {
 	mounts := make([]MountTarget, 0)
@@ -953,6 +976,21 @@ func (c Client) CreateVolume(ctx context.Context, request *FilesystemCreateReque
 		newVol.Properties.UnixPermissions = &request.UnixPermissions
 	}
 
+	// Only set the replication source if we are creating a replication destination
+	if request.ReplicationSourceID != "" {
+		volumeType := VolumeTypeDataProtection
+		endpointType := netapp.EndpointTypeDst
+		replicationSchedule := netapp.ReplicationSchedule(request.ReplicationSchedule)
+		newVol.Properties.VolumeType = &volumeType
+		newVol.Properties.DataProtection = &netapp.VolumePropertiesDataProtection{
+			Replication: &netapp.ReplicationObject{
+				EndpointType:           &endpointType,
+				RemoteVolumeResourceID: &request.ReplicationSourceID,
+				ReplicationSchedule:    &replicationSchedule,
+			},
+		}
+	}
+
 	Logc(ctx).WithFields(LogFields{
 		"name":          request.Name,
 		"creationToken": request.CreationToken,
@@ -962,6 +1000,7 @@ func (c Client) CreateVolume(ctx context.Context, request *FilesystemCreateReque
 		"subnetID":      request.SubnetID,
 		"snapshotID":    request.SnapshotID,
 		"snapshotDir":   request.SnapshotDirectory,
+		"mirrorSource":  request.ReplicationSourceID,
 	}).Debug("Issuing create request.")
 
 	logFields := LogFields{
@@ -1148,6 +1187,227 @@ func (c Client) DeleteVolume(ctx context.Context, filesystem *FileSystem) error
 	return nil
 }
 
+// ///////////////////////////////////////////////////////////////////////////////
+// Functions to manage volume replication
+// ///////////////////////////////////////////////////////////////////////////////
+
+// ReplicationStatus returns the state of the replication of which a volume is the destination.
+func (c Client) ReplicationStatus(ctx context.Context, filesystem *FileSystem) (*ReplicationStatus, error) {
+	logFields := LogFields{
+		"API":    "VolumesClient.ReplicationStatus",
+		"volume": filesystem.FullName,
+	}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	response, err := c.sdkClient.VolumesClient.ReplicationStatus(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		if IsANFNotFoundError(err) {
+			Logc(ctx).WithFields(logFields).Debug("Replication not found.")
+			return nil, utils.NotFoundError(fmt.Sprintf("replication of volume %s not found", filesystem.FullName))
+		}
+
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error getting replication status.")
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Read replication status.")
+
+	status := &ReplicationStatus{
+		Healthy:       DerefBool(response.Healthy),
+		TotalProgress: DerefString(response.TotalProgress),
+		ErrorMessage:  DerefString(response.ErrorMessage),
+	}
+	if response.MirrorState != nil {
+		status.MirrorState = string(*response.MirrorState)
+	}
+	if response.RelationshipStatus != nil {
+		status.RelationshipStatus = string(*response.RelationshipStatus)
+	}
+
+	return status, nil
+}
+
+// AuthorizeReplication authorizes the replication from a source volume to a destination volume, which starts
+// the baseline transfer.  The source volume is identified by its resource ID, since it is typically in another
+// region and therefore not in the resource cache.
+func (c Client) AuthorizeReplication(ctx context.Context, sourceVolumeID, destinationVolumeID string) error {
+	_, resourceGroup, _, netappAccount, cPoolName, volumeName, err := ParseVolumeID(sourceVolumeID)
+	if err != nil {
+		return err
+	}
+
+	logFields := LogFields{
+		"API":         "VolumesClient.BeginAuthorizeReplication",
+		"volume":      CreateVolumeFullName(resourceGroup, netappAccount, cPoolName, volumeName),
+		"destination": destinationVolumeID,
+	}
+
+	body := netapp.AuthorizeRequest{RemoteVolumeResourceID: &destinationVolumeID}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginAuthorizeReplication(responseCtx,
+		resourceGroup, netappAccount, cPoolName, volumeName, body, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error authorizing replication.")
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second}); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for replication authorization result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Replication authorized.")
+
+	return nil
+}
+
+// BreakReplication stops the replication to a destination volume, which makes it writable.
+func (c Client) BreakReplication(ctx context.Context, filesystem *FileSystem, force bool) error {
+	logFields := LogFields{
+		"API":    "VolumesClient.BeginBreakReplication",
+		"volume": filesystem.FullName,
+		"force":  force,
+	}
+
+	options := &netapp.VolumesClientBeginBreakReplicationOptions{
+		Body: &netapp.BreakReplicationRequest{ForceBreakReplication: &force},
+	}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginBreakReplication(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, options)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error breaking replication.")
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second}); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for replication break result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Replication broken.")
+
+	return nil
+}
+
+// ResyncReplication resumes a broken replication to a destination volume, discarding any changes made to it
+// since the replication was broken.
+func (c Client) ResyncReplication(ctx context.Context, filesystem *FileSystem) error {
+	logFields := LogFields{
+		"API":    "VolumesClient.BeginResyncReplication",
+		"volume": filesystem.FullName,
+	}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginResyncReplication(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error resyncing replication.")
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second}); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for replication resync result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Replication resynced.")
+
+	return nil
+}
+
+// ReestablishReplication restores a deleted replication from a source volume to a destination volume, using
+// the snapshots the two volumes still have in common.
+func (c Client) ReestablishReplication(ctx context.Context, filesystem *FileSystem, sourceVolumeID string) error {
+	logFields := LogFields{
+		"API":    "VolumesClient.BeginReestablishReplication",
+		"volume": filesystem.FullName,
+		"source": sourceVolumeID,
+	}
+
+	body := netapp.ReestablishReplicationRequest{SourceVolumeID: &sourceVolumeID}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginReestablishReplication(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, body, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error reestablishing replication.")
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second}); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for replication reestablish result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Replication reestablished.")
+
+	return nil
+}
+
+// DeleteReplication deletes the replication of which a volume is the source or destination.
+func (c Client) DeleteReplication(ctx context.Context, filesystem *FileSystem) error {
+	logFields := LogFields{
+		"API":    "VolumesClient.BeginDeleteReplication",
+		"volume": filesystem.FullName,
+	}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginDeleteReplication(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		if IsANFNotFoundError(err) {
+			Logc(ctx).WithFields(logFields).Info("Replication already deleted.")
+			return nil
+		}
+
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error deleting replication.")
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second}); err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for replication delete result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Replication deleted.")
+
+	return nil
+}
+
 // ///////////////////////////////////////////////////////////////////////////////
 // Functions to retrieve and manage snapshots
 // ///////////////////////////////////////////////////////////////////////////////
@@ -635,6 +635,14 @@ func (c Client) discoverCapacityPools(ctx context.Context) (*[]*CapacityPool, er
 			continue
 		}
 
+		// The pool size is informational, so a pool is not ignored for lack of it
+		var sizeInBytes int64
+		if size, ok := rawProperties["size"].(float64); ok {
+			sizeInBytes = int64(size)
+		} else {
+			Logc(ctx).WithFields(logFields).Warning("Capacity pool query returned invalid size.")
+		}
+
 		cpools = append(cpools,
 			&CapacityPool{
 				ID:                id,
@@ -648,6 +656,7 @@ func (c Client) discoverCapacityPools(ctx context.Context) (*[]*CapacityPool, er
 				ServiceLevel:      serviceLevel,
 				ProvisioningState: provisioningState,
 				QosType:           qosType,
+				SizeInBytes:       sizeInBytes,
 			})
 	}
 
@@ -30,6 +30,22 @@ const (
 
 	NetworkFeaturesBasic    = "Basic"
 	NetworkFeaturesStandard = "Standard"
+
+	VolumeTypeDataProtection = "DataProtection"
+
+	ReplicationEndpointSource      = "src"
+	ReplicationEndpointDestination = "dst"
+
+	ReplicationSchedule10Minutely = "_10minutely"
+	ReplicationScheduleHourly     = "hourly"
+	ReplicationScheduleDaily      = "daily"
+
+	MirrorStateUninitialized = "Uninitialized"
+	MirrorStateMirrored      = "Mirrored"
+	MirrorStateBroken        = "Broken"
+
+	RelationshipStatusIdle         = "Idle"
+	RelationshipStatusTransferring = "Transferring"
 )
 
 // AzureResources is the toplevel cache for the set of things we discover about our Azure environment.
@@ -98,6 +114,7 @@ type CapacityPool struct {
 	ServiceLevel      string
 	ProvisioningState string
 	QosType           string
+	SizeInBytes       int64
 }
 
 // FileSystem records details of a discovered Azure Subnet.
@@ -125,6 +142,8 @@ type FileSystem struct {
 	MountTargets      []MountTarget
 	SubvolumesEnabled bool
 	NetworkFeatures   string
+	VolumeType        string
+	Replication       *Replication
 }
 
 // FilesystemCreateRequest embodies all the details of a volume to be created.
@@ -143,6 +162,27 @@ type FilesystemCreateRequest struct {
 	SnapshotID        string
 	UnixPermissions   string
 	NetworkFeatures   string
+
+	// Set only to create a replication destination
+	ReplicationSourceID string
+	ReplicationSchedule string
+}
+
+// Replication records details of the cross-region replication of an Azure volume.
+type Replication struct {
+	EndpointType        string
+	RemoteVolumeID      string
+	RemoteVolumeRegion  string
+	ReplicationSchedule string
+}
+
+// ReplicationStatus records the state of the cross-region replication of an Azure volume.
+type ReplicationStatus struct {
+	Healthy            bool
+	MirrorState        string
+	RelationshipStatus string
+	TotalProgress      string
+	ErrorMessage       string
 }
 
 // ExportPolicy records details of a discovered Azure volume export policy.
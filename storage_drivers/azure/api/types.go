@@ -40,6 +40,13 @@ type Azure interface {
 	ResizeVolume(context.Context, *FileSystem, int64) error
 	DeleteVolume(context.Context, *FileSystem) error
 
+	ReplicationStatus(context.Context, *FileSystem) (*ReplicationStatus, error)
+	AuthorizeReplication(context.Context, string, string) error
+	BreakReplication(context.Context, *FileSystem, bool) error
+	ResyncReplication(context.Context, *FileSystem) error
+	ReestablishReplication(context.Context, *FileSystem, string) error
+	DeleteReplication(context.Context, *FileSystem) error
+
 	Subvolumes(context.Context, []string) (*[]*Subvolume, error)
 	Subvolume(context.Context, *storage.VolumeConfig, bool) (*Subvolume, error)
 	SubvolumeExists(context.Context, *storage.VolumeConfig, []string) (bool, *Subvolume, error)
@@ -38,6 +38,8 @@ const (
 	defaultVolumeSizeStr   = "107374182400"
 	defaultNetworkFeatures = "" // Leave empty, some regions may never support this
 
+	defaultReplicationSchedule = api.ReplicationScheduleHourly
+
 	// Constants for internal pool attributes
 
 	Size            = "size"
@@ -271,6 +273,10 @@ func (d *NASStorageDriver) populateConfigurationDefaults(
 		config.NASType = sa.NFS
 	}
 
+	if config.ReplicationSchedule == "" {
+		config.ReplicationSchedule = defaultReplicationSchedule
+	}
+
 	Logc(ctx).WithFields(LogFields{
 		"StoragePrefix":   *config.StoragePrefix,
 		"Size":            config.Size,
@@ -300,7 +306,7 @@ func (d *NASStorageDriver) initializeStoragePools(ctx context.Context) {
 		pool.Attributes()[sa.Snapshots] = sa.NewBoolOffer(true)
 		pool.Attributes()[sa.Clones] = sa.NewBoolOffer(true)
 		pool.Attributes()[sa.Encryption] = sa.NewBoolOffer(false)
-		pool.Attributes()[sa.Replication] = sa.NewBoolOffer(false)
+		pool.Attributes()[sa.Replication] = sa.NewBoolOffer(true)
 		pool.Attributes()[sa.Labels] = sa.NewLabelOffer(d.Config.Labels)
 		pool.Attributes()[sa.NASType] = sa.NewStringOffer(d.Config.NASType)
 
@@ -409,7 +415,7 @@ func (d *NASStorageDriver) initializeStoragePools(ctx context.Context) {
 			pool.Attributes()[sa.Snapshots] = sa.NewBoolOffer(true)
 			pool.Attributes()[sa.Clones] = sa.NewBoolOffer(true)
 			pool.Attributes()[sa.Encryption] = sa.NewBoolOffer(false)
-			pool.Attributes()[sa.Replication] = sa.NewBoolOffer(false)
+			pool.Attributes()[sa.Replication] = sa.NewBoolOffer(true)
 			pool.Attributes()[sa.Labels] = sa.NewLabelOffer(d.Config.Labels, vpool.Labels)
 
 			nasType := d.Config.NASType
@@ -551,6 +557,14 @@ func (d *NASStorageDriver) validate(ctx context.Context) error {
 		return err
 	}
 
+	// Validate replication schedule
+	switch d.Config.ReplicationSchedule {
+	case api.ReplicationSchedule10Minutely, api.ReplicationScheduleHourly, api.ReplicationScheduleDaily:
+		break
+	default:
+		return fmt.Errorf("invalid value for replicationSchedule: %s", d.Config.ReplicationSchedule)
+	}
+
 	// Validate pool-level attributes
 	for poolName, pool := range d.pools {
 
@@ -843,6 +857,16 @@ func (d *NASStorageDriver) Create(
 		createRequest.ExportPolicy = exportPolicy
 	}
 
+	// A replication destination must be created with its source, which cannot be changed later
+	if volConfig.IsMirrorDestination && volConfig.PeerVolumeHandle != "" {
+		sourceVolumeID, err := parseVolumeHandle(volConfig.PeerVolumeHandle)
+		if err != nil {
+			return fmt.Errorf("could not parse remote volume handle '%s'; %v", volConfig.PeerVolumeHandle, err)
+		}
+		createRequest.ReplicationSourceID = sourceVolumeID
+		createRequest.ReplicationSchedule = d.Config.ReplicationSchedule
+	}
+
 	// Create the volume
 	volume, err := d.SDK.CreateVolume(ctx, createRequest)
 	if err != nil {
@@ -1266,6 +1290,13 @@ func (d *NASStorageDriver) Destroy(ctx context.Context, volConfig *storage.Volum
 		return err
 	}
 
+	// A replication destination cannot be deleted while it is replicated to
+	if isReplicationDestination(extantVolume) {
+		if err = d.SDK.DeleteReplication(ctx, extantVolume); err != nil {
+			return err
+		}
+	}
+
 	// Delete the volume
 	if err = d.SDK.DeleteVolume(ctx, extantVolume); err != nil {
 		return err
@@ -1633,6 +1664,103 @@ func (d *NASStorageDriver) Get(ctx context.Context, name string) error {
 	return nil
 }
 
+// GetPoolCapacity returns the space in the capacity pools backing a storage pool.  Volume quotas are allocated
+// from their capacity pool when volumes are created, so a capacity pool's free space is its size less the
+// quotas of the volumes in it.
+func (d *NASStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	fields := LogFields{
+		"Method": "GetPoolCapacity",
+		"Type":   "NASStorageDriver",
+		"pool":   pool.Name(),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetPoolCapacity")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetPoolCapacity")
+
+	sPool, ok := d.pools[pool.Name()]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	// Update resource cache as needed
+	if err := d.SDK.RefreshAzureResources(ctx); err != nil {
+		return nil, fmt.Errorf("could not update ANF resource cache; %v", err)
+	}
+
+	cPools := d.SDK.CapacityPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[ServiceLevel])
+	if len(cPools) == 0 {
+		return nil, fmt.Errorf("no capacity pools found for storage pool %s", sPool.Name())
+	}
+
+	volumes, err := d.SDK.Volumes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list volumes; %v", err)
+	}
+
+	allocatedBytes := make(map[string]int64)
+	for _, volume := range *volumes {
+		cPoolFullName := api.CreateCapacityPoolFullName(volume.ResourceGroup, volume.NetAppAccount, volume.CapacityPool)
+		allocatedBytes[cPoolFullName] += volume.QuotaInBytes
+	}
+
+	capacity := &storage.PoolCapacity{PhysicalPools: make([]string, 0, len(cPools))}
+	for _, cPool := range cPools {
+		capacity.TotalBytes += uint64(cPool.SizeInBytes)
+		if freeBytes := cPool.SizeInBytes - allocatedBytes[cPool.FullName]; freeBytes > 0 {
+			capacity.FreeBytes += uint64(freeBytes)
+		}
+		capacity.PhysicalPools = append(capacity.PhysicalPools, cPool.FullName)
+	}
+
+	return capacity, nil
+}
+
+// GetVolumeHealth reports whether a volume is missing, in a failed state, or has used its entire quota.
+func (d *NASStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method": "GetVolumeHealth",
+		"Type":   "NASStorageDriver",
+		"name":   name,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetVolumeHealth")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetVolumeHealth")
+
+	// Update resource cache as needed
+	if err := d.SDK.RefreshAzureResources(ctx); err != nil {
+		return nil, fmt.Errorf("could not update ANF resource cache; %v", err)
+	}
+
+	volumeExists, volume, err := d.SDK.VolumeExists(ctx, volConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for existing volume %s; %v", name, err)
+	}
+	if !volumeExists {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s not found", name),
+		}, nil
+	}
+
+	// Transitional states such as Updating are not a cause for concern
+	if volume.ProvisioningState == api.StateError || volume.ProvisioningState == api.StateDeleting {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s state is %s", name, volume.ProvisioningState),
+		}, nil
+	}
+
+	if volume.QuotaInBytes > 0 && int64(volume.UsedBytes) >= volume.QuotaInBytes {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s is full", name),
+		}, nil
+	}
+
+	return &storage.VolumeHealth{}, nil
+}
+
 // Resize increases a volume's quota.
 func (d *NASStorageDriver) Resize(ctx context.Context, volConfig *storage.VolumeConfig, sizeBytes uint64) error {
 	name := volConfig.InternalName
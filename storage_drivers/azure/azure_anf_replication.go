@@ -0,0 +1,291 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/netapp/trident/logging"
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage_drivers/azure/api"
+	"github.com/netapp/trident/utils"
+)
+
+// parseVolumeHandle returns the resource ID of the volume identified by a mirror volume handle.  Trident reports
+// the handle of an ANF volume as <volume resource ID>:<creation token>, but the resource ID alone is also accepted.
+func parseVolumeHandle(volumeHandle string) (string, error) {
+	volumeID := volumeHandle
+	if index := strings.LastIndex(volumeHandle, ":"); index >= 0 {
+		volumeID = volumeHandle[:index]
+	}
+
+	if _, _, _, _, _, _, err := api.ParseVolumeID(volumeID); err != nil {
+		return "", err
+	}
+	return volumeID, nil
+}
+
+// getMirrorVolume returns the local volume of a mirror relationship.
+func (d *NASStorageDriver) getMirrorVolume(
+	ctx context.Context, localInternalVolumeName string,
+) (*api.FileSystem, error) {
+	if localInternalVolumeName == "" {
+		return nil, fmt.Errorf("invalid volume name")
+	}
+
+	// Update resource cache as needed
+	if err := d.SDK.RefreshAzureResources(ctx); err != nil {
+		return nil, fmt.Errorf("could not update ANF resource cache; %v", err)
+	}
+
+	return d.SDK.VolumeByCreationToken(ctx, localInternalVolumeName)
+}
+
+// isReplicationDestination returns whether a volume is currently the destination of a replication.
+func isReplicationDestination(volume *api.FileSystem) bool {
+	return volume.Replication != nil && volume.Replication.EndpointType == api.ReplicationEndpointDestination
+}
+
+// getMirrorDestination returns the local volume of a mirror relationship, ensuring it is the destination of a
+// replication from the remote volume.
+func (d *NASStorageDriver) getMirrorDestination(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
+) (*api.FileSystem, string, error) {
+	sourceVolumeID, err := parseVolumeHandle(remoteVolumeHandle)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not parse remoteVolumeHandle '%v'; %v", remoteVolumeHandle, err)
+	}
+
+	volume, err := d.getMirrorVolume(ctx, localInternalVolumeName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if volume.Replication != nil {
+		if volume.Replication.EndpointType != api.ReplicationEndpointDestination {
+			return nil, "", fmt.Errorf("volume %s is the source of a replication", localInternalVolumeName)
+		}
+		if !strings.EqualFold(volume.Replication.RemoteVolumeID, sourceVolumeID) {
+			return nil, "", fmt.Errorf("volume %s is replicated from %s, not %s", localInternalVolumeName,
+				volume.Replication.RemoteVolumeID, sourceVolumeID)
+		}
+	}
+
+	return volume, sourceVolumeID, nil
+}
+
+// EstablishMirror authorizes the replication to a data protection volume that was created as the destination of
+// the remote volume.  ANF has no replication policies, and the schedule is set when the destination is created.
+func (d *NASStorageDriver) EstablishMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule string,
+) error {
+	fields := LogFields{
+		"Method":             "EstablishMirror",
+		"Type":               "NASStorageDriver",
+		"localVolume":        localInternalVolumeName,
+		"remoteVolumeHandle": remoteVolumeHandle,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> EstablishMirror")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< EstablishMirror")
+
+	volume, sourceVolumeID, err := d.getMirrorDestination(ctx, localInternalVolumeName, remoteVolumeHandle)
+	if err != nil {
+		return err
+	}
+	if volume.Replication == nil {
+		return fmt.Errorf("mirrors can only be established with data protection volumes as the destination")
+	}
+
+	if replicationPolicy != "" || (replicationSchedule != "" &&
+		replicationSchedule != volume.Replication.ReplicationSchedule) {
+		Logc(ctx).WithFields(fields).WithFields(LogFields{
+			"replicationPolicy":   replicationPolicy,
+			"replicationSchedule": replicationSchedule,
+		}).Warning("Ignoring replication settings that ANF cannot apply to an existing volume.")
+	}
+
+	status, err := d.SDK.ReplicationStatus(ctx, volume)
+	if err != nil && !utils.IsNotFoundError(err) {
+		return err
+	}
+
+	// The baseline transfer starts once the source authorizes the replication
+	if status == nil || (status.MirrorState == api.MirrorStateUninitialized &&
+		status.RelationshipStatus != api.RelationshipStatusTransferring) {
+		return d.SDK.AuthorizeReplication(ctx, sourceVolumeID, volume.ID)
+	}
+
+	return nil
+}
+
+// ReestablishMirror resumes the replication to a volume that was previously a replication destination of the
+// remote volume, discarding any changes made to it since.
+func (d *NASStorageDriver) ReestablishMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule string,
+) error {
+	fields := LogFields{
+		"Method":             "ReestablishMirror",
+		"Type":               "NASStorageDriver",
+		"localVolume":        localInternalVolumeName,
+		"remoteVolumeHandle": remoteVolumeHandle,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> ReestablishMirror")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< ReestablishMirror")
+
+	volume, sourceVolumeID, err := d.getMirrorDestination(ctx, localInternalVolumeName, remoteVolumeHandle)
+	if err != nil {
+		return err
+	}
+
+	// A replication deleted when the volume was promoted must be restored
+	if volume.Replication == nil {
+		return d.SDK.ReestablishReplication(ctx, volume, sourceVolumeID)
+	}
+
+	status, err := d.SDK.ReplicationStatus(ctx, volume)
+	if err != nil {
+		return err
+	}
+
+	// If the replication is already established we have nothing to do
+	if status.MirrorState != api.MirrorStateBroken {
+		return nil
+	}
+
+	return d.SDK.ResyncReplication(ctx, volume)
+}
+
+// PromoteMirror breaks and deletes the replication to a volume, making it writable, optionally after a given
+// snapshot has been replicated.
+func (d *NASStorageDriver) PromoteMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) (bool, error) {
+	fields := LogFields{
+		"Method":             "PromoteMirror",
+		"Type":               "NASStorageDriver",
+		"localVolume":        localInternalVolumeName,
+		"remoteVolumeHandle": remoteVolumeHandle,
+		"snapshotName":       snapshotName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> PromoteMirror")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< PromoteMirror")
+
+	if remoteVolumeHandle == "" {
+		return false, nil
+	}
+
+	volume, err := d.getMirrorVolume(ctx, localInternalVolumeName)
+	if err != nil {
+		return false, err
+	}
+	if !isReplicationDestination(volume) {
+		// Already promoted
+		return false, nil
+	}
+
+	// Wait for the snapshot to be replicated
+	if snapshotName != "" {
+		_, snapName, err := storage.ParseSnapshotID(snapshotName)
+		if err != nil {
+			return false, err
+		}
+		if _, err = d.SDK.SnapshotForVolume(ctx, volume, snapName); err != nil {
+			if utils.IsNotFoundError(err) {
+				Logc(ctx).WithField("snapshot", snapshotName).Debug("Snapshot not yet present.")
+				return true, nil
+			}
+			return false, err
+		}
+	}
+
+	status, err := d.SDK.ReplicationStatus(ctx, volume)
+	if err != nil && !utils.IsNotFoundError(err) {
+		return false, err
+	}
+
+	// Break if the replication is initialized, otherwise there is nothing to break
+	if status != nil && status.MirrorState == api.MirrorStateMirrored {
+		if err = d.SDK.BreakReplication(ctx, volume, false); err != nil {
+			return false, err
+		}
+	}
+
+	return false, d.SDK.DeleteReplication(ctx, volume)
+}
+
+// GetMirrorStatus returns the current state of the replication to a volume
+func (d *NASStorageDriver) GetMirrorStatus(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
+) (string, error) {
+	// Empty remote means there is no mirror to check for
+	if remoteVolumeHandle == "" {
+		return "", nil
+	}
+
+	volume, err := d.getMirrorVolume(ctx, localInternalVolumeName)
+	if err != nil {
+		return "", err
+	}
+	if !isReplicationDestination(volume) {
+		return "", nil
+	}
+
+	status, err := d.SDK.ReplicationStatus(ctx, volume)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			return v1.MirrorStateEstablishing, nil
+		}
+		return "", err
+	}
+
+	// Translate the replication status to a mirror status
+	switch status.MirrorState {
+	case api.MirrorStateUninitialized:
+		return v1.MirrorStateEstablishing, nil
+	case api.MirrorStateMirrored:
+		return v1.MirrorStateEstablished, nil
+	case api.MirrorStateBroken:
+		if status.RelationshipStatus == api.RelationshipStatusTransferring {
+			return v1.MirrorStateEstablishing, nil
+		}
+		return v1.MirrorStatePromoting, nil
+	}
+
+	Logc(ctx).WithField("mirrorState", status.MirrorState).Error("Unknown replication status returned.")
+	return "", nil
+}
+
+// ReleaseMirror deletes the replication from a source volume, which would otherwise keep it from being deleted.
+func (d *NASStorageDriver) ReleaseMirror(ctx context.Context, localInternalVolumeName string) error {
+	volume, err := d.getMirrorVolume(ctx, localInternalVolumeName)
+	if err != nil {
+		return err
+	}
+
+	if volume.Replication == nil || volume.Replication.EndpointType != api.ReplicationEndpointSource {
+		return nil
+	}
+
+	return d.SDK.DeleteReplication(ctx, volume)
+}
+
+// GetReplicationDetails returns the replication schedule of a volume and the resource ID that identifies it
+// in its mirror volume handle.
+func (d *NASStorageDriver) GetReplicationDetails(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
+) (string, string, string, error) {
+	volume, err := d.getMirrorVolume(ctx, localInternalVolumeName)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	// Empty remote means there is no mirror to check for
+	if remoteVolumeHandle == "" || volume.Replication == nil {
+		return "", "", volume.ID, nil
+	}
+
+	return "", volume.Replication.ReplicationSchedule, volume.ID, nil
+}
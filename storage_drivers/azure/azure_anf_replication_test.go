@@ -0,0 +1,406 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage_drivers/azure/api"
+	"github.com/netapp/trident/utils"
+)
+
+func getStructsForMirrorVolume() (string, *api.FileSystem) {
+	sourceVolumeID := api.CreateVolumeID(SubscriptionID, "RG3", "NA3", "CP3", "source")
+
+	filesystem := &api.FileSystem{
+		ID:                api.CreateVolumeID(SubscriptionID, "RG1", "NA1", "CP1", "testvol1"),
+		ResourceGroup:     "RG1",
+		NetAppAccount:     "NA1",
+		CapacityPool:      "CP1",
+		Name:              "testvol1",
+		FullName:          "RG1/NA1/CP1/testvol1",
+		Location:          Location,
+		CreationToken:     "trident-testvol1",
+		ProvisioningState: api.StateAvailable,
+		VolumeType:        api.VolumeTypeDataProtection,
+		Replication: &api.Replication{
+			EndpointType:        api.ReplicationEndpointDestination,
+			RemoteVolumeID:      sourceVolumeID,
+			ReplicationSchedule: api.ReplicationScheduleHourly,
+		},
+	}
+
+	return sourceVolumeID + ":trident-source", filesystem
+}
+
+func TestParseVolumeHandle(t *testing.T) {
+	volumeID := api.CreateVolumeID(SubscriptionID, "RG1", "NA1", "CP1", "testvol1")
+
+	tests := map[string]struct {
+		handle   string
+		expected string
+		isError  bool
+	}{
+		"Resource ID and creation token": {volumeID + ":trident-testvol1", volumeID, false},
+		"Resource ID only":               {volumeID, volumeID, false},
+		"ONTAP handle":                   {"svm1:testvol1", "", true},
+		"Empty":                          {"", "", true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := parseVolumeHandle(test.handle)
+			if test.isError {
+				assert.Error(t, err, "expected error")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+				assert.Equal(t, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestEstablishMirror(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(nil, utils.NotFoundError("not found")).Times(1)
+	mockAPI.EXPECT().AuthorizeReplication(ctx, filesystem.Replication.RemoteVolumeID, filesystem.ID).
+		Return(nil).Times(1)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.NoError(t, result, "establish failed")
+}
+
+func TestEstablishMirror_AlreadyTransferring(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{
+		Healthy:            true,
+		MirrorState:        api.MirrorStateUninitialized,
+		RelationshipStatus: api.RelationshipStatusTransferring,
+	}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", remoteHandle, "", api.ReplicationScheduleDaily)
+
+	assert.NoError(t, result, "establish failed")
+}
+
+func TestEstablishMirror_NotDataProtectionVolume(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+	filesystem.Replication = nil
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.Error(t, result, "expected error")
+}
+
+func TestEstablishMirror_DifferentSource(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	_, filesystem := getStructsForMirrorVolume()
+	remoteHandle := api.CreateVolumeID(SubscriptionID, "RG4", "NA4", "CP4", "other") + ":trident-other"
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.Error(t, result, "expected error")
+}
+
+func TestEstablishMirror_InvalidHandle(t *testing.T) {
+	_, driver := newMockANFDriver(t)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", "svm1:source", "", "")
+
+	assert.Error(t, result, "expected error")
+}
+
+func TestEstablishMirror_DiscoveryFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, _ := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(errFailed).Times(1)
+
+	result := driver.EstablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.Error(t, result, "expected error")
+}
+
+func TestReestablishMirror_Resync(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{MirrorState: api.MirrorStateBroken, RelationshipStatus: api.RelationshipStatusIdle}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+	mockAPI.EXPECT().ResyncReplication(ctx, filesystem).Return(nil).Times(1)
+
+	result := driver.ReestablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.NoError(t, result, "reestablish failed")
+}
+
+func TestReestablishMirror_AlreadyMirrored(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{MirrorState: api.MirrorStateMirrored, RelationshipStatus: api.RelationshipStatusIdle}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+
+	result := driver.ReestablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.NoError(t, result, "reestablish failed")
+}
+
+func TestReestablishMirror_Promoted(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+	filesystem.Replication = nil
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReestablishReplication(ctx, filesystem,
+		api.CreateVolumeID(SubscriptionID, "RG3", "NA3", "CP3", "source")).Return(nil).Times(1)
+
+	result := driver.ReestablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.NoError(t, result, "reestablish failed")
+}
+
+func TestReestablishMirror_StatusFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(nil, errFailed).Times(1)
+
+	result := driver.ReestablishMirror(ctx, "trident-testvol1", remoteHandle, "", "")
+
+	assert.Error(t, result, "expected error")
+}
+
+func TestPromoteMirror(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{MirrorState: api.MirrorStateMirrored, RelationshipStatus: api.RelationshipStatusIdle}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+	mockAPI.EXPECT().BreakReplication(ctx, filesystem, false).Return(nil).Times(1)
+	mockAPI.EXPECT().DeleteReplication(ctx, filesystem).Return(nil).Times(1)
+
+	wait, err := driver.PromoteMirror(ctx, "trident-testvol1", remoteHandle, "")
+
+	assert.NoError(t, err, "promote failed")
+	assert.False(t, wait)
+}
+
+func TestPromoteMirror_WaitForSnapshot(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().SnapshotForVolume(ctx, filesystem, "snap1").
+		Return(nil, utils.NotFoundError("not found")).Times(1)
+
+	wait, err := driver.PromoteMirror(ctx, "trident-testvol1", remoteHandle, "pvc-1/snap1")
+
+	assert.NoError(t, err, "promote failed")
+	assert.True(t, wait)
+}
+
+func TestPromoteMirror_SnapshotReplicated(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{MirrorState: api.MirrorStateBroken, RelationshipStatus: api.RelationshipStatusIdle}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().SnapshotForVolume(ctx, filesystem, "snap1").Return(&api.Snapshot{Name: "snap1"}, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+	mockAPI.EXPECT().DeleteReplication(ctx, filesystem).Return(nil).Times(1)
+
+	wait, err := driver.PromoteMirror(ctx, "trident-testvol1", remoteHandle, "pvc-1/snap1")
+
+	assert.NoError(t, err, "promote failed")
+	assert.False(t, wait)
+}
+
+func TestPromoteMirror_AlreadyPromoted(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+	filesystem.Replication = nil
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	wait, err := driver.PromoteMirror(ctx, "trident-testvol1", remoteHandle, "")
+
+	assert.NoError(t, err, "promote failed")
+	assert.False(t, wait)
+}
+
+func TestPromoteMirror_BreakFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	status := &api.ReplicationStatus{MirrorState: api.MirrorStateMirrored, RelationshipStatus: api.RelationshipStatusIdle}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(status, nil).Times(1)
+	mockAPI.EXPECT().BreakReplication(ctx, filesystem, false).Return(errFailed).Times(1)
+
+	_, err := driver.PromoteMirror(ctx, "trident-testvol1", remoteHandle, "")
+
+	assert.Error(t, err, "expected error")
+}
+
+func TestGetMirrorStatus(t *testing.T) {
+	tests := map[string]struct {
+		status   *api.ReplicationStatus
+		err      error
+		expected string
+	}{
+		"Not found": {nil, utils.NotFoundError("not found"), v1.MirrorStateEstablishing},
+		"Uninitialized": {
+			&api.ReplicationStatus{MirrorState: api.MirrorStateUninitialized},
+			nil, v1.MirrorStateEstablishing,
+		},
+		"Mirrored": {
+			&api.ReplicationStatus{MirrorState: api.MirrorStateMirrored},
+			nil, v1.MirrorStateEstablished,
+		},
+		"Resyncing": {
+			&api.ReplicationStatus{
+				MirrorState:        api.MirrorStateBroken,
+				RelationshipStatus: api.RelationshipStatusTransferring,
+			},
+			nil, v1.MirrorStateEstablishing,
+		},
+		"Broken": {
+			&api.ReplicationStatus{MirrorState: api.MirrorStateBroken, RelationshipStatus: api.RelationshipStatusIdle},
+			nil, v1.MirrorStatePromoting,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAPI, driver := newMockANFDriver(t)
+			remoteHandle, filesystem := getStructsForMirrorVolume()
+
+			mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+			mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+			mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(test.status, test.err).Times(1)
+
+			result, err := driver.GetMirrorStatus(ctx, "trident-testvol1", remoteHandle)
+
+			assert.NoError(t, err, "get mirror status failed")
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestGetMirrorStatus_NotDestination(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+	filesystem.Replication = nil
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	result, err := driver.GetMirrorStatus(ctx, "trident-testvol1", remoteHandle)
+
+	assert.NoError(t, err, "get mirror status failed")
+	assert.Equal(t, "", result)
+}
+
+func TestGetMirrorStatus_StatusFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().ReplicationStatus(ctx, filesystem).Return(nil, errFailed).Times(1)
+
+	_, err := driver.GetMirrorStatus(ctx, "trident-testvol1", remoteHandle)
+
+	assert.Error(t, err, "expected error")
+}
+
+func TestReleaseMirror(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	_, filesystem := getStructsForMirrorVolume()
+	filesystem.VolumeType = ""
+	filesystem.Replication.EndpointType = api.ReplicationEndpointSource
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().DeleteReplication(ctx, filesystem).Return(nil).Times(1)
+
+	result := driver.ReleaseMirror(ctx, "trident-testvol1")
+
+	assert.NoError(t, result, "release failed")
+}
+
+func TestReleaseMirror_Destination(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	_, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	result := driver.ReleaseMirror(ctx, "trident-testvol1")
+
+	assert.NoError(t, result, "release failed")
+}
+
+func TestGetReplicationDetails(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	remoteHandle, filesystem := getStructsForMirrorVolume()
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(filesystem, nil).Times(1)
+
+	policy, schedule, volumeID, err := driver.GetReplicationDetails(ctx, "trident-testvol1", remoteHandle)
+
+	assert.NoError(t, err, "get replication details failed")
+	assert.Equal(t, "", policy)
+	assert.Equal(t, api.ReplicationScheduleHourly, schedule)
+	assert.Equal(t, filesystem.ID, volumeID)
+}
+
+func TestGetReplicationDetails_VolumeNotFound(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "trident-testvol1").Return(nil, errFailed).Times(1)
+
+	_, _, _, err := driver.GetReplicationDetails(ctx, "trident-testvol1", "")
+
+	assert.Error(t, err, "expected error")
+}
@@ -727,7 +727,7 @@ func TestInitializeStoragePools_NoVirtualPools(t *testing.T) {
 	pool.Attributes()[sa.Snapshots] = sa.NewBoolOffer(true)
 	pool.Attributes()[sa.Clones] = sa.NewBoolOffer(true)
 	pool.Attributes()[sa.Encryption] = sa.NewBoolOffer(false)
-	pool.Attributes()[sa.Replication] = sa.NewBoolOffer(false)
+	pool.Attributes()[sa.Replication] = sa.NewBoolOffer(true)
 	pool.Attributes()[sa.Labels] = sa.NewLabelOffer(driver.Config.Labels)
 	pool.Attributes()[sa.Region] = sa.NewStringOffer("region1")
 	pool.Attributes()[sa.Zone] = sa.NewStringOffer("zone1")
@@ -828,7 +828,7 @@ func TestInitializeStoragePools_VirtualPools(t *testing.T) {
 	pool0.Attributes()[sa.Snapshots] = sa.NewBoolOffer(true)
 	pool0.Attributes()[sa.Clones] = sa.NewBoolOffer(true)
 	pool0.Attributes()[sa.Encryption] = sa.NewBoolOffer(false)
-	pool0.Attributes()[sa.Replication] = sa.NewBoolOffer(false)
+	pool0.Attributes()[sa.Replication] = sa.NewBoolOffer(true)
 	pool0.Attributes()[sa.Labels] = sa.NewLabelOffer(driver.Config.Labels)
 	pool0.Attributes()[sa.Region] = sa.NewStringOffer("region2")
 	pool0.Attributes()[sa.Zone] = sa.NewStringOffer("zone2")
@@ -853,7 +853,7 @@ func TestInitializeStoragePools_VirtualPools(t *testing.T) {
 	pool1.Attributes()[sa.Snapshots] = sa.NewBoolOffer(true)
 	pool1.Attributes()[sa.Clones] = sa.NewBoolOffer(true)
 	pool1.Attributes()[sa.Encryption] = sa.NewBoolOffer(false)
-	pool1.Attributes()[sa.Replication] = sa.NewBoolOffer(false)
+	pool1.Attributes()[sa.Replication] = sa.NewBoolOffer(true)
 	pool1.Attributes()[sa.Labels] = sa.NewLabelOffer(driver.Config.Labels)
 	pool1.Attributes()[sa.Region] = sa.NewStringOffer("region1")
 	pool1.Attributes()[sa.Zone] = sa.NewStringOffer("zone1")
@@ -973,6 +973,17 @@ func TestValidate_InvalidNetworkFeatures(t *testing.T) {
 	assert.Error(t, result, "validate did not fail")
 }
 
+func TestValidate_InvalidReplicationSchedule(t *testing.T) {
+	_, driver := newMockANFDriver(t)
+	driver.Config.ReplicationSchedule = "weekly"
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	result := driver.validate(ctx)
+
+	assert.Error(t, result, "validate did not fail")
+}
+
 func getStructsForCreateNFSVolume(ctx context.Context, driver *NASStorageDriver, storagePool storage.Pool) (
 	*storage.VolumeConfig, *api.CapacityPool, *api.Subnet, *api.FilesystemCreateRequest, *api.FileSystem,
 ) {
@@ -1100,6 +1111,68 @@ func TestCreate_NFSVolume(t *testing.T) {
 	assert.Equal(t, "0777", volConfig.UnixPermissions)
 }
 
+func TestCreate_NFSVolume_MirrorDestination(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+	driver.Config.ReplicationSchedule = api.ReplicationScheduleDaily
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	driver.initializeTelemetry(ctx, BackendUUID)
+
+	storagePool := driver.pools["anf_pool"]
+
+	sourceVolumeID := api.CreateVolumeID(SubscriptionID, "RG3", "NA3", "CP3", "source")
+	volConfig, capacityPool, subnet, createRequest, filesystem := getStructsForCreateNFSVolume(ctx, driver, storagePool)
+	volConfig.IsMirrorDestination = true
+	volConfig.PeerVolumeHandle = sourceVolumeID + ":trident-source"
+	createRequest.ReplicationSourceID = sourceVolumeID
+	createRequest.ReplicationSchedule = api.ReplicationScheduleDaily
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeExists(ctx, volConfig).Return(false, nil, nil).Times(1)
+	mockAPI.EXPECT().HasFeature(api.FeatureUnixPermissions).Return(false).Times(1)
+	mockAPI.EXPECT().RandomCapacityPoolForStoragePool(ctx, storagePool,
+		api.ServiceLevelUltra).Return(capacityPool).Times(1)
+	mockAPI.EXPECT().RandomSubnetForStoragePool(ctx, storagePool).Return(subnet).Times(1)
+	mockAPI.EXPECT().CreateVolume(ctx, createRequest).Return(filesystem, nil).Times(1)
+	mockAPI.EXPECT().WaitForVolumeState(ctx, filesystem, api.StateAvailable, []string{api.StateError},
+		driver.volumeCreateTimeout).Return(api.StateAvailable, nil).Times(1)
+
+	result := driver.Create(ctx, volConfig, storagePool, nil)
+
+	assert.NoError(t, result, "create failed")
+	assert.Equal(t, filesystem.ID, volConfig.InternalID, "internal ID not set on volConfig")
+}
+
+func TestCreate_NFSVolume_InvalidMirrorSource(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	driver.initializeTelemetry(ctx, BackendUUID)
+
+	storagePool := driver.pools["anf_pool"]
+
+	volConfig, capacityPool, subnet, _, _ := getStructsForCreateNFSVolume(ctx, driver, storagePool)
+	volConfig.IsMirrorDestination = true
+	volConfig.PeerVolumeHandle = "svm1:source"
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeExists(ctx, volConfig).Return(false, nil, nil).Times(1)
+	mockAPI.EXPECT().HasFeature(api.FeatureUnixPermissions).Return(false).Times(1)
+	mockAPI.EXPECT().RandomCapacityPoolForStoragePool(ctx, storagePool,
+		api.ServiceLevelUltra).Return(capacityPool).Times(1)
+	mockAPI.EXPECT().RandomSubnetForStoragePool(ctx, storagePool).Return(subnet).Times(1)
+
+	result := driver.Create(ctx, volConfig, storagePool, nil)
+
+	assert.Error(t, result, "expected error")
+}
+
 func TestCreate_DiscoveryFailed(t *testing.T) {
 	mockAPI, driver := newMockANFDriver(t)
 	driver.Config.BackendName = "anf"
@@ -3143,6 +3216,47 @@ func TestDestroy_NFSVolume(t *testing.T) {
 	assert.Nil(t, result, "not nil")
 }
 
+func TestDestroy_NFSVolume_MirrorDestination(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.initializeTelemetry(ctx, BackendUUID)
+
+	volConfig, filesystem := getStructsForDestroyNFSVolume(ctx, driver)
+	filesystem.Replication = &api.Replication{
+		EndpointType:   api.ReplicationEndpointDestination,
+		RemoteVolumeID: api.CreateVolumeID(SubscriptionID, "RG3", "NA3", "CP3", "source"),
+	}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeExists(ctx, volConfig).Return(true, filesystem, nil).Times(1)
+	mockAPI.EXPECT().DeleteReplication(ctx, filesystem).Return(nil).Times(1)
+	mockAPI.EXPECT().DeleteVolume(ctx, filesystem).Return(nil).Times(1)
+	mockAPI.EXPECT().WaitForVolumeState(ctx, filesystem, api.StateDeleted, []string{api.StateError},
+		driver.defaultTimeout()).Return(api.StateDeleted, nil).Times(1)
+
+	result := driver.Destroy(ctx, volConfig)
+
+	assert.Nil(t, result, "not nil")
+}
+
+func TestDestroy_DeleteReplicationFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.initializeTelemetry(ctx, BackendUUID)
+
+	volConfig, filesystem := getStructsForDestroyNFSVolume(ctx, driver)
+	filesystem.Replication = &api.Replication{
+		EndpointType:   api.ReplicationEndpointDestination,
+		RemoteVolumeID: api.CreateVolumeID(SubscriptionID, "RG3", "NA3", "CP3", "source"),
+	}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeExists(ctx, volConfig).Return(true, filesystem, nil).Times(1)
+	mockAPI.EXPECT().DeleteReplication(ctx, filesystem).Return(errFailed).Times(1)
+
+	result := driver.Destroy(ctx, volConfig)
+
+	assert.NotNil(t, result, "expected error")
+}
+
 func TestDestroy_DiscoveryFailed(t *testing.T) {
 	mockAPI, driver := newMockANFDriver(t)
 	driver.initializeTelemetry(ctx, BackendUUID)
@@ -4363,6 +4477,74 @@ func TestGetStorageBackendPhysicalPoolNames(t *testing.T) {
 	assert.Equal(t, []string{}, result, "physical pool names mismatch")
 }
 
+func TestGetPoolCapacity(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+
+	storagePool := driver.pools["anf_pool"]
+
+	capacityPools := []*api.CapacityPool{
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", Name: "CP1", FullName: "RG1/NA1/CP1", SizeInBytes: 4096},
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", Name: "CP2", FullName: "RG1/NA1/CP2", SizeInBytes: 4096},
+	}
+	volumes := []*api.FileSystem{
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", CapacityPool: "CP1", QuotaInBytes: 1024},
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", CapacityPool: "CP1", QuotaInBytes: 1024},
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", CapacityPool: "CP2", QuotaInBytes: 8192},
+		{ResourceGroup: "RG1", NetAppAccount: "NA1", CapacityPool: "CP3", QuotaInBytes: 1024},
+	}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool,
+		api.ServiceLevelUltra).Return(capacityPools).Times(1)
+	mockAPI.EXPECT().Volumes(ctx).Return(&volumes, nil).Times(1)
+
+	result, err := driver.GetPoolCapacity(ctx, storagePool)
+
+	assert.NoError(t, err, "expected no error")
+	assert.Equal(t, uint64(8192), result.TotalBytes, "total bytes mismatch")
+	assert.Equal(t, uint64(2048), result.FreeBytes, "free bytes mismatch")
+	assert.Equal(t, []string{"RG1/NA1/CP1", "RG1/NA1/CP2"}, result.PhysicalPools, "physical pools mismatch")
+}
+
+func TestGetPoolCapacity_UnknownPool(t *testing.T) {
+	_, driver := newMockANFDriver(t)
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+
+	result, err := driver.GetPoolCapacity(ctx, storage.NewStoragePool(nil, "otherPool"))
+
+	assert.Nil(t, result, "expected nil result")
+	assert.True(t, utils.IsNotFoundError(err), "expected not found error")
+}
+
+func TestGetPoolCapacity_ListFailed(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+
+	storagePool := driver.pools["anf_pool"]
+	capacityPools := []*api.CapacityPool{{FullName: "RG1/NA1/CP1", SizeInBytes: 4096}}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool,
+		api.ServiceLevelUltra).Return(capacityPools).Times(1)
+	mockAPI.EXPECT().Volumes(ctx).Return(nil, errFailed).Times(1)
+
+	result, err := driver.GetPoolCapacity(ctx, storagePool)
+
+	assert.Nil(t, result, "expected nil result")
+	assert.Error(t, err, "expected error")
+}
+
 func TestGetInternalVolumeName_PassthroughStore(t *testing.T) {
 	_, driver := newMockANFDriver(t)
 
@@ -30,6 +30,8 @@ const (
 	TopologyLabelPrefix = "topology.kubernetes.io"
 
 	CredentialStoreK8sSecret CredentialStore = "secret"
+	CredentialStoreFile      CredentialStore = "file"
+	CredentialStoreHTTP      CredentialStore = "http"
 
 	KeyName string = "name"
 	KeyType string = "type"
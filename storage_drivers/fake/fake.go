@@ -73,6 +73,9 @@ type StorageDriver struct {
 	// state.
 	DestroyedSnapshots map[string]bool
 
+	// QuiescedVolumes records the volumes whose I/O is currently held off for a group snapshot
+	QuiescedVolumes map[string]bool
+
 	Secret string
 }
 
@@ -397,12 +400,16 @@ func (d *StorageDriver) initializeStoragePools() error {
 	d.fakePools = make(map[string]*fake.StoragePool)
 	for fakePoolName, fakePool := range d.Config.Pools {
 		d.fakePools[fakePoolName] = fakePool.ConstructClone()
+		if d.fakePools[fakePoolName].TotalBytes == 0 {
+			d.fakePools[fakePoolName].TotalBytes = fakePool.Bytes
+		}
 	}
 
 	// nil storage pools become problematic on re-install
 	if len(d.fakePools) == 0 && len(d.Config.Storage) == 0 {
 		d.fakePools["pool-0"] = &fake.StoragePool{
-			Bytes: 100 * 1024 * 1024 * 1024,
+			Bytes:      100 * 1024 * 1024 * 1024,
+			TotalBytes: 100 * 1024 * 1024 * 1024,
 			Attrs: map[string]sa.Offer{
 				sa.IOPS:             sa.NewIntOffer(0, 100),
 				sa.Snapshots:        sa.NewBoolOffer(false),
@@ -966,6 +973,40 @@ func (d *StorageDriver) CreateSnapshot(
 	return snapshot, nil
 }
 
+// QuiesceVolumes holds off I/O to a set of volumes while they are snapshotted one at a time.
+func (d *StorageDriver) QuiesceVolumes(ctx context.Context, volConfigs []*storage.VolumeConfig) error {
+	for _, volConfig := range volConfigs {
+		if _, ok := d.Volumes[volConfig.InternalName]; !ok {
+			return fmt.Errorf("volume %s not found", volConfig.InternalName)
+		}
+		if d.QuiescedVolumes[volConfig.InternalName] {
+			return fmt.Errorf("volume %s is already quiesced", volConfig.InternalName)
+		}
+	}
+
+	if d.QuiescedVolumes == nil {
+		d.QuiescedVolumes = make(map[string]bool)
+	}
+	for _, volConfig := range volConfigs {
+		d.QuiescedVolumes[volConfig.InternalName] = true
+	}
+
+	Logc(ctx).WithField("backend", d.Config.InstanceName).Debug("Quiesced fake volumes.")
+
+	return nil
+}
+
+// ReleaseVolumes resumes I/O to a set of volumes quiesced by QuiesceVolumes.
+func (d *StorageDriver) ReleaseVolumes(ctx context.Context, volConfigs []*storage.VolumeConfig) error {
+	for _, volConfig := range volConfigs {
+		delete(d.QuiescedVolumes, volConfig.InternalName)
+	}
+
+	Logc(ctx).WithField("backend", d.Config.InstanceName).Debug("Released fake volumes.")
+
+	return nil
+}
+
 func (d *StorageDriver) BootstrapSnapshot(
 	ctx context.Context, snapshot *storage.Snapshot, volConfig *storage.VolumeConfig,
 ) {
@@ -1006,6 +1047,11 @@ func (d *StorageDriver) RestoreSnapshot(
 	return nil
 }
 
+// RestoreRequiresNewestSnapshot indicates whether this driver can only restore a volume from its newest snapshot.
+func (d *StorageDriver) RestoreRequiresNewestSnapshot() bool {
+	return d.Config.RestoreNewestSnapshotOnly
+}
+
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *StorageDriver) DeleteSnapshot(
 	_ context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -1034,6 +1080,104 @@ func (d *StorageDriver) Get(_ context.Context, name string) error {
 	return nil
 }
 
+// GetVolumeHealth returns the modeled condition of a volume.  A volume that no longer exists is abnormal.
+func (d *StorageDriver) GetVolumeHealth(
+	_ context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	volume, ok := d.Volumes[volConfig.InternalName]
+	if !ok {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s not found", volConfig.InternalName),
+		}, nil
+	}
+
+	return &storage.VolumeHealth{Abnormal: volume.Abnormal, Message: volume.HealthMessage}, nil
+}
+
+// SetVolumeHealth sets the condition reported for a volume, so that tests may simulate unhealthy volumes.
+func (d *StorageDriver) SetVolumeHealth(name string, abnormal bool, message string) error {
+	volume, ok := d.Volumes[name]
+	if !ok {
+		return fmt.Errorf("could not find volume %s", name)
+	}
+
+	volume.Abnormal = abnormal
+	volume.HealthMessage = message
+	d.Volumes[name] = volume
+
+	return nil
+}
+
+// GetVolumeMetrics reports the size of each volume that exists.  The fake driver stores no data and serves no
+// I/O, so all other metrics are zero.
+func (d *StorageDriver) GetVolumeMetrics(
+	_ context.Context, volConfigs []*storage.VolumeConfig,
+) (map[string]*storage.VolumeMetrics, error) {
+	metrics := make(map[string]*storage.VolumeMetrics)
+	for _, volConfig := range volConfigs {
+		if volume, ok := d.Volumes[volConfig.InternalName]; ok {
+			metrics[volConfig.Name] = &storage.VolumeMetrics{TotalBytes: int64(volume.SizeBytes)}
+		}
+	}
+	return metrics, nil
+}
+
+// ModifyVolume changes the attributes of a fake volume, which has everything but a tiering policy.
+func (d *StorageDriver) ModifyVolume(
+	_ context.Context, volConfig *storage.VolumeConfig, modification storage.VolumeModification,
+) error {
+	if _, ok := d.Volumes[volConfig.InternalName]; !ok {
+		return fmt.Errorf("could not find volume %s", volConfig.InternalName)
+	}
+	if _, ok := modification[storage.ModifiableTieringPolicy]; ok {
+		return utils.UnsupportedError("fake volumes have no tiering policy")
+	}
+	return nil
+}
+
+// MoveVolume moves a fake volume to another physical pool, which always completes at once.
+func (d *StorageDriver) MoveVolume(
+	ctx context.Context, volConfig *storage.VolumeConfig, targetPool storage.Pool, _ bool,
+) (bool, int, error) {
+	name := volConfig.InternalName
+	volume, ok := d.Volumes[name]
+	if !ok {
+		return false, 0, fmt.Errorf("could not find volume %s", name)
+	}
+	if volume.PhysicalPool == targetPool.Name() {
+		return false, 100, nil
+	}
+
+	sourcePool, ok := d.fakePools[volume.PhysicalPool]
+	if !ok {
+		return false, 0, fmt.Errorf("could not find pool %s", volume.PhysicalPool)
+	}
+	fakePool, ok := d.fakePools[targetPool.Name()]
+	if !ok {
+		return false, 0, fmt.Errorf("volumes may only be moved to a physical pool, and %s is not one",
+			targetPool.Name())
+	}
+	if volume.SizeBytes > fakePool.Bytes {
+		return false, 0, fmt.Errorf("volume is too large, requested %d bytes, have %d available in pool %s",
+			volume.SizeBytes, fakePool.Bytes, targetPool.Name())
+	}
+
+	sourcePool.Bytes += volume.SizeBytes
+	fakePool.Bytes -= volume.SizeBytes
+	volume.RequestedPool = targetPool.Name()
+	volume.PhysicalPool = targetPool.Name()
+	d.Volumes[name] = volume
+
+	Logc(ctx).WithFields(LogFields{
+		"backend":      d.Config.InstanceName,
+		"name":         name,
+		"physicalPool": targetPool.Name(),
+	}).Debug("Moved fake volume.")
+
+	return false, 100, nil
+}
+
 // Resize expands the volume size.
 func (d *StorageDriver) Resize(_ context.Context, volConfig *storage.VolumeConfig, sizeBytes uint64) error {
 	name := volConfig.InternalName
@@ -1090,6 +1234,32 @@ func (d *StorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []st
 	return physicalPoolNames
 }
 
+// GetPoolCapacity returns the modeled capacity of a storage pool.  A virtual pool
+// reports the combined capacity of all the physical pools.
+func (d *StorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	var physicalPoolNames []string
+
+	if _, ok := d.physicalPools[pool.Name()]; ok {
+		physicalPoolNames = []string{pool.Name()}
+	} else if _, ok = d.virtualPools[pool.Name()]; ok {
+		physicalPoolNames = d.GetStorageBackendPhysicalPoolNames(ctx)
+	} else {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	capacity := &storage.PoolCapacity{PhysicalPools: physicalPoolNames}
+	for _, physicalPoolName := range physicalPoolNames {
+		fakePool, ok := d.fakePools[physicalPoolName]
+		if !ok {
+			return nil, fmt.Errorf("fake pool %s not found", physicalPoolName)
+		}
+		capacity.TotalBytes += fakePool.TotalBytes
+		capacity.FreeBytes += fakePool.Bytes
+	}
+
+	return capacity, nil
+}
+
 func (d *StorageDriver) GetInternalVolumeName(_ context.Context, name string) string {
 	if tridentconfig.UsingPassthroughStore {
 		// With a passthrough store, the name mapping must remain reversible
@@ -12,6 +12,7 @@ import (
 
 	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/storage/fake"
 	sa "github.com/netapp/trident/storage_attribute"
 	drivers "github.com/netapp/trident/storage_drivers"
@@ -149,3 +150,88 @@ func TestInitializeStoragePoolsLabels(t *testing.T) {
 		assert.Equal(t, c.virtualExpected, label, c.virtualErrorMessage)
 	}
 }
+
+func TestGetPoolCapacity(t *testing.T) {
+	ctx := context.Background()
+	physicalPools := map[string]*fake.StoragePool{
+		"fake-backend_pool_0": {
+			Bytes: 50 * 1024 * 1024 * 1024,
+			Attrs: map[string]sa.Offer{
+				sa.ProvisioningType: sa.NewStringOffer("thin", "thick"),
+			},
+		},
+		"fake-backend_pool_1": {
+			Bytes:      20 * 1024 * 1024 * 1024,
+			TotalBytes: 80 * 1024 * 1024 * 1024,
+			Attrs: map[string]sa.Offer{
+				sa.ProvisioningType: sa.NewStringOffer("thin", "thick"),
+			},
+		},
+	}
+	fakePool := drivers.FakeStorageDriverPool{Region: "us_east_1"}
+	virtualPool := drivers.FakeStorageDriverPool{Zone: "us_east_1a"}
+
+	d, err := NewFakeStorageDriverWithPools(ctx, physicalPools, fakePool,
+		[]drivers.FakeStorageDriverPool{virtualPool})
+	assert.NoError(t, err)
+
+	// Physical pool without an explicit total reports its initial size
+	capacity, err := d.GetPoolCapacity(ctx, d.physicalPools["fake-backend_pool_0"])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(50*1024*1024*1024), capacity.TotalBytes)
+	assert.Equal(t, uint64(50*1024*1024*1024), capacity.FreeBytes)
+	assert.Equal(t, []string{"fake-backend_pool_0"}, capacity.PhysicalPools)
+
+	capacity, err = d.GetPoolCapacity(ctx, d.physicalPools["fake-backend_pool_1"])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(80*1024*1024*1024), capacity.TotalBytes)
+	assert.Equal(t, uint64(20*1024*1024*1024), capacity.FreeBytes)
+
+	// Virtual pool spans all physical pools
+	capacity, err = d.GetPoolCapacity(ctx, d.virtualPools["fake_us_east_1_pool_0"])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(130*1024*1024*1024), capacity.TotalBytes)
+	assert.Equal(t, uint64(70*1024*1024*1024), capacity.FreeBytes)
+	assert.ElementsMatch(t, []string{"fake-backend_pool_0", "fake-backend_pool_1"}, capacity.PhysicalPools)
+
+	_, err = d.GetPoolCapacity(ctx, storage.NewStoragePool(nil, "missing"))
+	assert.Error(t, err)
+}
+
+func TestGetVolumeHealth(t *testing.T) {
+	ctx := context.Background()
+	d := NewFakeStorageDriverWithDebugTraceFlags(nil)
+	d.Volumes = map[string]fake.Volume{"vol1": {Name: "vol1", SizeBytes: 1024}}
+	volConfig := &storage.VolumeConfig{Name: "vol1", InternalName: "vol1"}
+
+	health, err := d.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.False(t, health.Abnormal)
+
+	assert.NoError(t, d.SetVolumeHealth("vol1", true, "LUN is offline"))
+
+	health, err = d.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+	assert.Equal(t, "LUN is offline", health.Message)
+
+	// Missing volumes are abnormal
+	health, err = d.GetVolumeHealth(ctx, &storage.VolumeConfig{Name: "vol2", InternalName: "vol2"})
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+
+	assert.Error(t, d.SetVolumeHealth("vol2", true, ""))
+}
+
+func TestGetVolumeMetrics(t *testing.T) {
+	ctx := context.Background()
+	d := NewFakeStorageDriverWithDebugTraceFlags(nil)
+	d.Volumes = map[string]fake.Volume{"trident_vol1": {Name: "trident_vol1", SizeBytes: 1024}}
+
+	metrics, err := d.GetVolumeMetrics(ctx, []*storage.VolumeConfig{
+		{Name: "vol1", InternalName: "trident_vol1"},
+		{Name: "vol2", InternalName: "trident_vol2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*storage.VolumeMetrics{"vol1": {TotalBytes: 1024}}, metrics)
+}
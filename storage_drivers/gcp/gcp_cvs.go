@@ -1639,6 +1639,11 @@ func (d *NFSStorageDriver) RestoreSnapshot(
 	return d.API.RestoreSnapshot(ctx, volume, snapshot)
 }
 
+// RestoreRequiresNewestSnapshot indicates whether this driver can only restore a volume from its newest snapshot.
+func (d *NFSStorageDriver) RestoreRequiresNewestSnapshot() bool {
+	return true
+}
+
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *NFSStorageDriver) DeleteSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -1818,6 +1823,44 @@ func (d *NFSStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) [
 	return []string{}
 }
 
+// GetPoolCapacity returns the space in the GCP pools backing a storage pool.  Only software storage class
+// volumes are created in GCP pools; hardware storage class volumes draw from no pool of known size.
+func (d *NFSStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	fields := LogFields{
+		"Method": "GetPoolCapacity",
+		"Type":   "NFSStorageDriver",
+		"pool":   pool.Name(),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetPoolCapacity")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetPoolCapacity")
+
+	sPool, ok := d.pools[pool.Name()]
+	if !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	if sPool.InternalAttributes()[StorageClass] != api.StorageClassSoftware {
+		return nil, utils.UnsupportedError(fmt.Sprintf(
+			"capacity reporting is only supported for pools of the %s storage class", api.StorageClassSoftware))
+	}
+
+	GCPPools, _, err := d.GetGCPPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[ServiceLevel], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &storage.PoolCapacity{PhysicalPools: make([]string, 0, len(GCPPools))}
+	for _, GCPPool := range GCPPools {
+		capacity.TotalBytes += uint64(GCPPool.SizeInBytes)
+		if freeBytes := GCPPool.AvailableCapacity(); freeBytes > 0 {
+			capacity.FreeBytes += uint64(freeBytes)
+		}
+		capacity.PhysicalPools = append(capacity.PhysicalPools, GCPPool.PoolID)
+	}
+
+	return capacity, nil
+}
+
 func (d *NFSStorageDriver) GetInternalVolumeName(ctx context.Context, name string) string {
 	if tridentconfig.UsingPassthroughStore {
 		// With a passthrough store, the name mapping must remain reversible
@@ -646,6 +646,48 @@ func TestGetPoolsForCreate(t *testing.T) {
 	}
 }
 
+func TestGetPoolCapacity(t *testing.T) {
+	sPool := storage.NewStoragePool(nil, "spool1")
+	sPool.InternalAttributes()[StorageClass] = api.StorageClassSoftware
+	sPool.InternalAttributes()[ServiceLevel] = api.PoolServiceLevel1
+
+	gcpClient, d := newMockGCPDriver(t)
+	d.pools = map[string]storage.Pool{sPool.Name(): sPool}
+
+	// pool2 has another service level and pool3 has reached its volume limit, so neither is counted
+	gcpClient.EXPECT().GetPools(gomock.Any()).Return(getDummyGCPPools(), nil)
+
+	capacity, err := d.GetPoolCapacity(ctx(), sPool)
+
+	assert.NoError(t, err, "Unexpected error")
+	assert.Equal(t, uint64(10000001000), capacity.TotalBytes, "Unexpected total bytes")
+	assert.Equal(t, uint64(10000000000), capacity.FreeBytes, "Unexpected free bytes")
+	assert.ElementsMatch(t, []string{"abc1", "abc4"}, capacity.PhysicalPools, "Unexpected physical pools")
+}
+
+func TestGetPoolCapacity_Errors(t *testing.T) {
+	sPool := storage.NewStoragePool(nil, "spool1")
+	sPool.InternalAttributes()[StorageClass] = api.StorageClassSoftware
+	sPool.InternalAttributes()[ServiceLevel] = api.PoolServiceLevel1
+
+	gcpClient, d := newMockGCPDriver(t)
+	d.pools = map[string]storage.Pool{sPool.Name(): sPool}
+
+	// Unknown pool
+	_, err := d.GetPoolCapacity(ctx(), storage.NewStoragePool(nil, "spool2"))
+	assert.True(t, utils.IsNotFoundError(err), "Expected not found error")
+
+	// GCP API failure
+	gcpClient.EXPECT().GetPools(gomock.Any()).Return(nil, errors.New("failed to get pools"))
+	_, err = d.GetPoolCapacity(ctx(), sPool)
+	assert.Error(t, err, "Expected error")
+
+	// Hardware storage class volumes are not created in GCP pools
+	sPool.InternalAttributes()[StorageClass] = api.StorageClassHardware
+	_, err = d.GetPoolCapacity(ctx(), sPool)
+	assert.True(t, utils.IsUnsupportedError(err), "Expected unsupported error")
+}
+
 func TestInitialize(t *testing.T) {
 	commonConfig := &drivers.CommonStorageDriverConfig{
 		Version:           1,
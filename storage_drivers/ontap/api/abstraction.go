@@ -53,10 +53,13 @@ type OntapAPI interface {
 	ExportPolicyCreate(ctx context.Context, policy string) error
 	ExportPolicyDestroy(ctx context.Context, policy string) error
 	ExportPolicyExists(ctx context.Context, policyName string) (bool, error)
-	ExportRuleCreate(ctx context.Context, policyName, desiredPolicyRule, nasProtocol string) error
+	ExportRuleCreate(
+		ctx context.Context, policyName, desiredPolicyRule, nasProtocol string, securityFlavors []string,
+	) error
 	ExportRuleDestroy(ctx context.Context, policyName string, ruleIndex int) error
-	ExportRuleList(ctx context.Context, policyName string) (map[string]int, error)
+	ExportRuleList(ctx context.Context, policyName string) (map[string]ExportRule, error)
 
+	FlexgroupConstituentCount(ctx context.Context, svmName, volumeName string) (int, error)
 	FlexgroupCreate(ctx context.Context, volume Volume) error
 	FlexgroupExists(ctx context.Context, volumeName string) (bool, error)
 	FlexgroupInfo(ctx context.Context, volumeName string) (*Volume, error)
@@ -96,6 +99,21 @@ type OntapAPI interface {
 	LunSetSize(ctx context.Context, lunPath, newSize string) (uint64, error)
 	LunMapGetReportingNodes(ctx context.Context, initiatorGroupName, lunPath string) ([]string, error)
 	LunListIgroupsMapped(ctx context.Context, lunPath string) ([]string, error)
+	LunListMetrics(ctx context.Context, pattern string) (map[string]*Metrics, error)
+
+	NVMeNamespaceCreate(ctx context.Context, ns NVMeNamespace) (string, error)
+	NVMeNamespaceSetSize(ctx context.Context, nsUUID string, newSize int64) error
+	NVMeNamespaceGetByName(ctx context.Context, name string) (*NVMeNamespace, error)
+	NVMeNamespaceList(ctx context.Context, pattern string) (NVMeNamespaces, error)
+	NVMeNamespaceGetSubsystems(ctx context.Context, nsUUID string) ([]string, error)
+	NVMeSubsystemCreate(ctx context.Context, subsystemName string) (*NVMeSubsystem, error)
+	NVMeSubsystemDelete(ctx context.Context, subsystemUUID string) error
+	NVMeAddHostToSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error
+	NVMeRemoveHostFromSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error
+	NVMeGetHostsOfSubsystem(ctx context.Context, subsystemUUID string) ([]string, error)
+	NVMeEnsureNamespaceMapped(ctx context.Context, subsystemUUID, nsUUID string) error
+	NVMeEnsureNamespaceUnmapped(ctx context.Context, subsystemUUID, nsUUID string) error
+	NVMeIsNamespaceMapped(ctx context.Context, subsystemUUID, nsUUID string) (bool, error)
 
 	IscsiInitiatorGetDefaultAuth(ctx context.Context) (IscsiInitiatorAuth, error)
 	IscsiInitiatorSetDefaultAuth(
@@ -104,6 +122,8 @@ type OntapAPI interface {
 	) error
 	IscsiInterfaceGet(ctx context.Context, svm string) ([]string, error)
 	IscsiNodeGetNameRequest(ctx context.Context) (string, error)
+	FcpInterfaceGet(ctx context.Context, svm string) ([]string, error)
+	FcpNodeGetNameRequest(ctx context.Context) (string, error)
 
 	IgroupCreate(ctx context.Context, initiatorGroupName, initiatorGroupType, osType string) error
 	IgroupDestroy(ctx context.Context, initiatorGroupName string) error
@@ -131,6 +151,7 @@ type OntapAPI interface {
 	QtreeCount(ctx context.Context, volumeName string) (int, error)
 	QtreeListByPrefix(ctx context.Context, prefix, volumePrefix string) (Qtrees, error)
 	QtreeGetByName(ctx context.Context, name, volumePrefix string) (*Qtree, error)
+	QtreeCloneFromSnapshot(ctx context.Context, snapshotName, volumeName, qtree, destinationQtree string) error
 
 	QuotaEntryList(ctx context.Context, volumeName string) (QuotaEntries, error)
 	QuotaOff(ctx context.Context, volumeName string) error
@@ -166,6 +187,10 @@ type OntapAPI interface {
 	SnapmirrorInitialize(
 		ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string,
 	) error
+	SnapmirrorUpdate(
+		ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName,
+		snapshotName string,
+	) error
 	SnapmirrorPolicyGet(ctx context.Context, replicationPolicy string) (*SnapmirrorPolicy, error)
 	SnapmirrorQuiesce(
 		ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string,
@@ -193,12 +218,19 @@ type OntapAPI interface {
 	VolumeExists(ctx context.Context, volumeName string) (bool, error)
 	VolumeInfo(ctx context.Context, volumeName string) (*Volume, error)
 	VolumeListByPrefix(ctx context.Context, prefix string) (Volumes, error)
+	VolumeListMetrics(ctx context.Context, pattern string) (map[string]*Metrics, error)
 	VolumeListBySnapshotParent(ctx context.Context, snapshotName, sourceVolume string) (VolumeNameList, error)
 	VolumeModifyExportPolicy(ctx context.Context, volumeName, policyName string) error
 	VolumeModifyUnixPermissions(
 		ctx context.Context, volumeNameInternal, volumeNameExternal, unixPermissions string,
 	) error
+	VolumeModifySnapshotDirectoryAccess(ctx context.Context, volumeName string, enable bool) error
+	VolumeModifySnapshotPolicy(ctx context.Context, volumeName, snapshotPolicy string) error
+	VolumeModifyTieringPolicy(ctx context.Context, volumeName, tieringPolicy string) error
+	VolumeMoveInfo(ctx context.Context, volumeName string) (*VolumeMove, error)
+	VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error
 	VolumeMount(ctx context.Context, name, junctionPath string) error
+	VolumeUnmount(ctx context.Context, name string, force bool) error
 	VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume) (Volumes, error)
 	VolumeRename(ctx context.Context, originalName, newName string) error
 	VolumeSetComment(ctx context.Context, volumeNameInternal, volumeNameExternal, comment string) error
@@ -207,6 +239,8 @@ type OntapAPI interface {
 	VolumeSize(ctx context.Context, volumeName string) (uint64, error)
 	VolumeUsedSize(ctx context.Context, volumeName string) (int, error)
 	VolumeSnapshotCreate(ctx context.Context, snapshotName, sourceVolume string) error
+	// VolumeGroupSnapshotCreate snapshots several volumes at a single point in time
+	VolumeGroupSnapshotCreate(ctx context.Context, snapshotName string, sourceVolumes []string) error
 	VolumeSnapshotList(ctx context.Context, sourceVolume string) (Snapshots, error)
 	VolumeSnapshotDelete(ctx context.Context, snapshotName, sourceVolume string) error
 	SMBShareCreate(ctx context.Context, shareName, path string) error
@@ -319,3 +353,12 @@ func GetError(ctx context.Context, response *APIResponse, errorIn error) (errorO
 	errorOut = fmt.Errorf("API error: %v", response)
 	return errorOut
 }
+
+// exportRuleSecurityFlavors returns the security flavors with which an export rule is created, which default to
+// any flavor.
+func exportRuleSecurityFlavors(securityFlavors []string) []string {
+	if len(securityFlavors) == 0 {
+		return []string{"any"}
+	}
+	return securityFlavors
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"runtime/debug"
 	"sort"
 	"strconv"
@@ -469,7 +470,8 @@ func (d OntapAPIREST) FlexgroupCreate(ctx context.Context, volume Volume) error
 
 	creationErr := d.api.FlexGroupCreate(ctx, volume.Name, int(volumeSize), volume.Aggregates, volume.SpaceReserve,
 		volume.SnapshotPolicy, volume.UnixPermissions, volume.ExportPolicy, volume.SecurityStyle, volume.TieringPolicy,
-		volume.Comment, volume.Qos, volume.Encrypt, volume.SnapshotReserve)
+		volume.Comment, volume.Qos, volume.Encrypt, volume.SnapshotReserve, volume.ConstituentsPerAggregate,
+		volume.DPVolume)
 	if creationErr != nil {
 		return fmt.Errorf("error creating volume: %v", creationErr)
 	}
@@ -512,6 +514,18 @@ func (d OntapAPIREST) FlexgroupInfo(ctx context.Context, volumeName string) (*Vo
 	return volumeInfo, nil
 }
 
+func (d OntapAPIREST) FlexgroupConstituentCount(ctx context.Context, svmName, volumeName string) (int, error) {
+	count, err := d.api.FlexGroupConstituentCount(ctx, svmName, volumeName)
+	if err != nil {
+		return 0, fmt.Errorf("error listing constituents of FlexGroup %s:%s; %v", svmName, volumeName, err)
+	}
+	if count == 0 {
+		return 0, NotFoundError(fmt.Sprintf("FlexGroup %s:%s has no constituents", svmName, volumeName))
+	}
+
+	return count, nil
+}
+
 func (d OntapAPIREST) FlexgroupSetComment(
 	ctx context.Context, volumeNameInternal, volumeNameExternal, comment string,
 ) error {
@@ -818,6 +832,58 @@ func (d OntapAPIREST) VolumeDisableSnapshotDirectoryAccess(ctx context.Context,
 	return nil
 }
 
+func (d OntapAPIREST) VolumeModifySnapshotDirectoryAccess(ctx context.Context, name string, enable bool) error {
+	if err := d.api.VolumeModifySnapshotDirectoryAccess(ctx, name, enable); err != nil {
+		return fmt.Errorf("error modifying snapshot directory access: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIREST) VolumeModifySnapshotPolicy(ctx context.Context, name, snapshotPolicy string) error {
+	if err := d.api.VolumeModifySnapshotPolicy(ctx, name, snapshotPolicy); err != nil {
+		return fmt.Errorf("error modifying snapshot policy: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIREST) VolumeModifyTieringPolicy(ctx context.Context, name, tieringPolicy string) error {
+	if err := d.api.VolumeModifyTieringPolicy(ctx, name, tieringPolicy); err != nil {
+		return fmt.Errorf("error modifying tiering policy: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIREST) VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error {
+	if err := d.api.VolumeMoveStart(ctx, volumeName, aggregate); err != nil {
+		return fmt.Errorf("error starting move of volume %s to aggregate %s: %v", volumeName, aggregate, err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIREST) VolumeMoveInfo(ctx context.Context, volumeName string) (*VolumeMove, error) {
+	movement, err := d.api.VolumeMoveInfo(ctx, volumeName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting move status of volume %s: %v", volumeName, err)
+	}
+	if movement == nil || movement.State == nil {
+		return nil, nil
+	}
+
+	volumeMove := &VolumeMove{State: *movement.State}
+	if movement.DestinationAggregate != nil && movement.DestinationAggregate.Name != nil {
+		volumeMove.DestinationAggregate = *movement.DestinationAggregate.Name
+	}
+	if movement.PercentComplete != nil {
+		volumeMove.PercentComplete = int(*movement.PercentComplete)
+	}
+
+	return volumeMove, nil
+}
+
 func (d OntapAPIREST) VolumeMount(ctx context.Context, name, junctionPath string) error {
 	// Mount the volume at the specified junction
 	if err := d.api.VolumeMount(ctx, name, junctionPath); err != nil {
@@ -833,6 +899,14 @@ func (d OntapAPIREST) VolumeMount(ctx context.Context, name, junctionPath string
 	return nil
 }
 
+func (d OntapAPIREST) VolumeUnmount(ctx context.Context, name string, _ bool) error {
+	if err := d.api.VolumeUnmount(ctx, name); err != nil {
+		return fmt.Errorf("error unmounting volume %v: %v", name, err)
+	}
+
+	return nil
+}
+
 func (d OntapAPIREST) VolumeRename(ctx context.Context, originalName, newName string) error {
 	return d.api.VolumeRename(ctx, originalName, newName)
 }
@@ -928,12 +1002,55 @@ func (d OntapAPIREST) VolumeListByPrefix(ctx context.Context, prefix string) (Vo
 	return volumes, nil
 }
 
+// VolumeListMetrics returns the space used and the performance of all volumes whose names match the supplied
+// pattern, keyed by volume name
+func (d OntapAPIREST) VolumeListMetrics(ctx context.Context, pattern string) (map[string]*Metrics, error) {
+	volumesResponse, err := d.api.VolumeListMetrics(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[string]*Metrics)
+
+	if volumesResponse != nil && volumesResponse.Payload != nil {
+		for _, volume := range volumesResponse.Payload.VolumeResponseInlineRecords {
+			if volume == nil || volume.Name == nil {
+				continue
+			}
+			volumeMetrics := &Metrics{Name: *volume.Name}
+			if volume.Space != nil {
+				volumeMetrics.UsedBytes = int64Value(volume.Space.Used)
+				volumeMetrics.TotalBytes = int64Value(volume.Space.Size)
+			}
+			if volume.Metric != nil {
+				if volume.Metric.Iops != nil {
+					volumeMetrics.ReadIOPS = int64Value(volume.Metric.Iops.Read)
+					volumeMetrics.WriteIOPS = int64Value(volume.Metric.Iops.Write)
+				}
+				if volume.Metric.Throughput != nil {
+					volumeMetrics.ReadThroughput = int64Value(volume.Metric.Throughput.Read)
+					volumeMetrics.WriteThroughput = int64Value(volume.Metric.Throughput.Write)
+				}
+				if volume.Metric.Latency != nil {
+					volumeMetrics.ReadLatency = int64Value(volume.Metric.Latency.Read)
+					volumeMetrics.WriteLatency = int64Value(volume.Metric.Latency.Write)
+				}
+			}
+			metrics[volumeMetrics.Name] = volumeMetrics
+		}
+	}
+
+	return metrics, nil
+}
+
 // VolumeListByAttrs is used to find bucket volumes for nas-eco and san-eco
 func (d OntapAPIREST) VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume) (Volumes, error) {
 	return d.api.VolumeListByAttrs(ctx, volumeAttrs)
 }
 
-func (d OntapAPIREST) ExportRuleCreate(ctx context.Context, policyName, desiredPolicyRules, nasProtocol string) error {
+func (d OntapAPIREST) ExportRuleCreate(
+	ctx context.Context, policyName, desiredPolicyRules, nasProtocol string, securityFlavors []string,
+) error {
 	var ruleResponse *n_a_s.ExportRuleCreateCreated
 	var err error
 	var protocol []string
@@ -943,6 +1060,7 @@ func (d OntapAPIREST) ExportRuleCreate(ctx context.Context, policyName, desiredP
 		"Type":               "OntapAPIREST",
 		"policyName":         policyName,
 		"desiredPolicyRules": desiredPolicyRules,
+		"securityFlavors":    securityFlavors,
 	}
 	Logd(ctx, d.driverName,
 		d.api.ClientConfig().DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> ExportRuleCreate")
@@ -958,8 +1076,9 @@ func (d OntapAPIREST) ExportRuleCreate(ctx context.Context, policyName, desiredP
 		}
 
 		Logc(ctx).Debugf("processing desiredPolicyRule for %v protocol: '%v'", nasProtocol, desiredPolicyRule)
-		ruleResponse, err = d.api.ExportRuleCreate(ctx, policyName, desiredPolicyRule, protocol, []string{"any"},
-			[]string{"any"}, []string{"any"})
+		ruleResponse, err = d.api.ExportRuleCreate(ctx, policyName, desiredPolicyRule, protocol,
+			exportRuleSecurityFlavors(securityFlavors), exportRuleSecurityFlavors(securityFlavors),
+			exportRuleSecurityFlavors(securityFlavors))
 		if err != nil {
 			err = fmt.Errorf("error creating export rule: %v", err)
 			Logc(ctx).WithFields(LogFields{
@@ -1016,13 +1135,23 @@ func (d OntapAPIREST) ExportPolicyExists(ctx context.Context, policyName string)
 	return true, nil
 }
 
-func (d OntapAPIREST) ExportRuleList(ctx context.Context, policyName string) (map[string]int, error) {
+func (d OntapAPIREST) ExportRuleList(ctx context.Context, policyName string) (map[string]ExportRule, error) {
 	ruleListResponse, err := d.api.ExportRuleList(ctx, policyName)
 	if err != nil {
 		return nil, fmt.Errorf("error listing export policy rules: %v", err)
 	}
 
-	rules := make(map[string]int)
+	securityFlavors := func(flavors []*models.ExportAuthenticationFlavor) []string {
+		names := make([]string, 0, len(flavors))
+		for _, flavor := range flavors {
+			if flavor != nil {
+				names = append(names, string(*flavor))
+			}
+		}
+		return names
+	}
+
+	rules := make(map[string]ExportRule)
 	if ruleListResponse != nil &&
 		ruleListResponse.Payload != nil &&
 		ruleListResponse.Payload.NumRecords != nil &&
@@ -1032,7 +1161,12 @@ func (d OntapAPIREST) ExportRuleList(ctx context.Context, policyName string) (ma
 		for _, rule := range exportRuleList {
 			for _, client := range rule.ExportRuleInlineClients {
 				if client.Match != nil && rule.Index != nil {
-					rules[*client.Match] = int(*rule.Index)
+					rules[*client.Match] = ExportRule{
+						Index:     int(*rule.Index),
+						RoRule:    securityFlavors(rule.ExportRuleInlineRoRule),
+						RwRule:    securityFlavors(rule.ExportRuleInlineRwRule),
+						SuperUser: securityFlavors(rule.ExportRuleInlineSuperuser),
+					}
 				}
 			}
 		}
@@ -1236,6 +1370,60 @@ func (d OntapAPIREST) VolumeSnapshotCreate(ctx context.Context, snapshotName, so
 	return nil
 }
 
+// VolumeGroupSnapshotCreate snapshots several volumes at a single point in time.  The volumes are placed in a
+// temporary consistency group, which is snapshotted and then deleted again without touching the volumes.  The
+// delete is retried, and if it still fails the consistency group is logged as an error, since it would block
+// later group snapshots of the same volumes.  A snapshot that was created is kept, so the error is only returned
+// along with a failure to create the snapshot.
+func (d OntapAPIREST) VolumeGroupSnapshotCreate(
+	ctx context.Context, snapshotName string, sourceVolumes []string,
+) (err error) {
+	consistencyGroupUUID, err := d.api.ConsistencyGroupCreateAndWait(ctx, snapshotName, sourceVolumes)
+	if err != nil {
+		return fmt.Errorf("could not create consistency group for snapshot %s: %v", snapshotName, err)
+	}
+
+	defer func() {
+		deleteErr := d.deleteConsistencyGroup(ctx, snapshotName, consistencyGroupUUID)
+		if deleteErr != nil && err != nil {
+			err = fmt.Errorf("%v; %v", err, deleteErr)
+		}
+	}()
+
+	if err = d.api.ConsistencyGroupSnapshotCreateAndWait(ctx, consistencyGroupUUID, snapshotName); err != nil {
+		return fmt.Errorf("could not create group snapshot: %v", err)
+	}
+	return nil
+}
+
+// deleteConsistencyGroup deletes a temporary consistency group, with backoff retry logic
+func (d OntapAPIREST) deleteConsistencyGroup(ctx context.Context, name, consistencyGroupUUID string) error {
+	deleteGroup := func() error {
+		return d.api.ConsistencyGroupDeleteAndWait(ctx, consistencyGroupUUID)
+	}
+	deleteNotify := func(err error, duration time.Duration) {
+		Logc(ctx).WithFields(LogFields{
+			"consistencyGroup": name,
+			"increment":        duration,
+		}).WithError(err).Debug("Could not delete consistency group, retrying.")
+	}
+	deleteBackoff := backoff.NewExponentialBackOff()
+	deleteBackoff.InitialInterval = 1 * time.Second
+	deleteBackoff.Multiplier = 2
+	deleteBackoff.RandomizationFactor = 0.1
+	deleteBackoff.MaxElapsedTime = 1 * time.Minute
+
+	if err := backoff.RetryNotify(deleteGroup, deleteBackoff, deleteNotify); err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"consistencyGroup":     name,
+			"consistencyGroupUUID": consistencyGroupUUID,
+		}).WithError(err).Error("Could not delete consistency group; it must be deleted manually.")
+		return fmt.Errorf("could not delete consistency group %s (%s): %v", name, consistencyGroupUUID, err)
+	}
+
+	return nil
+}
+
 // pollVolumeExistence polls for the volume, with backoff retry logic
 func (d OntapAPIREST) pollVolumeExistence(ctx context.Context, volumeName string) error {
 	checkVolumeStatus := func() error {
@@ -1344,6 +1532,89 @@ func (d OntapAPIREST) SnapshotRestoreFlexgroup(ctx context.Context, snapshotName
 	return nil
 }
 
+// QtreeCloneFromSnapshot populates an empty qtree with the contents of another qtree, as of one of their Flexvol's
+// snapshots.  Directories and symlinks are recreated with their permissions, owners and groups, and files are
+// cloned from the snapshot directory, which shares their blocks with the snapshot rather than copying their data.
+// The source is walked before anything is created, and FIFOs, sockets and devices, which cannot be created through
+// the API, fail the clone.  A file with more than one hard link is cloned once for each of its links, which no
+// longer share an inode afterwards.
+func (d OntapAPIREST) QtreeCloneFromSnapshot(
+	ctx context.Context, snapshotName, volumeName, qtree, destinationQtree string,
+) error {
+	type fileToCreate struct {
+		source, destination string
+		info                *models.FileInfo
+	}
+	toCreate := make([]fileToCreate, 0)
+
+	var walkDirectory func(sourceDir, destinationDir string) error
+	walkDirectory = func(sourceDir, destinationDir string) error {
+		entries, err := d.api.FileList(ctx, volumeName, sourceDir)
+		if err != nil {
+			return fmt.Errorf("error listing directory %s: %v", sourceDir, err)
+		}
+
+		for _, entry := range entries {
+			if entry.Name == nil || entry.Type == nil || *entry.Name == "." || *entry.Name == ".." {
+				continue
+			}
+			source := path.Join(sourceDir, *entry.Name)
+			destination := path.Join(destinationDir, *entry.Name)
+
+			switch *entry.Type {
+			case models.FileInfoTypeDirectory:
+				toCreate = append(toCreate, fileToCreate{source, destination, &models.FileInfo{
+					Type:            entry.Type,
+					UnixPermissions: entry.UnixPermissions,
+					OwnerID:         entry.OwnerID,
+					GroupID:         entry.GroupID,
+				}})
+				if err = walkDirectory(source, destination); err != nil {
+					return err
+				}
+			case models.FileInfoTypeSymlink:
+				toCreate = append(toCreate, fileToCreate{source, destination, &models.FileInfo{
+					Type:    entry.Type,
+					Target:  entry.Target,
+					OwnerID: entry.OwnerID,
+					GroupID: entry.GroupID,
+				}})
+			case models.FileInfoTypeFile:
+				if entry.HardLinksCount != nil && *entry.HardLinksCount > 1 {
+					Logc(ctx).WithFields(LogFields{
+						"path":      source,
+						"hardLinks": *entry.HardLinksCount,
+					}).Warning("File has more than one hard link; each link is cloned as a separate file.")
+				}
+				toCreate = append(toCreate, fileToCreate{source, destination, nil})
+			case models.FileInfoTypeFifo, models.FileInfoTypeSocket, models.FileInfoTypeBlockdev,
+				models.FileInfoTypeChardev:
+				return fmt.Errorf("cannot clone %s from snapshot, %s files cannot be created through the ONTAP "+
+					"API", source, *entry.Type)
+			default:
+				return fmt.Errorf("cannot clone %s of type %s from snapshot", source, *entry.Type)
+			}
+		}
+		return nil
+	}
+
+	if err := walkDirectory(path.Join(".snapshot", snapshotName, qtree), destinationQtree); err != nil {
+		return err
+	}
+
+	// Directories precede their contents, so each file is created after the directory that holds it
+	for _, file := range toCreate {
+		if file.info == nil {
+			if err := d.api.FileClone(ctx, volumeName, file.source, file.destination); err != nil {
+				return fmt.Errorf("error cloning file %s from snapshot %s: %v", file.source, snapshotName, err)
+			}
+		} else if err := d.api.FileCreate(ctx, volumeName, file.destination, file.info); err != nil {
+			return fmt.Errorf("error creating %s %s: %v", *file.info.Type, file.destination, err)
+		}
+	}
+	return nil
+}
+
 func (d OntapAPIREST) SnapshotDeleteByNameAndStyle(
 	ctx context.Context, snapshotName, sourceVolume, sourceVolumeUUID string,
 ) error {
@@ -1551,6 +1822,25 @@ func (d OntapAPIREST) SnapmirrorInitialize(
 	return nil
 }
 
+func (d OntapAPIREST) SnapmirrorUpdate(
+	ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName,
+	snapshotName string,
+) error {
+	err := d.api.SnapmirrorUpdate(ctx, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName,
+		snapshotName)
+	if err != nil {
+		if restErr, err := ExtractErrorResponse(ctx, err); err == nil {
+			if restErr.Error != nil && restErr.Error.Code != nil && *restErr.Error.Code == SNAPMIRROR_TRANSFER_IN_PROGRESS {
+				Logc(ctx).Debug("snapmirror transfer already in progress")
+				return nil
+			}
+		}
+		Logc(ctx).WithError(err).Error("Error on snapmirror update")
+		return err
+	}
+	return nil
+}
+
 func (d OntapAPIREST) SnapmirrorDelete(
 	ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName,
 	remoteSVMName string,
@@ -2126,6 +2416,55 @@ func (d OntapAPIREST) LunListIgroupsMapped(ctx context.Context, lunPath string)
 	return names, err
 }
 
+// LunListMetrics returns the space used and the performance of all LUNs whose names match the supplied pattern,
+// keyed by LUN path
+func (d OntapAPIREST) LunListMetrics(ctx context.Context, pattern string) (map[string]*Metrics, error) {
+	lunsResponse, err := d.api.LunListMetrics(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[string]*Metrics)
+
+	if lunsResponse != nil && lunsResponse.Payload != nil {
+		for _, lun := range lunsResponse.Payload.LunResponseInlineRecords {
+			if lun == nil || lun.Name == nil {
+				continue
+			}
+			lunMetrics := &Metrics{Name: *lun.Name}
+			if lun.Space != nil {
+				lunMetrics.UsedBytes = int64Value(lun.Space.Used)
+				lunMetrics.TotalBytes = int64Value(lun.Space.Size)
+			}
+			if lun.Metric != nil {
+				if lun.Metric.Iops != nil {
+					lunMetrics.ReadIOPS = int64Value(lun.Metric.Iops.Read)
+					lunMetrics.WriteIOPS = int64Value(lun.Metric.Iops.Write)
+				}
+				if lun.Metric.Throughput != nil {
+					lunMetrics.ReadThroughput = int64Value(lun.Metric.Throughput.Read)
+					lunMetrics.WriteThroughput = int64Value(lun.Metric.Throughput.Write)
+				}
+				if lun.Metric.Latency != nil {
+					lunMetrics.ReadLatency = int64Value(lun.Metric.Latency.Read)
+					lunMetrics.WriteLatency = int64Value(lun.Metric.Latency.Write)
+				}
+			}
+			metrics[lunMetrics.Name] = lunMetrics
+		}
+	}
+
+	return metrics, nil
+}
+
+// int64Value returns the value of an optional field in a REST response, or zero if it is not set
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
 // IgroupListLUNsMapped returns a list LUNs mapped to the igroup
 func (d OntapAPIREST) IgroupListLUNsMapped(ctx context.Context, initiatorGroupName string) ([]string, error) {
 	var names []string
@@ -2173,6 +2512,226 @@ func (d OntapAPIREST) LunSetSize(ctx context.Context, lunPath, newSize string) (
 	return d.api.LunSetSize(ctx, lunPath, newSize)
 }
 
+func nvmeNamespaceFromRestAttrsHelper(nsResponse *models.NvmeNamespace) *NVMeNamespace {
+	ns := &NVMeNamespace{}
+
+	if nsResponse.UUID != nil {
+		ns.UUID = *nsResponse.UUID
+	}
+	if nsResponse.Name != nil {
+		ns.Name = *nsResponse.Name
+	}
+	if nsResponse.OsType != nil {
+		ns.OsType = *nsResponse.OsType
+	}
+	if nsResponse.Comment != nil {
+		ns.Comment = *nsResponse.Comment
+	}
+	if nsResponse.Space != nil {
+		if nsResponse.Space.Size != nil {
+			ns.Size = strconv.FormatInt(*nsResponse.Space.Size, 10)
+		}
+		if nsResponse.Space.BlockSize != nil {
+			ns.BlockSize = int(*nsResponse.Space.BlockSize)
+		}
+	}
+	if nsResponse.Status != nil {
+		if nsResponse.Status.State != nil {
+			ns.State = *nsResponse.Status.State
+		}
+		if nsResponse.Status.Mapped != nil {
+			ns.Mapped = *nsResponse.Status.Mapped
+		}
+	}
+	if nsResponse.Location != nil && nsResponse.Location.Volume != nil && nsResponse.Location.Volume.Name != nil {
+		ns.VolumeName = *nsResponse.Location.Volume.Name
+	}
+
+	return ns
+}
+
+func (d OntapAPIREST) NVMeNamespaceCreate(ctx context.Context, ns NVMeNamespace) (string, error) {
+	fields := LogFields{
+		"Method": "NVMeNamespaceCreate",
+		"Type":   "OntapAPIREST",
+		"spec":   ns,
+	}
+	Logd(ctx, d.driverName,
+		d.api.ClientConfig().DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> NVMeNamespaceCreate")
+	defer Logd(ctx, d.driverName,
+		d.api.ClientConfig().DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< NVMeNamespaceCreate")
+
+	nsUUID, err := d.api.NVMeNamespaceCreate(ctx, ns)
+	if err != nil {
+		return "", fmt.Errorf("error creating namespace %v: %v", ns.Name, err)
+	}
+
+	return nsUUID, nil
+}
+
+func (d OntapAPIREST) NVMeNamespaceSetSize(ctx context.Context, nsUUID string, newSize int64) error {
+	fields := LogFields{
+		"Method":  "NVMeNamespaceSetSize",
+		"Type":    "OntapAPIREST",
+		"UUID":    nsUUID,
+		"NewSize": newSize,
+	}
+	Logd(ctx, d.driverName,
+		d.api.ClientConfig().DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> NVMeNamespaceSetSize")
+	defer Logd(ctx, d.driverName,
+		d.api.ClientConfig().DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< NVMeNamespaceSetSize")
+
+	return d.api.NVMeNamespaceSetSize(ctx, nsUUID, newSize)
+}
+
+func (d OntapAPIREST) NVMeNamespaceGetByName(ctx context.Context, name string) (*NVMeNamespace, error) {
+	nsResponse, err := d.api.NVMeNamespaceGetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if nsResponse == nil {
+		return nil, NotFoundError(fmt.Sprintf("namespace %s not found", name))
+	}
+
+	return nvmeNamespaceFromRestAttrsHelper(nsResponse), nil
+}
+
+func (d OntapAPIREST) NVMeNamespaceList(ctx context.Context, pattern string) (NVMeNamespaces, error) {
+	nsResponse, err := d.api.NVMeNamespaceList(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := NVMeNamespaces{}
+
+	if nsResponse != nil && nsResponse.Payload != nil {
+		for _, ns := range nsResponse.Payload.NvmeNamespaceResponseInlineRecords {
+			if ns != nil {
+				namespaces = append(namespaces, *nvmeNamespaceFromRestAttrsHelper(ns))
+			}
+		}
+	}
+
+	return namespaces, nil
+}
+
+// NVMeSubsystemCreate returns the named subsystem, creating it if it does not already exist
+func (d OntapAPIREST) NVMeSubsystemCreate(ctx context.Context, subsystemName string) (*NVMeSubsystem, error) {
+	subsystem, err := d.api.NVMeSubsystemGetByName(ctx, subsystemName)
+	if err != nil {
+		return nil, fmt.Errorf("error checking for subsystem %s; %v", subsystemName, err)
+	}
+
+	if subsystem == nil {
+		Logc(ctx).WithField("subsystem", subsystemName).Debug("Subsystem does not exist, creating it.")
+		if subsystem, err = d.api.NVMeSubsystemCreate(ctx, subsystemName); err != nil {
+			return nil, fmt.Errorf("error creating subsystem %s; %v", subsystemName, err)
+		}
+	}
+
+	if subsystem == nil || subsystem.UUID == nil || subsystem.TargetNqn == nil {
+		return nil, fmt.Errorf("unexpected response for subsystem %s", subsystemName)
+	}
+
+	return &NVMeSubsystem{
+		UUID: *subsystem.UUID,
+		Name: subsystemName,
+		NQN:  *subsystem.TargetNqn,
+	}, nil
+}
+
+func (d OntapAPIREST) NVMeSubsystemDelete(ctx context.Context, subsystemUUID string) error {
+	return d.api.NVMeSubsystemDelete(ctx, subsystemUUID)
+}
+
+// NVMeAddHostToSubsystem grants a host access to a subsystem, if it does not have access already
+func (d OntapAPIREST) NVMeAddHostToSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error {
+	hostNQNs, err := d.api.NVMeGetHostsOfSubsystem(ctx, subsystemUUID)
+	if err != nil {
+		return fmt.Errorf("error reading hosts of subsystem; %v", err)
+	}
+	if utils.SliceContainsString(hostNQNs, hostNQN) {
+		return nil
+	}
+
+	return d.api.NVMeAddHostNqnToSubsystem(ctx, hostNQN, subsystemUUID)
+}
+
+// NVMeRemoveHostFromSubsystem revokes a host's access to a subsystem, if it has access
+func (d OntapAPIREST) NVMeRemoveHostFromSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error {
+	hostNQNs, err := d.api.NVMeGetHostsOfSubsystem(ctx, subsystemUUID)
+	if err != nil {
+		return fmt.Errorf("error reading hosts of subsystem; %v", err)
+	}
+	if !utils.SliceContainsString(hostNQNs, hostNQN) {
+		return nil
+	}
+
+	return d.api.NVMeRemoveHostFromSubsystem(ctx, hostNQN, subsystemUUID)
+}
+
+func (d OntapAPIREST) NVMeGetHostsOfSubsystem(ctx context.Context, subsystemUUID string) ([]string, error) {
+	return d.api.NVMeGetHostsOfSubsystem(ctx, subsystemUUID)
+}
+
+// NVMeEnsureNamespaceMapped maps a namespace to a subsystem, if it is not mapped already
+func (d OntapAPIREST) NVMeEnsureNamespaceMapped(ctx context.Context, subsystemUUID, nsUUID string) error {
+	mapped, err := d.NVMeIsNamespaceMapped(ctx, subsystemUUID, nsUUID)
+	if err != nil {
+		return err
+	}
+	if mapped {
+		return nil
+	}
+
+	return d.api.NVMeSubsystemAddNamespace(ctx, subsystemUUID, nsUUID)
+}
+
+// NVMeEnsureNamespaceUnmapped unmaps a namespace from a subsystem, if it is mapped
+func (d OntapAPIREST) NVMeEnsureNamespaceUnmapped(ctx context.Context, subsystemUUID, nsUUID string) error {
+	mapped, err := d.NVMeIsNamespaceMapped(ctx, subsystemUUID, nsUUID)
+	if err != nil {
+		return err
+	}
+	if !mapped {
+		return nil
+	}
+
+	return d.api.NVMeSubsystemRemoveNamespace(ctx, subsystemUUID, nsUUID)
+}
+
+func (d OntapAPIREST) NVMeIsNamespaceMapped(ctx context.Context, subsystemUUID, nsUUID string) (bool, error) {
+	result, err := d.api.NVMeSubsystemMapList(ctx, subsystemUUID, nsUUID)
+	if err != nil {
+		return false, fmt.Errorf("error reading namespace maps; %v", err)
+	}
+	if result == nil || result.Payload == nil || result.Payload.NumRecords == nil {
+		return false, nil
+	}
+
+	return *result.Payload.NumRecords > 0, nil
+}
+
+// NVMeNamespaceGetSubsystems returns the UUIDs of all subsystems to which a namespace is mapped
+func (d OntapAPIREST) NVMeNamespaceGetSubsystems(ctx context.Context, nsUUID string) ([]string, error) {
+	result, err := d.api.NVMeSubsystemMapList(ctx, "", nsUUID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading namespace maps; %v", err)
+	}
+
+	subsystemUUIDs := make([]string, 0)
+	if result == nil || result.Payload == nil {
+		return subsystemUUIDs, nil
+	}
+	for _, nsMap := range result.Payload.NvmeSubsystemMapResponseInlineRecords {
+		if nsMap != nil && nsMap.Subsystem != nil && nsMap.Subsystem.UUID != nil {
+			subsystemUUIDs = append(subsystemUUIDs, *nsMap.Subsystem.UUID)
+		}
+	}
+
+	return subsystemUUIDs, nil
+}
+
 func (d OntapAPIREST) IscsiInitiatorGetDefaultAuth(ctx context.Context) (IscsiInitiatorAuth, error) {
 	authInfo := IscsiInitiatorAuth{}
 	response, err := d.api.IscsiInitiatorGetDefaultAuth(ctx)
@@ -2283,6 +2842,48 @@ func (d OntapAPIREST) IscsiNodeGetNameRequest(ctx context.Context) (string, erro
 	return *result.Payload.Target.Name, nil
 }
 
+// FcpInterfaceGet returns the WWPNs of the SVM's enabled FC data interfaces that are up.
+func (d OntapAPIREST) FcpInterfaceGet(ctx context.Context, svm string) ([]string, error) {
+	var wwpns []string
+	interfaceResponse, err := d.api.FcpInterfaceGet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get SVM FC interfaces: %v", err)
+	}
+	if interfaceResponse == nil || interfaceResponse.Payload == nil {
+		return nil, nil
+	}
+	for _, record := range interfaceResponse.Payload.FcInterfaceResponseInlineRecords {
+		if record.Enabled != nil && *record.Enabled && record.State != nil &&
+			*record.State == models.FcInterfaceStateUp && record.Wwpn != nil {
+			wwpns = append(wwpns, *record.Wwpn)
+		}
+	}
+
+	if len(wwpns) == 0 {
+		return nil, fmt.Errorf("SVM %s has no active FC interfaces", svm)
+	}
+
+	return wwpns, nil
+}
+
+// FcpNodeGetNameRequest returns the target WWNN of the SVM's FCP service.
+func (d OntapAPIREST) FcpNodeGetNameRequest(ctx context.Context) (string, error) {
+	result, err := d.api.FcpNodeGetName(ctx)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || result.Payload == nil {
+		return "", fmt.Errorf("FCP service response is empty")
+	}
+	if result.Payload.Enabled != nil && !*result.Payload.Enabled {
+		return "", fmt.Errorf("FCP service is not enabled")
+	}
+	if result.Payload.Target == nil || result.Payload.Target.Name == nil {
+		return "", fmt.Errorf("could not get FCP target name")
+	}
+	return *result.Payload.Target.Name, nil
+}
+
 func (d OntapAPIREST) IgroupCreate(ctx context.Context, initiatorGroupName, initiatorGroupType, osType string) error {
 	fields := LogFields{
 		"Method":             "IgroupCreate",
@@ -14,6 +14,7 @@ import (
 	mockapi "github.com/netapp/trident/mocks/mock_storage_drivers/mock_ontap"
 	"github.com/netapp/trident/storage_drivers/ontap/api"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/s_a_n"
+	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/storage"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/models"
 	"github.com/netapp/trident/utils"
 )
@@ -226,3 +227,222 @@ func TestOntapAPIREST_LunGetFSType_Failure(t *testing.T) {
 	assert.Empty(t, fstype)
 	assert.Error(t, err)
 }
+
+func TestOntapAPIREST_VolumeListMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mockapi.NewMockRestClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIRESTFromRestClientInterface(mock)
+	assert.NoError(t, err)
+
+	volumes := &storage.VolumeCollectionGetOK{
+		Payload: &models.VolumeResponse{
+			VolumeResponseInlineRecords: []*models.Volume{
+				{
+					Name:  utils.Ptr("trident_vol1"),
+					Space: &models.VolumeInlineSpace{Used: utils.Ptr(int64(100)), Size: utils.Ptr(int64(1000))},
+					Metric: &models.VolumeInlineMetric{
+						Iops:       &models.VolumeInlineMetricInlineIops{Read: utils.Ptr(int64(20))},
+						Throughput: &models.VolumeInlineMetricInlineThroughput{Write: utils.Ptr(int64(4096))},
+						Latency: &models.VolumeInlineMetricInlineLatency{
+							Read:  utils.Ptr(int64(300)),
+							Write: utils.Ptr(int64(500)),
+						},
+					},
+				},
+				{Name: utils.Ptr("trident_vol2")},
+				{},
+			},
+		},
+	}
+	mock.EXPECT().VolumeListMetrics(ctx, "trident_*").Return(volumes, nil)
+
+	metrics, err := oapi.VolumeListMetrics(ctx, "trident_*")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*api.Metrics{
+		"trident_vol1": {
+			Name:            "trident_vol1",
+			UsedBytes:       100,
+			TotalBytes:      1000,
+			ReadIOPS:        20,
+			WriteThroughput: 4096,
+			ReadLatency:     300,
+			WriteLatency:    500,
+		},
+		"trident_vol2": {Name: "trident_vol2"},
+	}, metrics)
+
+	mock.EXPECT().VolumeListMetrics(ctx, "trident_*").Return(nil, errors.New("failed"))
+	_, err = oapi.VolumeListMetrics(ctx, "trident_*")
+	assert.Error(t, err)
+}
+
+func TestOntapAPIREST_LunListMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mockapi.NewMockRestClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIRESTFromRestClientInterface(mock)
+	assert.NoError(t, err)
+
+	luns := &s_a_n.LunCollectionGetOK{
+		Payload: &models.LunResponse{
+			LunResponseInlineRecords: []*models.Lun{
+				{
+					Name:  utils.Ptr("/vol/trident_vol1/lun0"),
+					Space: &models.LunInlineSpace{Used: utils.Ptr(int64(100)), Size: utils.Ptr(int64(1000))},
+					Metric: &models.LunInlineMetric{
+						Iops:       &models.LunInlineMetricInlineIops{Write: utils.Ptr(int64(10))},
+						Throughput: &models.LunInlineMetricInlineThroughput{Read: utils.Ptr(int64(8192))},
+					},
+				},
+			},
+		},
+	}
+	mock.EXPECT().LunListMetrics(ctx, "/vol/trident_*/*").Return(luns, nil)
+
+	metrics, err := oapi.LunListMetrics(ctx, "/vol/trident_*/*")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*api.Metrics{
+		"/vol/trident_vol1/lun0": {
+			Name:           "/vol/trident_vol1/lun0",
+			UsedBytes:      100,
+			TotalBytes:     1000,
+			WriteIOPS:      10,
+			ReadThroughput: 8192,
+		},
+	}, metrics)
+
+	mock.EXPECT().LunListMetrics(ctx, "/vol/trident_*/*").Return(nil, errors.New("failed"))
+	_, err = oapi.LunListMetrics(ctx, "/vol/trident_*/*")
+	assert.Error(t, err)
+}
+
+func TestOntapAPIREST_QtreeCloneFromSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rsi := mockapi.NewMockRestClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIRESTFromRestClientInterface(rsi)
+	assert.NoError(t, err)
+
+	fileInfo := func(name, fileType string) *models.FileInfo {
+		return &models.FileInfo{
+			Name: utils.Ptr(name), Type: utils.Ptr(fileType), UnixPermissions: utils.Ptr(int64(755)),
+			OwnerID: utils.Ptr(int64(1000)), GroupID: utils.Ptr(int64(100)), HardLinksCount: utils.Ptr(int64(1)),
+		}
+	}
+
+	// Files with more than one hard link are cloned like any other
+	hardLink := fileInfo("file1", models.FileInfoTypeFile)
+	hardLink.HardLinksCount = utils.Ptr(int64(2))
+
+	rsi.EXPECT().FileList(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1").Return([]*models.FileInfo{
+		fileInfo(".", models.FileInfoTypeDirectory),
+		fileInfo("..", models.FileInfoTypeDirectory),
+		fileInfo("dir", models.FileInfoTypeDirectory),
+		hardLink,
+		{
+			Name: utils.Ptr("link"), Type: utils.Ptr(models.FileInfoTypeSymlink), Target: utils.Ptr("file1"),
+			OwnerID: utils.Ptr(int64(1000)), GroupID: utils.Ptr(int64(100)),
+		},
+	}, nil)
+	rsi.EXPECT().FileList(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1/dir").Return([]*models.FileInfo{
+		fileInfo("file2", models.FileInfoTypeFile),
+	}, nil)
+	gomock.InOrder(
+		rsi.EXPECT().FileCreate(ctx, "flexvol1", "qtree2/dir", &models.FileInfo{
+			Type: utils.Ptr(models.FileInfoTypeDirectory), UnixPermissions: utils.Ptr(int64(755)),
+			OwnerID: utils.Ptr(int64(1000)), GroupID: utils.Ptr(int64(100)),
+		}).Return(nil),
+		rsi.EXPECT().FileClone(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1/dir/file2",
+			"qtree2/dir/file2").Return(nil),
+		rsi.EXPECT().FileClone(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1/file1",
+			"qtree2/file1").Return(nil),
+		rsi.EXPECT().FileCreate(ctx, "flexvol1", "qtree2/link", &models.FileInfo{
+			Type: utils.Ptr(models.FileInfoTypeSymlink), Target: utils.Ptr("file1"),
+			OwnerID: utils.Ptr(int64(1000)), GroupID: utils.Ptr(int64(100)),
+		}).Return(nil),
+	)
+
+	err = oapi.QtreeCloneFromSnapshot(ctx, "qtree1__snap-1", "flexvol1", "qtree1", "qtree2")
+	assert.NoError(t, err)
+
+	// Special files fail the clone before anything is created
+	for _, fileType := range []string{
+		models.FileInfoTypeFifo, models.FileInfoTypeSocket, models.FileInfoTypeBlockdev, models.FileInfoTypeChardev,
+		models.FileInfoTypeLun,
+	} {
+		rsi.EXPECT().FileList(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1").Return([]*models.FileInfo{
+			fileInfo("file1", models.FileInfoTypeFile),
+			fileInfo("special", fileType),
+		}, nil)
+
+		err = oapi.QtreeCloneFromSnapshot(ctx, "qtree1__snap-1", "flexvol1", "qtree1", "qtree2")
+		assert.Error(t, err, "expected error cloning a file of type %s", fileType)
+	}
+
+	// A file that cannot be cloned fails the clone
+	rsi.EXPECT().FileList(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1").Return([]*models.FileInfo{
+		fileInfo("file1", models.FileInfoTypeFile),
+	}, nil)
+	rsi.EXPECT().FileClone(ctx, "flexvol1", ".snapshot/qtree1__snap-1/qtree1/file1", "qtree2/file1").
+		Return(errors.New("failed"))
+
+	err = oapi.QtreeCloneFromSnapshot(ctx, "qtree1__snap-1", "flexvol1", "qtree1", "qtree2")
+	assert.Error(t, err)
+}
+
+func TestOntapAPIREST_VolumeGroupSnapshotCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rsi := mockapi.NewMockRestClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIRESTFromRestClientInterface(rsi)
+	assert.NoError(t, err)
+
+	volumes := []string{"vol1", "vol2"}
+
+	// The temporary consistency group is deleted again, retrying if necessary
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "group", volumes).Return("cg-uuid", nil)
+	rsi.EXPECT().ConsistencyGroupSnapshotCreateAndWait(ctx, "cg-uuid", "group").Return(nil)
+	gomock.InOrder(
+		rsi.EXPECT().ConsistencyGroupDeleteAndWait(ctx, "cg-uuid").Return(errors.New("busy")),
+		rsi.EXPECT().ConsistencyGroupDeleteAndWait(ctx, "cg-uuid").Return(nil),
+	)
+
+	err = oapi.VolumeGroupSnapshotCreate(ctx, "group", volumes)
+	assert.NoError(t, err)
+
+	// The consistency group is deleted if the snapshot fails
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "group", volumes).Return("cg-uuid", nil)
+	rsi.EXPECT().ConsistencyGroupSnapshotCreateAndWait(ctx, "cg-uuid", "group").Return(errors.New("failed"))
+	rsi.EXPECT().ConsistencyGroupDeleteAndWait(ctx, "cg-uuid").Return(nil)
+
+	err = oapi.VolumeGroupSnapshotCreate(ctx, "group", volumes)
+	assert.Error(t, err)
+}
+
+func TestOntapAPIREST_FlexgroupConstituentCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mockapi.NewMockRestClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIRESTFromRestClientInterface(mock)
+	assert.NoError(t, err)
+
+	mock.EXPECT().FlexGroupConstituentCount(ctx, "svm1", "fg1").Return(8, nil)
+	count, err := oapi.FlexgroupConstituentCount(ctx, "svm1", "fg1")
+	assert.NoError(t, err)
+	assert.Equal(t, 8, count)
+
+	mock.EXPECT().FlexGroupConstituentCount(ctx, "svm2", "fg1").Return(0, nil)
+	_, err = oapi.FlexgroupConstituentCount(ctx, "svm2", "fg1")
+	assert.True(t, api.IsNotFoundError(err))
+
+	mock.EXPECT().FlexGroupConstituentCount(ctx, "svm1", "fg1").Return(0, errors.New("failed"))
+	_, err = oapi.FlexgroupConstituentCount(ctx, "svm1", "fg1")
+	assert.Error(t, err)
+	assert.False(t, api.IsNotFoundError(err))
+}
@@ -611,6 +611,10 @@ func (d OntapAPIZAPI) LunListIgroupsMapped(ctx context.Context, lunPath string)
 	return results, nil
 }
 
+func (d OntapAPIZAPI) LunListMetrics(_ context.Context, _ string) (map[string]*Metrics, error) {
+	return nil, errMetricsRequireREST
+}
+
 // IgroupListLUNsMapped returns a list of LUNs currently mapped to the given Igroup.
 func (d OntapAPIZAPI) IgroupListLUNsMapped(ctx context.Context, initiatorGroupName string) ([]string, error) {
 	var results []string
@@ -674,6 +678,61 @@ func (d OntapAPIZAPI) LunUnmap(ctx context.Context, initiatorGroupName, lunPath
 	return nil
 }
 
+// errNVMeRequiresREST is returned by all NVMe operations, which Trident supports only via the ONTAP REST API
+var errNVMeRequiresREST = utils.UnsupportedError("NVMe is only supported with the ONTAP REST API")
+
+func (d OntapAPIZAPI) NVMeNamespaceCreate(_ context.Context, _ NVMeNamespace) (string, error) {
+	return "", errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeNamespaceSetSize(_ context.Context, _ string, _ int64) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeNamespaceGetByName(_ context.Context, _ string) (*NVMeNamespace, error) {
+	return nil, errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeNamespaceList(_ context.Context, _ string) (NVMeNamespaces, error) {
+	return nil, errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeSubsystemCreate(_ context.Context, _ string) (*NVMeSubsystem, error) {
+	return nil, errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeSubsystemDelete(_ context.Context, _ string) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeAddHostToSubsystem(_ context.Context, _, _ string) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeRemoveHostFromSubsystem(_ context.Context, _, _ string) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeGetHostsOfSubsystem(_ context.Context, _ string) ([]string, error) {
+	return nil, errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeEnsureNamespaceMapped(_ context.Context, _, _ string) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeEnsureNamespaceUnmapped(_ context.Context, _, _ string) error {
+	return errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeIsNamespaceMapped(_ context.Context, _, _ string) (bool, error) {
+	return false, errNVMeRequiresREST
+}
+
+func (d OntapAPIZAPI) NVMeNamespaceGetSubsystems(_ context.Context, _ string) ([]string, error) {
+	return nil, errNVMeRequiresREST
+}
+
 func (d OntapAPIZAPI) IscsiInitiatorGetDefaultAuth(ctx context.Context) (IscsiInitiatorAuth, error) {
 	authInfo := IscsiInitiatorAuth{}
 	apiResponse, err := d.api.IscsiInitiatorGetDefaultAuth()
@@ -740,6 +799,17 @@ func (d OntapAPIZAPI) IscsiNodeGetNameRequest(ctx context.Context) (string, erro
 	return nodeNameResponse.Result.NodeName(), nil
 }
 
+// errFCPRequiresREST is returned by all FCP operations, which Trident supports only via the ONTAP REST API
+var errFCPRequiresREST = utils.UnsupportedError("FCP is only supported with the ONTAP REST API")
+
+func (d OntapAPIZAPI) FcpInterfaceGet(_ context.Context, _ string) ([]string, error) {
+	return nil, errFCPRequiresREST
+}
+
+func (d OntapAPIZAPI) FcpNodeGetNameRequest(_ context.Context) (string, error) {
+	return "", errFCPRequiresREST
+}
+
 func (d OntapAPIZAPI) IgroupCreate(ctx context.Context, initiatorGroupName, initiatorGroupType, osType string) error {
 	response, err := d.api.IgroupCreate(initiatorGroupName, initiatorGroupType, osType)
 	err = azgo.GetError(ctx, response, err)
@@ -1088,7 +1158,8 @@ func (d OntapAPIZAPI) FlexgroupCreate(ctx context.Context, volume Volume) error
 	flexgroupCreateResponse, err := d.api.FlexGroupCreate(ctx, volume.Name, int(sizeBytes), volume.Aggregates,
 		volume.SpaceReserve,
 		volume.SnapshotPolicy, volume.UnixPermissions, volume.ExportPolicy, volume.SecurityStyle, volume.TieringPolicy,
-		volume.Comment, volume.Qos, volume.Encrypt, volume.SnapshotReserve)
+		volume.Comment, volume.Qos, volume.Encrypt, volume.SnapshotReserve, volume.ConstituentsPerAggregate,
+		volume.DPVolume)
 	if err != nil {
 		return fmt.Errorf("error creating volume: %v", err)
 	}
@@ -1158,6 +1229,18 @@ func (d OntapAPIZAPI) FlexgroupInfo(ctx context.Context, volumeName string) (*Vo
 	return flexgroupInfo, nil
 }
 
+func (d OntapAPIZAPI) FlexgroupConstituentCount(ctx context.Context, svmName, volumeName string) (int, error) {
+	response, err := d.api.FlexGroupConstituentList(svmName, volumeName)
+	if err = azgo.GetError(ctx, response, err); err != nil {
+		return 0, fmt.Errorf("error listing constituents of FlexGroup %s:%s; %v", svmName, volumeName, err)
+	}
+	if response.Result.NumRecords() == 0 {
+		return 0, NotFoundError(fmt.Sprintf("FlexGroup %s:%s has no constituents", svmName, volumeName))
+	}
+
+	return response.Result.NumRecords(), nil
+}
+
 func (d OntapAPIZAPI) FlexgroupSetQosPolicyGroupName(ctx context.Context, name string, qos QosPolicyGroup) error {
 	return d.VolumeSetQosPolicyGroupName(ctx, name, qos)
 }
@@ -1435,6 +1518,33 @@ func (d OntapAPIZAPI) VolumeDisableSnapshotDirectoryAccess(ctx context.Context,
 	return nil
 }
 
+func (d OntapAPIZAPI) VolumeModifySnapshotDirectoryAccess(ctx context.Context, name string, enable bool) error {
+	snapDirResponse, err := d.api.VolumeModifySnapshotDirectoryAccess(name, enable)
+	if err = azgo.GetError(ctx, snapDirResponse, err); err != nil {
+		return fmt.Errorf("error modifying snapshot directory access: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIZAPI) VolumeModifySnapshotPolicy(ctx context.Context, name, snapshotPolicy string) error {
+	snapPolicyResponse, err := d.api.VolumeModifySnapshotPolicy(name, snapshotPolicy)
+	if err = azgo.GetError(ctx, snapPolicyResponse, err); err != nil {
+		return fmt.Errorf("error modifying snapshot policy: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIZAPI) VolumeModifyTieringPolicy(ctx context.Context, name, tieringPolicy string) error {
+	tieringPolicyResponse, err := d.api.VolumeModifyTieringPolicy(name, tieringPolicy)
+	if err = azgo.GetError(ctx, tieringPolicyResponse, err); err != nil {
+		return fmt.Errorf("error modifying tiering policy: %v", err)
+	}
+
+	return nil
+}
+
 func (d OntapAPIZAPI) VolumeMount(ctx context.Context, name, junctionPath string) error {
 	mountResponse, err := d.api.VolumeMount(name, junctionPath)
 	if err = azgo.GetError(ctx, mountResponse, err); err != nil {
@@ -1449,6 +1559,24 @@ func (d OntapAPIZAPI) VolumeMount(ctx context.Context, name, junctionPath string
 	return nil
 }
 
+func (d OntapAPIZAPI) VolumeUnmount(ctx context.Context, name string, force bool) error {
+	// This call is sync and idempotent
+	umountResp, err := d.api.VolumeUnmount(name, force)
+	if err != nil {
+		return fmt.Errorf("error unmounting volume %v: %v", name, err)
+	}
+
+	if zerr := azgo.NewZapiError(umountResp); !zerr.IsPassed() {
+		if zerr.Code() == azgo.EOBJECTNOTFOUND {
+			Logc(ctx).WithField("volume", name).Warn("Volume does not exist.")
+			return nil
+		}
+		return fmt.Errorf("error unmounting volume %v: %v", name, zerr)
+	}
+
+	return nil
+}
+
 func (d OntapAPIZAPI) VolumeRename(ctx context.Context, originalName, newName string) error {
 	renameResponse, err := d.api.VolumeRename(originalName, newName)
 	if err = azgo.GetError(ctx, renameResponse, err); err != nil {
@@ -1551,6 +1679,26 @@ func (d OntapAPIZAPI) VolumeListByPrefix(ctx context.Context, prefix string) (Vo
 	return volumes, nil
 }
 
+// errMetricsRequireREST is returned when volume metrics are requested, which Trident collects only via the ONTAP
+// REST API
+var errMetricsRequireREST = utils.UnsupportedError("volume metrics are only supported with the ONTAP REST API")
+
+func (d OntapAPIZAPI) VolumeListMetrics(_ context.Context, _ string) (map[string]*Metrics, error) {
+	return nil, errMetricsRequireREST
+}
+
+// errVolumeMoveRequiresREST is returned when a volume move is requested, which Trident follows only via the ONTAP
+// REST API
+var errVolumeMoveRequiresREST = utils.UnsupportedError("volume moves are only supported with the ONTAP REST API")
+
+func (d OntapAPIZAPI) VolumeMoveStart(_ context.Context, _, _ string) error {
+	return errVolumeMoveRequiresREST
+}
+
+func (d OntapAPIZAPI) VolumeMoveInfo(_ context.Context, _ string) (*VolumeMove, error) {
+	return nil, errVolumeMoveRequiresREST
+}
+
 func (d OntapAPIZAPI) VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume) (Volumes, error) {
 	aggrs := strings.Join(volumeAttrs.Aggregates, "|")
 	response, err := d.api.VolumeListByAttrs(volumeAttrs.Name, aggrs, volumeAttrs.SpaceReserve,
@@ -1573,15 +1721,18 @@ func (d OntapAPIZAPI) VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume
 	return volumes, nil
 }
 
-func (d OntapAPIZAPI) ExportRuleCreate(ctx context.Context, policyName, desiredPolicyRule, nasProtocol string) error {
+func (d OntapAPIZAPI) ExportRuleCreate(
+	ctx context.Context, policyName, desiredPolicyRule, nasProtocol string, securityFlavors []string,
+) error {
 	var ruleResponse *azgo.ExportRuleCreateResponse
 	var err error
+	flavors := exportRuleSecurityFlavors(securityFlavors)
 	if nasProtocol == sa.SMB {
 		ruleResponse, err = d.api.ExportRuleCreate(policyName, desiredPolicyRule,
-			[]string{"cifs"}, []string{"any"}, []string{"any"}, []string{"any"})
+			[]string{"cifs"}, flavors, flavors, flavors)
 	} else {
 		ruleResponse, err = d.api.ExportRuleCreate(policyName, desiredPolicyRule,
-			[]string{"nfs"}, []string{"any"}, []string{"any"}, []string{"any"})
+			[]string{"nfs"}, flavors, flavors, flavors)
 	}
 	if err = azgo.GetError(ctx, ruleResponse, err); err != nil {
 		err = fmt.Errorf("error creating export rule: %v", err)
@@ -1650,18 +1801,24 @@ func (d OntapAPIZAPI) ExportPolicyExists(ctx context.Context, policyName string)
 	return true, nil
 }
 
-func (d OntapAPIZAPI) ExportRuleList(ctx context.Context, policyName string) (map[string]int, error) {
+func (d OntapAPIZAPI) ExportRuleList(ctx context.Context, policyName string) (map[string]ExportRule, error) {
 	ruleListResponse, err := d.api.ExportRuleGetIterRequest(policyName)
 	if err = azgo.GetError(ctx, ruleListResponse, err); err != nil {
 		return nil, fmt.Errorf("error listing export policy rules: %v", err)
 	}
-	rules := make(map[string]int)
+	rules := make(map[string]ExportRule)
 
 	if ruleListResponse.Result.NumRecords() > 0 {
 		rulesAttrList := ruleListResponse.Result.AttributesList()
 		exportRuleList := rulesAttrList.ExportRuleInfo()
 		for _, rule := range exportRuleList {
-			rules[rule.ClientMatch()] = rule.RuleIndex()
+			roRule, rwRule, superUser := rule.RoRule(), rule.RwRule(), rule.SuperUserSecurity()
+			rules[rule.ClientMatch()] = ExportRule{
+				Index:     rule.RuleIndex(),
+				RoRule:    roRule.SecurityFlavor(),
+				RwRule:    rwRule.SecurityFlavor(),
+				SuperUser: superUser.SecurityFlavor(),
+			}
 		}
 	}
 
@@ -1872,6 +2029,11 @@ func (d OntapAPIZAPI) VolumeSnapshotCreate(ctx context.Context, snapshotName, so
 	return nil
 }
 
+// VolumeGroupSnapshotCreate is not supported, as consistency groups are only available via the ONTAP REST API
+func (d OntapAPIZAPI) VolumeGroupSnapshotCreate(_ context.Context, _ string, _ []string) error {
+	return utils.UnsupportedError("group snapshots are only supported with the ONTAP REST API")
+}
+
 // probeForVolume polls for the ONTAP volume to appear, with backoff retry logic
 func (d OntapAPIZAPI) probeForVolume(ctx context.Context, name string) error {
 	checkVolumeExists := func() error {
@@ -1997,6 +2159,10 @@ func (d OntapAPIZAPI) SnapshotRestoreFlexgroup(ctx context.Context, snapshotName
 	return d.SnapshotRestoreVolume(ctx, snapshotName, sourceVolume)
 }
 
+func (d OntapAPIZAPI) QtreeCloneFromSnapshot(_ context.Context, _, _, _, _ string) error {
+	return utils.UnsupportedError("cloning a qtree from a snapshot is only supported with the ONTAP REST API")
+}
+
 func (d OntapAPIZAPI) VolumeSnapshotDelete(_ context.Context, snapshotName, sourceVolume string) error {
 	snapResponse, err := d.api.SnapshotDelete(snapshotName, sourceVolume)
 	if err != nil {
@@ -2194,6 +2360,29 @@ func (d OntapAPIZAPI) SnapmirrorInitialize(
 	return nil
 }
 
+func (d OntapAPIZAPI) SnapmirrorUpdate(
+	ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName,
+	snapshotName string,
+) error {
+	_, err := d.api.SnapmirrorUpdate(localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName,
+		snapshotName)
+	if err != nil {
+		if zerr, ok := err.(azgo.ZapiError); ok {
+			if !zerr.IsPassed() {
+				if zerr.Code() == azgo.ETRANSFERINPROGRESS {
+					Logc(ctx).Debug("snapmirror transfer already in progress")
+				} else {
+					Logc(ctx).WithError(err).Error("Error on snapmirror update")
+					return err
+				}
+			}
+		} else {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d OntapAPIZAPI) SnapmirrorDelete(
 	ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName,
 	remoteSVMName string,
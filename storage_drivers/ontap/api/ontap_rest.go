@@ -29,8 +29,10 @@ import (
 	. "github.com/netapp/trident/logging"
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client"
+	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/application"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/cluster"
 	nas "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_a_s"
+	nvme "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_v_me"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/networking"
 	san "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/s_a_n"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/snapmirror"
@@ -860,6 +862,38 @@ func (c RestClient) modifyVolumeUnixPermissionsByNameAndStyle(
 	return c.PollJobStatus(ctx, volumeModifyAccepted.Payload)
 }
 
+// modifyVolumeByNameAndStyle applies the attributes set in volumeInfo to a volume
+func (c RestClient) modifyVolumeByNameAndStyle(
+	ctx context.Context, volumeName, style string, volumeInfo *models.Volume,
+) error {
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, style)
+	if err != nil {
+		return err
+	}
+	if volume == nil {
+		return fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+	if volume.UUID == nil {
+		return fmt.Errorf("could not find volume uuid with name %v", volumeName)
+	}
+
+	params := storage.NewVolumeModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = *volume.UUID
+	params.SetInfo(volumeInfo)
+
+	volumeModifyAccepted, err := c.api.Storage.VolumeModify(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if volumeModifyAccepted == nil {
+		return fmt.Errorf("unexpected response from volume modify")
+	}
+
+	return c.PollJobStatus(ctx, volumeModifyAccepted.Payload)
+}
+
 // setVolumeCommentByNameAndStyle sets a volume's comment to the supplied value
 // equivalent to filer::> volume modify -vserver iscsi_vs -volume v -comment newVolumeComment
 func (c RestClient) setVolumeCommentByNameAndStyle(
@@ -1136,7 +1170,7 @@ func (c RestClient) listAllVolumeNamesBackedBySnapshot(ctx context.Context, volu
 // equivalent to filer::> volume create -vserver iscsi_vs -volume v -aggregate aggr1 -size 1g -state online -type RW
 // -policy default -unix-permissions ---rwxr-xr-x -space-guarantee none -snapshot-policy none -security-style unix
 // -encrypt false
-func (c RestClient) createVolumeByStyle(ctx context.Context, name string, sizeInBytes int64, aggrs []string, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve int, style string, dpVolume bool) error {
+func (c RestClient) createVolumeByStyle(ctx context.Context, name string, sizeInBytes int64, aggrs []string, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve, constituentsPerAggregate int, style string, dpVolume bool) error {
 	params := storage.NewVolumeCreateParamsWithTimeout(c.httpClient.Timeout)
 	params.Context = ctx
 	params.HTTPClient = c.httpClient
@@ -1156,6 +1190,11 @@ func (c RestClient) createVolumeByStyle(ctx context.Context, name string, sizeIn
 		volumeInfo.VolumeInlineAggregates = volumeInfoAggregates
 	}
 
+	// Leave the constituent count to ONTAP unless one was requested
+	if constituentsPerAggregate > 0 {
+		volumeInfo.ConstituentsPerAggregate = utils.Ptr(int64(constituentsPerAggregate))
+	}
+
 	if snapshotReserve != NumericalValueNotSet {
 		volumeInfo.Space = &models.VolumeInlineSpace{
 			Snapshot: &models.VolumeInlineSpaceInlineSnapshot{
@@ -1232,6 +1271,33 @@ func (c RestClient) VolumeList(ctx context.Context, pattern string) (*storage.Vo
 	return c.getAllVolumesByPatternStyleAndState(ctx, pattern, models.VolumeStyleFlexvol, models.VolumeStateOnline)
 }
 
+// VolumeListMetrics returns the space used and the performance metrics of all volumes of any style or state
+// whose names match the supplied pattern
+func (c RestClient) VolumeListMetrics(ctx context.Context, pattern string) (*storage.VolumeCollectionGetOK, error) {
+	params := storage.NewVolumeCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = &c.svmUUID
+	params.SetName(utils.Ptr(pattern))
+	params.SetFields([]string{"name", "space.size", "space.used", "metric"})
+
+	result, err := c.api.Storage.VolumeCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	result.Payload, err = c.getAllVolumePayloadRecords(result.Payload, params)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
 // VolumeListByAttrs is used to find bucket volumes for nas-eco and san-eco
 func (c RestClient) VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume) (Volumes, error) {
 	params := storage.NewVolumeCollectionGetParamsWithTimeout(c.httpClient.Timeout)
@@ -1352,7 +1418,7 @@ func (c RestClient) VolumeCreate(ctx context.Context, name, aggregateName, size,
 
 	return c.createVolumeByStyle(ctx, name, sizeInBytes, []string{aggregateName}, spaceReserve, snapshotPolicy,
 		unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment, qosPolicyGroup, encrypt, snapshotReserve,
-		models.VolumeStyleFlexvol, dpVolume)
+		0, models.VolumeStyleFlexvol, dpVolume)
 }
 
 // VolumeExists tests for the existence of a flexvol
@@ -1373,6 +1439,11 @@ func (c RestClient) VolumeMount(
 	return c.mountVolumeByNameAndStyle(ctx, volumeName, junctionPath, models.VolumeStyleFlexvol)
 }
 
+// VolumeUnmount unmounts a flexvol
+func (c RestClient) VolumeUnmount(ctx context.Context, volumeName string) error {
+	return c.unmountVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol)
+}
+
 // VolumeRename changes the name of a flexvol
 func (c RestClient) VolumeRename(
 	ctx context.Context,
@@ -1409,6 +1480,76 @@ func (c RestClient) VolumeModifyUnixPermissions(ctx context.Context, volumeName,
 	return c.modifyVolumeUnixPermissionsByNameAndStyle(ctx, volumeName, unixPermissions, models.VolumeStyleFlexvol)
 }
 
+// VolumeModifySnapshotPolicy sets the snapshot policy of a flexvol
+func (c RestClient) VolumeModifySnapshotPolicy(ctx context.Context, volumeName, snapshotPolicy string) error {
+	volumeInfo := &models.Volume{
+		SnapshotPolicy: &models.VolumeInlineSnapshotPolicy{Name: utils.Ptr(snapshotPolicy)},
+	}
+	return c.modifyVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, volumeInfo)
+}
+
+// VolumeModifySnapshotDirectoryAccess enables or disables access to the ".snapshot" directory of a flexvol
+func (c RestClient) VolumeModifySnapshotDirectoryAccess(ctx context.Context, volumeName string, enable bool) error {
+	volumeInfo := &models.Volume{SnapshotDirectoryAccessEnabled: utils.Ptr(enable)}
+	return c.modifyVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, volumeInfo)
+}
+
+// VolumeModifyTieringPolicy sets the FabricPool tiering policy of a flexvol
+func (c RestClient) VolumeModifyTieringPolicy(ctx context.Context, volumeName, tieringPolicy string) error {
+	volumeInfo := &models.Volume{Tiering: &models.VolumeInlineTiering{Policy: utils.Ptr(tieringPolicy)}}
+	return c.modifyVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, volumeInfo)
+}
+
+// VolumeMoveStart starts moving a flexvol to another aggregate.  The move continues in the background, so
+// its progress must be followed with VolumeMoveInfo.
+func (c RestClient) VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error {
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol)
+	if err != nil {
+		return err
+	}
+	if volume == nil {
+		return fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+	if volume.UUID == nil {
+		return fmt.Errorf("could not find volume uuid with name %v", volumeName)
+	}
+
+	params := storage.NewVolumeModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = *volume.UUID
+	params.SetInfo(&models.Volume{
+		Movement: &models.VolumeInlineMovement{
+			DestinationAggregate: &models.VolumeInlineMovementInlineDestinationAggregate{
+				Name: utils.Ptr(aggregate),
+			},
+		},
+	})
+
+	volumeModifyAccepted, err := c.api.Storage.VolumeModify(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if volumeModifyAccepted == nil {
+		return fmt.Errorf("unexpected response from volume modify")
+	}
+
+	return nil
+}
+
+// VolumeMoveInfo returns the attributes of the latest move of a flexvol, or nil if it was never moved
+func (c RestClient) VolumeMoveInfo(ctx context.Context, volumeName string) (*models.VolumeInlineMovement, error) {
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol)
+	if err != nil {
+		return nil, err
+	}
+	if volume == nil {
+		return nil, fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+
+	return volume.Movement, nil
+}
+
 // VolumeSetComment sets a flexvol's comment to the supplied value
 // equivalent to filer::> volume modify -vserver iscsi_vs -volume v -comment newVolumeComment
 func (c RestClient) VolumeSetComment(ctx context.Context, volumeName, newVolumeComment string) error {
@@ -1471,6 +1612,106 @@ func (c RestClient) SnapshotCreateAndWait(ctx context.Context, volumeUUID, snaps
 	return c.PollJobStatus(ctx, snapshotCreateResult.Payload)
 }
 
+// ConsistencyGroupCreateAndWait creates a consistency group of existing volumes, waits on the job to complete,
+// and returns the UUID of the new consistency group
+func (c RestClient) ConsistencyGroupCreateAndWait(
+	ctx context.Context, consistencyGroupName string, volumeNames []string,
+) (string, error) {
+	params := application.NewConsistencyGroupCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	volumes := make([]*models.ConsistencyGroupInlineVolumesInlineArrayItem, 0, len(volumeNames))
+	for _, volumeName := range volumeNames {
+		volumes = append(volumes, &models.ConsistencyGroupInlineVolumesInlineArrayItem{
+			Name: utils.Ptr(volumeName),
+			ProvisioningOptions: &models.ConsistencyGroupInlineVolumesInlineArrayItemInlineProvisioningOptions{
+				Action: utils.Ptr("add"),
+			},
+		})
+	}
+
+	params.SetInfo(&models.ConsistencyGroup{
+		Name:                          utils.Ptr(consistencyGroupName),
+		Svm:                           &models.ConsistencyGroupInlineSvm{UUID: utils.Ptr(c.svmUUID)},
+		ConsistencyGroupInlineVolumes: volumes,
+	})
+
+	_, consistencyGroupCreateAccepted, err := c.api.Application.ConsistencyGroupCreate(params, c.authInfo)
+	if err != nil {
+		return "", fmt.Errorf("could not create consistency group: %v", err)
+	}
+	if consistencyGroupCreateAccepted != nil {
+		if err = c.PollJobStatus(ctx, consistencyGroupCreateAccepted.Payload); err != nil {
+			return "", err
+		}
+	}
+
+	getParams := application.NewConsistencyGroupCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	getParams.Context = ctx
+	getParams.HTTPClient = c.httpClient
+	getParams.SvmUUID = utils.Ptr(c.svmUUID)
+	getParams.Name = utils.Ptr(consistencyGroupName)
+	getParams.SetFields([]string{"uuid"})
+
+	result, err := c.api.Application.ConsistencyGroupCollectionGet(getParams, c.authInfo)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || result.Payload == nil || len(result.Payload.ConsistencyGroupResponseInlineRecords) != 1 ||
+		result.Payload.ConsistencyGroupResponseInlineRecords[0].UUID == nil {
+		return "", fmt.Errorf("could not find consistency group %s", consistencyGroupName)
+	}
+
+	return *result.Payload.ConsistencyGroupResponseInlineRecords[0].UUID, nil
+}
+
+// ConsistencyGroupSnapshotCreateAndWait snapshots all volumes of a consistency group at a single point in time
+// and waits on the job to complete
+func (c RestClient) ConsistencyGroupSnapshotCreateAndWait(
+	ctx context.Context, consistencyGroupUUID, snapshotName string,
+) error {
+	params := application.NewConsistencyGroupSnapshotCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.ConsistencyGroupUUID = consistencyGroupUUID
+	params.SetInfo(&models.ConsistencyGroupSnapshot{
+		Name: utils.Ptr(snapshotName),
+	})
+
+	_, snapshotCreateAccepted, err := c.api.Application.ConsistencyGroupSnapshotCreate(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("could not create consistency group snapshot: %v", err)
+	}
+	if snapshotCreateAccepted != nil {
+		return c.PollJobStatus(ctx, snapshotCreateAccepted.Payload)
+	}
+
+	return nil
+}
+
+// ConsistencyGroupDeleteAndWait deletes a consistency group, leaving its volumes and their snapshots in place,
+// and waits on the job to complete
+func (c RestClient) ConsistencyGroupDeleteAndWait(ctx context.Context, consistencyGroupUUID string) error {
+	params := application.NewConsistencyGroupDeleteParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.UUID = consistencyGroupUUID
+	params.DeleteData = utils.Ptr(false)
+
+	_, consistencyGroupDeleteAccepted, err := c.api.Application.ConsistencyGroupDelete(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("could not delete consistency group: %v", err)
+	}
+	if consistencyGroupDeleteAccepted != nil {
+		return c.PollJobStatus(ctx, consistencyGroupDeleteAccepted.Payload)
+	}
+
+	return nil
+}
+
 // SnapshotList lists snapshots
 func (c RestClient) SnapshotList(ctx context.Context, volumeUUID string) (*storage.SnapshotCollectionGetOK, error) {
 	params := storage.NewSnapshotCollectionGetParamsWithTimeout(c.httpClient.Timeout)
@@ -1584,6 +1825,114 @@ func (c RestClient) SnapshotRestoreFlexgroup(ctx context.Context, snapshotName,
 	return c.restoreSnapshotByNameAndStyle(ctx, snapshotName, volumeName, models.VolumeStyleFlexgroup)
 }
 
+// getFlexvolUUID returns the UUID of a flexvol
+func (c RestClient) getFlexvolUUID(ctx context.Context, volumeName string) (string, error) {
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol)
+	if err != nil {
+		return "", err
+	}
+	if volume == nil {
+		return "", fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+	if volume.UUID == nil {
+		return "", fmt.Errorf("could not find volume uuid with name %v", volumeName)
+	}
+	return *volume.UUID, nil
+}
+
+// FileList returns the entries of a directory within a flexvol, which may be in one of its snapshots
+func (c RestClient) FileList(ctx context.Context, volumeName, path string) ([]*models.FileInfo, error) {
+	volumeUUID, err := c.getFlexvolUUID(ctx, volumeName)
+	if err != nil {
+		return nil, err
+	}
+
+	params := storage.NewFileInfoCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient)
+	params.SetVolumeUUID(volumeUUID)
+	params.SetPath(path)
+	params.SetFields([]string{
+		"name", "type", "target", "unix_permissions", "owner_id", "group_id", "inode_number", "hard_links_count",
+	})
+
+	result, err := c.api.Storage.FileInfoCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Payload == nil {
+		return nil, nil
+	}
+
+	if HasNextLink(result.Payload) {
+		nextLink := result.Payload.Links.Next
+		done := false
+	NextLoop:
+		for !done {
+			resultNext, errNext := c.api.Storage.FileInfoCollectionGet(params, c.authInfo, WithNextLink(nextLink))
+			if errNext != nil {
+				return nil, errNext
+			}
+			if resultNext == nil || resultNext.Payload == nil || resultNext.Payload.NumRecords == nil {
+				done = true
+				continue NextLoop
+			}
+
+			result.Payload.FileInfoResponseInlineRecords = append(result.Payload.FileInfoResponseInlineRecords,
+				resultNext.Payload.FileInfoResponseInlineRecords...)
+
+			if !HasNextLink(resultNext.Payload) {
+				done = true
+				continue NextLoop
+			} else {
+				nextLink = resultNext.Payload.Links.Next
+			}
+		}
+	}
+	return result.Payload.FileInfoResponseInlineRecords, nil
+}
+
+// FileCreate creates a directory or symlink within a flexvol, with the permissions, owner and group in info
+func (c RestClient) FileCreate(ctx context.Context, volumeName, path string, info *models.FileInfo) error {
+	volumeUUID, err := c.getFlexvolUUID(ctx, volumeName)
+	if err != nil {
+		return err
+	}
+
+	params := storage.NewFileInfoCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient)
+	params.SetVolumeUUID(volumeUUID)
+	params.SetPath(path)
+	params.SetInfo(info)
+
+	_, err = c.api.Storage.FileInfoCreate(params, c.authInfo)
+	return err
+}
+
+// FileClone clones a file within a flexvol, from a source that may be in one of its snapshots, sharing the
+// source's blocks rather than copying its data
+func (c RestClient) FileClone(ctx context.Context, volumeName, sourcePath, destinationPath string) error {
+	params := storage.NewFileCloneCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient)
+	params.SetInfo(&models.FileClone{
+		SourcePath:      utils.Ptr(sourcePath),
+		DestinationPath: utils.Ptr(destinationPath),
+		Volume:          &models.FileCloneInlineVolume{Name: utils.Ptr(volumeName)},
+	})
+
+	fileCloneAccepted, err := c.api.Storage.FileCloneCreate(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if fileCloneAccepted == nil {
+		return fmt.Errorf("unexpected response from file clone")
+	}
+
+	return c.PollJobStatus(ctx, fileCloneAccepted.Payload)
+}
+
 // VolumeDisableSnapshotDirectoryAccess disables access to the ".snapshot" directory
 // Disable '.snapshot' to allow official mysql container's chmod-in-init to work
 func (c RestClient) VolumeDisableSnapshotDirectoryAccess(ctx context.Context, volumeName string) error {
@@ -1796,6 +2145,48 @@ func (c RestClient) IscsiNodeGetName(ctx context.Context) (*san.IscsiServiceGetO
 // IGROUP operations
 // ///////////////////////////////////////////////////////////////////////////
 
+// FcpNodeGetName returns information about the vserver's FCP service, including its target WWNN
+func (c RestClient) FcpNodeGetName(ctx context.Context) (*san.FcpServiceGetOK, error) {
+	params := san.NewFcpServiceGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.SvmUUID = c.svmUUID
+
+	params.SetFields([]string{"**"}) // TODO trim these down to just what we need
+
+	result, err := c.api.San.FcpServiceGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// FcpInterfaceGet returns information about the vserver's FC data interfaces
+func (c RestClient) FcpInterfaceGet(ctx context.Context) (*networking.FcInterfaceCollectionGetOK, error) {
+	params := networking.NewFcInterfaceCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.ReturnRecords = utils.Ptr(true)
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	params.DataProtocol = utils.Ptr("fcp")
+
+	params.SetFields([]string{"**"}) // TODO trim these down to just what we need
+
+	result, err := c.api.Networking.FcInterfaceCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
 // IgroupCreate creates the specified initiator group
 // equivalent to filer::> igroup create docker -vserver iscsi_vs -protocol iscsi -ostype linux
 func (c RestClient) IgroupCreate(ctx context.Context, initiatorGroupName, initiatorGroupType, osType string) error {
@@ -2289,6 +2680,46 @@ func (c RestClient) LunList(ctx context.Context, pattern string) (*san.LunCollec
 	return result, nil
 }
 
+// LunListMetrics returns the space used and the performance metrics of all LUNs whose names match the
+// supplied pattern
+func (c RestClient) LunListMetrics(ctx context.Context, pattern string) (*san.LunCollectionGetOK, error) {
+	params := san.NewLunCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	params.SetName(utils.Ptr(pattern))
+	params.SetFields([]string{"name", "space.size", "space.used", "metric"})
+
+	result, err := c.api.San.LunCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Payload == nil {
+		return result, nil
+	}
+
+	for payload := result.Payload; HasNextLink(payload); {
+		resultNext, errNext := c.api.San.LunCollectionGet(params, c.authInfo, WithNextLink(payload.Links.Next))
+		if errNext != nil {
+			return nil, errNext
+		}
+		if resultNext == nil || resultNext.Payload == nil || resultNext.Payload.NumRecords == nil {
+			break
+		}
+		payload = resultNext.Payload
+
+		if result.Payload.NumRecords == nil {
+			result.Payload.NumRecords = utils.Ptr(int64(0))
+		}
+		result.Payload.NumRecords = utils.Ptr(*result.Payload.NumRecords + *payload.NumRecords)
+		result.Payload.LunResponseInlineRecords = append(result.Payload.LunResponseInlineRecords,
+			payload.LunResponseInlineRecords...)
+	}
+
+	return result, nil
+}
+
 // LunDelete deletes a LUN
 func (c RestClient) LunDelete(
 	ctx context.Context,
@@ -2819,6 +3250,333 @@ func (c RestClient) LunSetSize(
 	return sizeBytes, nil
 }
 
+// ////////////////////////////////////////////////////////////////////////////
+// NVMe operations
+// ////////////////////////////////////////////////////////////////////////////
+
+// NVMeNamespaceCreate creates an NVMe namespace and returns its UUID
+// equivalent to filer::> vserver nvme namespace create -vserver nvme_vs -path /vol/v/namespace0 -size 1g -ostype linux
+func (c RestClient) NVMeNamespaceCreate(ctx context.Context, ns NVMeNamespace) (string, error) {
+	params := nvme.NewNvmeNamespaceCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.ReturnRecords = utils.Ptr(true)
+
+	sizeBytesStr, _ := utils.ConvertSizeToBytes(ns.Size)
+	sizeBytes, _ := strconv.ParseUint(sizeBytesStr, 10, 64)
+
+	nsInfo := &models.NvmeNamespace{
+		Name:   utils.Ptr(ns.Name), // example:  /vol/myVolume/namespace0
+		OsType: utils.Ptr(ns.OsType),
+		Space: &models.NvmeNamespaceInlineSpace{
+			Size: utils.Ptr(int64(sizeBytes)),
+		},
+		Comment: utils.Ptr(ns.Comment),
+		Svm:     &models.NvmeNamespaceInlineSvm{UUID: utils.Ptr(c.svmUUID)},
+	}
+	if ns.BlockSize != 0 {
+		nsInfo.Space.BlockSize = utils.Ptr(int64(ns.BlockSize))
+	}
+
+	params.SetInfo(nsInfo)
+
+	nsCreateAccepted, err := c.api.NvMe.NvmeNamespaceCreate(params, c.authInfo)
+	if err != nil {
+		return "", err
+	}
+	if nsCreateAccepted == nil || nsCreateAccepted.Payload == nil {
+		return "", fmt.Errorf("unexpected response from namespace create")
+	}
+	if nsCreateAccepted.Payload.NumRecords == nil || *nsCreateAccepted.Payload.NumRecords != 1 {
+		return "", fmt.Errorf("unexpected response from namespace create, created %v namespaces",
+			utils.PtrToString(nsCreateAccepted.Payload.NumRecords))
+	}
+
+	nsRecord := nsCreateAccepted.Payload.NvmeNamespaceResponseInlineRecords[0]
+	if nsRecord == nil || nsRecord.UUID == nil {
+		return "", fmt.Errorf("unexpected response from namespace create, namespace UUID was nil")
+	}
+
+	return *nsRecord.UUID, nil
+}
+
+// NVMeNamespaceSetSize sets the size of an NVMe namespace
+func (c RestClient) NVMeNamespaceSetSize(ctx context.Context, nsUUID string, newSize int64) error {
+	params := nvme.NewNvmeNamespaceModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = nsUUID
+
+	params.SetInfo(&models.NvmeNamespace{
+		Space: &models.NvmeNamespaceInlineSpace{
+			Size: utils.Ptr(newSize),
+		},
+	})
+
+	nsModify, err := c.api.NvMe.NvmeNamespaceModify(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("namespace resize failed; %v", err)
+	}
+	if nsModify == nil {
+		return fmt.Errorf("namespace resize failed")
+	}
+
+	return nil
+}
+
+// NVMeNamespaceList finds NVMe namespaces with the specified pattern
+func (c RestClient) NVMeNamespaceList(
+	ctx context.Context, pattern string,
+) (*nvme.NvmeNamespaceCollectionGetOK, error) {
+	params := nvme.NewNvmeNamespaceCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	params.SetName(utils.Ptr(pattern))
+	params.SetFields([]string{"**"})
+
+	result, err := c.api.NvMe.NvmeNamespaceCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	if HasNextLink(result.Payload) {
+		nextLink := result.Payload.Links.Next
+		done := false
+	NextLoop:
+		for !done {
+			resultNext, errNext := c.api.NvMe.NvmeNamespaceCollectionGet(params, c.authInfo, WithNextLink(nextLink))
+			if errNext != nil {
+				return nil, errNext
+			}
+			if resultNext == nil || resultNext.Payload == nil || resultNext.Payload.NumRecords == nil {
+				done = true
+				continue NextLoop
+			}
+
+			if result.Payload.NumRecords == nil {
+				result.Payload.NumRecords = utils.Ptr(int64(0))
+			}
+			result.Payload.NumRecords = utils.Ptr(*result.Payload.NumRecords + *resultNext.Payload.NumRecords)
+			result.Payload.NvmeNamespaceResponseInlineRecords = append(
+				result.Payload.NvmeNamespaceResponseInlineRecords,
+				resultNext.Payload.NvmeNamespaceResponseInlineRecords...)
+
+			if !HasNextLink(resultNext.Payload) {
+				done = true
+				continue NextLoop
+			} else {
+				nextLink = resultNext.Payload.Links.Next
+			}
+		}
+	}
+	return result, nil
+}
+
+// NVMeNamespaceGetByName gets the NVMe namespace with the specified name
+func (c RestClient) NVMeNamespaceGetByName(ctx context.Context, name string) (*models.NvmeNamespace, error) {
+	result, err := c.NVMeNamespaceList(ctx, name)
+	if err != nil || result == nil || result.Payload == nil {
+		return nil, err
+	}
+	if result.Payload.NumRecords != nil && *result.Payload.NumRecords == 1 &&
+		result.Payload.NvmeNamespaceResponseInlineRecords != nil {
+		return result.Payload.NvmeNamespaceResponseInlineRecords[0], nil
+	}
+	return nil, nil
+}
+
+// NVMeSubsystemAddNamespace maps an NVMe namespace to a subsystem
+// equivalent to filer::> vserver nvme subsystem map add -vserver nvme_vs -subsystem s1 -path /vol/v/namespace0
+func (c RestClient) NVMeSubsystemAddNamespace(ctx context.Context, subsystemUUID, nsUUID string) error {
+	params := nvme.NewNvmeSubsystemMapCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SetInfo(&models.NvmeSubsystemMap{
+		Namespace: &models.NvmeSubsystemMapInlineNamespace{UUID: utils.Ptr(nsUUID)},
+		Subsystem: &models.NvmeSubsystemMapInlineSubsystem{UUID: utils.Ptr(subsystemUUID)},
+		Svm:       &models.NvmeSubsystemMapInlineSvm{UUID: utils.Ptr(c.svmUUID)},
+	})
+
+	if _, err := c.api.NvMe.NvmeSubsystemMapCreate(params, c.authInfo); err != nil {
+		return fmt.Errorf("error mapping namespace to subsystem; %v", err)
+	}
+
+	return nil
+}
+
+// NVMeSubsystemRemoveNamespace unmaps an NVMe namespace from a subsystem
+// equivalent to filer::> vserver nvme subsystem map remove -vserver nvme_vs -subsystem s1 -path /vol/v/namespace0
+func (c RestClient) NVMeSubsystemRemoveNamespace(ctx context.Context, subsystemUUID, nsUUID string) error {
+	params := nvme.NewNvmeSubsystemMapDeleteParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.SubsystemUUID = subsystemUUID
+	params.NamespaceUUID = nsUUID
+
+	if _, err := c.api.NvMe.NvmeSubsystemMapDelete(params, c.authInfo); err != nil {
+		return fmt.Errorf("error unmapping namespace from subsystem; %v", err)
+	}
+
+	return nil
+}
+
+// NVMeSubsystemMapList lists the namespace maps of a subsystem, optionally filtered by namespace
+func (c RestClient) NVMeSubsystemMapList(
+	ctx context.Context, subsystemUUID, nsUUID string,
+) (*nvme.NvmeSubsystemMapCollectionGetOK, error) {
+	params := nvme.NewNvmeSubsystemMapCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	if subsystemUUID != "" {
+		params.SubsystemUUID = utils.Ptr(subsystemUUID)
+	}
+	if nsUUID != "" {
+		params.NamespaceUUID = utils.Ptr(nsUUID)
+	}
+	params.SetFields([]string{"**"})
+
+	return c.api.NvMe.NvmeSubsystemMapCollectionGet(params, c.authInfo)
+}
+
+// NVMeSubsystemList finds NVMe subsystems with the specified pattern
+func (c RestClient) NVMeSubsystemList(
+	ctx context.Context, pattern string,
+) (*nvme.NvmeSubsystemCollectionGetOK, error) {
+	params := nvme.NewNvmeSubsystemCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	params.SetName(utils.Ptr(pattern))
+	params.SetFields([]string{"**"})
+
+	return c.api.NvMe.NvmeSubsystemCollectionGet(params, c.authInfo)
+}
+
+// NVMeSubsystemGetByName gets the NVMe subsystem with the specified name
+func (c RestClient) NVMeSubsystemGetByName(ctx context.Context, subsystemName string) (*models.NvmeSubsystem, error) {
+	result, err := c.NVMeSubsystemList(ctx, subsystemName)
+	if err != nil || result == nil || result.Payload == nil {
+		return nil, err
+	}
+	if result.Payload.NumRecords != nil && *result.Payload.NumRecords == 1 &&
+		result.Payload.NvmeSubsystemResponseInlineRecords != nil {
+		return result.Payload.NvmeSubsystemResponseInlineRecords[0], nil
+	}
+	return nil, nil
+}
+
+// NVMeSubsystemCreate creates an NVMe subsystem
+// equivalent to filer::> vserver nvme subsystem create -vserver nvme_vs -subsystem s1 -ostype linux
+func (c RestClient) NVMeSubsystemCreate(ctx context.Context, subsystemName string) (*models.NvmeSubsystem, error) {
+	params := nvme.NewNvmeSubsystemCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.ReturnRecords = utils.Ptr(true)
+
+	params.SetInfo(&models.NvmeSubsystem{
+		Name:   utils.Ptr(subsystemName),
+		OsType: utils.Ptr("linux"),
+		Svm:    &models.NvmeSubsystemInlineSvm{UUID: utils.Ptr(c.svmUUID)},
+	})
+
+	subsysCreated, err := c.api.NvMe.NvmeSubsystemCreate(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if subsysCreated == nil || subsysCreated.Payload == nil {
+		return nil, fmt.Errorf("unexpected response from subsystem create")
+	}
+	if subsysCreated.Payload.NumRecords == nil || *subsysCreated.Payload.NumRecords != 1 {
+		return nil, fmt.Errorf("unexpected response from subsystem create, created %v subsystems",
+			utils.PtrToString(subsysCreated.Payload.NumRecords))
+	}
+
+	return subsysCreated.Payload.NvmeSubsystemResponseInlineRecords[0], nil
+}
+
+// NVMeSubsystemDelete deletes an NVMe subsystem
+func (c RestClient) NVMeSubsystemDelete(ctx context.Context, subsystemUUID string) error {
+	params := nvme.NewNvmeSubsystemDeleteParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = subsystemUUID
+	params.AllowDeleteWithHosts = utils.Ptr(true)
+
+	if _, err := c.api.NvMe.NvmeSubsystemDelete(params, c.authInfo); err != nil {
+		return fmt.Errorf("error deleting subsystem; %v", err)
+	}
+
+	return nil
+}
+
+// NVMeAddHostNqnToSubsystem grants a host access to an NVMe subsystem
+// equivalent to filer::> vserver nvme subsystem host add -vserver nvme_vs -subsystem s1 -host-nqn nqn.2014-08.org...
+func (c RestClient) NVMeAddHostNqnToSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error {
+	params := nvme.NewNvmeSubsystemHostCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.SubsystemUUID = subsystemUUID
+
+	params.SetInfo(&models.NvmeSubsystemHost{Nqn: utils.Ptr(hostNQN)})
+
+	if _, err := c.api.NvMe.NvmeSubsystemHostCreate(params, c.authInfo); err != nil {
+		return fmt.Errorf("error adding host to subsystem; %v", err)
+	}
+
+	return nil
+}
+
+// NVMeRemoveHostFromSubsystem revokes a host's access to an NVMe subsystem
+// equivalent to filer::> vserver nvme subsystem host remove -vserver nvme_vs -subsystem s1 -host-nqn nqn.2014-08...
+func (c RestClient) NVMeRemoveHostFromSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error {
+	params := nvme.NewNvmeSubsystemHostDeleteParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.SubsystemUUID = subsystemUUID
+	params.Nqn = hostNQN
+
+	if _, err := c.api.NvMe.NvmeSubsystemHostDelete(params, c.authInfo); err != nil {
+		return fmt.Errorf("error removing host from subsystem; %v", err)
+	}
+
+	return nil
+}
+
+// NVMeGetHostsOfSubsystem returns the NQNs of all hosts that may access an NVMe subsystem
+func (c RestClient) NVMeGetHostsOfSubsystem(ctx context.Context, subsystemUUID string) ([]string, error) {
+	params := nvme.NewNvmeSubsystemHostCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.SubsystemUUID = subsystemUUID
+	params.SetFields([]string{"nqn"})
+
+	result, err := c.api.NvMe.NvmeSubsystemHostCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	hostNQNs := make([]string, 0)
+	if result == nil || result.Payload == nil {
+		return hostNQNs, nil
+	}
+	for _, host := range result.Payload.NvmeSubsystemHostResponseInlineRecords {
+		if host != nil && host.Nqn != nil {
+			hostNQNs = append(hostNQNs, *host.Nqn)
+		}
+	}
+
+	return hostNQNs, nil
+}
+
 // ////////////////////////////////////////////////////////////////////////////
 // NETWORK operations
 // ////////////////////////////////////////////////////////////////////////////
@@ -3777,9 +4535,9 @@ func ToSliceVolumeAggregatesItems(aggrs []string) []*models.VolumeInlineAggregat
 func (c RestClient) FlexGroupCreate(
 	ctx context.Context, name string, size int, aggrs []string, spaceReserve, snapshotPolicy, unixPermissions,
 	exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup, encrypt *bool,
-	snapshotReserve int,
+	snapshotReserve, constituentsPerAggregate int, dpVolume bool,
 ) error {
-	return c.createVolumeByStyle(ctx, name, int64(size), aggrs, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment, qosPolicyGroup, encrypt, snapshotReserve, models.VolumeStyleFlexgroup, false)
+	return c.createVolumeByStyle(ctx, name, int64(size), aggrs, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment, qosPolicyGroup, encrypt, snapshotReserve, constituentsPerAggregate, models.VolumeStyleFlexgroup, dpVolume)
 }
 
 // FlexgroupCloneSplitStart starts splitting the flexgroup clone
@@ -3896,6 +4654,34 @@ func (c RestClient) FlexGroupGetAll(ctx context.Context, pattern string) (*stora
 	return c.getAllVolumesByPatternStyleAndState(ctx, pattern, models.VolumeStyleFlexgroup, models.VolumeStateOnline)
 }
 
+// FlexGroupConstituentCount returns the number of constituents of the flexgroup with the specified name
+// in the specified SVM
+func (c RestClient) FlexGroupConstituentCount(ctx context.Context, svmName, volumeName string) (int, error) {
+	params := storage.NewVolumeCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SetSvmName(utils.Ptr(svmName))
+	params.SetFlexgroupName(utils.Ptr(volumeName))
+	params.SetIsConstituent(utils.Ptr(true))
+	params.SetFields([]string{"name"})
+
+	result, err := c.api.Storage.VolumeCollectionGet(params, c.authInfo)
+	if err != nil {
+		return 0, err
+	}
+	if result == nil || result.Payload == nil {
+		return 0, nil
+	}
+
+	result.Payload, err = c.getAllVolumePayloadRecords(result.Payload, params)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(result.Payload.VolumeResponseInlineRecords), nil
+}
+
 // FlexGroupMount mounts a flexgroup at the specified junction
 func (c RestClient) FlexGroupMount(ctx context.Context, volumeName, junctionPath string) error {
 	return c.mountVolumeByNameAndStyle(ctx, volumeName, junctionPath, models.VolumeStyleFlexgroup)
@@ -5015,6 +5801,42 @@ func (c RestClient) SnapmirrorInitialize(
 	return nil
 }
 
+// SnapmirrorUpdate starts a transfer to the destination of a snapmirror relationship, optionally of a given
+// snapshot of the source volume
+func (c RestClient) SnapmirrorUpdate(
+	ctx context.Context, localFlexvolName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string,
+) error {
+	// first, find the relationship so we can then use the UUID to start a transfer
+	relationship, err := c.SnapmirrorGet(ctx, localFlexvolName, c.SVMName(), remoteFlexvolName, remoteSVMName)
+	if err != nil {
+		return err
+	}
+	if relationship == nil || relationship.UUID == nil {
+		return fmt.Errorf("unexpected response from snapmirror relationship lookup")
+	}
+
+	params := snapmirror.NewSnapmirrorRelationshipTransferCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient)
+	params.SetRelationshipUUID(string(*relationship.UUID))
+
+	params.Info = &models.SnapmirrorTransfer{}
+	if snapshotName != "" {
+		params.Info.SourceSnapshot = utils.Ptr(snapshotName)
+	}
+
+	snapmirrorRelationshipTransferCreateAccepted, err := c.api.Snapmirror.SnapmirrorRelationshipTransferCreate(params,
+		c.authInfo)
+	if err != nil {
+		return err
+	}
+	if snapmirrorRelationshipTransferCreateAccepted == nil {
+		return fmt.Errorf("unexpected response from snapmirror relationship transfer create")
+	}
+
+	return nil
+}
+
 func (c RestClient) SnapmirrorResync(
 	ctx context.Context, localFlexvolName, localSVMName, remoteFlexvolName, remoteSVMName string,
 ) error {
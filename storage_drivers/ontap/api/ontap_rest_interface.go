@@ -10,6 +10,7 @@ import (
 
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/cluster"
 	nas "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_a_s"
+	nvme "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_v_me"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/networking"
 	san "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/s_a_n"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/snapmirror"
@@ -31,6 +32,9 @@ type RestClientInterface interface {
 	SupportsFeature(ctx context.Context, feature Feature) bool
 	// VolumeList returns the names of all Flexvols whose names match the supplied pattern
 	VolumeList(ctx context.Context, pattern string) (*storage.VolumeCollectionGetOK, error)
+	// VolumeListMetrics returns the space used and the performance metrics of all volumes whose names match the
+	// supplied pattern
+	VolumeListMetrics(ctx context.Context, pattern string) (*storage.VolumeCollectionGetOK, error)
 	// VolumeListByAttrs is used to find bucket volumes for nas-eco and san-eco
 	VolumeListByAttrs(ctx context.Context, volumeAttrs *Volume) (Volumes, error)
 	// VolumeCreate creates a volume with the specified options
@@ -44,6 +48,8 @@ type RestClientInterface interface {
 	VolumeGetByName(ctx context.Context, volumeName string) (*models.Volume, error)
 	// VolumeMount mounts a flexvol at the specified junction
 	VolumeMount(ctx context.Context, volumeName, junctionPath string) error
+	// VolumeUnmount unmounts a flexvol
+	VolumeUnmount(ctx context.Context, volumeName string) error
 	// VolumeRename changes the name of a flexvol
 	VolumeRename(ctx context.Context, volumeName, newVolumeName string) error
 	VolumeModifyExportPolicy(ctx context.Context, volumeName, exportPolicyName string) error
@@ -54,6 +60,16 @@ type RestClientInterface interface {
 	// VolumeSetSize sets the size of the specified flexvol
 	VolumeSetSize(ctx context.Context, volumeName, newSize string) error
 	VolumeModifyUnixPermissions(ctx context.Context, volumeName, unixPermissions string) error
+	// VolumeModifySnapshotPolicy sets the snapshot policy of a flexvol
+	VolumeModifySnapshotPolicy(ctx context.Context, volumeName, snapshotPolicy string) error
+	// VolumeModifySnapshotDirectoryAccess enables or disables access to the ".snapshot" directory of a flexvol
+	VolumeModifySnapshotDirectoryAccess(ctx context.Context, volumeName string, enable bool) error
+	// VolumeModifyTieringPolicy sets the FabricPool tiering policy of a flexvol
+	VolumeModifyTieringPolicy(ctx context.Context, volumeName, tieringPolicy string) error
+	// VolumeMoveStart starts moving a flexvol to another aggregate
+	VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error
+	// VolumeMoveInfo returns the attributes of the latest move of a flexvol
+	VolumeMoveInfo(ctx context.Context, volumeName string) (*models.VolumeInlineMovement, error)
 	// VolumeSetComment sets a flexvol's comment to the supplied value
 	// equivalent to filer::> volume modify -vserver iscsi_vs -volume v -comment newVolumeComment
 	VolumeSetComment(ctx context.Context, volumeName, newVolumeComment string) error
@@ -68,6 +84,15 @@ type RestClientInterface interface {
 	SnapshotCreate(ctx context.Context, volumeUUID, snapshotName string) (*storage.SnapshotCreateAccepted, error)
 	// SnapshotCreateAndWait creates a snapshot and waits on the job to complete
 	SnapshotCreateAndWait(ctx context.Context, volumeUUID, snapshotName string) error
+	// ConsistencyGroupCreateAndWait creates a consistency group of existing volumes, waits on the job to complete,
+	// and returns the UUID of the new consistency group
+	ConsistencyGroupCreateAndWait(ctx context.Context, consistencyGroupName string, volumeNames []string) (string, error)
+	// ConsistencyGroupSnapshotCreateAndWait snapshots all volumes of a consistency group at a single point in time
+	// and waits on the job to complete
+	ConsistencyGroupSnapshotCreateAndWait(ctx context.Context, consistencyGroupUUID, snapshotName string) error
+	// ConsistencyGroupDeleteAndWait deletes a consistency group, leaving its volumes and their snapshots in place,
+	// and waits on the job to complete
+	ConsistencyGroupDeleteAndWait(ctx context.Context, consistencyGroupUUID string) error
 	// SnapshotList lists snapshots
 	SnapshotList(ctx context.Context, volumeUUID string) (*storage.SnapshotCollectionGetOK, error)
 	// SnapshotListByName lists snapshots by name
@@ -82,6 +107,12 @@ type RestClientInterface interface {
 	SnapshotRestoreVolume(ctx context.Context, snapshotName, volumeName string) error
 	// SnapshotRestoreFlexgroup restores a volume to a snapshot as a non-blocking operation
 	SnapshotRestoreFlexgroup(ctx context.Context, snapshotName, volumeName string) error
+	// FileList returns the entries of a directory within a flexvol, which may be in one of its snapshots
+	FileList(ctx context.Context, volumeName, path string) ([]*models.FileInfo, error)
+	// FileCreate creates a directory or symlink within a flexvol, with the permissions, owner and group in info
+	FileCreate(ctx context.Context, volumeName, path string, info *models.FileInfo) error
+	// FileClone clones a file within a flexvol, from a source that may be in one of its snapshots
+	FileClone(ctx context.Context, volumeName, sourcePath, destinationPath string) error
 	// VolumeDisableSnapshotDirectoryAccess disables access to the ".snapshot" directory
 	// Disable '.snapshot' to allow official mysql container's chmod-in-init to work
 	VolumeDisableSnapshotDirectoryAccess(ctx context.Context, volumeName string) error
@@ -104,6 +135,10 @@ type RestClientInterface interface {
 	IscsiInitiatorSetDefaultAuth(ctx context.Context, authType, userName, passphrase, outbountUserName, outboundPassphrase string) error
 	// IscsiNodeGetName returns information about the vserver's iSCSI node name
 	IscsiNodeGetName(ctx context.Context) (*san.IscsiServiceGetOK, error)
+	// FcpNodeGetName returns information about the vserver's FCP service, including its target WWNN
+	FcpNodeGetName(ctx context.Context) (*san.FcpServiceGetOK, error)
+	// FcpInterfaceGet returns information about the vserver's FC data interfaces
+	FcpInterfaceGet(ctx context.Context) (*networking.FcInterfaceCollectionGetOK, error)
 	// IgroupCreate creates the specified initiator group
 	// equivalent to filer::> igroup create docker -vserver iscsi_vs -protocol iscsi -ostype linux
 	IgroupCreate(ctx context.Context, initiatorGroupName, initiatorGroupType, osType string) error
@@ -133,6 +168,9 @@ type RestClientInterface interface {
 	LunGetByName(ctx context.Context, name string) (*models.Lun, error)
 	// LunList finds LUNs with the specified pattern
 	LunList(ctx context.Context, pattern string) (*san.LunCollectionGetOK, error)
+	// LunListMetrics returns the space used and the performance metrics of all LUNs whose names match the
+	// supplied pattern
+	LunListMetrics(ctx context.Context, pattern string) (*san.LunCollectionGetOK, error)
 	// LunDelete deletes a LUN
 	LunDelete(ctx context.Context, lunUUID string) error
 	// LunGetComment gets the comment for a given LUN.
@@ -167,6 +205,35 @@ type RestClientInterface interface {
 	LunSize(ctx context.Context, lunPath string) (int, error)
 	// LunSetSize sets the size for a given LUN.
 	LunSetSize(ctx context.Context, lunPath, newSize string) (uint64, error)
+	// NVMeNamespaceCreate creates an NVMe namespace and returns its UUID
+	NVMeNamespaceCreate(ctx context.Context, ns NVMeNamespace) (string, error)
+	// NVMeNamespaceSetSize sets the size of an NVMe namespace
+	NVMeNamespaceSetSize(ctx context.Context, nsUUID string, newSize int64) error
+	// NVMeNamespaceList finds NVMe namespaces with the specified pattern
+	NVMeNamespaceList(ctx context.Context, pattern string) (*nvme.NvmeNamespaceCollectionGetOK, error)
+	// NVMeNamespaceGetByName gets the NVMe namespace with the specified name
+	NVMeNamespaceGetByName(ctx context.Context, name string) (*models.NvmeNamespace, error)
+	// NVMeSubsystemAddNamespace maps an NVMe namespace to a subsystem
+	NVMeSubsystemAddNamespace(ctx context.Context, subsystemUUID, nsUUID string) error
+	// NVMeSubsystemRemoveNamespace unmaps an NVMe namespace from a subsystem
+	NVMeSubsystemRemoveNamespace(ctx context.Context, subsystemUUID, nsUUID string) error
+	// NVMeSubsystemMapList lists the namespace maps of a subsystem, optionally filtered by namespace
+	NVMeSubsystemMapList(ctx context.Context, subsystemUUID, nsUUID string) (*nvme.NvmeSubsystemMapCollectionGetOK,
+		error)
+	// NVMeSubsystemList finds NVMe subsystems with the specified pattern
+	NVMeSubsystemList(ctx context.Context, pattern string) (*nvme.NvmeSubsystemCollectionGetOK, error)
+	// NVMeSubsystemGetByName gets the NVMe subsystem with the specified name
+	NVMeSubsystemGetByName(ctx context.Context, subsystemName string) (*models.NvmeSubsystem, error)
+	// NVMeSubsystemCreate creates an NVMe subsystem
+	NVMeSubsystemCreate(ctx context.Context, subsystemName string) (*models.NvmeSubsystem, error)
+	// NVMeSubsystemDelete deletes an NVMe subsystem
+	NVMeSubsystemDelete(ctx context.Context, subsystemUUID string) error
+	// NVMeAddHostNqnToSubsystem grants a host access to an NVMe subsystem
+	NVMeAddHostNqnToSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error
+	// NVMeRemoveHostFromSubsystem revokes a host's access to an NVMe subsystem
+	NVMeRemoveHostFromSubsystem(ctx context.Context, hostNQN, subsystemUUID string) error
+	// NVMeGetHostsOfSubsystem returns the NQNs of all hosts that may access an NVMe subsystem
+	NVMeGetHostsOfSubsystem(ctx context.Context, subsystemUUID string) ([]string, error)
 	// NetworkIPInterfacesList lists all IP interfaces
 	NetworkIPInterfacesList(ctx context.Context) (*networking.NetworkIPInterfacesGetOK, error)
 	NetInterfaceGetDataLIFs(ctx context.Context, protocol string) ([]string, error)
@@ -217,7 +284,7 @@ type RestClientInterface interface {
 	// equivalent to filer::> volume create -vserver svm_name -volume fg_vol_name –auto-provision-as flexgroup -size fg_size
 	// -state online -type RW -policy default -unix-permissions ---rwxr-xr-x -space-guarantee none -snapshot-policy none
 	// -security-style unix -encrypt false
-	FlexGroupCreate(ctx context.Context, name string, size int, aggrs []string, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve int) error
+	FlexGroupCreate(ctx context.Context, name string, size int, aggrs []string, spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve, constituentsPerAggregate int, dpVolume bool) error
 	// FlexgroupCloneSplitStart starts splitting the flexgroup clone
 	FlexgroupCloneSplitStart(ctx context.Context, volumeName string) error
 	// FlexGroupDestroy destroys a FlexGroup
@@ -240,6 +307,9 @@ type RestClientInterface interface {
 	FlexGroupSetComment(ctx context.Context, volumeName, newVolumeComment string) error
 	// FlexGroupGetByName gets the flexgroup with the specified name
 	FlexGroupGetByName(ctx context.Context, volumeName string) (*models.Volume, error)
+	// FlexGroupConstituentCount returns the number of constituents of the flexgroup with the specified name
+	// in the specified SVM
+	FlexGroupConstituentCount(ctx context.Context, svmName, volumeName string) (int, error)
 	// FlexGroupGetAll returns all relevant details for all FlexGroups whose names match the supplied prefix
 	FlexGroupGetAll(ctx context.Context, pattern string) (*storage.VolumeCollectionGetOK, error)
 	// FlexGroupMount mounts a flexgroup at the specified junction
@@ -303,6 +373,7 @@ type RestClientInterface interface {
 	SnapmirrorGet(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) (*models.SnapmirrorRelationship, error)
 	SnapmirrorCreate(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, repPolicy, repSchedule string) error
 	SnapmirrorInitialize(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) error
+	SnapmirrorUpdate(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string) error
 	SnapmirrorResync(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) error
 	SnapmirrorBreak(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string) error
 	SnapmirrorQuiesce(ctx context.Context, localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) error
@@ -791,7 +791,7 @@ func (c Client) LunSize(flexvolName string) (int, error) {
 func (c Client) FlexGroupCreate(
 	ctx context.Context, name string, size int, aggrs []azgo.AggrNameType, spaceReserve, snapshotPolicy,
 	unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string, qosPolicyGroup QosPolicyGroup,
-	encrypt *bool, snapshotReserve int,
+	encrypt *bool, snapshotReserve, constituentsPerAggregate int, dpVolume bool,
 ) (*azgo.VolumeCreateAsyncResponse, error) {
 	junctionPath := fmt.Sprintf("/%s", name)
 
@@ -806,12 +806,22 @@ func (c Client) FlexGroupCreate(
 		SetExportPolicy(exportPolicy).
 		SetVolumeSecurityStyle(securityStyle).
 		SetAggrList(aggrList).
-		SetJunctionPath(junctionPath).
 		SetVolumeComment(comment)
 
+	// Leave the constituent count to ONTAP unless one was requested
+	if constituentsPerAggregate > 0 {
+		request.SetAggrListMultiplier(constituentsPerAggregate)
+	}
+
+	// A DP volume cannot be mounted until it has been initialized, and gets its permissions from its source.
 	// Set Unix permission for NFS volume only.
-	if unixPermissions != "" {
-		request.SetUnixPermissions(unixPermissions)
+	if dpVolume {
+		request.SetVolumeType("DP")
+	} else {
+		request.SetJunctionPath(junctionPath)
+		if unixPermissions != "" {
+			request.SetUnixPermissions(unixPermissions)
+		}
 	}
 	// For encrypt == nil - we don't explicitely set the encrypt argument.
 	// If destination aggregate is NAE enabled, new volume will be aggregate encrypted
@@ -1082,6 +1092,32 @@ func (c Client) FlexGroupGetAll(prefix string) (*azgo.VolumeGetIterResponse, err
 	return c.volumeGetIterAll(prefix, queryVolIDAttrs, queryVolStateAttrs)
 }
 
+// FlexGroupConstituentList returns the names of all constituents of the FlexGroup with the specified name
+// in the specified SVM
+func (c Client) FlexGroupConstituentList(svmName, name string) (*azgo.VolumeGetIterResponse, error) {
+	// Limit the volumes to the constituents of the FlexGroup
+	query := &azgo.VolumeGetIterRequestQuery{}
+	queryVolIDAttrs := azgo.NewVolumeIdAttributesType().
+		SetName(azgo.VolumeNameType(name + "__*")).
+		SetOwningVserverName(svmName).
+		SetStyleExtended("flexgroup_constituent")
+	volumeAttributes := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*queryVolIDAttrs)
+	query.SetVolumeAttributes(*volumeAttributes)
+
+	// Limit the returned data to names
+	desiredAttributes := &azgo.VolumeGetIterRequestDesiredAttributes{}
+	desiredVolIDAttrs := azgo.NewVolumeIdAttributesType().SetName("")
+	desiredVolumeAttributes := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*desiredVolIDAttrs)
+	desiredAttributes.SetVolumeAttributes(*desiredVolumeAttributes)
+
+	response, err := azgo.NewVolumeGetIterRequest().
+		SetMaxRecords(c.config.ContextBasedZapiRecords).
+		SetQuery(*query).
+		SetDesiredAttributes(*desiredAttributes).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
 // WaitForAsyncResponse handles waiting for an AsyncResponse to return successfully or return an error.
 func (c Client) WaitForAsyncResponse(ctx context.Context, zapiResult interface{}, maxWaitTime time.Duration) error {
 	asyncResult, err := azgo.NewZapiAsyncResult(ctx, zapiResult)
@@ -1343,6 +1379,63 @@ func (c Client) VolumeDisableSnapshotDirectoryAccess(name string) (*azgo.VolumeM
 	return response, err
 }
 
+// VolumeModifySnapshotPolicy sets the snapshot policy of a volume
+func (c Client) VolumeModifySnapshotPolicy(name, snapshotPolicy string) (*azgo.VolumeModifyIterResponse, error) {
+	volattr := &azgo.VolumeModifyIterRequestAttributes{}
+	ssattr := azgo.NewVolumeSnapshotAttributesType().SetSnapshotPolicy(snapshotPolicy)
+	volSnapshotAttrs := azgo.NewVolumeAttributesType().SetVolumeSnapshotAttributes(*ssattr)
+	volattr.SetVolumeAttributes(*volSnapshotAttrs)
+
+	queryattr := &azgo.VolumeModifyIterRequestQuery{}
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	volIdAttrs := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+	queryattr.SetVolumeAttributes(*volIdAttrs)
+
+	response, err := azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
+// VolumeModifySnapshotDirectoryAccess enables or disables access to the ".snapshot" directory
+func (c Client) VolumeModifySnapshotDirectoryAccess(name string, enable bool) (*azgo.VolumeModifyIterResponse, error) {
+	volattr := &azgo.VolumeModifyIterRequestAttributes{}
+	ssattr := azgo.NewVolumeSnapshotAttributesType().SetSnapdirAccessEnabled(enable)
+	volSnapshotAttrs := azgo.NewVolumeAttributesType().SetVolumeSnapshotAttributes(*ssattr)
+	volattr.SetVolumeAttributes(*volSnapshotAttrs)
+
+	queryattr := &azgo.VolumeModifyIterRequestQuery{}
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	volIdAttrs := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+	queryattr.SetVolumeAttributes(*volIdAttrs)
+
+	response, err := azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
+// VolumeModifyTieringPolicy sets the FabricPool tiering policy of a volume
+func (c Client) VolumeModifyTieringPolicy(name, tieringPolicy string) (*azgo.VolumeModifyIterResponse, error) {
+	volattr := &azgo.VolumeModifyIterRequestAttributes{}
+	compAggrAttr := azgo.NewVolumeCompAggrAttributesType().SetTieringPolicy(tieringPolicy)
+	volCompAggrAttrs := azgo.NewVolumeAttributesType().SetVolumeCompAggrAttributes(*compAggrAttr)
+	volattr.SetVolumeAttributes(*volCompAggrAttrs)
+
+	queryattr := &azgo.VolumeModifyIterRequestQuery{}
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	volIdAttrs := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+	queryattr.SetVolumeAttributes(*volIdAttrs)
+
+	response, err := azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
 // Use this to set the QoS Policy Group for volume clones since
 // we can't set adaptive policy groups directly during volume clone creation.
 func (c Client) VolumeSetQosPolicyGroupName(
@@ -2634,6 +2727,21 @@ func (c Client) SnapmirrorInitialize(
 	return query.ExecuteUsing(c.zr)
 }
 
+// SnapmirrorUpdate starts a transfer to the destination of a snapmirror relationship, optionally of a given
+// snapshot of the source volume
+func (c Client) SnapmirrorUpdate(
+	localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string,
+) (*azgo.SnapmirrorUpdateResponse, error) {
+	query := azgo.NewSnapmirrorUpdateRequest()
+	query.SetDestinationLocation(ToSnapmirrorLocation(c.SVMName(), localInternalVolumeName))
+	query.SetSourceLocation(ToSnapmirrorLocation(remoteSVMName, remoteFlexvolName))
+	if snapshotName != "" {
+		query.SetSourceSnapshot(snapshotName)
+	}
+
+	return query.ExecuteUsing(c.zr)
+}
+
 func (c Client) SnapmirrorResync(
 	localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string,
 ) (*azgo.SnapmirrorResyncResponse, error) {
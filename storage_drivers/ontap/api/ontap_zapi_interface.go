@@ -121,7 +121,7 @@ type ZapiClientInterface interface {
 	FlexGroupCreate(
 		ctx context.Context, name string, size int, aggrs []azgo.AggrNameType,
 		spaceReserve, snapshotPolicy, unixPermissions, exportPolicy, securityStyle, tieringPolicy, comment string,
-		qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve int,
+		qosPolicyGroup QosPolicyGroup, encrypt *bool, snapshotReserve, constituentsPerAggregate int, dpVolume bool,
 	) (*azgo.VolumeCreateAsyncResponse, error)
 	// FlexGroupDestroy destroys a FlexGroup
 	FlexGroupDestroy(ctx context.Context, name string, force bool) (*azgo.VolumeDestroyAsyncResponse, error)
@@ -148,6 +148,9 @@ type ZapiClientInterface interface {
 	FlexGroupGet(name string) (*azgo.VolumeAttributesType, error)
 	// FlexGroupGetAll returns all relevant details for all FlexGroups whose names match the supplied prefix
 	FlexGroupGetAll(prefix string) (*azgo.VolumeGetIterResponse, error)
+	// FlexGroupConstituentList returns the names of all constituents of the FlexGroup with the specified name
+	// in the specified SVM
+	FlexGroupConstituentList(svmName, name string) (*azgo.VolumeGetIterResponse, error)
 	// WaitForAsyncResponse handles waiting for an AsyncResponse to return successfully or return an error.
 	WaitForAsyncResponse(ctx context.Context, zapiResult interface{}, maxWaitTime time.Duration) error
 	// JobGetIterStatus returns the current job status for Async requests.
@@ -170,6 +173,12 @@ type ZapiClientInterface interface {
 	// VolumeDisableSnapshotDirectoryAccess disables access to the ".snapshot" directory
 	// Disable '.snapshot' to allow official mysql container's chmod-in-init to work
 	VolumeDisableSnapshotDirectoryAccess(name string) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeModifySnapshotPolicy sets the snapshot policy of a volume
+	VolumeModifySnapshotPolicy(name, snapshotPolicy string) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeModifySnapshotDirectoryAccess enables or disables access to the ".snapshot" directory
+	VolumeModifySnapshotDirectoryAccess(name string, enable bool) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeModifyTieringPolicy sets the FabricPool tiering policy of a volume
+	VolumeModifyTieringPolicy(name, tieringPolicy string) (*azgo.VolumeModifyIterResponse, error)
 	// Use this to set the QoS Policy Group for volume clones since
 	// we can't set adaptive policy groups directly during volume clone creation.
 	VolumeSetQosPolicyGroupName(name string, qosPolicyGroup QosPolicyGroup) (*azgo.VolumeModifyIterResponse, error)
@@ -325,6 +334,8 @@ type ZapiClientInterface interface {
 		error)
 	SnapmirrorInitialize(localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) (*azgo.SnapmirrorInitializeResponse,
 		error)
+	SnapmirrorUpdate(localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string) (*azgo.SnapmirrorUpdateResponse,
+		error)
 	SnapmirrorResync(localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName string) (*azgo.SnapmirrorResyncResponse,
 		error)
 	SnapmirrorBreak(localInternalVolumeName, localSVMName, remoteFlexvolName, remoteSVMName, snapshotName string) (*azgo.SnapmirrorBreakResponse,
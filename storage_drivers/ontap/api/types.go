@@ -2,6 +2,8 @@
 
 package api
 
+import "github.com/netapp/trident/utils"
+
 //go:generate mockgen -destination=../../../mocks/mock_storage_drivers/mock_ontap/mock_api.go github.com/netapp/trident/storage_drivers/ontap/api OntapAPI,AggregateSpace,Response
 
 type Volume struct {
@@ -24,6 +26,9 @@ type Volume struct {
 	UnixPermissions   string
 	UUID              string
 	DPVolume          bool
+
+	// ConstituentsPerAggregate is only used to create a FlexGroup, and leaves the choice to ONTAP if not set
+	ConstituentsPerAggregate int
 }
 
 type (
@@ -31,6 +36,29 @@ type (
 	VolumeNameList []string
 )
 
+// VolumeMove describes the latest move of a volume to another aggregate
+type VolumeMove struct {
+	DestinationAggregate string
+	State                string
+	PercentComplete      int
+}
+
+const (
+	VolumeMoveStateSuccess = "success"
+	VolumeMoveStateFailed  = "failed"
+	VolumeMoveStateAborted = "aborted"
+)
+
+// IsRunning returns whether a volume move is still in progress
+func (m *VolumeMove) IsRunning() bool {
+	switch m.State {
+	case "", VolumeMoveStateSuccess, VolumeMoveStateFailed, VolumeMoveStateAborted:
+		return false
+	default:
+		return true
+	}
+}
+
 type Snapshot struct {
 	CreateTime string
 	Name       string
@@ -56,6 +84,19 @@ type Lun struct {
 	SpaceAllocated *bool
 }
 
+// Metrics describes the space used by a volume or LUN and its performance, averaged by ONTAP over a short sample
+type Metrics struct {
+	Name            string
+	UsedBytes       int64
+	TotalBytes      int64
+	ReadIOPS        int64
+	WriteIOPS       int64
+	ReadThroughput  int64 // Bytes per second
+	WriteThroughput int64 // Bytes per second
+	ReadLatency     int64 // Microseconds
+	WriteLatency    int64 // Microseconds
+}
+
 type LunMap struct {
 	IgroupName string
 	LunID      int
@@ -63,6 +104,26 @@ type LunMap struct {
 
 type Luns []Lun
 
+type NVMeNamespace struct {
+	UUID       string
+	Name       string
+	Size       string
+	OsType     string
+	BlockSize  int
+	State      string
+	Comment    string
+	Mapped     bool
+	VolumeName string
+}
+
+type NVMeNamespaces []NVMeNamespace
+
+type NVMeSubsystem struct {
+	UUID string
+	Name string
+	NQN  string
+}
+
 type IscsiInitiatorAuth struct {
 	SVMName                string
 	ChapUser               string
@@ -73,6 +134,32 @@ type IscsiInitiatorAuth struct {
 	AuthType               string
 }
 
+// ExportRule is a rule of an export policy, with the security flavors it allows for read-only, read-write and
+// superuser access.
+type ExportRule struct {
+	Index     int
+	RoRule    []string
+	RwRule    []string
+	SuperUser []string
+}
+
+// HasSecurityFlavors returns whether a rule allows exactly the given security flavors for every kind of access.
+func (r *ExportRule) HasSecurityFlavors(securityFlavors []string) bool {
+	securityFlavors = exportRuleSecurityFlavors(securityFlavors)
+	sameFlavors := func(flavors []string) bool {
+		if len(flavors) != len(securityFlavors) {
+			return false
+		}
+		for _, flavor := range flavors {
+			if !utils.SliceContainsString(securityFlavors, flavor) {
+				return false
+			}
+		}
+		return true
+	}
+	return sameFlavors(r.RoRule) && sameFlavors(r.RwRule) && sameFlavors(r.SuperUser)
+}
+
 type Qtree struct {
 	ExportPolicy    string
 	Name            string
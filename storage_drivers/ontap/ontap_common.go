@@ -372,27 +372,155 @@ func reconcileExportPolicyRules(
 
 	// first grab all existing rules
 	rules, err := clientAPI.ExportRuleList(ctx, policyName)
+	securityFlavors := getExportRuleSecurityFlavors(config)
 
 	for _, rule := range desiredPolicyRules {
-		if _, ok := rules[rule]; ok {
+		if existingRule, ok := rules[rule]; ok && existingRule.HasSecurityFlavors(securityFlavors) {
 			// Rule already exists and we want it, so don't create it or delete it
 			delete(rules, rule)
 		} else {
-			// Rule does not exist, so create it
-			if err = clientAPI.ExportRuleCreate(ctx, policyName, rule, config.NASType); err != nil {
+			// Rule does not exist or allows other security flavors, so create it; any rule it replaces is
+			// deleted below
+			if err = clientAPI.ExportRuleCreate(ctx, policyName, rule, config.NASType, securityFlavors); err != nil {
 				return err
 			}
 		}
 	}
 	// Now that the desired rules exists, delete the undesired rules
-	for _, ruleIndex := range rules {
-		if err = clientAPI.ExportRuleDestroy(ctx, policyName, ruleIndex); err != nil {
+	for _, rule := range rules {
+		if err = clientAPI.ExportRuleDestroy(ctx, policyName, rule.Index); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// getVolumeExportPolicyName returns the name of the export policy of a volume or qtree that has its own, which is
+// named for the volume or qtree.
+func getVolumeExportPolicyName(internalName string) string {
+	return internalName
+}
+
+// usesVolumeExportPolicy returns whether a volume or qtree has its own export policy, which grants access only to
+// the nodes to which it is published.
+func usesVolumeExportPolicy(config *drivers.OntapStorageDriverConfig, volConfig *storage.VolumeConfig) bool {
+	return tridentconfig.CurrentDriverContext == tridentconfig.ContextCSI && config.AutoExportPolicy &&
+		config.PerVolumeExportPolicy && volConfig.AccessInfo.PublishEnforcement && !volConfig.ImportNotManaged
+}
+
+// enableVolumeExportPolicy gives a volume or qtree its own export policy, with no rules until the volume is
+// published.  It must only be called while the volume is published to no node, as any node mounting it through
+// the backend's export policy loses access.
+func enableVolumeExportPolicy(
+	ctx context.Context, clientAPI api.OntapAPI, volConfig *storage.VolumeConfig, policyName string,
+	modifyExportPolicy func(ctx context.Context, policyName string) error,
+) error {
+	fields := LogFields{
+		"volume":       volConfig.Name,
+		"internalName": volConfig.InternalName,
+		"exportPolicy": policyName,
+	}
+
+	// Do not enable publish enforcement on unmanaged imports.
+	if volConfig.ImportNotManaged {
+		Logc(ctx).WithFields(fields).Debug("Unable to set export policy; imported volume is not managed.")
+		return nil
+	}
+
+	if err := ensureExportPolicyExists(ctx, policyName, clientAPI); err != nil {
+		return fmt.Errorf("error creating export policy %s; %v", policyName, err)
+	}
+	if err := modifyExportPolicy(ctx, policyName); err != nil {
+		return fmt.Errorf("error setting export policy %s of volume %s; %v", policyName, volConfig.InternalName,
+			err)
+	}
+
+	volConfig.ExportPolicy = policyName
+	volConfig.AccessInfo.PublishEnforcement = true
+
+	Logc(ctx).WithFields(fields).Debug("Volume has its own export policy.")
+
+	return nil
+}
+
+// publishVolumeExportPolicy grants the node in publishInfo access to a volume or qtree that has its own export
+// policy, by adding a rule for the node's IPs to the policy.
+func publishVolumeExportPolicy(
+	ctx context.Context, clientAPI api.OntapAPI, config *drivers.OntapStorageDriverConfig,
+	publishInfo *utils.VolumePublishInfo, policyName string,
+) error {
+	var node *utils.Node
+	for _, n := range publishInfo.Nodes {
+		if n.Name == publishInfo.HostName {
+			node = n
+			break
+		}
+	}
+	if node == nil {
+		return fmt.Errorf("node %s not found", publishInfo.HostName)
+	}
+
+	desiredRules, err := getDesiredExportPolicyRules(ctx, []*utils.Node{node}, config)
+	if err != nil {
+		return fmt.Errorf("unable to determine desired export policy rules; %v", err)
+	}
+	if len(desiredRules) == 0 {
+		return fmt.Errorf("node %s has no IP addresses within autoExportCIDRs", node.Name)
+	}
+
+	if err = ensureExportPolicyExists(ctx, policyName, clientAPI); err != nil {
+		return fmt.Errorf("error creating export policy %s; %v", policyName, err)
+	}
+	rules, err := clientAPI.ExportRuleList(ctx, policyName)
+	if err != nil {
+		return fmt.Errorf("error listing rules of export policy %s; %v", policyName, err)
+	}
+	securityFlavors := getExportRuleSecurityFlavors(config)
+	for _, rule := range desiredRules {
+		existingRule, ok := rules[rule]
+		if ok && existingRule.HasSecurityFlavors(securityFlavors) {
+			continue
+		}
+		if err = clientAPI.ExportRuleCreate(ctx, policyName, rule, config.NASType, securityFlavors); err != nil {
+			return err
+		}
+		// Replace a rule that allows other security flavors
+		if ok {
+			if err = clientAPI.ExportRuleDestroy(ctx, policyName, existingRule.Index); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unpublishVolumeExportPolicy revokes the access of a node to a volume or qtree that has its own export policy, by
+// reducing the rules of the policy to those for the nodes in publishInfo, to which the volume remains published.
+func unpublishVolumeExportPolicy(
+	ctx context.Context, clientAPI api.OntapAPI, config *drivers.OntapStorageDriverConfig,
+	publishInfo *utils.VolumePublishInfo, policyName string,
+) error {
+	desiredRules, err := getDesiredExportPolicyRules(ctx, publishInfo.Nodes, config)
+	if err != nil {
+		return fmt.Errorf("unable to determine desired export policy rules; %v", err)
+	}
+	if err = reconcileExportPolicyRules(ctx, policyName, desiredRules, clientAPI, config); err != nil {
+		return fmt.Errorf("error revoking access of node %s to export policy %s; %v", publishInfo.HostName,
+			policyName, err)
+	}
+	return nil
+}
+
+// destroyVolumeExportPolicy deletes the export policy of a volume or qtree that had its own.  A policy left behind
+// applies to no volume, so failures are only logged.
+func destroyVolumeExportPolicy(ctx context.Context, clientAPI api.OntapAPI, policyName string) {
+	if err := clientAPI.ExportPolicyDestroy(ctx, policyName); err != nil {
+		Logc(ctx).WithField("exportPolicy", policyName).WithError(err).Warning(
+			"Could not delete export policy of volume.")
+	}
+}
+
 // getSVMState gets the backend SVM state and reason for offline if any.
 // Input:
 // protocol - to get the data LIFs of similar service from backend.
@@ -425,8 +553,13 @@ func getSVMState(
 		return StateReasonSVMStopped, changeMap
 	}
 
-	// Get data LIFs.
-	upDataLIFs, err := client.NetInterfaceGetDataLIFs(ctx, protocol)
+	// Get data LIFs.  FC interfaces have no IP addresses, so they are listed separately.
+	var upDataLIFs []string
+	if protocol == sa.FCP {
+		upDataLIFs, err = client.FcpInterfaceGet(ctx, client.SVMName())
+	} else {
+		upDataLIFs, err = client.NetInterfaceGetDataLIFs(ctx, protocol)
+	}
 	if err != nil || len(upDataLIFs) == 0 {
 		if err != nil {
 			// Log error and keep going.
@@ -498,12 +631,15 @@ func reconcileSANNodeAccess(
 }
 
 // filterUnusedTridentIgroups returns Trident-created igroups not in use for backend. Includes per-backend
-// igroup and any of the form <node name>-<trident uuid>.
+// igroup and any per-node igroup of the form <node name>-<protocol>-<backend uuid> or the legacy form
+// <node name>-<trident uuid>.
 func filterUnusedTridentIgroups(igroups, nodes []string, backendUUID, tridentUUID string) []string {
 	unusedIgroups := make([]string, 0, len(igroups))
-	nodeMap := make(map[string]struct{}, len(nodes))
+	usedIgroups := make(map[string]struct{}, 3*len(nodes))
 	for _, node := range nodes {
-		nodeMap[node] = struct{}{}
+		usedIgroups[getNodeSpecificIgroupName(node, sa.ISCSI, backendUUID)] = struct{}{}
+		usedIgroups[getNodeSpecificIgroupName(node, sa.FCP, backendUUID)] = struct{}{}
+		usedIgroups[getLegacyNodeSpecificIgroupName(node, tridentUUID)] = struct{}{}
 	}
 	backendIgroup := getDefaultIgroupName(tridentconfig.ContextCSI, backendUUID)
 
@@ -511,11 +647,10 @@ func filterUnusedTridentIgroups(igroups, nodes []string, backendUUID, tridentUUI
 		if igroup == backendIgroup {
 			// Always include deprecated backend igroup
 			unusedIgroups = append(unusedIgroups, igroup)
-		} else if strings.HasSuffix(igroup, tridentUUID) {
-			// Skip igroups without trident uuid
-			igroupNodeName := strings.TrimSuffix(igroup, "-"+tridentUUID)
-			if _, ok := nodeMap[igroupNodeName]; !ok {
-				// Include igroup that is not part of node map
+		} else if strings.HasSuffix(igroup, "-"+backendUUID) || strings.HasSuffix(igroup, "-"+tridentUUID) {
+			// Skip igroups without backend or trident uuid
+			if _, ok := usedIgroups[igroup]; !ok {
+				// Include igroup that is not used by any node
 				unusedIgroups = append(unusedIgroups, igroup)
 			}
 		}
@@ -603,19 +738,67 @@ func PopulateOntapLunMapping(
 	return nil
 }
 
-// getNodeSpecificIgroupName generates a distinct igroup name for node name.
-// Igroup names may collide if node names are over 59 characters.
-func getNodeSpecificIgroupName(nodeName, tridentUUID string) string {
+// getNodeSpecificIgroupName generates a distinct name for the igroup holding a node's initiators of the given
+// protocol, of the form <node name>-<protocol>-<backend uuid>.  Including the protocol and backend keeps a node's
+// iSCSI and FC igroups apart, as well as those of backends sharing an SVM.
+// Igroup names may collide if node names are over 53 characters.
+func getNodeSpecificIgroupName(nodeName, protocol, backendUUID string) string {
+	suffix := fmt.Sprintf("-%s-%s", protocol, backendUUID)
+
+	if len(nodeName)+len(suffix) > MaximumIgroupNameLength {
+		// If the new igroup name is over the igroup character limit, it means the host name is too long.
+		nodeName = nodeName[:MaximumIgroupNameLength-len(suffix)]
+	}
+	return nodeName + suffix
+}
+
+// getLegacyNodeSpecificIgroupName returns the name of a per-node igroup created by earlier releases, which was
+// shared by all iSCSI backends on an SVM.
+func getLegacyNodeSpecificIgroupName(nodeName, tridentUUID string) string {
 	igroupName := fmt.Sprintf("%s-%s", nodeName, tridentUUID)
 
 	if len(igroupName) > MaximumIgroupNameLength {
-		// If the new igroup name is over the igroup character limit, it means the host name is too long.
 		igroupPrefixLength := MaximumIgroupNameLength - len(tridentUUID) - 1
 		igroupName = fmt.Sprintf("%s-%s", nodeName[:igroupPrefixLength], tridentUUID)
 	}
 	return igroupName
 }
 
+// unpublishLUNFromNode unmaps a LUN from the per-node igroups of the node specified in publishInfo, including
+// any legacy igroup recorded in the volume's access info, and destroys those igroups once no LUNs remain mapped.
+func unpublishLUNFromNode(
+	ctx context.Context, clientAPI api.OntapAPI, volConfig *storage.VolumeConfig,
+	publishInfo *utils.VolumePublishInfo, lunPath, protocol string,
+) error {
+	igroupNames := []string{getNodeSpecificIgroupName(publishInfo.HostName, protocol, publishInfo.BackendUUID)}
+	legacyIgroupName := getLegacyNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	if utils.SliceContainsString(strings.Split(volConfig.AccessInfo.IscsiIgroup, ","), legacyIgroupName) {
+		igroupNames = append(igroupNames, legacyIgroupName)
+	}
+
+	for _, igroupName := range igroupNames {
+		if err := LunUnmapIgroup(ctx, clientAPI, igroupName, lunPath); err != nil {
+			return fmt.Errorf("error unmapping LUN %s from igroup %s; %v", lunPath, igroupName, err)
+		}
+
+		// Remove igroup from volume config's access Info
+		if protocol == sa.FCP {
+			volConfig.AccessInfo.FCPIgroup = removeIgroupFromIscsiIgroupList(volConfig.AccessInfo.FCPIgroup,
+				igroupName)
+		} else {
+			volConfig.AccessInfo.IscsiIgroup = removeIgroupFromIscsiIgroupList(volConfig.AccessInfo.IscsiIgroup,
+				igroupName)
+		}
+
+		// Remove igroup if no LUNs are mapped.
+		if err := DestroyUnmappedIgroup(ctx, clientAPI, igroupName); err != nil {
+			return fmt.Errorf("error removing empty igroup; %v", err)
+		}
+	}
+
+	return nil
+}
+
 // PublishLUN publishes the volume to the host specified in publishInfo from ontap-san or
 // ontap-san-economy. This method may or may not be running on the host where the volume will be
 // mounted, so it should limit itself to updating access rules, initiator groups, etc. that require
@@ -747,6 +930,111 @@ func PublishLUN(
 	return nil
 }
 
+// GetFCPTargetInfo returns the FC target WWNN and the WWPNs of the FC interfaces of the provided client's SVM.
+func GetFCPTargetInfo(
+	ctx context.Context, clientAPI api.OntapAPI, config *drivers.OntapStorageDriverConfig,
+) (fcpNodeName string, fcpInterfaces []string, returnError error) {
+	fcpNodeName, err := clientAPI.FcpNodeGetNameRequest(ctx)
+	if err != nil {
+		returnError = fmt.Errorf("could not get SVM FCP node name: %v", err)
+		return
+	}
+
+	fcpInterfaces, err = clientAPI.FcpInterfaceGet(ctx, config.SVM)
+	if err != nil {
+		returnError = fmt.Errorf("could not get SVM FC interfaces: %v", err)
+		return
+	}
+	if fcpInterfaces == nil {
+		returnError = fmt.Errorf("SVM %s has no active FC interfaces", config.SVM)
+		return
+	}
+
+	return
+}
+
+// PublishFCPLUN publishes a LUN to the host specified in publishInfo over Fibre Channel.  The host's WWPNs
+// are added to a per-node igroup of type fcp, to which the LUN is mapped.  Like PublishLUN, this method
+// limits itself to storage controller operations.
+func PublishFCPLUN(
+	ctx context.Context, clientAPI api.OntapAPI, config *drivers.OntapStorageDriverConfig,
+	publishInfo *utils.VolumePublishInfo, lunPath, igroupName, fcpNodeName string,
+) error {
+	fields := LogFields{
+		"Method":      "PublishFCPLUN",
+		"Type":        "ontap_common",
+		"lunPath":     lunPath,
+		"igroup":      igroupName,
+		"fcpNodeName": fcpNodeName,
+		"publishInfo": publishInfo,
+	}
+	Logd(ctx, config.StorageDriverName, config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> PublishFCPLUN")
+	defer Logd(ctx, config.StorageDriverName,
+		config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< PublishFCPLUN")
+
+	// Host WWPNs must have been passed in
+	if len(publishInfo.HostWWPN) == 0 {
+		return fmt.Errorf("no FC WWPNs are known for node %s", publishInfo.HostName)
+	}
+
+	// Get the fstype
+	fstype := drivers.DefaultFileSystemType
+	lunFSType, err := clientAPI.LunGetFSType(ctx, lunPath)
+	if err != nil || lunFSType == "" {
+		if err != nil {
+			Logc(ctx).Warnf("failed to get fstype for LUN: %v", err)
+		}
+		Logc(ctx).WithFields(LogFields{
+			"LUN":    lunPath,
+			"fstype": fstype,
+		}).Warn("LUN attribute fstype not found, using default.")
+	} else {
+		fstype = lunFSType
+	}
+
+	lun, err := clientAPI.LunGetByName(ctx, lunPath)
+	if err != nil || lun == nil {
+		return fmt.Errorf("problem retrieving LUN info: %v", err)
+	}
+
+	if err = clientAPI.IgroupCreate(ctx, igroupName, sa.FCP, "linux"); err != nil {
+		return fmt.Errorf("error creating igroup: %v", err)
+	}
+
+	// Add WWPNs to igroup
+	for _, wwpn := range publishInfo.HostWWPN {
+		if err = clientAPI.EnsureIgroupAdded(ctx, igroupName, wwpn); err != nil {
+			return fmt.Errorf("error adding WWPN %v to igroup %v: %v", wwpn, igroupName, err)
+		}
+	}
+
+	// Map LUN (it may already be mapped)
+	lunID, err := clientAPI.EnsureLunMapped(ctx, igroupName, lunPath)
+	if err != nil {
+		return err
+	}
+
+	// xfs volumes are always mounted with '-o nouuid' to allow clones to be mounted to the same node as the source
+	if fstype == tridentconfig.FsXfs {
+		publishInfo.MountOptions = drivers.EnsureMountOption(publishInfo.MountOptions, drivers.MountOptionNoUUID)
+	}
+
+	// Add fields needed by Attach
+	publishInfo.SANType = sa.FCP
+	publishInfo.FCPLunNumber = int32(lunID)
+	publishInfo.FCTargetWWNN = fcpNodeName
+	publishInfo.FCPLunSerial = lun.SerialNumber
+	if publishInfo.FCPIgroup == "" {
+		publishInfo.FCPIgroup = igroupName
+	} else if !strings.Contains(publishInfo.FCPIgroup, igroupName) {
+		publishInfo.FCPIgroup += "," + igroupName
+	}
+	publishInfo.FilesystemType = fstype
+	publishInfo.SharedTarget = true
+
+	return nil
+}
+
 // addUniqueIscsiIGroupName added iscsiIgroup name in the IscsiIgroup name string if it is not present.
 func addUniqueIscsiIGroupName(publishInfo *utils.VolumePublishInfo, igroupName string) {
 	if publishInfo.IscsiIgroup == "" {
@@ -915,6 +1203,11 @@ func InitializeSANDriver(
 		return err
 	}
 
+	// NVMe uses neither igroups nor CHAP, and FCP uses only the per-node igroups created during publish
+	if config.SANType == sa.NVMe || config.SANType == sa.FCP {
+		return nil
+	}
+
 	if config.DriverContext != tridentconfig.ContextCSI {
 		if config.IgroupName == "" {
 			config.IgroupName = getDefaultIgroupName(driverContext, backendUUID)
@@ -1106,6 +1399,23 @@ func ValidateSANDriver(
 			Warning("Specifying data LIF is no longer supported for SAN backends.")
 	}
 
+	switch config.SANType {
+	case "", sa.ISCSI:
+	case sa.NVMe, sa.FCP:
+		if !config.UseREST {
+			return fmt.Errorf("sanType %s requires the ONTAP REST API; set useREST to true", config.SANType)
+		}
+		if config.DriverContext != tridentconfig.ContextCSI {
+			return fmt.Errorf("sanType %s is only supported with CSI", config.SANType)
+		}
+		if config.UseCHAP {
+			return fmt.Errorf("useCHAP is not supported with sanType %s", config.SANType)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid sanType %s; must be %s, %s or %s", config.SANType, sa.ISCSI, sa.NVMe, sa.FCP)
+	}
+
 	switch config.DriverContext {
 	case tridentconfig.ContextDocker:
 		// Make sure this host is logged into the ONTAP iSCSI target
@@ -1184,9 +1494,56 @@ func ValidateNASDriver(
 		return fmt.Errorf("failed to validate auto-export CIDR(s): %w", err)
 	}
 
+	if err := validateNfsSecurity(config); err != nil {
+		return err
+	}
+
+	if config.PerVolumeExportPolicy && !config.AutoExportPolicy {
+		return fmt.Errorf("perVolumeExportPolicy requires autoExportPolicy")
+	}
+
 	return nil
 }
 
+// validateNfsSecurity ensures that the NFS security flavor of a backend is known, that it is not requested
+// for SMB, and that a Kerberos flavor does not conflict with any sec= option in the NFS mount options.
+func validateNfsSecurity(config *drivers.OntapStorageDriverConfig) error {
+	if config.NfsSecurity == "" {
+		return nil
+	}
+	if !utils.IsValidNFSSecurity(config.NfsSecurity) {
+		return fmt.Errorf("invalid value for nfsSecurity: %s; must be one of %s, %s, %s or %s",
+			config.NfsSecurity, utils.NFSSecuritySys, utils.NFSSecurityKrb5, utils.NFSSecurityKrb5i,
+			utils.NFSSecurityKrb5p)
+	}
+	if config.NASType == sa.SMB && config.NfsSecurity != utils.NFSSecuritySys {
+		return fmt.Errorf("nfsSecurity %s is not supported for NASType %s", config.NfsSecurity, config.NASType)
+	}
+	flavor := utils.GetNFSSecurityFlavor(config.NfsMountOptions)
+	if utils.IsKerberosNFSSecurity(config.NfsSecurity) && flavor != "" && flavor != config.NfsSecurity {
+		return fmt.Errorf("nfsMountOptions option sec=%s conflicts with nfsSecurity %s", flavor, config.NfsSecurity)
+	}
+	return nil
+}
+
+// getExportRuleSecurityFlavors returns the security flavors with which export rules allow read-only, read-write
+// and superuser access.  Kerberos backends allow only their own flavor, while others allow any flavor.
+func getExportRuleSecurityFlavors(config *drivers.OntapStorageDriverConfig) []string {
+	if config.NASType == sa.NFS && utils.IsKerberosNFSSecurity(config.NfsSecurity) {
+		return []string{config.NfsSecurity}
+	}
+	return []string{"any"}
+}
+
+// getNfsMountOptions returns the mount options with which NFS volumes are published, which for Kerberos
+// backends always include a sec= option for the backend's flavor.
+func getNfsMountOptions(config *drivers.OntapStorageDriverConfig, mountOptions string) string {
+	if utils.IsKerberosNFSSecurity(config.NfsSecurity) {
+		return utils.SetNFSSecurityMountOption(mountOptions, config.NfsSecurity)
+	}
+	return mountOptions
+}
+
 func ValidateStoragePrefix(storagePrefix string) error {
 	// Ensure storage prefix is compatible with ONTAP
 	matched, err := regexp.MatchString(`^$|^[a-zA-Z_.-][a-zA-Z0-9_.-]*$`, storagePrefix)
@@ -1216,6 +1573,7 @@ const (
 	DefaultSecurityStyleSMB          = "ntfs"
 	DefaultNfsMountOptionsDocker     = "-o nfsvers=3"
 	DefaultNfsMountOptionsKubernetes = ""
+	DefaultNfsSecurity               = utils.NFSSecuritySys
 	DefaultSplitOnClone              = "false"
 	DefaultLuksEncryption            = "false"
 	DefaultMirroring                 = "false"
@@ -1273,6 +1631,7 @@ func PopulateConfigurationDefaults(ctx context.Context, config *drivers.OntapSto
 
 	if config.DriverContext != tridentconfig.ContextCSI {
 		config.AutoExportPolicy = false
+		config.PerVolumeExportPolicy = false
 	}
 
 	if config.AutoExportPolicy {
@@ -1336,6 +1695,11 @@ func PopulateConfigurationDefaults(ctx context.Context, config *drivers.OntapSto
 		config.NASType = sa.NFS
 	}
 
+	// If SANType is not provided in the backend config, default to iSCSI
+	if config.SANType == "" {
+		config.SANType = sa.ISCSI
+	}
+
 	switch config.NASType {
 	case sa.SMB:
 		if config.SecurityStyle == "" {
@@ -1357,6 +1721,9 @@ func PopulateConfigurationDefaults(ctx context.Context, config *drivers.OntapSto
 		if config.SecurityStyle == "" {
 			config.SecurityStyle = DefaultSecurityStyleNFS
 		}
+		if config.NfsSecurity == "" {
+			config.NfsSecurity = DefaultNfsSecurity
+		}
 	}
 
 	Logc(ctx).WithFields(LogFields{
@@ -1370,6 +1737,7 @@ func PopulateConfigurationDefaults(ctx context.Context, config *drivers.OntapSto
 		"ExportPolicy":           config.ExportPolicy,
 		"SecurityStyle":          config.SecurityStyle,
 		"NfsMountOptions":        config.NfsMountOptions,
+		"NfsSecurity":            config.NfsSecurity,
 		"SplitOnClone":           config.SplitOnClone,
 		"FileSystemType":         config.FileSystemType,
 		"Encryption":             config.Encryption,
@@ -1381,7 +1749,9 @@ func PopulateConfigurationDefaults(ctx context.Context, config *drivers.OntapSto
 		"TieringPolicy":          config.TieringPolicy,
 		"AutoExportPolicy":       config.AutoExportPolicy,
 		"AutoExportCIDRs":        config.AutoExportCIDRs,
+		"PerVolumeExportPolicy":  config.PerVolumeExportPolicy,
 		"FlexgroupAggregateList": config.FlexGroupAggregateList,
+		"SANType":                config.SANType,
 	}).Debugf("Configuration defaults")
 
 	return nil
@@ -1843,6 +2213,9 @@ func InitializeStoragePoolsCommon(
 
 		pool.Attributes()[sa.Labels] = sa.NewLabelOffer(config.Labels)
 		pool.Attributes()[sa.NASType] = sa.NewStringOffer(config.NASType)
+		if config.NASType == sa.NFS && config.NfsSecurity != "" {
+			pool.Attributes()[sa.NFSSecurity] = sa.NewStringOffer(config.NfsSecurity)
+		}
 
 		pool.InternalAttributes()[Size] = config.Size
 		pool.InternalAttributes()[Region] = config.Region
@@ -1985,6 +2358,9 @@ func InitializeStoragePoolsCommon(
 
 		pool.Attributes()[sa.Labels] = sa.NewLabelOffer(config.Labels, vpool.Labels)
 		pool.Attributes()[sa.NASType] = sa.NewStringOffer(nasType)
+		if nasType == sa.NFS && config.NfsSecurity != "" {
+			pool.Attributes()[sa.NFSSecurity] = sa.NewStringOffer(config.NfsSecurity)
+		}
 
 		if region != "" {
 			pool.Attributes()[sa.Region] = sa.NewStringOffer(region)
@@ -2341,6 +2717,138 @@ func getStorageBackendPhysicalPoolNamesCommon(physicalPools map[string]storage.P
 	return physicalPoolNames
 }
 
+// getPoolCapacityCommon returns the space in the aggregates backing a storage pool.  A physical pool
+// is a single aggregate, while a virtual pool may place volumes on any aggregate assigned to the SVM.
+func getPoolCapacityCommon(
+	ctx context.Context, pool storage.Pool, physicalPools, virtualPools map[string]storage.Pool, client api.OntapAPI,
+) (*storage.PoolCapacity, error) {
+	var aggregates []string
+
+	if _, ok := physicalPools[pool.Name()]; ok {
+		aggregates = []string{pool.Name()}
+	} else if _, ok = virtualPools[pool.Name()]; ok {
+		aggregates = getStorageBackendPhysicalPoolNamesCommon(physicalPools)
+	} else {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	return getAggregateCapacityCommon(ctx, aggregates, client)
+}
+
+// getAggregateCapacityCommon adds up the total and free space of a set of aggregates
+func getAggregateCapacityCommon(
+	ctx context.Context, aggregates []string, client api.OntapAPI,
+) (*storage.PoolCapacity, error) {
+	capacity := &storage.PoolCapacity{
+		PhysicalPools: make([]string, 0, len(aggregates)),
+	}
+
+	for _, aggregate := range aggregates {
+		aggrSpaceList, err := client.GetSVMAggregateSpace(ctx, aggregate)
+		if err != nil {
+			return nil, fmt.Errorf("could not get space for aggregate %s; %v", aggregate, err)
+		}
+		if len(aggrSpaceList) == 0 {
+			Logc(ctx).WithField("aggregate", aggregate).Warning("No space information found for aggregate.")
+			continue
+		}
+
+		// Only the first record for the aggregate is used, which is consistent with checkAggregateLimits
+		aggrSpace := aggrSpaceList[0]
+		capacity.PhysicalPools = append(capacity.PhysicalPools, aggregate)
+		capacity.TotalBytes += uint64(aggrSpace.Size())
+		if aggrSpace.Size() > aggrSpace.Used() {
+			capacity.FreeBytes += uint64(aggrSpace.Size() - aggrSpace.Used())
+		}
+	}
+
+	return capacity, nil
+}
+
+// getFlexvolHealthCommon reports whether a Flexvol is missing, offline or out of space.
+func getFlexvolHealthCommon(ctx context.Context, name string, client api.OntapAPI) (*storage.VolumeHealth, error) {
+	exists, err := client.VolumeExists(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not check for volume %s; %v", name, err)
+	}
+	if !exists {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s was not found or is not online", name),
+		}, nil
+	}
+
+	volume, err := client.VolumeInfo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get volume %s; %v", name, err)
+	}
+
+	usedBytes, err := client.VolumeUsedSize(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get used space of volume %s; %v", name, err)
+	}
+
+	return getVolumeSpaceHealth(name, volume, usedBytes), nil
+}
+
+// getVolumeSpaceHealth reports a volume as abnormal if its data has consumed all the space not set
+// aside for snapshots.
+func getVolumeSpaceHealth(name string, volume *api.Volume, usedBytes int) *storage.VolumeHealth {
+	sizeBytes, err := strconv.ParseUint(volume.Size, 10, 64)
+	if err != nil || sizeBytes == 0 {
+		// Without a size, there is nothing to compare against
+		return &storage.VolumeHealth{}
+	}
+
+	usableBytes := sizeBytes * uint64(100-volume.SnapshotReserve) / 100
+	if usedBytes >= 0 && uint64(usedBytes) >= usableBytes {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s is full", name),
+		}
+	}
+
+	return &storage.VolumeHealth{}
+}
+
+// getVolumeMetricsCommon returns the space used and the performance of a set of volumes, keyed by volume name.
+// The metrics of every storage object matching the pattern are retrieved with one request, and are matched to
+// the volumes by the object name for each volume.
+func getVolumeMetricsCommon(
+	ctx context.Context, volConfigs []*storage.VolumeConfig, pattern string,
+	listMetrics func(context.Context, string) (map[string]*api.Metrics, error), objectName func(string) string,
+) (map[string]*storage.VolumeMetrics, error) {
+	objectMetrics, err := listMetrics(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeMetrics := make(map[string]*storage.VolumeMetrics)
+	for _, volConfig := range volConfigs {
+		metrics, ok := objectMetrics[objectName(volConfig.InternalName)]
+		if !ok {
+			continue
+		}
+		volumeMetrics[volConfig.Name] = &storage.VolumeMetrics{
+			UsedBytes:       metrics.UsedBytes,
+			TotalBytes:      metrics.TotalBytes,
+			ReadIOPS:        metrics.ReadIOPS,
+			WriteIOPS:       metrics.WriteIOPS,
+			ReadThroughput:  metrics.ReadThroughput,
+			WriteThroughput: metrics.WriteThroughput,
+			ReadLatency:     metrics.ReadLatency,
+			WriteLatency:    metrics.WriteLatency,
+		}
+	}
+
+	return volumeMetrics, nil
+}
+
+// flexvolName is the name of the ONTAP volume that backs a Trident volume in the Flexvol and FlexGroup drivers.
+func flexvolName(internalName string) string {
+	return internalName
+}
+
 func getPoolsForCreate(
 	ctx context.Context, volConfig *storage.VolumeConfig, storagePool storage.Pool,
 	volAttributes map[string]sa.Request, physicalPools, virtualPools map[string]storage.Pool,
@@ -2385,6 +2893,64 @@ func getPoolsForCreate(
 	return candidatePools, nil
 }
 
+// moveFlexvol moves a FlexVol to the aggregate of a physical pool, returning whether the move is still running and
+// its percentage complete.  A move is only started if the volume is neither in that aggregate nor already moving
+// there, so it may be called repeatedly until the move completes.  If the last move to that aggregate failed, a
+// VolumeMoveFailedError is returned, and the move is only started again if restartFailed is set.
+func moveFlexvol(
+	ctx context.Context, name string, targetPool storage.Pool, physicalPools map[string]storage.Pool,
+	client api.OntapAPI, restartFailed bool,
+) (bool, int, error) {
+	aggregate := targetPool.Name()
+	if _, ok := physicalPools[aggregate]; !ok {
+		return false, 0, fmt.Errorf("volumes may only be moved to a physical pool, and %s is not one", aggregate)
+	}
+
+	volume, err := client.VolumeInfo(ctx, name)
+	if err != nil {
+		return false, 0, err
+	}
+	if len(volume.Aggregates) > 0 && volume.Aggregates[0] == aggregate {
+		return false, 100, nil
+	}
+
+	volumeMove, err := client.VolumeMoveInfo(ctx, name)
+	if err != nil {
+		return false, 0, err
+	}
+	if volumeMove != nil {
+		if volumeMove.IsRunning() {
+			if volumeMove.DestinationAggregate != aggregate {
+				return false, 0, fmt.Errorf("volume %s is already moving to aggregate %s", name,
+					volumeMove.DestinationAggregate)
+			}
+			return true, volumeMove.PercentComplete, nil
+		}
+		if volumeMove.State != api.VolumeMoveStateSuccess {
+			if volumeMove.DestinationAggregate == aggregate {
+				moveErr := fmt.Sprintf("move of volume %s to aggregate %s %s", name, aggregate, volumeMove.State)
+				if !restartFailed {
+					return false, 0, utils.VolumeMoveFailedError(moveErr)
+				}
+				if err = client.VolumeMoveStart(ctx, name, aggregate); err != nil {
+					return false, 0, err
+				}
+				return true, 0, utils.VolumeMoveFailedError(moveErr + "; restarting it")
+			}
+			Logc(ctx).WithFields(LogFields{
+				"volume":    name,
+				"aggregate": volumeMove.DestinationAggregate,
+				"state":     volumeMove.State,
+			}).Warning("Previous volume move did not succeed.")
+		}
+	}
+
+	if err = client.VolumeMoveStart(ctx, name, aggregate); err != nil {
+		return false, 0, err
+	}
+	return true, 0, nil
+}
+
 func getInternalVolumeNameCommon(commonConfig *drivers.CommonStorageDriverConfig, name string) string {
 	if tridentconfig.UsingPassthroughStore {
 		// With a passthrough store, the name mapping must remain reversible
@@ -2678,6 +3244,84 @@ func createFlexvolSnapshot(
 	return nil, fmt.Errorf("could not find snapshot %s for souce volume %s", internalSnapName, internalVolName)
 }
 
+// createFlexvolGroupSnapshot snapshots a group of Flexvols at a single point in time.  Every snapshot in the
+// group has the internal name of the group snapshot.
+func createFlexvolGroupSnapshot(
+	ctx context.Context, groupConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	config *drivers.OntapStorageDriverConfig, client api.OntapAPI,
+	sizeGetter func(context.Context, string) (int, error),
+) ([]*storage.Snapshot, error) {
+	fields := LogFields{
+		"Method":        "CreateGroupSnapshot",
+		"Type":          "ontap_common",
+		"groupSnapshot": groupConfig.InternalName,
+	}
+	Logd(ctx, config.StorageDriverName,
+		config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, config.StorageDriverName,
+		config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	internalVolNames := make([]string, 0, len(snapConfigs))
+	sizes := make([]int, 0, len(snapConfigs))
+	for _, snapConfig := range snapConfigs {
+		// If any of the volumes doesn't exist, return error
+		volExists, err := client.VolumeExists(ctx, snapConfig.VolumeInternalName)
+		if err != nil {
+			return nil, fmt.Errorf("error checking for existing volume: %v", err)
+		}
+		if !volExists {
+			return nil, fmt.Errorf("volume %s does not exist", snapConfig.VolumeInternalName)
+		}
+
+		size, err := sizeGetter(ctx, snapConfig.VolumeInternalName)
+		if err != nil {
+			return nil, fmt.Errorf("error reading volume size: %v", err)
+		}
+
+		internalVolNames = append(internalVolNames, snapConfig.VolumeInternalName)
+		sizes = append(sizes, size)
+	}
+
+	if err := client.VolumeGroupSnapshotCreate(ctx, groupConfig.InternalName, internalVolNames); err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*storage.Snapshot, 0, len(snapConfigs))
+	for i, snapConfig := range snapConfigs {
+		snapConfig.InternalName = groupConfig.InternalName
+
+		volSnapshots, err := client.VolumeSnapshotList(ctx, snapConfig.VolumeInternalName)
+		if err != nil {
+			return nil, err
+		}
+
+		var snapshot *storage.Snapshot
+		for _, snap := range volSnapshots {
+			if snap.Name == snapConfig.InternalName {
+				snapshot = &storage.Snapshot{
+					Config:    snapConfig,
+					Created:   snap.CreateTime,
+					SizeBytes: int64(sizes[i]),
+					State:     storage.SnapshotStateOnline,
+				}
+				break
+			}
+		}
+		if snapshot == nil {
+			return nil, fmt.Errorf("could not find snapshot %s for source volume %s", snapConfig.InternalName,
+				snapConfig.VolumeInternalName)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"groupSnapshot": groupConfig.InternalName,
+		"volumeNames":   internalVolNames,
+	}).Info("Group snapshot created.")
+
+	return snapshots, nil
+}
+
 // cloneFlexvol creates a volume clone
 func cloneFlexvol(
 	ctx context.Context, name, source, snapshot, labels string, split bool, config *drivers.OntapStorageDriverConfig,
@@ -2851,6 +3495,7 @@ func EnableSANPublishEnforcement(
 	volumeConfig.AccessInfo.IscsiLunNumber = -1
 	volumeConfig.AccessInfo.PublishEnforcement = true
 	volumeConfig.AccessInfo.IscsiIgroup = ""
+	volumeConfig.AccessInfo.FCPIgroup = ""
 	return nil
 }
 
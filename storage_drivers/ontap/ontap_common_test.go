@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -2395,6 +2396,11 @@ func TestEnsureExportPolicyExists(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// newExportRule returns an export rule that allows the given security flavors for every kind of access.
+func newExportRule(index int, securityFlavors ...string) api.ExportRule {
+	return api.ExportRule{Index: index, RoRule: securityFlavors, RwRule: securityFlavors, SuperUser: securityFlavors}
+}
+
 func TestReconcileExportPolicyRules(t *testing.T) {
 	// Test-1: Positive flow
 
@@ -2409,12 +2415,13 @@ func TestReconcileExportPolicyRules(t *testing.T) {
 	}
 	config.NASType = sa.SMB
 	desiredRules := []string{"0.0.0.0/0", "::/0"}
-	ruleList := make(map[string]int)
-	ruleList["0.0.0.1/0"] = 0
-	ruleList["::/0"] = 1
+	ruleList := make(map[string]api.ExportRule)
+	ruleList["0.0.0.1/0"] = newExportRule(0, "any")
+	ruleList["::/0"] = newExportRule(1, "any")
 	mockAPI.EXPECT().ExportRuleList(ctx, "dummyPolicy").Return(ruleList, nil)
-	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], config.NASType).Return(nil)
-	mockAPI.EXPECT().ExportRuleDestroy(ctx, "dummyPolicy", ruleList["0.0.0.1/0"]).Return(nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], config.NASType,
+		[]string{"any"}).Return(nil)
+	mockAPI.EXPECT().ExportRuleDestroy(ctx, "dummyPolicy", ruleList["0.0.0.1/0"].Index).Return(nil)
 
 	err := reconcileExportPolicyRules(ctx, "dummyPolicy", desiredRules, mockAPI, config)
 
@@ -2425,7 +2432,7 @@ func TestReconcileExportPolicyRules(t *testing.T) {
 	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
 	mockAPI.EXPECT().ExportRuleList(ctx, "dummyPolicy").Return(ruleList, nil)
 	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0],
-		config.NASType).Return(fmt.Errorf("Error Creating export rule"))
+		config.NASType, []string{"any"}).Return(fmt.Errorf("Error Creating export rule"))
 
 	err = reconcileExportPolicyRules(ctx, "dummyPolicy", desiredRules, mockAPI, config)
 
@@ -2436,17 +2443,93 @@ func TestReconcileExportPolicyRules(t *testing.T) {
 	mockCtrl = gomock.NewController(t)
 	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
 	desiredRules = []string{"0.0.0.0/0", "::/0"}
-	ruleList = make(map[string]int)
-	ruleList["0.0.0.1/0"] = 0
-	ruleList["::/0"] = 1
+	ruleList = make(map[string]api.ExportRule)
+	ruleList["0.0.0.1/0"] = newExportRule(0, "any")
+	ruleList["::/0"] = newExportRule(1, "any")
 	mockAPI.EXPECT().ExportRuleList(ctx, "dummyPolicy").Return(ruleList, nil)
-	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], config.NASType).Return(nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], config.NASType,
+		[]string{"any"}).Return(nil)
 	mockAPI.EXPECT().ExportRuleDestroy(ctx, "dummyPolicy",
-		ruleList["0.0.0.1/0"]).Return(fmt.Errorf("Error destroying export rule"))
+		ruleList["0.0.0.1/0"].Index).Return(fmt.Errorf("Error destroying export rule"))
 
 	err = reconcileExportPolicyRules(ctx, "dummyPolicy", desiredRules, mockAPI, config)
 
 	assert.Error(t, err)
+
+	// Test-4: Kerberos export rules allow only the backend's security flavor
+
+	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
+	config.NASType = sa.NFS
+	config.NfsSecurity = utils.NFSSecurityKrb5p
+	mockAPI.EXPECT().ExportRuleList(ctx, "dummyPolicy").Return(map[string]api.ExportRule{}, nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], sa.NFS,
+		[]string{utils.NFSSecurityKrb5p}).Return(nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[1], sa.NFS,
+		[]string{utils.NFSSecurityKrb5p}).Return(nil)
+
+	err = reconcileExportPolicyRules(ctx, "dummyPolicy", desiredRules, mockAPI, config)
+
+	assert.NoError(t, err)
+
+	// Test-5: Rules that allow other security flavors are replaced
+
+	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
+	ruleList = map[string]api.ExportRule{
+		desiredRules[0]: newExportRule(1, "any"),
+		desiredRules[1]: newExportRule(2, utils.NFSSecurityKrb5p),
+	}
+	mockAPI.EXPECT().ExportRuleList(ctx, "dummyPolicy").Return(ruleList, nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "dummyPolicy", desiredRules[0], sa.NFS,
+		[]string{utils.NFSSecurityKrb5p}).Return(nil)
+	mockAPI.EXPECT().ExportRuleDestroy(ctx, "dummyPolicy", 1).Return(nil)
+
+	err = reconcileExportPolicyRules(ctx, "dummyPolicy", desiredRules, mockAPI, config)
+
+	assert.NoError(t, err)
+}
+
+func TestValidateNfsSecurity(t *testing.T) {
+	tests := []struct {
+		name          string
+		nasType       string
+		nfsSecurity   string
+		mountOptions  string
+		errorExpected bool
+	}{
+		{name: "Unset", nasType: sa.NFS},
+		{name: "Sys", nasType: sa.NFS, nfsSecurity: utils.NFSSecuritySys},
+		{name: "SysWithKerberosMountOption", nasType: sa.NFS, nfsSecurity: utils.NFSSecuritySys,
+			mountOptions: "-o sec=krb5"},
+		{name: "Krb5p", nasType: sa.NFS, nfsSecurity: utils.NFSSecurityKrb5p, mountOptions: "nfsvers=4.1"},
+		{name: "Krb5pWithMatchingMountOption", nasType: sa.NFS, nfsSecurity: utils.NFSSecurityKrb5p,
+			mountOptions: "nfsvers=4.1,sec=krb5p"},
+		{name: "Krb5pWithConflictingMountOption", nasType: sa.NFS, nfsSecurity: utils.NFSSecurityKrb5p,
+			mountOptions: "nfsvers=4.1,sec=krb5i", errorExpected: true},
+		{name: "Invalid", nasType: sa.NFS, nfsSecurity: "krb6", errorExpected: true},
+		{name: "KerberosSMB", nasType: sa.SMB, nfsSecurity: utils.NFSSecurityKrb5, errorExpected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &drivers.OntapStorageDriverConfig{NfsMountOptions: test.mountOptions, NfsSecurity: test.nfsSecurity}
+			config.NASType = test.nasType
+
+			err := validateNfsSecurity(config)
+			if test.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetNfsMountOptions(t *testing.T) {
+	config := &drivers.OntapStorageDriverConfig{NfsSecurity: utils.NFSSecuritySys}
+	assert.Equal(t, "-o nfsvers=3", getNfsMountOptions(config, "-o nfsvers=3"))
+
+	config.NfsSecurity = utils.NFSSecurityKrb5i
+	assert.Equal(t, "-o nfsvers=4.1,sec=krb5i", getNfsMountOptions(config, "-o nfsvers=4.1"))
+	assert.Equal(t, "nfsvers=4.1,sec=krb5i", getNfsMountOptions(config, "nfsvers=4.1,sec=sys"))
 }
 
 func TestIsDefaultAuthTypeOfType(t *testing.T) {
@@ -3016,14 +3099,14 @@ func TestReconcileNASNodeAccess(t *testing.T) {
 
 	policyName := "fakePolicy"
 
-	ruleMap := make(map[string]int)
-	ruleMap["1.1.1.1"] = 1
+	ruleMap := make(map[string]api.ExportRule)
+	ruleMap["1.1.1.1"] = newExportRule(1, "any")
 	error := fmt.Errorf("Error returned")
 
 	// Test1: Poitive flow
 	mockAPI.EXPECT().ExportPolicyCreate(ctx, policyName).Return(nil)
 	mockAPI.EXPECT().ExportRuleList(ctx, policyName).Return(ruleMap, nil)
-	mockAPI.EXPECT().ExportRuleDestroy(ctx, policyName, ruleMap["1.1.1.1"]).Return(nil)
+	mockAPI.EXPECT().ExportRuleDestroy(ctx, policyName, ruleMap["1.1.1.1"].Index).Return(nil)
 
 	err := reconcileNASNodeAccess(ctx, nodeList, config, mockAPI, policyName)
 
@@ -3051,7 +3134,7 @@ func TestReconcileNASNodeAccess(t *testing.T) {
 	config.AutoExportCIDRs = []string{}
 	mockAPI.EXPECT().ExportPolicyCreate(ctx, policyName).Return(nil)
 	mockAPI.EXPECT().ExportRuleList(ctx, policyName).Return(ruleMap, nil)
-	mockAPI.EXPECT().ExportRuleDestroy(ctx, policyName, ruleMap["1.1.1.1"]).AnyTimes().Return(error)
+	mockAPI.EXPECT().ExportRuleDestroy(ctx, policyName, ruleMap["1.1.1.1"].Index).AnyTimes().Return(error)
 
 	err = reconcileNASNodeAccess(ctx, nodeList, config, mockAPI, policyName)
 
@@ -3112,6 +3195,15 @@ func TestFilterUnusedTridentIgroups(t *testing.T) {
 			nodes:    []string{"node2"},
 			expected: []string{"node1-" + tridentUUID, "trident-" + backendUUID},
 		},
+		{
+			name: "some nodes used with per-protocol igroups",
+			igroups: []string{
+				"node1-iscsi-" + backendUUID, "node1-fcp-" + backendUUID, "node2-iscsi-" + backendUUID,
+				"node2-fcp-" + backendUUID, "node1-iscsi-5678",
+			},
+			nodes:    []string{"node2"},
+			expected: []string{"node1-iscsi-" + backendUUID, "node1-fcp-" + backendUUID},
+		},
 	}
 
 	for _, test := range tests {
@@ -3168,6 +3260,101 @@ func TestGetPoolsForCreate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetPoolCapacityCommon(t *testing.T) {
+	ctx := context.Background()
+	mockAPI := newMockOntapAPI(t)
+
+	aggr1 := storage.NewStoragePool(nil, "aggr1")
+	aggr2 := storage.NewStoragePool(nil, "aggr2")
+	vpool := storage.NewStoragePool(nil, "vpool")
+	physicalPools := map[string]storage.Pool{"aggr1": aggr1, "aggr2": aggr2}
+	virtualPools := map[string]storage.Pool{"vpool": vpool}
+
+	mockAPI.EXPECT().GetSVMAggregateSpace(ctx, "aggr1").AnyTimes().
+		Return([]api.SVMAggregateSpace{api.NewSVMAggregateSpace(1000, 400, 300)}, nil)
+	mockAPI.EXPECT().GetSVMAggregateSpace(ctx, "aggr2").AnyTimes().
+		Return([]api.SVMAggregateSpace{api.NewSVMAggregateSpace(2000, 2100, 2000)}, nil)
+
+	// Physical pool
+	capacity, err := getPoolCapacityCommon(ctx, aggr1, physicalPools, virtualPools, mockAPI)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), capacity.TotalBytes)
+	assert.Equal(t, uint64(600), capacity.FreeBytes)
+	assert.Equal(t, []string{"aggr1"}, capacity.PhysicalPools)
+
+	// Virtual pool spans all aggregates; an overcommitted aggregate contributes no free space
+	capacity, err = getPoolCapacityCommon(ctx, vpool, physicalPools, virtualPools, mockAPI)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3000), capacity.TotalBytes)
+	assert.Equal(t, uint64(600), capacity.FreeBytes)
+	assert.ElementsMatch(t, []string{"aggr1", "aggr2"}, capacity.PhysicalPools)
+
+	// Unknown pool
+	_, err = getPoolCapacityCommon(ctx, storage.NewStoragePool(nil, "missing"), physicalPools, virtualPools, mockAPI)
+	assert.True(t, utils.IsNotFoundError(err))
+}
+
+func TestGetAggregateCapacityCommon_Error(t *testing.T) {
+	ctx := context.Background()
+	mockAPI := newMockOntapAPI(t)
+
+	mockAPI.EXPECT().GetSVMAggregateSpace(ctx, "aggr1").
+		Return(nil, fmt.Errorf("failed to get aggregate space"))
+	_, err := getAggregateCapacityCommon(ctx, []string{"aggr1"}, mockAPI)
+	assert.Error(t, err)
+
+	// Aggregates without space records are skipped
+	mockAPI.EXPECT().GetSVMAggregateSpace(ctx, "aggr1").Return([]api.SVMAggregateSpace{}, nil)
+	capacity, err := getAggregateCapacityCommon(ctx, []string{"aggr1"}, mockAPI)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), capacity.TotalBytes)
+	assert.Empty(t, capacity.PhysicalPools)
+}
+
+func TestGetFlexvolHealthCommon(t *testing.T) {
+	ctx := context.Background()
+	mockAPI := newMockOntapAPI(t)
+
+	// Healthy volume
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Size: "1000", SnapshotReserve: 10}, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol1").Return(500, nil)
+
+	health, err := getFlexvolHealthCommon(ctx, "vol1", mockAPI)
+	assert.NoError(t, err)
+	assert.False(t, health.Abnormal)
+
+	// Full volume
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Size: "1000", SnapshotReserve: 10}, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol1").Return(900, nil)
+
+	health, err = getFlexvolHealthCommon(ctx, "vol1", mockAPI)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+	assert.Contains(t, health.Message, "full")
+
+	// Missing volume
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, nil)
+
+	health, err = getFlexvolHealthCommon(ctx, "vol1", mockAPI)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+
+	// API failures are errors rather than abnormal volumes
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, fmt.Errorf("API failed"))
+
+	_, err = getFlexvolHealthCommon(ctx, "vol1", mockAPI)
+	assert.Error(t, err)
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Size: "1000"}, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol1").Return(0, fmt.Errorf("API failed"))
+
+	_, err = getFlexvolHealthCommon(ctx, "vol1", mockAPI)
+	assert.Error(t, err)
+}
+
 func TestGetPoolsForCreate_NoMatchingPools(t *testing.T) {
 	ctx := context.Background()
 
@@ -3979,6 +4166,43 @@ func TestValidateSANDriver_BackendIgroupDeprecation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateSANDriver_SANType(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		useREST       bool
+		useCHAP       bool
+		driverContext tridentconfig.DriverContext
+		sanType       string
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		"NVMeValid":      {useREST: true, driverContext: tridentconfig.ContextCSI, sanType: sa.NVMe, wantErr: assert.NoError},
+		"NVMeZAPI":       {useREST: false, driverContext: tridentconfig.ContextCSI, sanType: sa.NVMe, wantErr: assert.Error},
+		"NVMeDocker":     {useREST: true, driverContext: tridentconfig.ContextDocker, sanType: sa.NVMe, wantErr: assert.Error},
+		"NVMeCHAP":       {useREST: true, useCHAP: true, driverContext: tridentconfig.ContextCSI, sanType: sa.NVMe, wantErr: assert.Error},
+		"FCPValid":       {useREST: true, driverContext: tridentconfig.ContextCSI, sanType: sa.FCP, wantErr: assert.NoError},
+		"FCPZAPI":        {useREST: false, driverContext: tridentconfig.ContextCSI, sanType: sa.FCP, wantErr: assert.Error},
+		"FCPDocker":      {useREST: true, driverContext: tridentconfig.ContextDocker, sanType: sa.FCP, wantErr: assert.Error},
+		"FCPCHAP":        {useREST: true, useCHAP: true, driverContext: tridentconfig.ContextCSI, sanType: sa.FCP, wantErr: assert.Error},
+		"InvalidSANType": {useREST: true, driverContext: tridentconfig.ContextCSI, sanType: "fc", wantErr: assert.Error},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := &drivers.OntapStorageDriverConfig{
+				CommonStorageDriverConfig: &drivers.CommonStorageDriverConfig{
+					DebugTraceFlags: map[string]bool{"method": true},
+					DriverContext:   test.driverContext,
+				},
+				UseREST: test.useREST,
+				UseCHAP: test.useCHAP,
+				SANType: test.sanType,
+			}
+
+			test.wantErr(t, ValidateSANDriver(ctx, config, []string{"1.1.1.1"}))
+		})
+	}
+}
+
 func TestValidateNASDriver(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -4083,6 +4307,22 @@ func TestValidateNASDriver(t *testing.T) {
 	err = ValidateNASDriver(ctx, mockAPI, config)
 
 	assert.NoError(t, err)
+
+	// Test 10 - Per-volume export policies without automatic export policies
+	config.PerVolumeExportPolicy = true
+	config.AutoExportPolicy = false
+	config.DataLIF = ""
+
+	err = ValidateNASDriver(ctx, mockAPI, config)
+
+	assert.Error(t, err)
+
+	config.AutoExportPolicy = true
+	config.DataLIF = ""
+
+	err = ValidateNASDriver(ctx, mockAPI, config)
+
+	assert.NoError(t, err)
 }
 
 func TestGetSnapshotReserve(t *testing.T) {
@@ -4375,6 +4615,98 @@ func TestCreateFlexvolSnapshot(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateFlexvolGroupSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	config := &drivers.OntapStorageDriverConfig{
+		CommonStorageDriverConfig: &drivers.CommonStorageDriverConfig{
+			DebugTraceFlags:   map[string]bool{"method": true},
+			StorageDriverName: tridentconfig.OntapNASStorageDriverName,
+		},
+	}
+	groupConfig := &storage.GroupSnapshotConfig{
+		Name:         "group",
+		InternalName: "group",
+		VolumeNames:  []string{"vol1", "vol2"},
+	}
+	newSnapConfigs := func() []*storage.SnapshotConfig {
+		return []*storage.SnapshotConfig{
+			{Name: "group", VolumeName: "vol1", VolumeInternalName: "trident_vol1"},
+			{Name: "group", VolumeName: "vol2", VolumeInternalName: "trident_vol2"},
+		}
+	}
+	internalVolNames := []string{"trident_vol1", "trident_vol2"}
+
+	mockCtrl := gomock.NewController(t)
+
+	// Success case
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	for i, volName := range internalVolNames {
+		mockAPI.EXPECT().VolumeExists(ctx, volName).Return(true, nil)
+		mockAPI.EXPECT().VolumeUsedSize(ctx, volName).Return(100*(i+1), nil)
+		mockAPI.EXPECT().VolumeSnapshotList(ctx, volName).Return(api.Snapshots{
+			{Name: "other", CreateTime: "2023-05-01T09:00:00Z"},
+			{Name: "group", CreateTime: "2023-05-01T10:00:00Z"},
+		}, nil)
+	}
+	mockAPI.EXPECT().VolumeGroupSnapshotCreate(ctx, "group", internalVolNames).Return(nil)
+
+	snapshots, err := createFlexvolGroupSnapshot(ctx, groupConfig, newSnapConfigs(), config, mockAPI,
+		mockAPI.VolumeUsedSize)
+
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	for i, snapshot := range snapshots {
+		assert.Equal(t, "group", snapshot.Config.InternalName)
+		assert.Equal(t, internalVolNames[i], snapshot.Config.VolumeInternalName)
+		assert.Equal(t, "2023-05-01T10:00:00Z", snapshot.Created)
+		assert.Equal(t, int64(100*(i+1)), snapshot.SizeBytes)
+		assert.Equal(t, storage.SnapshotStateOnline, snapshot.State)
+	}
+
+	// Error case: volume doesn't exist
+	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().VolumeExists(ctx, "trident_vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "trident_vol1").Return(100, nil)
+	mockAPI.EXPECT().VolumeExists(ctx, "trident_vol2").Return(false, nil)
+
+	snapshots, err = createFlexvolGroupSnapshot(ctx, groupConfig, newSnapConfigs(), config, mockAPI,
+		mockAPI.VolumeUsedSize)
+
+	assert.Nil(t, snapshots, "Expected no snapshots")
+	assert.Error(t, err)
+
+	// Error case: group snapshot fails
+	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
+	for _, volName := range internalVolNames {
+		mockAPI.EXPECT().VolumeExists(ctx, volName).Return(true, nil)
+		mockAPI.EXPECT().VolumeUsedSize(ctx, volName).Return(100, nil)
+	}
+	mockAPI.EXPECT().VolumeGroupSnapshotCreate(ctx, "group", internalVolNames).Return(
+		fmt.Errorf("consistency group error"))
+
+	snapshots, err = createFlexvolGroupSnapshot(ctx, groupConfig, newSnapConfigs(), config, mockAPI,
+		mockAPI.VolumeUsedSize)
+
+	assert.Nil(t, snapshots, "Expected no snapshots")
+	assert.Error(t, err)
+
+	// Error case: snapshot not found afterwards
+	mockAPI = mockapi.NewMockOntapAPI(mockCtrl)
+	for _, volName := range internalVolNames {
+		mockAPI.EXPECT().VolumeExists(ctx, volName).Return(true, nil)
+		mockAPI.EXPECT().VolumeUsedSize(ctx, volName).Return(100, nil)
+	}
+	mockAPI.EXPECT().VolumeGroupSnapshotCreate(ctx, "group", internalVolNames).Return(nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "trident_vol1").Return(api.Snapshots{{Name: "other"}}, nil)
+
+	snapshots, err = createFlexvolGroupSnapshot(ctx, groupConfig, newSnapConfigs(), config, mockAPI,
+		mockAPI.VolumeUsedSize)
+
+	assert.Nil(t, snapshots, "Expected no snapshots")
+	assert.Error(t, err)
+}
+
 func TestIsFlexvolRW(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -5245,6 +5577,9 @@ func TestEnableSANPublishEnforcement_Succeeds(t *testing.T) {
 				IscsiAccessInfo: utils.IscsiAccessInfo{
 					IscsiLunNumber: 1,
 				},
+				FCPAccessInfo: utils.FCPAccessInfo{
+					FCPIgroup: "trident-1234",
+				},
 			},
 			ImportNotManaged: false,
 		},
@@ -5255,50 +5590,41 @@ func TestEnableSANPublishEnforcement_Succeeds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, volume.Config.AccessInfo.PublishEnforcement)
 	assert.Equal(t, int32(-1), volume.Config.AccessInfo.IscsiAccessInfo.IscsiLunNumber)
+	assert.Empty(t, volume.Config.AccessInfo.FCPIgroup)
 }
 
 func TestGetNodeSpecificIgroup(t *testing.T) {
+	const backendUUID = "b11ad8a0-f182-420f-b00e-d82ce9d80962"
+	// The longest node name that fits alongside "-iscsi-<backend uuid>"
+	maxNodeLength := MaximumIgroupNameLength - len("-"+sa.ISCSI+"-"+backendUUID)
+
 	tests := map[string]struct {
-		node, uuid string
-		truncate   bool
+		node     string
+		truncate bool
 	}{
 		"get igroup name does not truncate with short node names": {
 			node:     "node12345678910.my.fqdn",
-			uuid:     "b11ad8a0-f182-420f-b00e-d82ce9d80962",
 			truncate: false,
-			// node12345678910.my.fqdn-b11ad8a0-f182-420f-b00e-d82ce9d80962
-		},
-		"get igroup name does not truncate when generated igroup name < max igroup length": {
-			node:     "node12345678910.my.fqdn-12345678910-12345678910-1234567891",
-			uuid:     "b11ad8a0-f182-420f-b00e-d82ce9d80962",
-			truncate: false,
-			// node12345678910.my.fqdn-12345678910-12345678910-1234567891-b11ad8a0-f182-420f-b00e-d82ce9d80962
 		},
 		"get igroup name does not truncate when generated igroup name = max igroup length": {
-			node:     "node12345678910.my.fqdn-12345678910-12345678910-12345678910",
-			uuid:     "b11ad8a0-f182-420f-b00e-d82ce9d80962",
+			node:     strings.Repeat("n", maxNodeLength),
 			truncate: false,
-			// node12345678910.my.fqdn-12345678910-12345678910-12345678910-b11ad8a0-f182-420f-b00e-d82ce9d80962
 		},
-		"get igroup name does truncate when node is < max igroup length and generated igroup name > max igroup length": {
-			node:     "node12345678910.my.fqdn-12345678910-12345678910-12345678910-12345678910-12345678910-12345678910",
-			uuid:     "b11ad8a0-f182-420f-b00e-d82ce9d80962",
+		"get igroup name does truncate when generated igroup name > max igroup length": {
+			node:     strings.Repeat("n", maxNodeLength+1),
 			truncate: true,
-			// node12345678910.my.fqdn-12345678910-12345678910-12345678910-b11ad8a0-f182-420f-b00e-d82ce9d80962
 		},
-		"get igroup name does truncate when node is > max igroup length and generated igroup name > max igroup length": {
-			node:     "node12345678910.my.fqdn-12345678910-12345678910-12345678910-12345678910-12345678910-123456789101",
-			uuid:     "b11ad8a0-f182-420f-b00e-d82ce9d80962",
+		"get igroup name does truncate when node is > max igroup length": {
+			node:     strings.Repeat("n", MaximumIgroupNameLength+1),
 			truncate: true,
-			// node12345678910.my.fqdn-12345678910-12345678910-12345678910-b11ad8a0-f182-420f-b00e-d82ce9d80962
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			igroup := getNodeSpecificIgroupName(test.node, test.uuid)
+			igroup := getNodeSpecificIgroupName(test.node, sa.ISCSI, backendUUID)
 			assert.LessOrEqual(t, len(igroup), MaximumIgroupNameLength)
-			assert.Contains(t, igroup, test.uuid)
+			assert.True(t, strings.HasSuffix(igroup, "-"+sa.ISCSI+"-"+backendUUID))
 			if test.truncate {
 				assert.NotContains(t, igroup, test.node)
 			} else {
@@ -5306,6 +5632,12 @@ func TestGetNodeSpecificIgroup(t *testing.T) {
 			}
 		})
 	}
+
+	// A node's iSCSI and FC igroups are distinct, as are those of different backends
+	assert.NotEqual(t, getNodeSpecificIgroupName("node1", sa.ISCSI, backendUUID),
+		getNodeSpecificIgroupName("node1", sa.FCP, backendUUID))
+	assert.NotEqual(t, getNodeSpecificIgroupName("node1", sa.ISCSI, backendUUID),
+		getNodeSpecificIgroupName("node1", sa.ISCSI, "c22be9b1-f182-420f-b00e-d82ce9d80962"))
 }
 
 func TestRemoveIgroupFromList(t *testing.T) {
@@ -5412,3 +5744,76 @@ func TestGetSVMState(t *testing.T) {
 	assert.Empty(t, state, "There should not be any state reason")
 	assert.False(t, code.Contains(storage.BackendStateReasonChange), "Should be online and no state reason change")
 }
+
+func TestMoveFlexvol(t *testing.T) {
+	ctx := context.Background()
+	mockAPI := newMockOntapAPI(t)
+	targetPool := storage.NewStoragePool(nil, "aggr2")
+	physicalPools := map[string]storage.Pool{"aggr1": storage.NewStoragePool(nil, "aggr1"), "aggr2": targetPool}
+
+	// A volume that is not moving is moved to the target aggregate
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(nil, nil)
+	mockAPI.EXPECT().VolumeMoveStart(ctx, "vol1", "aggr2").Return(nil)
+	moving, percentComplete, err := moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, true)
+	assert.NoError(t, err)
+	assert.True(t, moving)
+	assert.Equal(t, 0, percentComplete)
+
+	// A running move is followed rather than started again
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(&api.VolumeMove{
+		DestinationAggregate: "aggr2", State: "replicating", PercentComplete: 60,
+	}, nil)
+	moving, percentComplete, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, true)
+	assert.NoError(t, err)
+	assert.True(t, moving)
+	assert.Equal(t, 60, percentComplete)
+
+	// A volume in the target aggregate has been moved
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr2"}}, nil)
+	moving, percentComplete, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, true)
+	assert.NoError(t, err)
+	assert.False(t, moving)
+	assert.Equal(t, 100, percentComplete)
+
+	// A failed move to the target aggregate is reported, and restarted if asked
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(&api.VolumeMove{
+		DestinationAggregate: "aggr2", State: api.VolumeMoveStateFailed,
+	}, nil)
+	mockAPI.EXPECT().VolumeMoveStart(ctx, "vol1", "aggr2").Return(nil)
+	moving, _, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, true)
+	assert.True(t, utils.IsVolumeMoveFailedError(err), "expected volume move failed error")
+	assert.True(t, moving)
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(&api.VolumeMove{
+		DestinationAggregate: "aggr2", State: api.VolumeMoveStateAborted,
+	}, nil)
+	moving, _, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, false)
+	assert.True(t, utils.IsVolumeMoveFailedError(err), "expected volume move failed error")
+	assert.False(t, moving)
+
+	// A failed move to another aggregate does not prevent a move to the target aggregate
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(&api.VolumeMove{
+		DestinationAggregate: "aggr3", State: api.VolumeMoveStateFailed,
+	}, nil)
+	mockAPI.EXPECT().VolumeMoveStart(ctx, "vol1", "aggr2").Return(nil)
+	moving, _, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, false)
+	assert.NoError(t, err)
+	assert.True(t, moving)
+
+	// A move to another aggregate is not interrupted
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMoveInfo(ctx, "vol1").Return(&api.VolumeMove{
+		DestinationAggregate: "aggr3", State: "replicating",
+	}, nil)
+	_, _, err = moveFlexvol(ctx, "vol1", targetPool, physicalPools, mockAPI, true)
+	assert.Error(t, err)
+
+	// Volumes may only be moved to physical pools
+	_, _, err = moveFlexvol(ctx, "vol1", storage.NewStoragePool(nil, "virtual"), physicalPools, mockAPI, true)
+	assert.Error(t, err)
+}
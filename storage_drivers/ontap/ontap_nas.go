@@ -300,6 +300,7 @@ func (d *NASStorageDriver) Create(
 	volConfig.Encryption = configEncryption
 	volConfig.QosPolicy = qosPolicy
 	volConfig.AdaptiveQosPolicy = adaptiveQosPolicy
+	volConfig.TieringPolicy = tieringPolicy
 
 	Logc(ctx).WithFields(LogFields{
 		"name":              name,
@@ -524,6 +525,9 @@ func (d *NASStorageDriver) Destroy(ctx context.Context, volConfig *storage.Volum
 	if err := d.API.VolumeDestroy(ctx, name, true); err != nil {
 		return err
 	}
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		destroyVolumeExportPolicy(ctx, d.API, getVolumeExportPolicyName(name))
+	}
 	if d.Config.NASType == sa.SMB {
 		if err := d.DestroySMBShare(ctx, name); err != nil {
 			return err
@@ -667,6 +671,7 @@ func (d *NASStorageDriver) Publish(
 	if volConfig.MountOptions != "" {
 		mountOptions = volConfig.MountOptions
 	}
+	mountOptions = getNfsMountOptions(&d.Config, mountOptions)
 
 	// Add fields needed by Attach
 	// TODO (akerr) Figure out if this is the behavior we want or if we should be changing the junction path for
@@ -684,9 +689,45 @@ func (d *NASStorageDriver) Publish(
 		publishInfo.MountOptions = mountOptions
 	}
 
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		return publishVolumeExportPolicy(ctx, d.API, &d.Config, publishInfo, getVolumeExportPolicyName(name))
+	}
+
 	return publishShare(ctx, d.API, &d.Config, publishInfo, name, d.API.VolumeModifyExportPolicy)
 }
 
+// Unpublish the volume from the host specified in publishInfo.  This method may or may not be running on the host
+// where the volume will be mounted, so it should limit itself to updating access rules, initiator groups, etc.
+// that require some host identity (but not locality) as well as storage controller API access.
+func (d *NASStorageDriver) Unpublish(
+	ctx context.Context, volConfig *storage.VolumeConfig, publishInfo *utils.VolumePublishInfo,
+) error {
+	name := volConfig.InternalName
+
+	fields := LogFields{
+		"Method": "Unpublish",
+		"Type":   "NASStorageDriver",
+		"name":   name,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Unpublish")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Unpublish")
+
+	if tridentconfig.CurrentDriverContext != tridentconfig.ContextCSI || !d.CanEnablePublishEnforcement() {
+		return nil
+	}
+
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		return unpublishVolumeExportPolicy(ctx, d.API, &d.Config, publishInfo, getVolumeExportPolicyName(name))
+	}
+
+	// Move a volume using the backend's export policy to its own once it is published to no node
+	if len(publishInfo.Nodes) == 0 {
+		return d.EnablePublishEnforcement(ctx, &storage.Volume{Config: volConfig})
+	}
+
+	return nil
+}
+
 // CanSnapshot determines whether a snapshot as specified in the provided snapshot config may be taken.
 func (d *NASStorageDriver) CanSnapshot(_ context.Context, _ *storage.SnapshotConfig, _ *storage.VolumeConfig) error {
 	return nil
@@ -848,6 +889,28 @@ func (d *NASStorageDriver) CreateSnapshot(
 	return createFlexvolSnapshot(ctx, snapConfig, &d.Config, d.API, d.API.VolumeUsedSize)
 }
 
+// SupportsGroupSnapshots indicates whether this driver can snapshot a group of volumes atomically.  Group
+// snapshots rely on ONTAP consistency groups, which are only available via the REST API.
+func (d *NASStorageDriver) SupportsGroupSnapshots() bool {
+	return d.Config.UseREST
+}
+
+// CreateGroupSnapshot snapshots a group of volumes at a single point in time.
+func (d *NASStorageDriver) CreateGroupSnapshot(
+	ctx context.Context, groupConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	_ []*storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	fields := LogFields{
+		"Method":        "CreateGroupSnapshot",
+		"Type":          "NASStorageDriver",
+		"groupSnapshot": groupConfig.InternalName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	return createFlexvolGroupSnapshot(ctx, groupConfig, snapConfigs, &d.Config, d.API, d.API.VolumeUsedSize)
+}
+
 // RestoreSnapshot restores a volume (in place) from a snapshot.
 func (d *NASStorageDriver) RestoreSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -864,6 +927,11 @@ func (d *NASStorageDriver) RestoreSnapshot(
 	return RestoreSnapshot(ctx, snapConfig, &d.Config, d.API)
 }
 
+// RestoreRequiresNewestSnapshot indicates whether this driver can only restore a volume from its newest snapshot.
+func (d *NASStorageDriver) RestoreRequiresNewestSnapshot() bool {
+	return true
+}
+
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *NASStorageDriver) DeleteSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -920,6 +988,26 @@ func (d *NASStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) [
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
 }
 
+// GetPoolCapacity returns the space in the aggregates backing a storage pool
+func (d *NASStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, pool, d.physicalPools, d.virtualPools, d.API)
+}
+
+// GetVolumeHealth reports whether a volume's Flexvol is missing, offline or full
+func (d *NASStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	return getFlexvolHealthCommon(ctx, volConfig.InternalName, d.API)
+}
+
+// GetVolumeMetrics reports the space used by each volume's Flexvol and its performance
+func (d *NASStorageDriver) GetVolumeMetrics(
+	ctx context.Context, volConfigs []*storage.VolumeConfig,
+) (map[string]*storage.VolumeMetrics, error) {
+	return getVolumeMetricsCommon(ctx, volConfigs, *d.Config.StoragePrefix+"*", d.API.VolumeListMetrics,
+		flexvolName)
+}
+
 func (d *NASStorageDriver) getStoragePoolAttributes(ctx context.Context) map[string]sa.Offer {
 	client := d.GetAPI()
 	mirroring, _ := client.IsSVMDRCapable(ctx)
@@ -1155,6 +1243,110 @@ func (d *NASStorageDriver) Resize(
 	return nil
 }
 
+// MoveVolume moves a FlexVol to the aggregate of another physical pool with an ONTAP volume move, which does not
+// disrupt its clients.
+func (d *NASStorageDriver) MoveVolume(
+	ctx context.Context, volConfig *storage.VolumeConfig, targetPool storage.Pool, restartFailed bool,
+) (bool, int, error) {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method":     "MoveVolume",
+		"Type":       "NASStorageDriver",
+		"name":       name,
+		"targetPool": targetPool.Name(),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> MoveVolume")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< MoveVolume")
+
+	return moveFlexvol(ctx, name, targetPool, d.physicalPools, d.API, restartFailed)
+}
+
+// ModifyVolume changes the attributes of a FlexVol to those in its config.  Attributes are changed one at a
+// time, so a failure may leave some of them changed; the modification may simply be retried.
+func (d *NASStorageDriver) ModifyVolume(
+	ctx context.Context, volConfig *storage.VolumeConfig, modification storage.VolumeModification,
+) error {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method":       "ModifyVolume",
+		"Type":         "NASStorageDriver",
+		"name":         name,
+		"modification": modification,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> ModifyVolume")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< ModifyVolume")
+
+	if _, ok := modification[storage.ModifiableExportPolicy]; ok && d.Config.AutoExportPolicy {
+		return fmt.Errorf("the export policy of volume %s is managed by Trident", name)
+	}
+	if _, ok := modification[storage.ModifiableUnixPermissions]; ok && d.Config.NASType == sa.SMB {
+		return fmt.Errorf("unix permissions cannot be set on SMB volume %s", name)
+	}
+
+	volExists, err := d.API.VolumeExists(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error checking for existing volume %s; %v", name, err)
+	}
+	if !volExists {
+		return fmt.Errorf("volume %s does not exist", name)
+	}
+
+	_, qosModified := modification[storage.ModifiableQosPolicy]
+	_, adaptiveQosModified := modification[storage.ModifiableAdaptiveQosPolicy]
+	if qosModified || adaptiveQosModified {
+		qosPolicyGroup, err := api.NewQosPolicyGroup(volConfig.QosPolicy, volConfig.AdaptiveQosPolicy)
+		if err != nil {
+			return err
+		}
+		if qosPolicyGroup.Kind == api.InvalidQosPolicyGroupKind {
+			return fmt.Errorf("the QoS policy group of volume %s cannot be removed", name)
+		}
+		if err = d.API.VolumeSetQosPolicyGroupName(ctx, name, qosPolicyGroup); err != nil {
+			return err
+		}
+	}
+
+	if snapshotPolicy, ok := modification[storage.ModifiableSnapshotPolicy]; ok {
+		if err = d.API.VolumeModifySnapshotPolicy(ctx, name, snapshotPolicy); err != nil {
+			return err
+		}
+	}
+
+	if snapshotDir, ok := modification[storage.ModifiableSnapshotDir]; ok {
+		enableSnapshotDir, err := strconv.ParseBool(snapshotDir)
+		if err != nil {
+			return fmt.Errorf("invalid boolean value for snapshotDir: %v", err)
+		}
+		if err = d.API.VolumeModifySnapshotDirectoryAccess(ctx, name, enableSnapshotDir); err != nil {
+			return err
+		}
+	}
+
+	if tieringPolicy, ok := modification[storage.ModifiableTieringPolicy]; ok {
+		if tieringPolicy == "" {
+			tieringPolicy = d.API.TieringPolicyValue(ctx)
+			volConfig.TieringPolicy = tieringPolicy
+		}
+		if err = d.API.VolumeModifyTieringPolicy(ctx, name, tieringPolicy); err != nil {
+			return err
+		}
+	}
+
+	if exportPolicy, ok := modification[storage.ModifiableExportPolicy]; ok {
+		if err = d.API.VolumeModifyExportPolicy(ctx, name, exportPolicy); err != nil {
+			return err
+		}
+	}
+
+	if unixPermissions, ok := modification[storage.ModifiableUnixPermissions]; ok {
+		if err = d.API.VolumeModifyUnixPermissions(ctx, name, volConfig.Name, unixPermissions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (d *NASStorageDriver) ReconcileNodeAccess(
 	ctx context.Context, nodes []*utils.Node, backendUUID, _ string,
 ) error {
@@ -1178,6 +1370,20 @@ func (d *NASStorageDriver) ReconcileNodeAccess(
 	return reconcileNASNodeAccess(ctx, nodes, &d.Config, d.API, policyName)
 }
 
+// EnablePublishEnforcement gives a volume its own export policy, allowing access only from the nodes to which it
+// is published.
+func (d *NASStorageDriver) EnablePublishEnforcement(ctx context.Context, volume *storage.Volume) error {
+	name := volume.Config.InternalName
+	return enableVolumeExportPolicy(ctx, d.API, volume.Config, getVolumeExportPolicyName(name),
+		func(ctx context.Context, policyName string) error {
+			return d.API.VolumeModifyExportPolicy(ctx, name, policyName)
+		})
+}
+
+func (d *NASStorageDriver) CanEnablePublishEnforcement() bool {
+	return d.Config.AutoExportPolicy && d.Config.PerVolumeExportPolicy
+}
+
 // GetBackendState returns the reason if SVM is offline, and a flag to indicate if there is change
 // in physical pools list.
 func (d *NASStorageDriver) GetBackendState(ctx context.Context) (string, *roaring.Bitmap) {
@@ -1291,6 +1497,13 @@ func (d *NASStorageDriver) PromoteMirror(
 		d.API)
 }
 
+// UpdateMirror starts a transfer of a snapshot of the remote volume to a snapmirror destination
+func (d *NASStorageDriver) UpdateMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) error {
+	return updateMirror(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotName, d.API)
+}
+
 // GetMirrorStatus returns the current state of a snapmirror relationship
 func (d *NASStorageDriver) GetMirrorStatus(
 	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
@@ -25,7 +25,12 @@ import (
 	"github.com/netapp/trident/utils"
 )
 
-const flexgroupCreateTimeout = 60 * time.Second
+const (
+	flexgroupCreateTimeout = 60 * time.Second
+
+	// ONTAP limit on the number of constituents of a FlexGroup placed on each of its aggregates
+	maxConstituentsPerAggregate = 1000
+)
 
 // NASFlexGroupStorageDriver is for NFS and SMB FlexGroup storage provisioning
 type NASFlexGroupStorageDriver struct {
@@ -410,6 +415,11 @@ func (d *NASFlexGroupStorageDriver) validate(ctx context.Context) error {
 		return fmt.Errorf("ONTAP version does not support FlexGroups")
 	}
 
+	if err := validateReplicationConfig(ctx, d.Config.ReplicationPolicy, d.Config.ReplicationSchedule,
+		d.API); err != nil {
+		return fmt.Errorf("replication validation failed: %v", err)
+	}
+
 	if err := ValidateNASDriver(ctx, d.API, &d.Config); err != nil {
 		return fmt.Errorf("driver validation failed: %v", err)
 	}
@@ -445,9 +455,45 @@ func (d *NASFlexGroupStorageDriver) validate(ctx context.Context) error {
 		}
 	}
 
+	aggregates := d.Config.FlexGroupAggregateList
+	if len(aggregates) == 0 {
+		aggregates = vserverAggrs
+	}
+	if _, err = d.getConstituentsPerAggregate(aggregates); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// getConstituentsPerAggregate returns how many constituents to place on each aggregate of a new FlexGroup so that
+// it has the configured number of constituents, or 0 to leave the choice to ONTAP.  A FlexGroup can only be the
+// SnapMirror destination of a FlexGroup with as many constituents, so setting the same count on the backends at
+// both ends of a mirror lets their SVMs have different numbers of aggregates.
+func (d *NASFlexGroupStorageDriver) getConstituentsPerAggregate(aggregates []string) (int, error) {
+	if d.Config.FlexGroupConstituentCount == "" {
+		return 0, nil
+	}
+
+	constituentCount, err := strconv.Atoi(d.Config.FlexGroupConstituentCount)
+	if err != nil || constituentCount < 1 {
+		return 0, fmt.Errorf("invalid config value for flexgroupConstituentCount: %s",
+			d.Config.FlexGroupConstituentCount)
+	}
+	if len(aggregates) == 0 || constituentCount%len(aggregates) != 0 {
+		return 0, fmt.Errorf("flexgroupConstituentCount %d cannot be spread evenly across the %d aggregates %v",
+			constituentCount, len(aggregates), aggregates)
+	}
+
+	constituentsPerAggregate := constituentCount / len(aggregates)
+	if constituentsPerAggregate > maxConstituentsPerAggregate {
+		return 0, fmt.Errorf("flexgroupConstituentCount %d needs more than %d constituents on each of the "+
+			"aggregates %v", constituentCount, maxConstituentsPerAggregate, aggregates)
+	}
+
+	return constituentsPerAggregate, nil
+}
+
 // Create a volume with the specified options
 func (d *NASFlexGroupStorageDriver) Create(
 	ctx context.Context, volConfig *storage.VolumeConfig, storagePool storage.Pool, volAttributes map[string]sa.Request,
@@ -472,6 +518,13 @@ func (d *NASFlexGroupStorageDriver) Create(
 		return drivers.NewVolumeExistsError(name)
 	}
 
+	// If the FlexGroup shall be a mirror destination, check that the SVM is peered with the other side
+	if volConfig.IsMirrorDestination && volConfig.PeerVolumeHandle != "" {
+		if err = checkSVMPeered(ctx, volConfig, d.API.SVMName(), d.API); err != nil {
+			return err
+		}
+	}
+
 	// Get the aggregates assigned to the SVM.  There must be at least one!
 	vserverAggrs, err := d.API.GetSVMAggregateNames(ctx)
 	if err != nil {
@@ -494,6 +547,11 @@ func (d *NASFlexGroupStorageDriver) Create(
 		flexGroupAggregateList = d.Config.FlexGroupAggregateList
 	}
 
+	constituentsPerAggregate, err := d.getConstituentsPerAggregate(flexGroupAggregateList)
+	if err != nil {
+		return err
+	}
+
 	Logc(ctx).WithFields(LogFields{
 		"aggregates": vserverAggrs,
 	}).Debug("Read aggregates assigned to SVM.")
@@ -589,6 +647,7 @@ func (d *NASFlexGroupStorageDriver) Create(
 		"snapshotDir":     enableSnapshotDir,
 		"exportPolicy":    exportPolicy,
 		"aggregates":      flexGroupAggregateList,
+		"constituents":    constituentsPerAggregate * len(flexGroupAggregateList),
 		"securityStyle":   securityStyle,
 		"encryption":      utils.GetPrintableBoolPtrValue(enableEncryption),
 		"qosPolicy":       qosPolicy,
@@ -622,6 +681,8 @@ func (d *NASFlexGroupStorageDriver) Create(
 				TieringPolicy:   tieringPolicy,
 				UnixPermissions: unixPermissions,
 				DPVolume:        volConfig.IsMirrorDestination,
+
+				ConstituentsPerAggregate: constituentsPerAggregate,
 			})
 		return err
 	}
@@ -661,6 +722,11 @@ func (d *NASFlexGroupStorageDriver) Create(
 		}
 	}
 
+	// If a DP volume, skip mounting the volume
+	if volConfig.IsMirrorDestination {
+		return nil
+	}
+
 	// Mount the volume at the specified junction
 	if err := d.API.FlexgroupMount(ctx, name, "/"+name); err != nil {
 		createErrors = append(createErrors,
@@ -940,6 +1006,13 @@ func (d *NASFlexGroupStorageDriver) Destroy(ctx context.Context, volConfig *stor
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Destroy")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Destroy")
 
+	// If the FlexGroup has been a snapmirror destination
+	if err := d.API.SnapmirrorDeleteViaDestination(ctx, name, d.API.SVMName()); err != nil {
+		if !api.IsNotFoundError(err) {
+			return err
+		}
+	}
+
 	// This call is async, but we will receive an immediate error back for anything but very rare volume deletion
 	// failures. Failures in this category are almost certainly likely to be beyond our capability to fix or even
 	// diagnose, so we defer to the ONTAP cluster admin
@@ -978,6 +1051,7 @@ func (d *NASFlexGroupStorageDriver) Publish(
 	if volConfig.MountOptions != "" {
 		mountOptions = volConfig.MountOptions
 	}
+	mountOptions = getNfsMountOptions(&d.Config, mountOptions)
 
 	// Add fields needed by Attach
 	if d.Config.NASType == sa.SMB {
@@ -1235,6 +1309,11 @@ func (d *NASFlexGroupStorageDriver) RestoreSnapshot(
 	return nil
 }
 
+// RestoreRequiresNewestSnapshot indicates whether this driver can only restore a volume from its newest snapshot.
+func (d *NASFlexGroupStorageDriver) RestoreRequiresNewestSnapshot() bool {
+	return true
+}
+
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *NASFlexGroupStorageDriver) DeleteSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -1309,6 +1388,56 @@ func (d *NASFlexGroupStorageDriver) GetStorageBackendPhysicalPoolNames(context.C
 	return physicalPoolNames
 }
 
+// GetPoolCapacity returns the space in the aggregates across which FlexGroups are created
+func (d *NASFlexGroupStorageDriver) GetPoolCapacity(
+	ctx context.Context, pool storage.Pool,
+) (*storage.PoolCapacity, error) {
+	if _, ok := d.virtualPools[pool.Name()]; !ok && pool.Name() != d.physicalPool.Name() {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	aggregates := d.Config.FlexGroupAggregateList
+	if len(aggregates) == 0 {
+		vserverAggrs, err := d.vserverAggregates(ctx, d.Config.SVM)
+		if err != nil {
+			return nil, err
+		}
+		aggregates = vserverAggrs
+	}
+
+	return getAggregateCapacityCommon(ctx, aggregates, d.API)
+}
+
+// GetVolumeHealth reports whether a volume's FlexGroup is missing, offline or full
+func (d *NASFlexGroupStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	name := volConfig.InternalName
+
+	exists, err := d.API.FlexgroupExists(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not check for FlexGroup %s; %v", name, err)
+	}
+	if !exists {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("FlexGroup %s was not found or is not online", name),
+		}, nil
+	}
+
+	volume, err := d.API.FlexgroupInfo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get FlexGroup %s; %v", name, err)
+	}
+
+	usedBytes, err := d.API.FlexgroupUsedSize(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get used space of FlexGroup %s; %v", name, err)
+	}
+
+	return getVolumeSpaceHealth(name, volume, usedBytes), nil
+}
+
 func (d *NASFlexGroupStorageDriver) vserverAggregates(ctx context.Context, svmName string) ([]string, error) {
 	var err error
 	// Get the aggregates assigned to the SVM.  There must be at least one!
@@ -1324,14 +1453,23 @@ func (d *NASFlexGroupStorageDriver) vserverAggregates(ctx context.Context, svmNa
 	return vserverAggrs, nil
 }
 
+// GetVolumeMetrics reports the space used by each volume's FlexGroup and its performance
+func (d *NASFlexGroupStorageDriver) GetVolumeMetrics(
+	ctx context.Context, volConfigs []*storage.VolumeConfig,
+) (map[string]*storage.VolumeMetrics, error) {
+	return getVolumeMetricsCommon(ctx, volConfigs, *d.Config.StoragePrefix+"*", d.API.VolumeListMetrics,
+		flexvolName)
+}
+
 func (d *NASFlexGroupStorageDriver) getStoragePoolAttributes() map[string]sa.Offer {
 	return map[string]sa.Offer{
 		sa.BackendType:      sa.NewStringOffer(d.Name()),
 		sa.Snapshots:        sa.NewBoolOffer(true),
 		sa.Encryption:       sa.NewBoolOffer(true),
-		sa.Replication:      sa.NewBoolOffer(false),
+		sa.Replication:      sa.NewBoolOffer(true),
 		sa.Clones:           sa.NewBoolOffer(true),
 		sa.ProvisioningType: sa.NewStringOffer("thick", "thin"),
+		sa.NFSSecurity:      sa.NewStringOffer(d.Config.NfsSecurity),
 	}
 }
 
@@ -1377,7 +1515,7 @@ func (d *NASFlexGroupStorageDriver) CreateFollowup(ctx context.Context, volConfi
 				volConfig.InternalName)
 			// Overwriting mount path, mounting at root instead of admin share
 			volConfig.AccessInfo.SMBPath = "/" + volConfig.InternalName
-			err = d.MountFlexgroup(ctx, volConfig.InternalName, volConfig.AccessInfo.SMBPath)
+			err = d.MountFlexgroup(ctx, volConfig.InternalName, volConfig.AccessInfo.SMBPath, flexgroup)
 			if err != nil {
 				return err
 			}
@@ -1390,7 +1528,7 @@ func (d *NASFlexGroupStorageDriver) CreateFollowup(ctx context.Context, volConfi
 			}
 		} else {
 			volConfig.AccessInfo.NfsPath = "/" + volConfig.InternalName
-			err = d.MountFlexgroup(ctx, volConfig.InternalName, volConfig.AccessInfo.NfsPath)
+			err = d.MountFlexgroup(ctx, volConfig.InternalName, volConfig.AccessInfo.NfsPath, flexgroup)
 			if err != nil {
 				return err
 			}
@@ -1613,10 +1751,196 @@ func (d NASFlexGroupStorageDriver) GetCommonConfig(context.Context) *drivers.Com
 	return d.Config.CommonStorageDriverConfig
 }
 
+// getMirrorDestination returns the FlexGroup that is the destination of a snapmirror relationship, ensuring that
+// its constituents are on aggregates assigned to the SVM and match the source FlexGroup's constituent count.
+func (d *NASFlexGroupStorageDriver) getMirrorDestination(
+	ctx context.Context, name, remoteVolumeHandle string,
+) (*api.Volume, error) {
+	flexgroup, err := d.API.FlexgroupInfo(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if flexgroup == nil {
+		return nil, fmt.Errorf("FlexGroup %s not found", name)
+	}
+
+	vserverAggrs, err := d.API.GetSVMAggregateNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if containsAll, _ := utils.SliceContainsElements(vserverAggrs, flexgroup.Aggregates); !containsAll {
+		return nil, fmt.Errorf("not all aggregates of FlexGroup %s are assigned to the SVM; FlexGroup aggregates: %v "+
+			"assigned aggregates: %v", name, flexgroup.Aggregates, vserverAggrs)
+	}
+
+	remoteSVMName, remoteFlexgroupName, err := parseVolumeHandle(remoteVolumeHandle)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse remoteVolumeHandle '%v'; %v", remoteVolumeHandle, err)
+	}
+
+	localCount, err := d.API.FlexgroupConstituentCount(ctx, d.API.SVMName(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteCount, err := d.API.FlexgroupConstituentCount(ctx, remoteSVMName, remoteFlexgroupName)
+	if err != nil {
+		if !api.IsNotFoundError(err) {
+			return nil, err
+		}
+		// A source on a peered cluster isn't visible from here, so leave the check to ONTAP
+		Logc(ctx).WithError(err).Warningf("Could not verify the constituent count of source FlexGroup %s.",
+			remoteVolumeHandle)
+		return flexgroup, nil
+	}
+
+	if localCount != remoteCount {
+		return nil, fmt.Errorf("FlexGroup %s has %d constituents but source FlexGroup %s has %d; the "+
+			"destination must have the same number of constituents as the source", name, localCount,
+			remoteVolumeHandle, remoteCount)
+	}
+
+	return flexgroup, nil
+}
+
+// EstablishMirror will create a new snapmirror relationship between a RW and a DP FlexGroup that have not
+// previously had a relationship
+func (d *NASFlexGroupStorageDriver) EstablishMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule string,
+) error {
+	// If replication policy in TMR is empty use the backend policy
+	if replicationPolicy == "" {
+		replicationPolicy = d.GetConfig().ReplicationPolicy
+	}
+
+	// Validate replication policy, if it is invalid, use the backend policy
+	isAsync, err := validateReplicationPolicy(ctx, replicationPolicy, d.API)
+	if err != nil {
+		Logc(ctx).Debugf("Replication policy given in TMR %s is invalid, using policy %s from backend.",
+			replicationPolicy, d.GetConfig().ReplicationPolicy)
+		replicationPolicy = d.GetConfig().ReplicationPolicy
+		isAsync, err = validateReplicationPolicy(ctx, replicationPolicy, d.API)
+		if err != nil {
+			Logc(ctx).Debugf("Replication policy %s in backend should be valid.", replicationPolicy)
+		}
+	}
+
+	// If replication policy is async type, validate the replication schedule from TMR or use backend schedule
+	if isAsync {
+		if replicationSchedule != "" {
+			if err := validateReplicationSchedule(ctx, replicationSchedule, d.API); err != nil {
+				Logc(ctx).Debugf("Replication schedule given in TMR %s is invalid, using schedule %s from backend.",
+					replicationSchedule, d.GetConfig().ReplicationSchedule)
+				replicationSchedule = d.GetConfig().ReplicationSchedule
+			}
+		} else {
+			replicationSchedule = d.GetConfig().ReplicationSchedule
+		}
+	} else {
+		replicationSchedule = ""
+	}
+
+	getMirrorDestination := func(ctx context.Context, name string) (*api.Volume, error) {
+		return d.getMirrorDestination(ctx, name, remoteVolumeHandle)
+	}
+
+	return establishMirrorWithVolumeInfo(ctx, localInternalVolumeName, remoteVolumeHandle, replicationPolicy,
+		replicationSchedule, getMirrorDestination, d.API)
+}
+
+// ReestablishMirror will attempt to resync a snapmirror relationship,
+// if and only if the relationship existed previously
+func (d *NASFlexGroupStorageDriver) ReestablishMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule string,
+) error {
+	// If replication policy in TMR is empty use the backend policy
+	if replicationPolicy == "" {
+		replicationPolicy = d.GetConfig().ReplicationPolicy
+	}
+
+	// Validate replication policy, if it is invalid, use the backend policy
+	isAsync, err := validateReplicationPolicy(ctx, replicationPolicy, d.API)
+	if err != nil {
+		Logc(ctx).Debugf("Replication policy given in TMR %s is invalid, using policy %s from backend.",
+			replicationPolicy, d.GetConfig().ReplicationPolicy)
+		replicationPolicy = d.GetConfig().ReplicationPolicy
+		isAsync, err = validateReplicationPolicy(ctx, replicationPolicy, d.API)
+		if err != nil {
+			Logc(ctx).Debugf("Replication policy %s in backend should be valid.", replicationPolicy)
+		}
+	}
+
+	// If replication policy is async type, validate the replication schedule from TMR or use backend schedule
+	if isAsync {
+		if replicationSchedule != "" {
+			if err := validateReplicationSchedule(ctx, replicationSchedule, d.API); err != nil {
+				Logc(ctx).Debugf("Replication schedule given in TMR %s is invalid, using schedule %s from backend.",
+					replicationSchedule, d.GetConfig().ReplicationSchedule)
+				replicationSchedule = d.GetConfig().ReplicationSchedule
+			}
+		} else {
+			replicationSchedule = d.GetConfig().ReplicationSchedule
+		}
+	} else {
+		replicationSchedule = ""
+	}
+
+	// A FlexGroup being resynced must still be on aggregates assigned to the SVM and match its source
+	if _, err = d.getMirrorDestination(ctx, localInternalVolumeName, remoteVolumeHandle); err != nil {
+		return err
+	}
+
+	return reestablishMirror(ctx, localInternalVolumeName, remoteVolumeHandle, replicationPolicy, replicationSchedule,
+		d.API)
+}
+
+// PromoteMirror will break the snapmirror and make the destination FlexGroup RW,
+// optionally after a given snapshot has synced
+func (d *NASFlexGroupStorageDriver) PromoteMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) (bool, error) {
+	return promoteMirrorWithSnapshotList(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotName,
+		d.GetConfig().ReplicationPolicy, d.API.FlexgroupSnapshotList, d.API)
+}
+
+// UpdateMirror starts a transfer of a snapshot of the remote volume to a snapmirror destination
+func (d *NASFlexGroupStorageDriver) UpdateMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) error {
+	return updateMirror(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotName, d.API)
+}
+
+// GetMirrorStatus returns the current state of a snapmirror relationship
+func (d *NASFlexGroupStorageDriver) GetMirrorStatus(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
+) (string, error) {
+	return getMirrorStatus(ctx, localInternalVolumeName, remoteVolumeHandle, d.API)
+}
+
+// ReleaseMirror will release the snapmirror relationship data of the source FlexGroup
+func (d *NASFlexGroupStorageDriver) ReleaseMirror(ctx context.Context, localInternalVolumeName string) error {
+	return releaseMirror(ctx, localInternalVolumeName, d.API)
+}
+
+// GetReplicationDetails returns the replication policy and schedule of a snapmirror relationship
+func (d *NASFlexGroupStorageDriver) GetReplicationDetails(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
+) (string, string, string, error) {
+	return getReplicationDetails(ctx, localInternalVolumeName, remoteVolumeHandle, d.API)
+}
+
 // MountFlexgroup returns the flexgroup volume mount error(if any)
-func (d NASFlexGroupStorageDriver) MountFlexgroup(ctx context.Context, name, junctionPath string) error {
+func (d NASFlexGroupStorageDriver) MountFlexgroup(
+	ctx context.Context, name, junctionPath string, flexgroup *api.Volume,
+) error {
 	if err := d.API.FlexgroupMount(ctx, name, junctionPath); err != nil {
-		return fmt.Errorf("error mounting volume to junction %s; %v", junctionPath, err)
+		// An API error is returned if we attempt to mount a DP volume that has not yet been snapmirrored,
+		// we expect this to be the case.
+		if api.IsApiError(err) && flexgroup.DPVolume {
+			Logc(ctx).Debugf("Received expected API error when mounting DP FlexGroup to junction; %v", err)
+		} else {
+			return fmt.Errorf("error mounting volume to junction %s; %v", junctionPath, err)
+		}
 	}
 	return nil
 }
@@ -1251,6 +1251,7 @@ func TestOntapNasFlexgroupStorageDriverVolumeDestroy(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.message, func(t *testing.T) {
 			mockAPI.EXPECT().SVMName().AnyTimes().Return(svmName)
+			mockAPI.EXPECT().SnapmirrorDeleteViaDestination(ctx, volConfig.InternalName, svmName).Return(nil)
 			mockAPI.EXPECT().FlexgroupDestroy(ctx, volConfig.InternalName, true).Return(nil)
 			if test.nasType == sa.SMB {
 				if test.smbShare == "" {
@@ -1281,6 +1282,7 @@ func TestOntapNasFlexgroupStorageDriverVolumeDestroy_Fail(t *testing.T) {
 	}
 
 	mockAPI.EXPECT().SVMName().AnyTimes().Return(svmName)
+	mockAPI.EXPECT().SnapmirrorDeleteViaDestination(ctx, volConfig.InternalName, svmName).Return(nil)
 	mockAPI.EXPECT().FlexgroupDestroy(ctx, volConfig.InternalName, true).Return(fmt.Errorf("cannot delete volume"))
 
 	result := driver.Destroy(ctx, volConfig)
@@ -1313,6 +1315,7 @@ func TestOntapNasFlexgroupStorageDriverSMBShareDestroy_VolumeNotFound(t *testing
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			mockAPI.EXPECT().SVMName().AnyTimes().Return(svmName)
+			mockAPI.EXPECT().SnapmirrorDeleteViaDestination(ctx, volConfig.InternalName, svmName).Return(nil)
 			mockAPI.EXPECT().FlexgroupDestroy(ctx, volConfig.InternalName, true).Return(nil)
 			if test.name == "SMBShareServerError" {
 				mockAPI.EXPECT().SMBShareExists(ctx, volNameInternal).Return(false,
@@ -1345,6 +1348,7 @@ func TestOntapNasFlexgroupStorageDriverSMBDestroy_Fail(t *testing.T) {
 	driver.Config.NASType = sa.SMB
 
 	mockAPI.EXPECT().SVMName().AnyTimes().Return(svmName)
+	mockAPI.EXPECT().SnapmirrorDeleteViaDestination(ctx, volConfig.InternalName, svmName).Return(nil)
 	mockAPI.EXPECT().FlexgroupDestroy(ctx, volConfig.InternalName, true).Return(nil)
 	mockAPI.EXPECT().SMBShareExists(ctx, volNameInternal).Return(true, nil)
 	mockAPI.EXPECT().SMBShareDestroy(ctx, volNameInternal).Return(fmt.Errorf("cannot delete SMB share"))
@@ -2688,8 +2692,9 @@ func TestOntapNasFlexgroupStorageDriverCreateFollowup_VolumeMountFailure(t *test
 			if test.name == "MountVolumeAPIError" {
 				mockAPI.EXPECT().FlexgroupMount(ctx, "vol1", "/vol1").Return(api.ApiError(test.message))
 
+				// A DP FlexGroup cannot be mounted until it has been snapmirrored
 				result := driver.CreateFollowup(ctx, volConfig)
-				assert.Error(t, result, "Flexgroup volume mounted")
+				assert.NoError(t, result, "DP Flexgroup volume mount not tolerated")
 			} else if test.name == "MountNFSVolumeFailed" {
 				mockAPI.EXPECT().FlexgroupMount(ctx, "vol1", "/vol1").Return(fmt.Errorf(test.message))
 
@@ -2758,6 +2763,7 @@ func TestOntapNasFlexgroupStorageDriverCreateFollowup_WithJunctionPath_NASType_S
 
 func TestOntapNasFlexgroupStorageDriverCreateFollowup_GetStoragePoolAttributes(t *testing.T) {
 	_, driver := newMockOntapNASFlexgroupDriver(t)
+	driver.Config.NfsSecurity = "krb5p"
 
 	poolAttr := driver.getStoragePoolAttributes()
 
@@ -2766,8 +2772,9 @@ func TestOntapNasFlexgroupStorageDriverCreateFollowup_GetStoragePoolAttributes(t
 	assert.Equal(t, "true", poolAttr[Snapshots].ToString())
 	assert.Equal(t, "true", poolAttr[Clones].ToString())
 	assert.Equal(t, "true", poolAttr[Encryption].ToString())
-	assert.Equal(t, "false", poolAttr[Replication].ToString())
+	assert.Equal(t, "true", poolAttr[Replication].ToString())
 	assert.Equal(t, "thick,thin", poolAttr[ProvisioningType].ToString())
+	assert.Equal(t, "krb5p", poolAttr[sa.NFSSecurity].ToString())
 }
 
 func TestOntapNasFlexgroupStorageDriverCreatePrepare(t *testing.T) {
@@ -3203,3 +3210,348 @@ func TestOntapNasFlexgroupGetVserverAggrMediaType(t *testing.T) {
 		})
 	}
 }
+
+func TestOntapNasFlexgroupGetVolumeHealth(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	volConfig := &storage.VolumeConfig{InternalName: "fg1"}
+
+	mockAPI.EXPECT().FlexgroupExists(ctx, "fg1").Return(true, nil)
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fg1").Return(&api.Volume{Size: "1000"}, nil)
+	mockAPI.EXPECT().FlexgroupUsedSize(ctx, "fg1").Return(100, nil)
+
+	health, err := driver.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.False(t, health.Abnormal)
+
+	mockAPI.EXPECT().FlexgroupExists(ctx, "fg1").Return(true, nil)
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fg1").Return(&api.Volume{Size: "1000"}, nil)
+	mockAPI.EXPECT().FlexgroupUsedSize(ctx, "fg1").Return(1000, nil)
+
+	health, err = driver.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+
+	mockAPI.EXPECT().FlexgroupExists(ctx, "fg1").Return(false, nil)
+
+	health, err = driver.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+}
+
+func TestOntapNasFlexgroupGetVolumeMetrics(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	volConfigs := []*storage.VolumeConfig{{Name: "fg1", InternalName: *driver.Config.StoragePrefix + "fg1"}}
+
+	mockAPI.EXPECT().VolumeListMetrics(ctx, *driver.Config.StoragePrefix+"*").Return(map[string]*api.Metrics{
+		*driver.Config.StoragePrefix + "fg1": {UsedBytes: 100, TotalBytes: 1000, ReadThroughput: 4096},
+	}, nil)
+
+	metrics, err := driver.GetVolumeMetrics(ctx, volConfigs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*storage.VolumeMetrics{
+		"fg1": {UsedBytes: 100, TotalBytes: 1000, ReadThroughput: 4096},
+	}, metrics)
+}
+
+func TestOntapNasFlexgroupStorageDriverVolumeCreate_MirrorDestination(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Size:                "400g",
+		FileSystem:          "nfs",
+		InternalName:        "vol1",
+		PeerVolumeHandle:    "fakesvm:vol1",
+		IsMirrorDestination: true,
+	}
+
+	sb := &storage.StorageBackend{}
+	sb.SetBackendUUID(BackendUUID)
+	pool1 := storage.NewStoragePool(sb, "pool1")
+	pool1.SetInternalAttributes(map[string]string{
+		SpaceReserve:    "none",
+		SnapshotPolicy:  "none",
+		SnapshotReserve: "0",
+		SnapshotDir:     "true",
+		Encryption:      "false",
+	})
+	driver.physicalPool = pool1
+	driver.Config.FlexGroupConstituentCount = "8"
+	volAttrs := map[string]sa.Request{}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().FlexgroupExists(ctx, "vol1").Return(false, nil)
+	mockAPI.EXPECT().GetSVMPeers(ctx).Return([]string{"fakesvm"}, nil)
+	mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{"aggr1", "aggr2"}, nil)
+	mockAPI.EXPECT().FlexgroupCreate(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, flexgroup api.Volume) error {
+			assert.True(t, flexgroup.DPVolume)
+			assert.Equal(t, 4, flexgroup.ConstituentsPerAggregate)
+			assert.Equal(t, []string{"aggr1", "aggr2"}, flexgroup.Aggregates)
+			return nil
+		})
+
+	result := driver.Create(ctx, volConfig, pool1, volAttrs)
+
+	assert.NoError(t, result)
+}
+
+func TestOntapNasFlexgroupStorageDriverVolumeCreate_MirrorDestinationNotPeered(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Size:                "400g",
+		FileSystem:          "nfs",
+		InternalName:        "vol1",
+		PeerVolumeHandle:    "fakesvm:vol1",
+		IsMirrorDestination: true,
+	}
+	pool1 := storage.NewStoragePool(nil, "pool1")
+	driver.physicalPool = pool1
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().FlexgroupExists(ctx, "vol1").Return(false, nil)
+	mockAPI.EXPECT().GetSVMPeers(ctx).Return([]string{"othersvm"}, nil)
+
+	result := driver.Create(ctx, volConfig, pool1, map[string]sa.Request{})
+
+	assert.Error(t, result)
+	assert.True(t, drivers.IsBackendIneligibleError(result))
+}
+
+func TestOntapNasFlexgroupStorageDriverGetConstituentsPerAggregate(t *testing.T) {
+	_, driver := newMockOntapNASFlexgroupDriver(t)
+
+	tests := []struct {
+		name             string
+		constituentCount string
+		aggregates       []string
+		expected         int
+		expectErr        bool
+	}{
+		{"NotSet", "", []string{"aggr1"}, 0, false},
+		{"OneAggregate", "8", []string{"aggr1"}, 8, false},
+		{"TwoAggregates", "8", []string{"aggr1", "aggr2"}, 4, false},
+		{"NotANumber", "eight", []string{"aggr1"}, 0, true},
+		{"Zero", "0", []string{"aggr1"}, 0, true},
+		{"Uneven", "9", []string{"aggr1", "aggr2"}, 0, true},
+		{"NoAggregates", "8", []string{}, 0, true},
+		{"TooMany", "2002", []string{"aggr1", "aggr2"}, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			driver.Config.FlexGroupConstituentCount = test.constituentCount
+
+			constituentsPerAggregate, err := driver.getConstituentsPerAggregate(test.aggregates)
+
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, constituentsPerAggregate)
+		})
+	}
+}
+
+func TestOntapNasFlexgroupStorageDriverEstablishMirror(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	flexgroup := &api.Volume{
+		Name:       "fakevolume1",
+		DPVolume:   true,
+		Aggregates: []string{ONTAPTEST_VSERVER_AGGR_NAME},
+	}
+	snapmirror := &api.Snapmirror{
+		State:              "uninitialized",
+		RelationshipStatus: "idle",
+	}
+	snapmirror2 := &api.Snapmirror{
+		State:              "snapmirrored",
+		RelationshipStatus: "idle",
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fakevolume1").Return(flexgroup, nil)
+	mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm1", "fakevolume1").Return(8, nil)
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm2", "fakevolume2").Return(8, nil)
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+	mockAPI.EXPECT().SnapmirrorInitialize(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil)
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror2, nil)
+
+	result := driver.EstablishMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "", "")
+
+	assert.NoError(t, result)
+}
+
+func TestOntapNasFlexgroupStorageDriverEstablishMirror_Failure(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+
+	dpVolume := &api.Volume{DPVolume: true, Aggregates: []string{ONTAPTEST_VSERVER_AGGR_NAME}}
+
+	tests := []struct {
+		name        string
+		flexgroup   *api.Volume
+		err         error
+		localCount  int
+		remoteCount int
+		countErr    error
+	}{
+		{"FlexgroupInfoFailed", nil, fmt.Errorf("failed to get FlexGroup"), 0, 0, nil},
+		{"FlexgroupNotFound", nil, nil, 0, 0, nil},
+		{"AggregateNotAssigned", &api.Volume{DPVolume: true, Aggregates: []string{"aggr2"}}, nil, 0, 0, nil},
+		{"ConstituentCountFailed", dpVolume, nil, 0, 0, fmt.Errorf("failed to list constituents")},
+		{"ConstituentCountMismatch", dpVolume, nil, 4, 8, nil},
+		{
+			"NotDPVolume", &api.Volume{DPVolume: false, Aggregates: []string{ONTAPTEST_VSERVER_AGGR_NAME}}, nil,
+			8, 8, nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockAPI.EXPECT().FlexgroupInfo(ctx, "fakevolume1").Return(test.flexgroup, test.err)
+			if test.flexgroup != nil {
+				mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
+			}
+			if test.countErr != nil {
+				mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm1", "fakevolume1").Return(0, test.countErr)
+			} else if test.localCount != 0 {
+				mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm1", "fakevolume1").Return(test.localCount, nil)
+				mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm2", "fakevolume2").Return(test.remoteCount,
+					nil)
+			}
+
+			result := driver.EstablishMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "", "")
+
+			assert.Error(t, result)
+		})
+	}
+}
+
+func TestOntapNasFlexgroupStorageDriverReestablishMirror(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	flexgroup := &api.Volume{
+		Name:       "fakevolume1",
+		DPVolume:   false,
+		Aggregates: []string{ONTAPTEST_VSERVER_AGGR_NAME},
+	}
+	snapmirror := &api.Snapmirror{
+		State:              "broken_off",
+		RelationshipStatus: "idle",
+		LastTransferType:   "update",
+		IsHealthy:          true,
+	}
+	snapmirror2 := &api.Snapmirror{
+		State:              "snapmirrored",
+		RelationshipStatus: "idle",
+		IsHealthy:          true,
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fakevolume1").Return(flexgroup, nil)
+	mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm1", "fakevolume1").Return(8, nil)
+	// The source is on a peered cluster, so its constituents cannot be counted from here
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm2", "fakevolume2").Return(0,
+		api.NotFoundError("not found"))
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil,
+		api.NotFoundError("not found"))
+	mockAPI.EXPECT().SnapmirrorCreate(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2", "", "").Return(nil)
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+	mockAPI.EXPECT().SnapmirrorResync(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil)
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror2, nil)
+
+	result := driver.ReestablishMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "", "")
+
+	assert.NoError(t, result)
+}
+
+func TestOntapNasFlexgroupStorageDriverReestablishMirror_AggregateNotAssigned(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	flexgroup := &api.Volume{
+		Name:       "fakevolume1",
+		Aggregates: []string{"aggr1", "aggr2"},
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fakevolume1").Return(flexgroup, nil)
+	mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{"aggr1"}, nil)
+
+	result := driver.ReestablishMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "", "")
+
+	assert.Error(t, result)
+}
+
+func TestOntapNasFlexgroupStorageDriverReestablishMirror_ConstituentCountMismatch(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	flexgroup := &api.Volume{
+		Name:       "fakevolume1",
+		Aggregates: []string{ONTAPTEST_VSERVER_AGGR_NAME},
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().FlexgroupInfo(ctx, "fakevolume1").Return(flexgroup, nil)
+	mockAPI.EXPECT().GetSVMAggregateNames(ctx).Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm1", "fakevolume1").Return(4, nil)
+	mockAPI.EXPECT().FlexgroupConstituentCount(ctx, "fakesvm2", "fakevolume2").Return(8, nil)
+
+	result := driver.ReestablishMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "", "")
+
+	assert.ErrorContains(t, result, "same number of constituents")
+}
+
+func TestOntapNasFlexgroupStorageDriverPromoteMirror(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	snapmirror := &api.Snapmirror{
+		State:              "snapmirrored",
+		RelationshipStatus: "idle",
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+	mockAPI.EXPECT().FlexgroupSnapshotList(ctx, "fakevolume1").Return(api.Snapshots{{Name: "snap0"}}, nil)
+
+	waitingForSnap, err := driver.PromoteMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "pvc-1/snap1")
+
+	assert.True(t, waitingForSnap)
+	assert.NoError(t, err)
+
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+	mockAPI.EXPECT().FlexgroupSnapshotList(ctx, "fakevolume1").Return(api.Snapshots{{Name: "snap1"}}, nil)
+	mockAPI.EXPECT().SnapmirrorQuiesce(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil)
+	mockAPI.EXPECT().SnapmirrorAbort(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil)
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+	mockAPI.EXPECT().SnapmirrorBreak(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2",
+		"snap1").Return(nil)
+	mockAPI.EXPECT().SnapmirrorDelete(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(nil)
+
+	waitingForSnap, err = driver.PromoteMirror(ctx, "fakevolume1", "fakesvm2:fakevolume2", "pvc-1/snap1")
+
+	assert.False(t, waitingForSnap)
+	assert.NoError(t, err)
+}
+
+func TestOntapNasFlexgroupStorageDriverGetMirrorStatus(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+	snapmirror := &api.Snapmirror{
+		State:              "snapmirrored",
+		RelationshipStatus: "idle",
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().SnapmirrorGet(ctx, "fakevolume1", "fakesvm1", "fakevolume2", "fakesvm2").Return(snapmirror, nil)
+
+	status, err := driver.GetMirrorStatus(ctx, "fakevolume1", "fakesvm2:fakevolume2")
+
+	assert.Equal(t, "established", status)
+	assert.NoError(t, err)
+}
+
+func TestOntapNasFlexgroupStorageDriverReleaseMirror(t *testing.T) {
+	mockAPI, driver := newMockOntapNASFlexgroupDriver(t)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("fakesvm1")
+	mockAPI.EXPECT().SnapmirrorRelease(ctx, "fakevolume1", "fakesvm1").Return(nil)
+
+	result := driver.ReleaseMirror(ctx, "fakevolume1")
+
+	assert.NoError(t, result)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -30,7 +31,11 @@ var QtreeInternalIDRegex = regexp.MustCompile(`^/svm/(?P<svm>[^/]+)/flexvol/(?P<
 
 const (
 	deletedQtreeNamePrefix                      = "deleted_"
+	restoringQtreeNamePrefix                    = "restoring_"
 	maxQtreeNameLength                          = 64
+	maxSnapshotNameLength                       = 255
+	maxFlexvolSnapshots                         = 1023
+	qtreeSnapshotSeparator                      = "__"
 	minQtreesPerFlexvol                         = 50
 	defaultQtreesPerFlexvol                     = 200
 	maxQtreesPerFlexvol                         = 300
@@ -180,7 +185,8 @@ func (d *NASQtreeStorageDriver) Initialize(
 	d.housekeepingWaitGroup = &sync.WaitGroup{}
 	d.housekeepingTasks = make(map[string]*HousekeepingTask, 2)
 	// pruneTasks := []func(){d.pruneUnusedFlexvols, d.reapDeletedQtrees}
-	// d.housekeepingTasks[pruneTask] = NewPruneTask(d, pruneTasks)
+	pruneTasks := []func(context.Context){d.pruneOrphanedQtreeSnapshots}
+	d.housekeepingTasks[pruneTask] = NewPruneTask(ctx, d, pruneTasks)
 	resizeTasks := []func(context.Context){d.resizeQuotas}
 	d.housekeepingTasks[resizeTask] = NewResizeTask(ctx, d, resizeTasks)
 	for _, task := range d.housekeepingTasks {
@@ -449,9 +455,12 @@ func (d *NASQtreeStorageDriver) Create(
 	return drivers.NewBackendIneligibleError(name, createErrors, physicalPoolNames)
 }
 
-// CreateClone creates a volume clone
+// CreateClone creates a volume clone.  The clone is a new qtree in the Flexvol of its source qtree, populated by
+// cloning the source qtree's files from a Flexvol snapshot, which shares their blocks with the snapshot rather than
+// copying their data.  If no source snapshot is specified, a temporary snapshot is created and then deleted once the
+// clone is populated.  Cloning files requires the ONTAP REST API.
 func (d *NASQtreeStorageDriver) CreateClone(
-	ctx context.Context, _, cloneVolConfig *storage.VolumeConfig, _ storage.Pool,
+	ctx context.Context, sourceVolConfig, cloneVolConfig *storage.VolumeConfig, _ storage.Pool,
 ) error {
 	name := cloneVolConfig.InternalName
 	source := cloneVolConfig.CloneSourceVolumeInternal
@@ -467,17 +476,324 @@ func (d *NASQtreeStorageDriver) CreateClone(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateClone")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateClone")
 
-	return fmt.Errorf("cloning is not supported by backend type %s", d.Name())
+	if !d.Config.UseREST {
+		return utils.UnsupportedError(fmt.Sprintf("cloning requires the ONTAP REST API with backend type %s",
+			d.Name()))
+	}
+
+	// Ensure the Flexvol isn't pruned, and the source snapshot isn't reaped, while the clone is populated
+	utils.Lock(ctx, "clone", d.sharedLockID)
+	defer utils.Unlock(ctx, "clone", d.sharedLockID)
+
+	// Ensure qtree name isn't too long
+	if len(name) > maxQtreeNameLength {
+		return fmt.Errorf("volume %s name exceeds the limit of %d characters", name, maxQtreeNameLength)
+	}
+
+	sourceInternalID := ""
+	if sourceVolConfig != nil {
+		sourceInternalID = sourceVolConfig.InternalID
+	}
+	sourceQtree, flexvol, err := d.getQtreeFlexvol(ctx, sourceInternalID, source)
+	if err != nil {
+		return fmt.Errorf("could not find clone source volume %s; %v", source, err)
+	}
+
+	// Ensure volume doesn't already exist
+	volumePattern, name, err := d.SetVolumePatternToFindQtree(ctx, cloneVolConfig.InternalID, name,
+		d.FlexvolNamePrefix())
+	if err != nil {
+		return err
+	}
+	exists, existsInFlexvol, err := d.API.QtreeExists(ctx, name, volumePattern)
+	if err != nil {
+		return fmt.Errorf("error checking for existing volume: %v", err)
+	}
+	if exists {
+		Logc(ctx).WithFields(LogFields{"qtree": name, "flexvol": existsInFlexvol}).Debug("Qtree already exists.")
+		if cloneVolConfig.InternalID == "" {
+			cloneVolConfig.InternalID = d.CreateQtreeInternalID(d.Config.SVM, existsInFlexvol, name)
+		}
+		return drivers.NewVolumeExistsError(name)
+	}
+
+	// Determine volume size in bytes
+	requestedSize, err := utils.ConvertSizeToBytes(cloneVolConfig.Size)
+	if err != nil {
+		return fmt.Errorf("could not convert volume size %s: %v", cloneVolConfig.Size, err)
+	}
+	sizeBytes, err := strconv.ParseUint(requestedSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%v is an invalid volume size: %v", cloneVolConfig.Size, err)
+	}
+
+	// The clone shares its source's Flexvol, so it must fit within the same limits as a new qtree
+	if err = d.checkFlexvolLimitsForQtree(ctx, flexvol, sizeBytes); err != nil {
+		return err
+	}
+
+	// Without a source snapshot, snapshot the source qtree as it is now
+	if snapshot == "" {
+		snapshot = cloneVolConfig.Name
+		flexvolSnapshot, err := qtreeSnapshotName(sourceQtree, snapshot)
+		if err != nil {
+			return err
+		}
+		if err = d.checkFlexvolSnapshotLimit(ctx, flexvol); err != nil {
+			return err
+		}
+		if err = d.API.VolumeSnapshotCreate(ctx, flexvolSnapshot, flexvol); err != nil {
+			return fmt.Errorf("could not create snapshot of clone source volume %s; %v", source, err)
+		}
+		defer func() {
+			if err := d.API.VolumeSnapshotDelete(ctx, flexvolSnapshot, flexvol); err != nil {
+				Logc(ctx).WithField("snapshot", flexvolSnapshot).WithError(err).Warning(
+					"Could not delete temporary clone snapshot.")
+			}
+		}()
+	}
+	flexvolSnapshot, err := qtreeSnapshotName(sourceQtree, snapshot)
+	if err != nil {
+		return err
+	}
+
+	// Grow the Flexvol to contain the new qtree
+	if err = d.resizeFlexvol(ctx, flexvol, sizeBytes); err != nil {
+		return fmt.Errorf("flexvol resize failed %s/%s: %v", flexvol, name, err)
+	}
+
+	// Create the qtree with the same attributes as its source
+	err = d.API.QtreeCreate(ctx, name, flexvol, cloneVolConfig.UnixPermissions, cloneVolConfig.ExportPolicy,
+		cloneVolConfig.SecurityStyle, cloneVolConfig.QosPolicy)
+	if err != nil {
+		d.shrinkFlexvol(ctx, flexvol, name)
+		return fmt.Errorf("qtree creation failed %s/%s: %v", flexvol, name, err)
+	}
+	cloneVolConfig.InternalID = d.CreateQtreeInternalID(d.Config.SVM, flexvol, name)
+
+	// Populate the qtree from the snapshot, and add its quota.  If either fails, the qtree is removed so that a
+	// retry doesn't find a volume that exists but was never populated.
+	if err = d.API.QtreeCloneFromSnapshot(ctx, flexvolSnapshot, flexvol, sourceQtree, name); err == nil {
+		err = d.setQuotaForQtree(ctx, name, flexvol, sizeBytes)
+	}
+	if err != nil {
+		path := fmt.Sprintf("/vol/%s/%s", flexvol, name)
+		if destroyErr := d.API.QtreeDestroyAsync(ctx, path, true); destroyErr != nil {
+			Logc(ctx).WithField("qtree", path).WithError(destroyErr).Error("Could not delete incomplete clone.")
+		}
+		d.shrinkFlexvol(ctx, flexvol, name)
+		return fmt.Errorf("could not populate clone %s from snapshot %s; %v", name, flexvolSnapshot, err)
+	}
+
+	if d.Config.NASType == sa.SMB {
+		if err = d.EnsureSMBShare(ctx, name, flexvol); err != nil {
+			return err
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{"qtree": name, "flexvol": flexvol}).Debug("Created qtree clone.")
+
+	return nil
 }
 
+// Import brings an existing qtree under Trident's control.  The qtree is named by its path within its Flexvol,
+// in the format <flexvol_name>/<qtree_name>.  If Trident will manage its lifecycle, the qtree is renamed to its
+// internal name and its Flexvol is moved into Trident's Flexvol naming, which requires that the Flexvol holds no
+// other qtree.  The Flexvol is recorded in the volume's internal ID.
 func (d *NASQtreeStorageDriver) Import(
-	context.Context, *storage.VolumeConfig, string,
-) error {
-	return errors.New("import is not implemented")
+	ctx context.Context, volConfig *storage.VolumeConfig, originalName string,
+) (err error) {
+	fields := LogFields{
+		"Method":       "Import",
+		"Type":         "NASQtreeStorageDriver",
+		"originalName": originalName,
+		"newName":      volConfig.InternalName,
+		"notManaged":   volConfig.ImportNotManaged,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Import")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Import")
+
+	flexvol, qtreeName, err := d.ParseQtreeImportName(originalName)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the Flexvol exists and is mounted where Trident expects to find its qtrees
+	volume, err := d.API.VolumeInfo(ctx, flexvol)
+	if err != nil {
+		return err
+	} else if volume == nil {
+		return fmt.Errorf("volume %s not found", flexvol)
+	}
+	if volume.AccessType != "" && volume.AccessType != "rw" {
+		Logc(ctx).WithField("originalName", originalName).Error("Could not import qtree, volume type is not rw.")
+		return fmt.Errorf("volume %s type is %s, not rw", flexvol, volume.AccessType)
+	}
+	if volume.JunctionPath != "/"+flexvol {
+		return fmt.Errorf("volume %s junction path is '%s', not /%s", flexvol, volume.JunctionPath, flexvol)
+	}
+
+	// Ensure the qtree exists
+	qtree, err := d.API.QtreeGetByName(ctx, qtreeName, flexvol)
+	if err != nil {
+		return err
+	} else if qtree == nil || qtree.Volume != flexvol {
+		return fmt.Errorf("qtree %s not found", originalName)
+	}
+
+	// Use the qtree's quota as its size
+	quotaStatus, err := d.API.QuotaStatus(ctx, flexvol)
+	if err != nil {
+		return err
+	}
+	if quotaStatus != "on" {
+		return fmt.Errorf("quotas are %s on volume %s, not on", quotaStatus, flexvol)
+	}
+	sizeBytes, err := d.getQuotaDiskLimitSize(ctx, qtreeName, flexvol)
+	if err != nil {
+		return fmt.Errorf("could not read quota of qtree %s: %v", originalName, err)
+	} else if sizeBytes <= 0 {
+		return fmt.Errorf("qtree %s has no quota disk limit", originalName)
+	}
+	volConfig.Size = strconv.FormatInt(sizeBytes, 10)
+	volConfig.UnixPermissions = qtree.UnixPermissions
+	volConfig.SecurityStyle = qtree.SecurityStyle
+	volConfig.ExportPolicy = qtree.ExportPolicy
+
+	// Rename the qtree and its Flexvol, and take over its quota and export policy, if Trident will manage its
+	// lifecycle
+	name := qtreeName
+	if !volConfig.ImportNotManaged {
+		name = volConfig.InternalName
+		if len(name) > maxQtreeNameLength {
+			return fmt.Errorf("volume %s name exceeds the limit of %d characters", name, maxQtreeNameLength)
+		}
+
+		originalFlexvol := flexvol
+		if !strings.HasPrefix(flexvol, d.FlexvolNamePrefix()) {
+			count, err := d.API.QtreeCount(ctx, flexvol)
+			if err != nil {
+				return fmt.Errorf("could not count qtrees in volume %s: %v", flexvol, err)
+			}
+			if count != 1 {
+				return fmt.Errorf("volume %s holds %d qtrees; only a qtree alone in its volume may be imported "+
+					"as managed, import it as not managed instead", flexvol, count)
+			}
+
+			newFlexvol := d.FlexvolNamePrefix() + utils.RandomString(10)
+			if err = d.renameQtreeFlexvol(ctx, flexvol, newFlexvol); err != nil {
+				Logc(ctx).WithField("originalName", originalName).Errorf(
+					"Could not import qtree, rename volume failed: %v", err)
+				return fmt.Errorf("volume %s rename failed: %v", flexvol, err)
+			}
+			flexvol = newFlexvol
+		}
+
+		// Restore the original names if any later step fails
+		qtreeRenamed := false
+		defer func() {
+			if err == nil {
+				return
+			}
+			if qtreeRenamed {
+				path := fmt.Sprintf("/vol/%s/%s", flexvol, name)
+				originalPath := fmt.Sprintf("/vol/%s/%s", flexvol, qtreeName)
+				if renameErr := d.API.QtreeRename(ctx, path, originalPath); renameErr != nil {
+					Logc(ctx).WithField("qtree", name).Errorf("Could not restore qtree name: %v", renameErr)
+				}
+			}
+			if flexvol != originalFlexvol {
+				if renameErr := d.renameQtreeFlexvol(ctx, flexvol, originalFlexvol); renameErr != nil {
+					Logc(ctx).WithField("flexvol", flexvol).Errorf("Could not restore volume name: %v", renameErr)
+				}
+			}
+		}()
+
+		if name != qtreeName {
+			path := fmt.Sprintf("/vol/%s/%s", flexvol, qtreeName)
+			newPath := fmt.Sprintf("/vol/%s/%s", flexvol, name)
+			if err = d.API.QtreeRename(ctx, path, newPath); err != nil {
+				Logc(ctx).WithField("originalName", originalName).Errorf(
+					"Could not import qtree, rename qtree failed: %v", err)
+				return fmt.Errorf("qtree %s rename failed: %v", originalName, err)
+			}
+			qtreeRenamed = true
+		}
+
+		if err = d.setQuotaForQtree(ctx, name, flexvol, uint64(sizeBytes)); err != nil {
+			return err
+		}
+
+		if d.Config.AutoExportPolicy {
+			exportPolicy := getExportPolicyName(volConfig.ImportBackendUUID)
+			if err = d.API.QtreeModifyExportPolicy(ctx, name, flexvol, exportPolicy); err != nil {
+				return fmt.Errorf("could not set export policy of qtree %s: %v", name, err)
+			}
+			volConfig.ExportPolicy = exportPolicy
+		}
+	}
+	volConfig.InternalID = d.CreateQtreeInternalID(d.Config.SVM, flexvol, name)
+
+	if d.Config.NASType == sa.SMB {
+		if err = d.EnsureSMBShare(ctx, name, flexvol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rename changes the name of a qtree.  A new name in the format <flexvol_name>/<qtree_name> also renames the
+// qtree's Flexvol, which returns a qtree imported as managed to its original location.
+func (d *NASQtreeStorageDriver) Rename(ctx context.Context, name, newName string) error {
+	fields := LogFields{
+		"Method":  "Rename",
+		"Type":    "NASQtreeStorageDriver",
+		"name":    name,
+		"newName": newName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Rename")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Rename")
+
+	exists, flexvol, err := d.API.QtreeExists(ctx, name, d.FlexvolNamePrefix()+"*")
+	if err != nil {
+		return fmt.Errorf("could not find qtree %s: %v", name, err)
+	} else if !exists {
+		return fmt.Errorf("qtree %s not found", name)
+	}
+
+	newFlexvol, newQtree := flexvol, newName
+	if parsedFlexvol, parsedQtree, parseErr := d.ParseQtreeImportName(newName); parseErr == nil {
+		newFlexvol, newQtree = parsedFlexvol, parsedQtree
+	}
+
+	if newQtree != name {
+		path := fmt.Sprintf("/vol/%s/%s", flexvol, name)
+		newPath := fmt.Sprintf("/vol/%s/%s", flexvol, newQtree)
+		if err = d.API.QtreeRename(ctx, path, newPath); err != nil {
+			return fmt.Errorf("qtree %s rename failed: %v", name, err)
+		}
+	}
+
+	if newFlexvol != flexvol {
+		if err = d.renameQtreeFlexvol(ctx, flexvol, newFlexvol); err != nil {
+			return fmt.Errorf("volume %s rename failed: %v", flexvol, err)
+		}
+	}
+
+	return nil
 }
 
-func (d *NASQtreeStorageDriver) Rename(context.Context, string, string) error {
-	return errors.New("rename is not implemented")
+// renameQtreeFlexvol renames a Flexvol and moves its junction to match, since the driver expects each Flexvol
+// holding qtrees to be mounted at /<flexvol_name>.
+func (d *NASQtreeStorageDriver) renameQtreeFlexvol(ctx context.Context, flexvol, newFlexvol string) error {
+	if err := d.API.VolumeRename(ctx, flexvol, newFlexvol); err != nil {
+		return err
+	}
+	if err := d.API.VolumeUnmount(ctx, newFlexvol, false); err != nil {
+		return err
+	}
+	return d.API.VolumeMount(ctx, newFlexvol, "/"+newFlexvol)
 }
 
 // Destroy the volume
@@ -522,15 +838,18 @@ func (d *NASQtreeStorageDriver) Destroy(ctx context.Context, volConfig *storage.
 		Logc(ctx).WithFields(LogFields{"InternalID": volConfig.InternalID}).Debug("setting InternalID")
 	}
 
+	// A qtree's own export policy cannot be deleted while the qtree uses it, so the qtree reverts to its Flexvol's
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		if err = d.destroyQtreeExportPolicy(ctx, name, flexvol); err != nil {
+			Logc(ctx).Error(err)
+			return deleteError
+		}
+	}
+
 	// Rename qtree so it doesn't show up in lists while ONTAP is deleting it in the background.
 	// Ensure the deleted name doesn't exceed the qtree name length limit of 64 characters.
 	path := fmt.Sprintf("/vol/%s/%s", flexvol, name)
-	deletedName := deletedQtreeNamePrefix + name + "_" + utils.RandomString(5)
-	if len(deletedName) > maxQtreeNameLength {
-		trimLength := len(deletedQtreeNamePrefix) + 10
-		deletedName = deletedQtreeNamePrefix + name[trimLength:] + "_" + utils.RandomString(5)
-	}
-	deletedPath := fmt.Sprintf("/vol/%s/%s", flexvol, deletedName)
+	deletedPath := fmt.Sprintf("/vol/%s/%s", flexvol, temporaryQtreeName(deletedQtreeNamePrefix, name))
 
 	err = d.API.QtreeRename(ctx, path, deletedPath)
 	if err != nil {
@@ -595,6 +914,7 @@ func (d *NASQtreeStorageDriver) Publish(
 	if volConfig.MountOptions != "" {
 		mountOptions = volConfig.MountOptions
 	}
+	mountOptions = getNfsMountOptions(&d.Config, mountOptions)
 
 	// Add fields needed by Attach
 	if d.Config.NASType == sa.SMB {
@@ -608,9 +928,50 @@ func (d *NASQtreeStorageDriver) Publish(
 		publishInfo.MountOptions = mountOptions
 	}
 
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		if err = publishVolumeExportPolicy(ctx, d.API, &d.Config, publishInfo,
+			getVolumeExportPolicyName(name)); err != nil {
+			return err
+		}
+		// The node must still be able to reach the qtree through its Flexvol
+		return publishShare(ctx, d.API, &d.Config, publishInfo, flexvol, d.API.VolumeModifyExportPolicy)
+	}
+
 	return d.publishQtreeShare(ctx, name, flexvol, publishInfo)
 }
 
+// Unpublish the volume from the host specified in publishInfo.  This method may or may not be running on the host
+// where the volume will be mounted, so it should limit itself to updating access rules, initiator groups, etc.
+// that require some host identity (but not locality) as well as storage controller API access.
+func (d *NASQtreeStorageDriver) Unpublish(
+	ctx context.Context, volConfig *storage.VolumeConfig, publishInfo *utils.VolumePublishInfo,
+) error {
+	name := volConfig.InternalName
+
+	fields := LogFields{
+		"Method": "Unpublish",
+		"Type":   "NASQtreeStorageDriver",
+		"name":   name,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Unpublish")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Unpublish")
+
+	if tridentconfig.CurrentDriverContext != tridentconfig.ContextCSI || !d.CanEnablePublishEnforcement() {
+		return nil
+	}
+
+	if usesVolumeExportPolicy(&d.Config, volConfig) {
+		return unpublishVolumeExportPolicy(ctx, d.API, &d.Config, publishInfo, getVolumeExportPolicyName(name))
+	}
+
+	// Move a qtree using the backend's export policy to its own once it is published to no node
+	if len(publishInfo.Nodes) == 0 {
+		return d.EnablePublishEnforcement(ctx, &storage.Volume{Config: volConfig})
+	}
+
+	return nil
+}
+
 func (d *NASQtreeStorageDriver) publishQtreeShare(
 	ctx context.Context, qtree, flexvol string, publishInfo *utils.VolumePublishInfo,
 ) error {
@@ -647,16 +1008,143 @@ func (d *NASQtreeStorageDriver) publishQtreeShare(
 	return publishShare(ctx, d.API, &d.Config, publishInfo, flexvol, d.API.VolumeModifyExportPolicy)
 }
 
+// destroyQtreeExportPolicy deletes the export policy of a qtree that has its own, after setting the qtree's export
+// policy to that of its Flexvol.
+func (d *NASQtreeStorageDriver) destroyQtreeExportPolicy(ctx context.Context, qtree, flexvol string) error {
+	volume, err := d.API.VolumeInfo(ctx, flexvol)
+	if err != nil {
+		return fmt.Errorf("error getting Flexvol %s; %v", flexvol, err)
+	}
+	if err = d.API.QtreeModifyExportPolicy(ctx, qtree, flexvol, volume.ExportPolicy); err != nil {
+		return fmt.Errorf("error modifying qtree export policy; %v", err)
+	}
+	destroyVolumeExportPolicy(ctx, d.API, getVolumeExportPolicyName(qtree))
+	return nil
+}
+
+// qtreeSnapshotName returns the name of the Flexvol snapshot holding a snapshot of a qtree.  Qtree snapshots are
+// ordinary snapshots of the qtree's Flexvol whose name is the qtree name and the snapshot name joined by
+// qtreeSnapshotSeparator, so each qtree's snapshots can be told apart from those of its neighbours.
+func qtreeSnapshotName(qtree, snapshot string) (string, error) {
+	if qtree == "" || snapshot == "" {
+		return "", fmt.Errorf("invalid qtree snapshot %s of qtree %s", snapshot, qtree)
+	}
+	if strings.Contains(snapshot, qtreeSnapshotSeparator) {
+		return "", fmt.Errorf("snapshot name %s may not contain '%s'", snapshot, qtreeSnapshotSeparator)
+	}
+	name := qtree + qtreeSnapshotSeparator + snapshot
+	if len(name) > maxSnapshotNameLength {
+		return "", fmt.Errorf("snapshot %s of qtree %s exceeds the limit of %d characters", snapshot, qtree,
+			maxSnapshotNameLength)
+	}
+	return name, nil
+}
+
+// parseQtreeSnapshotName splits the name of a Flexvol snapshot holding a qtree snapshot into the qtree and
+// snapshot names.  Qtree names may contain the separator but snapshot names may not, so the last one is used.
+func parseQtreeSnapshotName(name string) (qtree, snapshot string, ok bool) {
+	index := strings.LastIndex(name, qtreeSnapshotSeparator)
+	if index <= 0 || index+len(qtreeSnapshotSeparator) == len(name) {
+		return "", "", false
+	}
+	return name[:index], name[index+len(qtreeSnapshotSeparator):], true
+}
+
+// getQtreeFlexvol returns the name of a qtree and of the Flexvol containing it.
+func (d *NASQtreeStorageDriver) getQtreeFlexvol(
+	ctx context.Context, internalID, internalName string,
+) (qtree, flexvol string, err error) {
+	volumePattern, qtree, err := d.SetVolumePatternToFindQtree(ctx, internalID, internalName, d.FlexvolNamePrefix())
+	if err != nil {
+		return "", "", err
+	}
+	exists, flexvol, err := d.API.QtreeExists(ctx, qtree, volumePattern)
+	if err != nil {
+		return "", "", fmt.Errorf("error checking for existing qtree: %v", err)
+	}
+	if !exists {
+		return "", "", utils.NotFoundError(fmt.Sprintf("qtree %s not found", qtree))
+	}
+	return qtree, flexvol, nil
+}
+
+// getSnapshotQtreeFlexvol returns the name of the qtree of a snapshot and of the Flexvol containing it.
+func (d *NASQtreeStorageDriver) getSnapshotQtreeFlexvol(
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
+) (qtree, flexvol string, err error) {
+	internalID := ""
+	if volConfig != nil {
+		internalID = volConfig.InternalID
+	}
+	return d.getQtreeFlexvol(ctx, internalID, snapConfig.VolumeInternalName)
+}
+
+// temporaryQtreeName returns a unique name for a qtree that is being deleted or restored, made of a prefix and the
+// name of the qtree it replaces, trimmed so as not to exceed the qtree name length limit of 64 characters.
+func temporaryQtreeName(prefix, name string) string {
+	temporaryName := prefix + name + "_" + utils.RandomString(5)
+	if len(temporaryName) > maxQtreeNameLength {
+		trimLength := len(temporaryName) - maxQtreeNameLength
+		temporaryName = prefix + name[trimLength:] + "_" + utils.RandomString(5)
+	}
+	return temporaryName
+}
+
+// checkFlexvolLimitsForQtree ensures that a qtree may be added to a Flexvol without exceeding the limits on the
+// number of qtrees per Flexvol and on the Flexvol's size that Create applies when choosing a Flexvol.
+func (d *NASQtreeStorageDriver) checkFlexvolLimitsForQtree(
+	ctx context.Context, flexvol string, sizeBytes uint64,
+) error {
+	count, err := d.API.QtreeCount(ctx, flexvol)
+	if err != nil {
+		return fmt.Errorf("error enumerating qtrees: %v", err)
+	}
+	if count >= d.qtreesPerFlexvol {
+		return fmt.Errorf("flexvol %s already contains the limit of %d qtrees", flexvol, d.qtreesPerFlexvol)
+	}
+
+	shouldLimitFlexvolQuotaSize, flexvolQuotaSizeLimit, err := drivers.CheckVolumeSizeLimits(
+		ctx, sizeBytes, d.Config.CommonStorageDriverConfig)
+	if err != nil {
+		return err
+	}
+	if shouldLimitFlexvolQuotaSize {
+		sizeWithRequest, err := d.getOptimalSizeForFlexvol(ctx, flexvol, sizeBytes)
+		if err != nil {
+			return fmt.Errorf("error checking size of flexvol %s: %v", flexvol, err)
+		}
+		if sizeWithRequest > flexvolQuotaSizeLimit {
+			return fmt.Errorf("flexvol %s would exceed the size limit of %d bytes", flexvol, flexvolQuotaSizeLimit)
+		}
+	}
+
+	return nil
+}
+
+// checkFlexvolSnapshotLimit ensures that another snapshot may be created in a Flexvol, whose snapshots are shared
+// by all of its qtrees.
+func (d *NASQtreeStorageDriver) checkFlexvolSnapshotLimit(ctx context.Context, flexvol string) error {
+	snapshots, err := d.API.VolumeSnapshotList(ctx, flexvol)
+	if err != nil {
+		return fmt.Errorf("error enumerating snapshots: %v", err)
+	}
+	if len(snapshots) >= maxFlexvolSnapshots {
+		return fmt.Errorf("flexvol %s already has the limit of %d snapshots", flexvol, maxFlexvolSnapshots)
+	}
+	return nil
+}
+
 // CanSnapshot determines whether a snapshot as specified in the provided snapshot config may be taken.
 func (d *NASQtreeStorageDriver) CanSnapshot(
-	_ context.Context, _ *storage.SnapshotConfig, _ *storage.VolumeConfig,
+	_ context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
 ) error {
-	return utils.UnsupportedError(fmt.Sprintf("snapshots are not supported by backend type %s", d.Name()))
+	_, err := qtreeSnapshotName(snapConfig.VolumeInternalName, snapConfig.Name)
+	return err
 }
 
 // GetSnapshot returns a snapshot of a volume, or an error if it does not exist.
 func (d *NASQtreeStorageDriver) GetSnapshot(
-	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
 ) (*storage.Snapshot, error) {
 	fields := LogFields{
 		"Method":       "GetSnapshot",
@@ -667,7 +1155,20 @@ func (d *NASQtreeStorageDriver) GetSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetSnapshot")
 
-	return nil, utils.UnsupportedError(fmt.Sprintf("snapshots are not supported by backend type %s", d.Name()))
+	snapshots, err := d.getQtreeSnapshots(ctx, snapConfig, volConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Config.Name == snapConfig.Name {
+			snapConfig.InternalName = snapshot.Config.InternalName
+			snapshot.Config = snapConfig
+			return snapshot, nil
+		}
+	}
+
+	return nil, nil
 }
 
 // GetSnapshots returns the list of snapshots associated with the specified volume
@@ -682,13 +1183,62 @@ func (d *NASQtreeStorageDriver) GetSnapshots(ctx context.Context, volConfig *sto
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetSnapshots")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetSnapshots")
 
-	// Qtrees can't have snapshots, so return an empty list
-	return []*storage.Snapshot{}, nil
+	snapConfig := &storage.SnapshotConfig{
+		VolumeName:         volConfig.Name,
+		VolumeInternalName: volConfig.InternalName,
+	}
+	return d.getQtreeSnapshots(ctx, snapConfig, volConfig)
+}
+
+// getQtreeSnapshots returns the snapshots of the qtree named in a snapshot config, which are those snapshots of
+// its Flexvol whose names start with the qtree name and the separator.  Each snapshot is reported with the size
+// of the qtree's quota, as the space it uses is shared with the rest of the Flexvol.
+func (d *NASQtreeStorageDriver) getQtreeSnapshots(
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	qtree, flexvol, err := d.getSnapshotQtreeFlexvol(ctx, snapConfig, volConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := d.getQuotaDiskLimitSize(ctx, qtree, flexvol)
+	if err != nil {
+		return nil, fmt.Errorf("error reading volume size: %v", err)
+	}
+
+	flexvolSnapshots, err := d.API.VolumeSnapshotList(ctx, flexvol)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating snapshots: %v", err)
+	}
+
+	result := make([]*storage.Snapshot, 0)
+
+	for _, flexvolSnapshot := range flexvolSnapshots {
+		snapQtree, snapName, ok := parseQtreeSnapshotName(flexvolSnapshot.Name)
+		if !ok || snapQtree != qtree {
+			continue
+		}
+
+		result = append(result, &storage.Snapshot{
+			Config: &storage.SnapshotConfig{
+				Version:            tridentconfig.OrchestratorAPIVersion,
+				Name:               snapName,
+				InternalName:       flexvolSnapshot.Name,
+				VolumeName:         snapConfig.VolumeName,
+				VolumeInternalName: snapConfig.VolumeInternalName,
+			},
+			Created:   flexvolSnapshot.CreateTime,
+			SizeBytes: size,
+			State:     storage.SnapshotStateOnline,
+		})
+	}
+
+	return result, nil
 }
 
 // CreateSnapshot creates a snapshot for the given volume
 func (d *NASQtreeStorageDriver) CreateSnapshot(
-	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
 ) (*storage.Snapshot, error) {
 	fields := LogFields{
 		"Method":       "CreateSnapshot",
@@ -699,12 +1249,43 @@ func (d *NASQtreeStorageDriver) CreateSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateSnapshot")
 
-	return nil, utils.UnsupportedError(fmt.Sprintf("snapshots are not supported by backend type %s", d.Name()))
+	qtree, flexvol, err := d.getSnapshotQtreeFlexvol(ctx, snapConfig, volConfig)
+	if err != nil {
+		return nil, err
+	}
+	flexvolSnapshot, err := qtreeSnapshotName(qtree, snapConfig.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.checkFlexvolSnapshotLimit(ctx, flexvol); err != nil {
+		return nil, err
+	}
+
+	if err = d.API.VolumeSnapshotCreate(ctx, flexvolSnapshot, flexvol); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := d.GetSnapshot(ctx, snapConfig, volConfig)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("could not find snapshot %s for source volume %s", flexvolSnapshot, qtree)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotName": snapConfig.InternalName,
+		"volumeName":   snapConfig.VolumeInternalName,
+	}).Info("Snapshot created.")
+
+	return snapshot, nil
 }
 
-// RestoreSnapshot restores a volume (in place) from a snapshot.
+// RestoreSnapshot restores a volume (in place) from a snapshot.  A new qtree with the same attributes is populated
+// by cloning the qtree's files from the Flexvol snapshot, and then takes the place of the original qtree, which is
+// deleted.  The other qtrees in the Flexvol are unaffected.  Cloning files requires the ONTAP REST API.
 func (d *NASQtreeStorageDriver) RestoreSnapshot(
-	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
 ) error {
 	fields := LogFields{
 		"Method":       "RestoreSnapshot",
@@ -715,12 +1296,100 @@ func (d *NASQtreeStorageDriver) RestoreSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> RestoreSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< RestoreSnapshot")
 
-	return utils.UnsupportedError(fmt.Sprintf("snapshots are not supported by backend type %s", d.Name()))
+	if !d.Config.UseREST {
+		return utils.UnsupportedError(fmt.Sprintf(
+			"snapshot restore requires the ONTAP REST API with backend type %s", d.Name()))
+	}
+
+	// Ensure the Flexvol isn't pruned, and the snapshot isn't reaped, while the qtree is restored
+	utils.Lock(ctx, "restore", d.sharedLockID)
+	defer utils.Unlock(ctx, "restore", d.sharedLockID)
+
+	qtree, flexvol, err := d.getSnapshotQtreeFlexvol(ctx, snapConfig, volConfig)
+	if err != nil {
+		return err
+	}
+	flexvolSnapshot, err := qtreeSnapshotName(qtree, snapConfig.Name)
+	if err != nil {
+		return err
+	}
+
+	// The restored qtree takes on the attributes and quota of the original
+	original, err := d.API.QtreeGetByName(ctx, qtree, flexvol)
+	if err != nil {
+		return fmt.Errorf("could not read qtree %s; %v", qtree, err)
+	}
+	size, err := d.getQuotaDiskLimitSize(ctx, qtree, flexvol)
+	if err != nil {
+		return fmt.Errorf("error reading volume size: %v", err)
+	}
+	sizeBytes := uint64(size)
+	qosPolicy := ""
+	if volConfig != nil {
+		qosPolicy = volConfig.QosPolicy
+	}
+
+	// Grow the Flexvol to contain both qtrees until the original is deleted, then shrink it again
+	if err = d.resizeFlexvol(ctx, flexvol, sizeBytes); err != nil {
+		return fmt.Errorf("flexvol resize failed %s/%s: %v", flexvol, qtree, err)
+	}
+	defer d.shrinkFlexvol(ctx, flexvol)
+
+	restoringName := temporaryQtreeName(restoringQtreeNamePrefix, qtree)
+	restoringPath := fmt.Sprintf("/vol/%s/%s", flexvol, restoringName)
+	err = d.API.QtreeCreate(ctx, restoringName, flexvol, original.UnixPermissions, original.ExportPolicy,
+		original.SecurityStyle, qosPolicy)
+	if err != nil {
+		return fmt.Errorf("qtree creation failed %s/%s: %v", flexvol, restoringName, err)
+	}
+
+	// Populate the new qtree from the snapshot, and add its quota.  If either fails, the new qtree is removed and
+	// the original is left untouched.
+	if err = d.API.QtreeCloneFromSnapshot(ctx, flexvolSnapshot, flexvol, qtree, restoringName); err == nil {
+		err = d.setQuotaForQtree(ctx, restoringName, flexvol, sizeBytes)
+	}
+	if err != nil {
+		if destroyErr := d.API.QtreeDestroyAsync(ctx, restoringPath, true); destroyErr != nil {
+			Logc(ctx).WithField("qtree", restoringPath).WithError(destroyErr).Error(
+				"Could not delete incomplete restore.")
+		}
+		return fmt.Errorf("could not restore volume %s from snapshot %s; %v", qtree, flexvolSnapshot, err)
+	}
+
+	// Swap the restored qtree for the original, then delete the original in the background
+	path := fmt.Sprintf("/vol/%s/%s", flexvol, qtree)
+	deletedPath := fmt.Sprintf("/vol/%s/%s", flexvol, temporaryQtreeName(deletedQtreeNamePrefix, qtree))
+	if err = d.API.QtreeRename(ctx, path, deletedPath); err != nil {
+		if destroyErr := d.API.QtreeDestroyAsync(ctx, restoringPath, true); destroyErr != nil {
+			Logc(ctx).WithField("qtree", restoringPath).WithError(destroyErr).Error(
+				"Could not delete incomplete restore.")
+		}
+		return fmt.Errorf("could not replace volume %s with its restored copy; %v", qtree, err)
+	}
+	if err = d.API.QtreeRename(ctx, restoringPath, path); err != nil {
+		if renameErr := d.API.QtreeRename(ctx, deletedPath, path); renameErr != nil {
+			Logc(ctx).WithField("qtree", deletedPath).WithError(renameErr).Error("Could not restore qtree name.")
+		}
+		return fmt.Errorf("could not replace volume %s with its restored copy; %v", qtree, err)
+	}
+	if err = d.setQuotaForQtree(ctx, qtree, flexvol, sizeBytes); err != nil {
+		Logc(ctx).WithField("qtree", qtree).WithError(err).Warning("Could not set quota of restored qtree.")
+	}
+	if err = d.API.QtreeDestroyAsync(ctx, deletedPath, true); err != nil {
+		Logc(ctx).WithField("qtree", deletedPath).WithError(err).Warning("Could not delete replaced qtree.")
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotName": flexvolSnapshot,
+		"volumeName":   qtree,
+	}).Debug("Restored snapshot.")
+
+	return nil
 }
 
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *NASQtreeStorageDriver) DeleteSnapshot(
-	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
+	ctx context.Context, snapConfig *storage.SnapshotConfig, volConfig *storage.VolumeConfig,
 ) error {
 	fields := LogFields{
 		"Method":       "DeleteSnapshot",
@@ -731,7 +1400,26 @@ func (d *NASQtreeStorageDriver) DeleteSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> DeleteSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< DeleteSnapshot")
 
-	return utils.UnsupportedError(fmt.Sprintf("snapshots are not supported by backend type %s", d.Name()))
+	qtree, flexvol, err := d.getSnapshotQtreeFlexvol(ctx, snapConfig, volConfig)
+	if err != nil {
+		if utils.IsNotFoundError(err) {
+			// Snapshots of a qtree that no longer exists are pruned by the housekeeping task
+			Logc(ctx).WithField("qtree", snapConfig.VolumeInternalName).Warning("Qtree not found.")
+			return nil
+		}
+		return err
+	}
+	flexvolSnapshot, err := qtreeSnapshotName(qtree, snapConfig.Name)
+	if err != nil {
+		return err
+	}
+
+	if err = d.API.VolumeSnapshotDelete(ctx, flexvolSnapshot, flexvol); err != nil {
+		return err
+	}
+
+	Logc(ctx).WithField("snapshotName", flexvolSnapshot).Debug("Deleted snapshot.")
+	return nil
 }
 
 // Get tests for the existence of a volume
@@ -1262,30 +1950,88 @@ func (d *NASQtreeStorageDriver) pruneUnusedFlexvols(ctx context.Context) {
 }
 
 // reapDeletedQtrees is called periodically by a background task.  Any qtrees
-// that have been deleted (discovered by virtue of having a well-known hardcoded
-// prefix on their names) are destroyed.  This is only needed for the exceptional case
-// in which a qtree was renamed (prior to being destroyed) but the subsequent
-// destroy call failed or was never made due to a process interruption.
+// that have been deleted, or were left behind by a snapshot restore (discovered by
+// virtue of having a well-known hardcoded prefix on their names) are destroyed.  This
+// is only needed for the exceptional case in which a qtree was renamed (prior to being
+// destroyed) or created for a restore, but the subsequent destroy call failed or was
+// never made due to a process interruption.
 func (d *NASQtreeStorageDriver) reapDeletedQtrees(ctx context.Context) {
-	// Ensure we don't reap any qtree that is involved in a qtree delete workflow
+	// Ensure we don't reap any qtree that is involved in a qtree delete or restore workflow
 	utils.Lock(ctx, "reap", d.sharedLockID)
 	defer utils.Unlock(ctx, "reap", d.sharedLockID)
 
 	Logc(ctx).Debug("Housekeeping, checking for deleted qtrees.")
 
-	// Get all deleted qtrees in all Flexvols managed by this driver
-	prefix := deletedQtreeNamePrefix + *d.Config.StoragePrefix
-	qtrees, err := d.API.QtreeListByPrefix(ctx, prefix, d.FlexvolNamePrefix())
+	// Get all deleted and restoring qtrees in all Flexvols managed by this driver
+	for _, namePrefix := range []string{deletedQtreeNamePrefix, restoringQtreeNamePrefix} {
+		prefix := namePrefix + *d.Config.StoragePrefix
+		qtrees, err := d.API.QtreeListByPrefix(ctx, prefix, d.FlexvolNamePrefix())
+		if err != nil {
+			Logc(ctx).Errorf("Error listing deleted qtrees. %v", err)
+			return
+		}
+
+		for _, qtree := range qtrees {
+			qtreePath := fmt.Sprintf("/vol/%s/%s", qtree.Volume, qtree.Name)
+			Logc(ctx).WithField("qtree", qtreePath).Debug("Housekeeping, reaping deleted qtree.")
+			if err := d.API.QtreeDestroyAsync(ctx, qtreePath, true); err != nil {
+				Logc(ctx).Error(err)
+			}
+		}
+	}
+}
+
+// pruneOrphanedQtreeSnapshots is called periodically by a background task.  Any Flexvol snapshots holding
+// snapshots of qtrees managed by this driver (discovered by virtue of the qtree snapshot naming scheme) whose qtree
+// no longer exists in that Flexvol are deleted.  This is only needed for the exceptional case in which a qtree was
+// destroyed, or a clone's temporary snapshot was left behind, without its snapshots being deleted.
+func (d *NASQtreeStorageDriver) pruneOrphanedQtreeSnapshots(ctx context.Context) {
+	// Ensure we don't prune any snapshot that is involved in a qtree provisioning workflow
+	utils.Lock(ctx, "pruneSnapshots", d.sharedLockID)
+	defer utils.Unlock(ctx, "pruneSnapshots", d.sharedLockID)
+
+	Logc(ctx).Debug("Housekeeping, checking for snapshots of deleted qtrees.")
+
+	// Get all qtrees in all Flexvols managed by this driver
+	qtrees, err := d.API.QtreeListByPrefix(ctx, *d.Config.StoragePrefix, d.FlexvolNamePrefix())
 	if err != nil {
-		Logc(ctx).Errorf("Error listing deleted qtrees. %v", err)
+		Logc(ctx).WithError(err).Error("Could not list qtrees.")
 		return
 	}
-
+	flexvolQtrees := make(map[string]map[string]bool)
 	for _, qtree := range qtrees {
-		qtreePath := fmt.Sprintf("/vol/%s/%s", qtree.Volume, qtree.Name)
-		Logc(ctx).WithField("qtree", qtreePath).Debug("Housekeeping, reaping deleted qtree.")
-		if err := d.API.QtreeDestroyAsync(ctx, qtreePath, true); err != nil {
-			Logc(ctx).Error(err)
+		if flexvolQtrees[qtree.Volume] == nil {
+			flexvolQtrees[qtree.Volume] = make(map[string]bool)
+		}
+		flexvolQtrees[qtree.Volume][qtree.Name] = true
+	}
+
+	volumes, err := d.API.VolumeListByPrefix(ctx, d.FlexvolNamePrefix())
+	if err != nil {
+		Logc(ctx).WithError(err).Error("Could not list Flexvols.")
+		return
+	}
+
+	for _, volume := range volumes {
+		flexvol := volume.Name
+
+		snapshots, err := d.API.VolumeSnapshotList(ctx, flexvol)
+		if err != nil {
+			Logc(ctx).WithField("flexvol", flexvol).WithError(err).Warning("Could not list Flexvol snapshots.")
+			continue
+		}
+
+		for _, snapshot := range snapshots {
+			qtree, _, ok := parseQtreeSnapshotName(snapshot.Name)
+			if !ok || !strings.HasPrefix(qtree, *d.Config.StoragePrefix) || flexvolQtrees[flexvol][qtree] {
+				continue
+			}
+
+			fields := LogFields{"flexvol": flexvol, "snapshot": snapshot.Name}
+			Logc(ctx).WithFields(fields).Debug("Housekeeping, deleting snapshot of deleted qtree.")
+			if err := d.API.VolumeSnapshotDelete(ctx, snapshot.Name, flexvol); err != nil {
+				Logc(ctx).WithFields(fields).WithError(err).Error("Could not delete snapshot of deleted qtree.")
+			}
 		}
 	}
 }
@@ -1305,25 +2051,47 @@ func (d *NASQtreeStorageDriver) ensureDefaultExportPolicy(ctx context.Context) e
 
 // ensureDefaultExportPolicyRule guarantees that the export policy used on Flexvols managed by this
 // driver has at least one rule, which is necessary (but not always sufficient) to enable qtrees
-// to be mounted by clients.
+// to be mounted by clients.  Rules that allow other security flavors than the backend's, such as
+// rules left from before the backend required Kerberos, are replaced.
 func (d *NASQtreeStorageDriver) ensureDefaultExportPolicyRule(ctx context.Context) error {
 	ruleList, err := d.API.ExportRuleList(ctx, d.flexvolExportPolicy)
 	if err != nil {
 		return fmt.Errorf("error listing export policy rules: %v", err)
 	}
 
+	securityFlavors := getExportRuleSecurityFlavors(&d.Config)
+
 	if len(ruleList) == 0 {
 
 		// No rules, so create one for IPv4 and IPv6
 		rules := []string{"0.0.0.0/0", "::/0"}
 		for _, rule := range rules {
-			err := d.API.ExportRuleCreate(ctx, d.flexvolExportPolicy, rule, d.Config.NASType)
+			err := d.API.ExportRuleCreate(ctx, d.flexvolExportPolicy, rule, d.Config.NASType, securityFlavors)
 			if err != nil {
 				return fmt.Errorf("error creating export rule: %v", err)
 			}
 		}
-	} else {
-		Logc(ctx).WithField("exportPolicy", d.flexvolExportPolicy).Debug("Export policy has at least one rule.")
+		return nil
+	}
+
+	Logc(ctx).WithField("exportPolicy", d.flexvolExportPolicy).Debug("Export policy has at least one rule.")
+
+	for clientMatch, rule := range ruleList {
+		if rule.HasSecurityFlavors(securityFlavors) {
+			continue
+		}
+		Logc(ctx).WithFields(LogFields{
+			"exportPolicy":    d.flexvolExportPolicy,
+			"clientMatch":     clientMatch,
+			"securityFlavors": securityFlavors,
+		}).Info("Replacing export rule with other security flavors.")
+		if err = d.API.ExportRuleCreate(ctx, d.flexvolExportPolicy, clientMatch, d.Config.NASType,
+			securityFlavors); err != nil {
+			return fmt.Errorf("error creating export rule: %v", err)
+		}
+		if err = d.API.ExportRuleDestroy(ctx, d.flexvolExportPolicy, rule.Index); err != nil {
+			return fmt.Errorf("error deleting export rule: %v", err)
+		}
 	}
 
 	return nil
@@ -1339,6 +2107,11 @@ func (d *NASQtreeStorageDriver) GetStorageBackendPhysicalPoolNames(context.Conte
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
 }
 
+// GetPoolCapacity returns the space in the aggregates backing a storage pool
+func (d *NASQtreeStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, pool, d.physicalPools, d.virtualPools, d.API)
+}
+
 func (d *NASQtreeStorageDriver) getStoragePoolAttributes() map[string]sa.Offer {
 	return map[string]sa.Offer{
 		sa.BackendType:      sa.NewStringOffer(d.Name()),
@@ -1415,12 +2188,11 @@ func (d *NASQtreeStorageDriver) CreateFollowup(ctx context.Context, volConfig *s
 	// Set export path info on the volume config
 	if d.Config.NASType == sa.SMB {
 		volConfig.AccessInfo.SMBServer = d.Config.DataLIF
-		volConfig.AccessInfo.SMBPath = ConstructOntapNASQTreeSMBVolumePath(ctx, d.Config.SMBShare, flexvol,
-			volConfig.InternalName)
+		volConfig.AccessInfo.SMBPath = ConstructOntapNASQTreeSMBVolumePath(ctx, d.Config.SMBShare, flexvol, name)
 		volConfig.FileSystem = sa.SMB
 	} else {
 		volConfig.AccessInfo.NfsServerIP = d.Config.DataLIF
-		volConfig.AccessInfo.NfsPath = fmt.Sprintf("/%s/%s", flexvol, volConfig.InternalName)
+		volConfig.AccessInfo.NfsPath = fmt.Sprintf("/%s/%s", flexvol, name)
 		volConfig.AccessInfo.MountOptions = strings.TrimPrefix(d.Config.NfsMountOptions, "-o ")
 	}
 
@@ -1523,8 +2295,9 @@ func (d *NASQtreeStorageDriver) GetVolumeExternalWrappers(
 			continue
 		}
 
-		// Don't include deleted qtrees
-		if strings.HasPrefix(qtree.Name, deletedQtreeNamePrefix) {
+		// Don't include deleted or restoring qtrees
+		if strings.HasPrefix(qtree.Name, deletedQtreeNamePrefix) ||
+			strings.HasPrefix(qtree.Name, restoringQtreeNamePrefix) {
 			continue
 		}
 
@@ -1851,6 +2624,41 @@ func (d *NASQtreeStorageDriver) resizeFlexvol(ctx context.Context, flexvol strin
 	return nil
 }
 
+// shrinkFlexvol returns a Flexvol to the optimal size for the quotas of its qtrees once a clone or restore no
+// longer needs the space it grew the Flexvol by.  Qtrees being deleted or restored, and the named qtrees, are not
+// counted.  Failures are only logged, since the Flexvol is merely larger than it needs to be.
+func (d *NASQtreeStorageDriver) shrinkFlexvol(ctx context.Context, flexvol string, deletedQtrees ...string) {
+	volAttrs, err := d.API.VolumeInfo(ctx, flexvol)
+	if err != nil {
+		Logc(ctx).WithField("flexvol", flexvol).WithError(err).Warning("Could not read Flexvol to shrink it.")
+		return
+	}
+	quotaEntries, err := d.API.QuotaEntryList(ctx, flexvol)
+	if err != nil {
+		Logc(ctx).WithField("flexvol", flexvol).WithError(err).Warning("Could not read quotas to shrink Flexvol.")
+		return
+	}
+
+	var totalDiskLimitBytes uint64
+	for _, rule := range quotaEntries {
+		qtree := path.Base(rule.Target)
+		if utils.SliceContainsString(deletedQtrees, qtree) || strings.HasPrefix(qtree, deletedQtreeNamePrefix) ||
+			strings.HasPrefix(qtree, restoringQtreeNamePrefix) {
+			continue
+		}
+		totalDiskLimitBytes += uint64(rule.DiskLimitBytes)
+	}
+
+	flexvolSizeBytes := calculateFlexvolEconomySizeBytes(ctx, flexvol, volAttrs, 0, totalDiskLimitBytes)
+	if currentSizeBytes, err := strconv.ParseUint(volAttrs.Size, 10, 64); err == nil &&
+		flexvolSizeBytes >= currentSizeBytes {
+		return
+	}
+	if err = d.API.VolumeSetSize(ctx, flexvol, strconv.FormatUint(flexvolSizeBytes, 10)); err != nil {
+		Logc(ctx).WithField("flexvol", flexvol).WithError(err).Warning("Could not shrink Flexvol.")
+	}
+}
+
 func (d *NASQtreeStorageDriver) ReconcileNodeAccess(
 	ctx context.Context, nodes []*utils.Node, backendUUID, _ string,
 ) error {
@@ -1872,6 +2680,26 @@ func (d *NASQtreeStorageDriver) ReconcileNodeAccess(
 	return reconcileNASNodeAccess(ctx, nodes, &d.Config, d.API, policyName)
 }
 
+// EnablePublishEnforcement gives a qtree its own export policy, allowing access only from the nodes to which it
+// is published.
+func (d *NASQtreeStorageDriver) EnablePublishEnforcement(ctx context.Context, volume *storage.Volume) error {
+	if volume.Config.ImportNotManaged {
+		return nil
+	}
+	qtree, flexvol, err := d.getQtreeFlexvol(ctx, volume.Config.InternalID, volume.Config.InternalName)
+	if err != nil {
+		return err
+	}
+	return enableVolumeExportPolicy(ctx, d.API, volume.Config, getVolumeExportPolicyName(qtree),
+		func(ctx context.Context, policyName string) error {
+			return d.API.QtreeModifyExportPolicy(ctx, qtree, flexvol, policyName)
+		})
+}
+
+func (d *NASQtreeStorageDriver) CanEnablePublishEnforcement() bool {
+	return d.Config.AutoExportPolicy && d.Config.PerVolumeExportPolicy
+}
+
 // GetBackendState returns the reason if SVM is offline, and a flag to indicate if there is change
 // in physical pools list.
 func (d *NASQtreeStorageDriver) GetBackendState(ctx context.Context) (string, *roaring.Bitmap) {
@@ -1918,6 +2746,16 @@ func (d NASQtreeStorageDriver) ParseQtreeInternalID(internalId string) (svm, fle
 	return
 }
 
+// ParseQtreeImportName parses the name of a qtree to import, which is in the format <flexvol_name>/<qtree_name>,
+// and returns the flexvol and qtree names
+func (d NASQtreeStorageDriver) ParseQtreeImportName(originalName string) (flexvol, qtree string, err error) {
+	parts := strings.Split(originalName, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("qtree name %s is not in the format <flexvol_name>/<qtree_name>", originalName)
+	}
+	return parts[0], parts[1], nil
+}
+
 // CreateQtreeInternalID creates a string in the format /svm/<svm_name>/flexvol/<flexvol_name>/qtree/<qtree_name>
 func (d NASQtreeStorageDriver) CreateQtreeInternalID(svm, flexvol, name string) string {
 	return fmt.Sprintf("/svm/%s/flexvol/%s/qtree/%s", svm, flexvol, name)
@@ -1935,6 +2773,11 @@ func (d NASQtreeStorageDriver) SetVolumePatternToFindQtree(
 		if volumePrefix != "" {
 			volumePattern = volumePrefix + "*"
 		}
+		// Qtrees imported without being managed are named by their path within their Flexvol
+		if flexvol, qtree, parseErr := d.ParseQtreeImportName(internalName); parseErr == nil {
+			volumePattern = flexvol
+			qtreeName = qtree
+		}
 	} else {
 		if _, flexVolumeName, qtreeName, err = d.ParseQtreeInternalID(internalID); err == nil {
 			volumePattern = flexVolumeName
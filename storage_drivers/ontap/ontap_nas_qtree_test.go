@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -16,6 +17,7 @@ import (
 	sa "github.com/netapp/trident/storage_attribute"
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/storage_drivers/ontap/api"
+	"github.com/netapp/trident/utils"
 )
 
 func newNASQtreeStorageDriver(api api.OntapAPI) *NASQtreeStorageDriver {
@@ -99,12 +101,13 @@ func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_NoRulesSet(t *testi
 
 	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
 	// Return an empty set of rules when asked for them
-	ruleListCall := mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(make(map[string]int), nil)
+	ruleListCall := mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(
+		make(map[string]api.ExportRule), nil)
 	// Ensure that the default rules are created after getting an empty list of rules
 	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[0],
-		gomock.Any()).After(ruleListCall).Return(nil)
+		gomock.Any(), gomock.Any()).After(ruleListCall).Return(nil)
 	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[1],
-		gomock.Any()).After(ruleListCall).Return(nil)
+		gomock.Any(), gomock.Any()).After(ruleListCall).Return(nil)
 
 	qtreeDriver := newNASQtreeStorageDriver(mockAPI)
 	qtreeDriver.flexvolExportPolicy = fakeExportPolicy
@@ -114,14 +117,41 @@ func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_NoRulesSet(t *testi
 	}
 }
 
+func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_Kerberos(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	fakeExportPolicy := "foobar"
+	rules := []string{"0.0.0.0/0", "::/0"}
+	flavors := []string{utils.NFSSecurityKrb5p}
+
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	// Return an empty set of rules when asked for them
+	ruleListCall := mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(
+		make(map[string]api.ExportRule), nil)
+	// Ensure that the default rules allow only the backend's Kerberos flavor
+	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[0], sa.NFS,
+		flavors).After(ruleListCall).Return(nil)
+	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[1], sa.NFS,
+		flavors).After(ruleListCall).Return(nil)
+
+	qtreeDriver := newNASQtreeStorageDriver(mockAPI)
+	qtreeDriver.flexvolExportPolicy = fakeExportPolicy
+	qtreeDriver.Config.NASType = sa.NFS
+	qtreeDriver.Config.NfsSecurity = utils.NFSSecurityKrb5p
+
+	if err := qtreeDriver.ensureDefaultExportPolicyRule(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_RulesExist(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
 	fakeExportPolicy := "foobar"
-	fakeRules := map[string]int{
-		"foo": 0,
-		"bar": 1,
-		"baz": 2,
+	fakeRules := map[string]api.ExportRule{
+		"foo": newExportRule(0, "any"),
+		"bar": newExportRule(1, "any"),
+		"baz": newExportRule(2, "any"),
 	}
 
 	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
@@ -136,6 +166,33 @@ func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_RulesExist(t *testi
 	}
 }
 
+func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_ReplacesOtherFlavors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+
+	fakeExportPolicy := "foobar"
+	flavors := []string{utils.NFSSecurityKrb5p}
+	fakeRules := map[string]api.ExportRule{
+		"0.0.0.0/0": newExportRule(1, "any"),
+		"::/0":      newExportRule(2, utils.NFSSecurityKrb5p),
+	}
+
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(fakeRules, nil)
+	// Ensure that the rule allowing any flavor is replaced by one allowing only the backend's Kerberos flavor
+	ruleCreateCall := mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), fakeExportPolicy, "0.0.0.0/0", sa.NFS,
+		flavors).Return(nil)
+	mockAPI.EXPECT().ExportRuleDestroy(gomock.Any(), fakeExportPolicy, 1).After(ruleCreateCall).Return(nil)
+
+	qtreeDriver := newNASQtreeStorageDriver(mockAPI)
+	qtreeDriver.flexvolExportPolicy = fakeExportPolicy
+	qtreeDriver.Config.NASType = sa.NFS
+	qtreeDriver.Config.NfsSecurity = utils.NFSSecurityKrb5p
+
+	if err := qtreeDriver.ensureDefaultExportPolicyRule(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_ErrorGettingRules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
@@ -161,9 +218,11 @@ func TestNASQtreeStorageDriver_ensureDefaultExportPolicyRule_ErrorCreatingRules(
 
 	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
 	// Return an empty set of rules when asked for them
-	ruleListCall := mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(make(map[string]int), nil)
+	ruleListCall := mockAPI.EXPECT().ExportRuleList(gomock.Any(), fakeExportPolicy).Return(
+		make(map[string]api.ExportRule), nil)
 	// Ensure that the default rules are created after getting an empty list of rules
-	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[0], gomock.Any()).After(ruleListCall).Return(
+	mockAPI.EXPECT().ExportRuleCreate(gomock.Any(), gomock.Any(), rules[0], gomock.Any(),
+		gomock.Any()).After(ruleListCall).Return(
 		fmt.Errorf("foobar"),
 	)
 
@@ -395,3 +454,572 @@ func TestNASQtreeStorageDriverGetBackendState(t *testing.T) {
 	assert.Equal(t, reason, StateReasonSVMUnreachable, "should be 'SVM is not reachable'")
 	assert.NotNil(t, changeMap, "should not be nil")
 }
+
+func TestQtreeSnapshotName(t *testing.T) {
+	name, err := qtreeSnapshotName("test_pvc_1", "snap-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "test_pvc_1__snap-1", name)
+
+	qtree, snapshot, ok := parseQtreeSnapshotName(name)
+	assert.True(t, ok)
+	assert.Equal(t, "test_pvc_1", qtree)
+	assert.Equal(t, "snap-1", snapshot)
+
+	// Qtree names may contain the separator, but snapshot names may not
+	qtree, snapshot, ok = parseQtreeSnapshotName("test__pvc_1__snap-1")
+	assert.True(t, ok)
+	assert.Equal(t, "test__pvc_1", qtree)
+	assert.Equal(t, "snap-1", snapshot)
+	_, err = qtreeSnapshotName("test_pvc_1", "snap__1")
+	assert.Error(t, err)
+
+	_, err = qtreeSnapshotName("test_pvc_1", strings.Repeat("s", maxSnapshotNameLength))
+	assert.Error(t, err)
+	_, err = qtreeSnapshotName("test_pvc_1", "")
+	assert.Error(t, err)
+
+	for _, name := range []string{"hourly.2023-05-01_0005", "__snap-1", "test_pvc_1__"} {
+		_, _, ok = parseQtreeSnapshotName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestNASQtreeStorageDriver_GetSnapshots(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Name:         "pvc-1",
+		InternalName: "test_pvc_1",
+		InternalID:   "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_1",
+	}
+
+	mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+	mockAPI.EXPECT().QuotaGetEntry(ctx, "flexvol1", "test_pvc_1", "tree").Return(
+		&api.QuotaEntry{DiskLimitBytes: 1073741824}, nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "flexvol1").Return(api.Snapshots{
+		{Name: "test_pvc_1__snap-1", CreateTime: "2023-05-01T10:00:00Z"},
+		{Name: "test_pvc_10__snap-1", CreateTime: "2023-05-01T10:00:00Z"},
+		{Name: "test_pvc_2__snap-1", CreateTime: "2023-05-01T10:00:00Z"},
+		{Name: "hourly.2023-05-01_0005", CreateTime: "2023-05-01T10:05:00Z"},
+	}, nil)
+
+	snapshots, err := driver.GetSnapshots(ctx, volConfig)
+
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, "snap-1", snapshots[0].Config.Name)
+	assert.Equal(t, "test_pvc_1__snap-1", snapshots[0].Config.InternalName)
+	assert.Equal(t, "pvc-1", snapshots[0].Config.VolumeName)
+	assert.Equal(t, int64(1073741824), snapshots[0].SizeBytes)
+}
+
+func TestNASQtreeStorageDriver_CreateSnapshot(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Name:         "pvc-1",
+		InternalName: "test_pvc_1",
+		InternalID:   "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_1",
+	}
+	snapConfig := &storage.SnapshotConfig{
+		Name:               "snap-1",
+		InternalName:       "snap-1",
+		VolumeName:         "pvc-1",
+		VolumeInternalName: "test_pvc_1",
+	}
+
+	assert.NoError(t, driver.CanSnapshot(ctx, snapConfig, volConfig))
+
+	mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil).Times(2)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "flexvol1").Return(api.Snapshots{}, nil)
+	mockAPI.EXPECT().VolumeSnapshotCreate(ctx, "test_pvc_1__snap-1", "flexvol1").Return(nil)
+	mockAPI.EXPECT().QuotaGetEntry(ctx, "flexvol1", "test_pvc_1", "tree").Return(
+		&api.QuotaEntry{DiskLimitBytes: 1073741824}, nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "flexvol1").Return(api.Snapshots{
+		{Name: "test_pvc_1__snap-1", CreateTime: "2023-05-01T10:00:00Z"},
+	}, nil)
+
+	snapshot, err := driver.CreateSnapshot(ctx, snapConfig, volConfig)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test_pvc_1__snap-1", snapshot.Config.InternalName)
+	assert.Equal(t, "2023-05-01T10:00:00Z", snapshot.Created)
+	assert.Equal(t, storage.SnapshotStateOnline, snapshot.State)
+}
+
+func TestNASQtreeStorageDriver_RestoreSnapshot(t *testing.T) {
+	volConfig := &storage.VolumeConfig{
+		InternalName: "test_pvc_1",
+		InternalID:   "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_1",
+	}
+	snapConfig := &storage.SnapshotConfig{
+		Name:               "snap-1",
+		InternalName:       "test_pvc_1__snap-1",
+		VolumeInternalName: "test_pvc_1",
+	}
+	expectRestoringQtree := func(mockAPI *mockapi.MockOntapAPI, restoringName *string) {
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+		mockAPI.EXPECT().QtreeGetByName(ctx, "test_pvc_1", "flexvol1").Return(&api.Qtree{
+			Name: "test_pvc_1", Volume: "flexvol1", UnixPermissions: "0755", ExportPolicy: "fake-export-policy",
+			SecurityStyle: "unix",
+		}, nil)
+		mockAPI.EXPECT().QuotaGetEntry(ctx, "flexvol1", "test_pvc_1", "tree").Return(
+			&api.QuotaEntry{DiskLimitBytes: 1073741824}, nil)
+		mockAPI.EXPECT().VolumeInfo(ctx, "flexvol1").Return(&api.Volume{Name: "flexvol1"}, nil)
+		mockAPI.EXPECT().QuotaEntryList(ctx, "flexvol1").Return(api.QuotaEntries{}, nil)
+		mockAPI.EXPECT().VolumeSetSize(ctx, "flexvol1", "1073741824").Return(nil)
+		mockAPI.EXPECT().QtreeCreate(ctx, gomock.Any(), "flexvol1", "0755", "fake-export-policy", "unix", "").
+			DoAndReturn(func(_ context.Context, name, _, _, _, _, _ string) error {
+				*restoringName = name
+				return nil
+			})
+	}
+	expectShrink := func(mockAPI *mockapi.MockOntapAPI, temporaryQtree string) {
+		mockAPI.EXPECT().VolumeInfo(ctx, "flexvol1").Return(&api.Volume{Name: "flexvol1", Size: "2147483648"}, nil)
+		mockAPI.EXPECT().QuotaEntryList(ctx, "flexvol1").Return(api.QuotaEntries{
+			{Target: "/vol/flexvol1/test_pvc_1", DiskLimitBytes: 1073741824},
+			{Target: "/vol/flexvol1/" + temporaryQtree, DiskLimitBytes: 1073741824},
+		}, nil)
+		mockAPI.EXPECT().VolumeSetSize(ctx, "flexvol1", "1073741824").Return(nil)
+	}
+
+	t.Run("Restored", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		var restoringName, deletedPath string
+
+		expectRestoringQtree(mockAPI, &restoringName)
+		mockAPI.EXPECT().QtreeCloneFromSnapshot(ctx, "test_pvc_1__snap-1", "flexvol1", "test_pvc_1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, _, destinationQtree string) error {
+				assert.Equal(t, restoringName, destinationQtree)
+				return nil
+			})
+		mockAPI.EXPECT().QuotaSetEntry(ctx, gomock.Any(), "flexvol1", "tree", "1048576").Return(nil).Times(2)
+		mockAPI.EXPECT().QtreeRename(ctx, "/vol/flexvol1/test_pvc_1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, newPath string) error {
+				deletedPath = newPath
+				return nil
+			})
+		mockAPI.EXPECT().QtreeRename(ctx, gomock.Any(), "/vol/flexvol1/test_pvc_1").DoAndReturn(
+			func(_ context.Context, path, _ string) error {
+				assert.Equal(t, "/vol/flexvol1/"+restoringName, path)
+				return nil
+			})
+		mockAPI.EXPECT().QtreeDestroyAsync(ctx, gomock.Any(), true).DoAndReturn(
+			func(_ context.Context, path string, _ bool) error {
+				assert.Equal(t, deletedPath, path)
+				return nil
+			})
+		expectShrink(mockAPI, deletedQtreeNamePrefix+"test_pvc_1_abcde")
+
+		assert.NoError(t, driver.RestoreSnapshot(ctx, snapConfig, volConfig))
+		assert.True(t, strings.HasPrefix(restoringName, restoringQtreeNamePrefix))
+		assert.True(t, strings.HasPrefix(deletedPath, "/vol/flexvol1/"+deletedQtreeNamePrefix))
+	})
+
+	t.Run("Copy fails", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		var restoringName string
+
+		expectRestoringQtree(mockAPI, &restoringName)
+		mockAPI.EXPECT().QtreeCloneFromSnapshot(ctx, "test_pvc_1__snap-1", "flexvol1", "test_pvc_1", gomock.Any()).
+			Return(fmt.Errorf("failed"))
+		mockAPI.EXPECT().QtreeDestroyAsync(ctx, gomock.Any(), true).DoAndReturn(
+			func(_ context.Context, path string, _ bool) error {
+				assert.Equal(t, "/vol/flexvol1/"+restoringName, path, "only the incomplete copy should be deleted")
+				return nil
+			})
+		expectShrink(mockAPI, restoringQtreeNamePrefix+"test_pvc_1_abcde")
+
+		assert.Error(t, driver.RestoreSnapshot(ctx, snapConfig, volConfig))
+	})
+
+	t.Run("ZAPI", func(t *testing.T) {
+		_, driver := newMockOntapNasQtreeDriver(t)
+
+		err := driver.RestoreSnapshot(ctx, snapConfig, volConfig)
+		assert.True(t, utils.IsUnsupportedError(err), "expected an unsupported error")
+	})
+}
+
+func TestNASQtreeStorageDriver_DeleteSnapshot(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	volConfig := &storage.VolumeConfig{
+		InternalName: "test_pvc_1",
+		InternalID:   "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_1",
+	}
+	snapConfig := &storage.SnapshotConfig{
+		Name:               "snap-1",
+		InternalName:       "test_pvc_1__snap-1",
+		VolumeInternalName: "test_pvc_1",
+	}
+
+	mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+	mockAPI.EXPECT().VolumeSnapshotDelete(ctx, "test_pvc_1__snap-1", "flexvol1").Return(nil)
+	assert.NoError(t, driver.DeleteSnapshot(ctx, snapConfig, volConfig))
+
+	// Snapshots of a missing qtree are left to housekeeping
+	mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(false, "", nil)
+	assert.NoError(t, driver.DeleteSnapshot(ctx, snapConfig, volConfig))
+}
+
+func TestNASQtreeStorageDriver_CreateClone(t *testing.T) {
+	sourceVolConfig := &storage.VolumeConfig{
+		Name:         "pvc-1",
+		InternalName: "test_pvc_1",
+		InternalID:   "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_1",
+	}
+	newCloneVolConfig := func(snapshot string) *storage.VolumeConfig {
+		return &storage.VolumeConfig{
+			Name:                      "pvc-2",
+			InternalName:              "test_pvc_2",
+			Size:                      "1073741824",
+			UnixPermissions:           "0755",
+			ExportPolicy:              "fake-export-policy",
+			SecurityStyle:             "unix",
+			CloneSourceVolumeInternal: "test_pvc_1",
+			CloneSourceSnapshot:       snapshot,
+		}
+	}
+	expectQtreeCreate := func(mockAPI *mockapi.MockOntapAPI) {
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_2", "test_*").Return(false, "", nil)
+		mockAPI.EXPECT().QtreeCount(ctx, "flexvol1").Return(10, nil)
+		mockAPI.EXPECT().VolumeInfo(ctx, "flexvol1").Return(&api.Volume{Name: "flexvol1"}, nil)
+		mockAPI.EXPECT().QuotaEntryList(ctx, "flexvol1").Return(api.QuotaEntries{}, nil)
+		mockAPI.EXPECT().VolumeSetSize(ctx, "flexvol1", "1073741824").Return(nil)
+		mockAPI.EXPECT().QtreeCreate(ctx, "test_pvc_2", "flexvol1", "0755", "fake-export-policy", "unix", "").
+			Return(nil)
+	}
+
+	t.Run("From snapshot", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		driver.flexvolNamePrefix = "test_"
+		cloneVolConfig := newCloneVolConfig("snap-1")
+
+		expectQtreeCreate(mockAPI)
+		mockAPI.EXPECT().QtreeCloneFromSnapshot(ctx, "test_pvc_1__snap-1", "flexvol1", "test_pvc_1", "test_pvc_2").
+			Return(nil)
+		mockAPI.EXPECT().QuotaSetEntry(ctx, "test_pvc_2", "flexvol1", "tree", "1048576").Return(nil)
+
+		assert.NoError(t, driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil))
+		assert.Equal(t, "/svm/SVM1/flexvol/flexvol1/qtree/test_pvc_2", cloneVolConfig.InternalID)
+		assert.True(t, driver.quotaResizeMap["flexvol1"])
+	})
+
+	t.Run("From temporary snapshot", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		driver.flexvolNamePrefix = "test_"
+		cloneVolConfig := newCloneVolConfig("")
+
+		mockAPI.EXPECT().VolumeSnapshotList(ctx, "flexvol1").Return(api.Snapshots{}, nil)
+		mockAPI.EXPECT().VolumeSnapshotCreate(ctx, "test_pvc_1__pvc-2", "flexvol1").Return(nil)
+		expectQtreeCreate(mockAPI)
+		mockAPI.EXPECT().QtreeCloneFromSnapshot(ctx, "test_pvc_1__pvc-2", "flexvol1", "test_pvc_1", "test_pvc_2").
+			Return(nil)
+		mockAPI.EXPECT().QuotaSetEntry(ctx, "test_pvc_2", "flexvol1", "tree", "1048576").Return(nil)
+		mockAPI.EXPECT().VolumeSnapshotDelete(ctx, "test_pvc_1__pvc-2", "flexvol1").Return(nil)
+
+		assert.NoError(t, driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil))
+	})
+
+	t.Run("Copy fails", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		driver.flexvolNamePrefix = "test_"
+		cloneVolConfig := newCloneVolConfig("snap-1")
+
+		expectQtreeCreate(mockAPI)
+		mockAPI.EXPECT().QtreeCloneFromSnapshot(ctx, "test_pvc_1__snap-1", "flexvol1", "test_pvc_1", "test_pvc_2").
+			Return(fmt.Errorf("failed"))
+		mockAPI.EXPECT().QtreeDestroyAsync(ctx, "/vol/flexvol1/test_pvc_2", true).Return(nil)
+		mockAPI.EXPECT().VolumeInfo(ctx, "flexvol1").Return(&api.Volume{Name: "flexvol1", Size: "2147483648"}, nil)
+		mockAPI.EXPECT().QuotaEntryList(ctx, "flexvol1").Return(api.QuotaEntries{
+			{Target: "/vol/flexvol1/test_pvc_1", DiskLimitBytes: 1073741824},
+			{Target: "/vol/flexvol1/test_pvc_2", DiskLimitBytes: 1073741824},
+		}, nil)
+		mockAPI.EXPECT().VolumeSetSize(ctx, "flexvol1", "1073741824").Return(nil)
+
+		assert.Error(t, driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil))
+	})
+
+	t.Run("Flexvol full", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		driver.flexvolNamePrefix = "test_"
+		cloneVolConfig := newCloneVolConfig("snap-1")
+
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_2", "test_*").Return(false, "", nil)
+		mockAPI.EXPECT().QtreeCount(ctx, "flexvol1").Return(defaultQtreesPerFlexvol, nil)
+
+		assert.Error(t, driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil))
+	})
+
+	t.Run("Too many snapshots", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.Config.UseREST = true
+		driver.flexvolNamePrefix = "test_"
+		cloneVolConfig := newCloneVolConfig("")
+
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", "flexvol1").Return(true, "flexvol1", nil)
+		mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_2", "test_*").Return(false, "", nil)
+		mockAPI.EXPECT().QtreeCount(ctx, "flexvol1").Return(10, nil)
+		mockAPI.EXPECT().VolumeSnapshotList(ctx, "flexvol1").Return(make(api.Snapshots, maxFlexvolSnapshots), nil)
+
+		assert.Error(t, driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil))
+	})
+
+	t.Run("ZAPI", func(t *testing.T) {
+		_, driver := newMockOntapNasQtreeDriver(t)
+		cloneVolConfig := newCloneVolConfig("snap-1")
+
+		err := driver.CreateClone(ctx, sourceVolConfig, cloneVolConfig, nil)
+		assert.True(t, utils.IsUnsupportedError(err), "expected an unsupported error")
+	})
+}
+
+func TestNASQtreeStorageDriver_reapDeletedQtrees(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	driver.flexvolNamePrefix = "trident_qtree_pool_test_"
+
+	mockAPI.EXPECT().QtreeListByPrefix(ctx, "deleted_test_", "trident_qtree_pool_test_").Return(api.Qtrees{
+		{Name: "deleted_test_pvc_1_abcde", Volume: "trident_qtree_pool_test_A"},
+	}, nil)
+	mockAPI.EXPECT().QtreeListByPrefix(ctx, "restoring_test_", "trident_qtree_pool_test_").Return(api.Qtrees{
+		{Name: "restoring_test_pvc_2_abcde", Volume: "trident_qtree_pool_test_B"},
+	}, nil)
+	mockAPI.EXPECT().QtreeDestroyAsync(ctx, "/vol/trident_qtree_pool_test_A/deleted_test_pvc_1_abcde", true).
+		Return(nil)
+	mockAPI.EXPECT().QtreeDestroyAsync(ctx, "/vol/trident_qtree_pool_test_B/restoring_test_pvc_2_abcde", true).
+		Return(nil)
+
+	driver.reapDeletedQtrees(ctx)
+}
+
+func TestNASQtreeStorageDriver_pruneOrphanedQtreeSnapshots(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	driver.flexvolNamePrefix = "trident_qtree_pool_test_"
+
+	mockAPI.EXPECT().QtreeListByPrefix(ctx, "test_", "trident_qtree_pool_test_").Return(api.Qtrees{
+		{Name: "test_pvc_1", Volume: "trident_qtree_pool_test_A"},
+		{Name: "test_pvc_2", Volume: "trident_qtree_pool_test_B"},
+	}, nil)
+	mockAPI.EXPECT().VolumeListByPrefix(ctx, "trident_qtree_pool_test_").Return(api.Volumes{
+		&api.Volume{Name: "trident_qtree_pool_test_A"},
+		&api.Volume{Name: "trident_qtree_pool_test_B"},
+	}, nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "trident_qtree_pool_test_A").Return(api.Snapshots{
+		{Name: "test_pvc_1__snap-1"},
+		{Name: "test_pvc_2__snap-1"},
+		{Name: "other_pvc_3__snap-1"},
+		{Name: "hourly.2023-05-01_0005"},
+	}, nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "trident_qtree_pool_test_B").Return(api.Snapshots{
+		{Name: "test_pvc_2__snap-1"},
+	}, nil)
+	mockAPI.EXPECT().VolumeSnapshotDelete(ctx, "test_pvc_2__snap-1", "trident_qtree_pool_test_A").Return(nil)
+
+	driver.pruneOrphanedQtreeSnapshots(ctx)
+}
+
+func TestNASQtreeStorageDriver_ParseQtreeImportName(t *testing.T) {
+	driver := newNASQtreeStorageDriver(nil)
+
+	flexvol, qtree, err := driver.ParseQtreeImportName("flexvol1/qtree1")
+	assert.NoError(t, err)
+	assert.Equal(t, "flexvol1", flexvol)
+	assert.Equal(t, "qtree1", qtree)
+
+	for _, name := range []string{"qtree1", "/qtree1", "flexvol1/", "flexvol1/qtree1/dir"} {
+		_, _, err = driver.ParseQtreeImportName(name)
+		assert.Error(t, err, name)
+	}
+
+	// Qtrees imported without being managed are found in their own Flexvol
+	volumePattern, qtreeName, err := driver.SetVolumePatternToFindQtree(ctx, "", "flexvol1/qtree1", "trident_qtree_pool_")
+	assert.NoError(t, err)
+	assert.Equal(t, "flexvol1", volumePattern)
+	assert.Equal(t, "qtree1", qtreeName)
+}
+
+func TestNASQtreeStorageDriver_Import(t *testing.T) {
+	expectQtree := func(mockAPI *mockapi.MockOntapAPI) {
+		mockAPI.EXPECT().VolumeInfo(ctx, "legacy").Return(&api.Volume{
+			Name: "legacy", AccessType: "rw", JunctionPath: "/legacy",
+		}, nil)
+		mockAPI.EXPECT().QtreeGetByName(ctx, "app1", "legacy").Return(&api.Qtree{
+			Name: "app1", Volume: "legacy", UnixPermissions: "0755", SecurityStyle: "unix", ExportPolicy: "legacy",
+		}, nil)
+		mockAPI.EXPECT().QuotaStatus(ctx, "legacy").Return("on", nil)
+		mockAPI.EXPECT().QuotaGetEntry(ctx, "legacy", "app1", "tree").Return(&api.QuotaEntry{
+			Target: "/vol/legacy/app1", DiskLimitBytes: 1073741824,
+		}, nil)
+	}
+
+	t.Run("Managed", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.flexvolNamePrefix = "trident_qtree_pool_"
+		driver.Config.AutoExportPolicy = true
+		volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1", ImportBackendUUID: "backend1"}
+
+		var flexvol string
+		expectQtree(mockAPI)
+		mockAPI.EXPECT().QtreeCount(ctx, "legacy").Return(1, nil)
+		mockAPI.EXPECT().VolumeRename(ctx, "legacy", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, newName string) error {
+				flexvol = newName
+				return nil
+			})
+		mockAPI.EXPECT().VolumeUnmount(ctx, gomock.Any(), false).Return(nil)
+		mockAPI.EXPECT().VolumeMount(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, name, junction string) error {
+				assert.Equal(t, "/"+name, junction)
+				return nil
+			})
+		mockAPI.EXPECT().QtreeRename(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mockAPI.EXPECT().QuotaSetEntry(ctx, "trident_pvc_1", gomock.Any(), "tree", "1048576").Return(nil)
+		mockAPI.EXPECT().QtreeModifyExportPolicy(ctx, "trident_pvc_1", gomock.Any(), "trident-backend1").Return(nil)
+
+		assert.NoError(t, driver.Import(ctx, volConfig, "legacy/app1"))
+		assert.True(t, strings.HasPrefix(flexvol, "trident_qtree_pool_"))
+		assert.Equal(t, "1073741824", volConfig.Size)
+		assert.Equal(t, "0755", volConfig.UnixPermissions)
+		assert.Equal(t, "trident-backend1", volConfig.ExportPolicy)
+		assert.Equal(t, "/svm/SVM1/flexvol/"+flexvol+"/qtree/trident_pvc_1", volConfig.InternalID)
+		assert.True(t, driver.quotaResizeMap[flexvol])
+	})
+
+	t.Run("Managed shared volume", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.flexvolNamePrefix = "trident_qtree_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+
+		expectQtree(mockAPI)
+		mockAPI.EXPECT().QtreeCount(ctx, "legacy").Return(2, nil)
+
+		assert.Error(t, driver.Import(ctx, volConfig, "legacy/app1"))
+	})
+
+	t.Run("Managed rollback", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.flexvolNamePrefix = "trident_qtree_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+
+		var flexvol string
+		expectQtree(mockAPI)
+		mockAPI.EXPECT().QtreeCount(ctx, "legacy").Return(1, nil)
+		mockAPI.EXPECT().VolumeRename(ctx, "legacy", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, newName string) error {
+				flexvol = newName
+				return nil
+			})
+		mockAPI.EXPECT().VolumeUnmount(ctx, gomock.Any(), false).Return(nil).Times(2)
+		mockAPI.EXPECT().VolumeMount(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockAPI.EXPECT().QtreeRename(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockAPI.EXPECT().QuotaSetEntry(ctx, "trident_pvc_1", gomock.Any(), "tree", "1048576").Return(
+			fmt.Errorf("quota failed"))
+		mockAPI.EXPECT().VolumeRename(ctx, gomock.Any(), "legacy").DoAndReturn(
+			func(_ context.Context, name, _ string) error {
+				assert.Equal(t, flexvol, name)
+				return nil
+			})
+
+		assert.Error(t, driver.Import(ctx, volConfig, "legacy/app1"))
+	})
+
+	t.Run("NotManaged", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		volConfig := &storage.VolumeConfig{InternalName: "legacy/app1", ImportNotManaged: true}
+
+		expectQtree(mockAPI)
+
+		assert.NoError(t, driver.Import(ctx, volConfig, "legacy/app1"))
+		assert.Equal(t, "1073741824", volConfig.Size)
+		assert.Equal(t, "legacy", volConfig.ExportPolicy)
+		assert.Equal(t, "/svm/SVM1/flexvol/legacy/qtree/app1", volConfig.InternalID)
+	})
+
+	t.Run("Junction path", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+
+		mockAPI.EXPECT().VolumeInfo(ctx, "legacy").Return(&api.Volume{
+			Name: "legacy", AccessType: "rw", JunctionPath: "/data/legacy",
+		}, nil)
+
+		assert.Error(t, driver.Import(ctx, volConfig, "legacy/app1"))
+	})
+
+	t.Run("Quotas off", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+
+		mockAPI.EXPECT().VolumeInfo(ctx, "legacy").Return(&api.Volume{
+			Name: "legacy", AccessType: "rw", JunctionPath: "/legacy",
+		}, nil)
+		mockAPI.EXPECT().QtreeGetByName(ctx, "app1", "legacy").Return(&api.Qtree{Name: "app1", Volume: "legacy"}, nil)
+		mockAPI.EXPECT().QuotaStatus(ctx, "legacy").Return("off", nil)
+
+		assert.Error(t, driver.Import(ctx, volConfig, "legacy/app1"))
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		_, driver := newMockOntapNasQtreeDriver(t)
+		assert.Error(t, driver.Import(ctx, &storage.VolumeConfig{}, "app1"))
+	})
+}
+
+func TestNASQtreeStorageDriver_Rename(t *testing.T) {
+	t.Run("Qtree", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.flexvolNamePrefix = "trident_qtree_pool_"
+
+		mockAPI.EXPECT().QtreeExists(ctx, "trident_pvc_1", "trident_qtree_pool_*").Return(
+			true, "trident_qtree_pool_abc", nil)
+		mockAPI.EXPECT().QtreeRename(ctx, "/vol/trident_qtree_pool_abc/trident_pvc_1",
+			"/vol/trident_qtree_pool_abc/trident_pvc_2").Return(nil)
+
+		assert.NoError(t, driver.Rename(ctx, "trident_pvc_1", "trident_pvc_2"))
+	})
+
+	t.Run("Import name", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+		driver.flexvolNamePrefix = "trident_qtree_pool_"
+
+		mockAPI.EXPECT().QtreeExists(ctx, "trident_pvc_1", "trident_qtree_pool_*").Return(
+			true, "trident_qtree_pool_abc", nil)
+		mockAPI.EXPECT().QtreeRename(ctx, "/vol/trident_qtree_pool_abc/trident_pvc_1",
+			"/vol/trident_qtree_pool_abc/app1").Return(nil)
+		mockAPI.EXPECT().VolumeRename(ctx, "trident_qtree_pool_abc", "legacy").Return(nil)
+		mockAPI.EXPECT().VolumeUnmount(ctx, "legacy", false).Return(nil)
+		mockAPI.EXPECT().VolumeMount(ctx, "legacy", "/legacy").Return(nil)
+
+		assert.NoError(t, driver.Rename(ctx, "trident_pvc_1", "legacy/app1"))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockAPI, driver := newMockOntapNasQtreeDriver(t)
+
+		mockAPI.EXPECT().QtreeExists(ctx, "trident_pvc_1", gomock.Any()).Return(false, "", nil)
+
+		assert.Error(t, driver.Rename(ctx, "trident_pvc_1", "trident_pvc_2"))
+	})
+}
+
+func TestNASQtreeStorageDriverEnablePublishEnforcement(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+	driver.Config.AutoExportPolicy = true
+	driver.Config.PerVolumeExportPolicy = true
+	assert.True(t, driver.CanEnablePublishEnforcement())
+
+	volume := &storage.Volume{Config: &storage.VolumeConfig{InternalName: "test_pvc_1"}}
+	mockAPI.EXPECT().QtreeExists(ctx, "test_pvc_1", gomock.Any()).Return(true, "flexvol1", nil)
+	mockAPI.EXPECT().ExportPolicyCreate(ctx, "test_pvc_1").Return(nil)
+	mockAPI.EXPECT().QtreeModifyExportPolicy(ctx, "test_pvc_1", "flexvol1", "test_pvc_1").Return(nil)
+
+	assert.NoError(t, driver.EnablePublishEnforcement(ctx, volume))
+	assert.True(t, volume.Config.AccessInfo.PublishEnforcement)
+	assert.Equal(t, "test_pvc_1", volume.Config.ExportPolicy)
+}
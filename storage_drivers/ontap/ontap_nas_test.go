@@ -2178,6 +2178,67 @@ func TestOntapNasStorageDriverResize_Failure(t *testing.T) {
 	assert.Error(t, result)
 }
 
+func TestOntapNasStorageDriverModifyVolume(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Name:              "pvc-1",
+		InternalName:      "vol1",
+		AdaptiveQosPolicy: "silver",
+		SnapshotPolicy:    "default",
+		SnapshotDir:       "true",
+		TieringPolicy:     "auto",
+		ExportPolicy:      "export-1",
+		UnixPermissions:   "0750",
+	}
+	modification := storage.VolumeModification{
+		storage.ModifiableQosPolicy:         "",
+		storage.ModifiableAdaptiveQosPolicy: "silver",
+		storage.ModifiableSnapshotPolicy:    "default",
+		storage.ModifiableSnapshotDir:       "true",
+		storage.ModifiableTieringPolicy:     "auto",
+		storage.ModifiableExportPolicy:      "export-1",
+		storage.ModifiableUnixPermissions:   "0750",
+	}
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeSetQosPolicyGroupName(ctx, "vol1",
+		api.QosPolicyGroup{Name: "silver", Kind: api.QosAdaptivePolicyGroupKind}).Return(nil)
+	mockAPI.EXPECT().VolumeModifySnapshotPolicy(ctx, "vol1", "default").Return(nil)
+	mockAPI.EXPECT().VolumeModifySnapshotDirectoryAccess(ctx, "vol1", true).Return(nil)
+	mockAPI.EXPECT().VolumeModifyTieringPolicy(ctx, "vol1", "auto").Return(nil)
+	mockAPI.EXPECT().VolumeModifyExportPolicy(ctx, "vol1", "export-1").Return(nil)
+	mockAPI.EXPECT().VolumeModifyUnixPermissions(ctx, "vol1", "pvc-1", "0750").Return(nil)
+
+	result := driver.ModifyVolume(ctx, volConfig, modification)
+
+	assert.NoError(t, result)
+}
+
+func TestOntapNasStorageDriverModifyVolume_Failure(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1", SnapshotPolicy: "default"}
+
+	// The export policy of a volume is managed by Trident with autoExportPolicy
+	driver.Config.AutoExportPolicy = true
+	result := driver.ModifyVolume(ctx, volConfig, storage.VolumeModification{storage.ModifiableExportPolicy: "e1"})
+	assert.Error(t, result)
+	driver.Config.AutoExportPolicy = false
+
+	// A QoS policy group cannot be removed
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	result = driver.ModifyVolume(ctx, volConfig, storage.VolumeModification{storage.ModifiableQosPolicy: ""})
+	assert.Error(t, result)
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, nil)
+	result = driver.ModifyVolume(ctx, volConfig, storage.VolumeModification{storage.ModifiableSnapshotPolicy: "default"})
+	assert.Error(t, result)
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeModifySnapshotPolicy(ctx, "vol1", "default").Return(fmt.Errorf("no such policy"))
+	result = driver.ModifyVolume(ctx, volConfig, storage.VolumeModification{storage.ModifiableSnapshotPolicy: "default"})
+	assert.Error(t, result)
+}
+
 func TestOntapNasStorageDriverStoreConfig(t *testing.T) {
 	_, driver := newMockOntapNASDriver(t)
 	ontapConf := newOntapStorageDriverConfig()
@@ -3153,6 +3214,31 @@ func TestOntapNasStorageDriverVolumePublish_NASType_None(t *testing.T) {
 	assert.NoError(t, result)
 }
 
+func TestOntapNasStorageDriverVolumePublish_Kerberos(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	driver.Config.NfsSecurity = utils.NFSSecurityKrb5p
+
+	volConfig := &storage.VolumeConfig{
+		Size:             "1g",
+		Encryption:       "false",
+		FileSystem:       "nfs",
+		InternalName:     "vol1",
+		PeerVolumeHandle: "SVM1:vol1",
+		ImportNotManaged: false,
+		UnixPermissions:  "",
+		MountOptions:     "-o nfsvers=4.1,sec=sys",
+	}
+	volConfig.AccessInfo.NfsPath = "/nfs"
+	publishInfo := &utils.VolumePublishInfo{}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	result := driver.Publish(ctx, volConfig, publishInfo)
+
+	assert.NoError(t, result)
+	assert.Equal(t, "-o nfsvers=4.1,sec=krb5p", publishInfo.MountOptions)
+}
+
 func TestOntapNasStorageDriverVolumePublish_NASType_SMB(t *testing.T) {
 	mockAPI, driver := newMockOntapNASDriver(t)
 	driver.Config.NASType = "smb"
@@ -3195,3 +3281,131 @@ func TestOntapNasStorageDriverBackendName(t *testing.T) {
 
 	assert.Equal(t, result, "myBackend")
 }
+
+func newMockOntapNASDriverWithVolumeExportPolicies(t *testing.T) (*mockapi.MockOntapAPI, *NASStorageDriver) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	driver.Config.AutoExportPolicy = true
+	driver.Config.PerVolumeExportPolicy = true
+	driver.Config.AutoExportCIDRs = []string{"0.0.0.0/0"}
+	driver.Config.NASType = sa.NFS
+	return mockAPI, driver
+}
+
+func TestOntapNasStorageDriverEnablePublishEnforcement(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriverWithVolumeExportPolicies(t)
+	assert.True(t, driver.CanEnablePublishEnforcement())
+
+	volume := &storage.Volume{Config: &storage.VolumeConfig{Name: "pvc-1", InternalName: "trident_pvc_1"}}
+	mockAPI.EXPECT().ExportPolicyCreate(ctx, "trident_pvc_1").Return(nil)
+	mockAPI.EXPECT().VolumeModifyExportPolicy(ctx, "trident_pvc_1", "trident_pvc_1").Return(nil)
+
+	assert.NoError(t, driver.EnablePublishEnforcement(ctx, volume))
+	assert.True(t, volume.Config.AccessInfo.PublishEnforcement)
+	assert.Equal(t, "trident_pvc_1", volume.Config.ExportPolicy)
+
+	// Backends without per-volume export policies cannot enforce publication
+	driver.Config.PerVolumeExportPolicy = false
+	assert.False(t, driver.CanEnablePublishEnforcement())
+}
+
+func TestOntapNasStorageDriverVolumePublish_VolumeExportPolicy(t *testing.T) {
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	mockAPI, driver := newMockOntapNASDriverWithVolumeExportPolicies(t)
+	volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+	volConfig.AccessInfo.NfsPath = "/trident_pvc_1"
+	volConfig.AccessInfo.PublishEnforcement = true
+	publishInfo := &utils.VolumePublishInfo{
+		HostName: "node1",
+		Nodes: []*utils.Node{
+			{Name: "node1", IPs: []string{"1.1.1.1"}},
+			{Name: "node2", IPs: []string{"2.2.2.2"}},
+		},
+	}
+
+	// Only the publishing node is added to the volume's export policy
+	mockAPI.EXPECT().ExportPolicyCreate(ctx, "trident_pvc_1").Return(nil)
+	mockAPI.EXPECT().ExportRuleList(ctx, "trident_pvc_1").Return(map[string]api.ExportRule{}, nil)
+	mockAPI.EXPECT().ExportRuleCreate(ctx, "trident_pvc_1", "1.1.1.1", sa.NFS, []string{"any"}).Return(nil)
+
+	assert.NoError(t, driver.Publish(ctx, volConfig, publishInfo))
+
+	// Publishing again to the same node changes nothing
+	mockAPI.EXPECT().ExportPolicyCreate(ctx, "trident_pvc_1").Return(nil)
+	mockAPI.EXPECT().ExportRuleList(ctx, "trident_pvc_1").Return(
+		map[string]api.ExportRule{"1.1.1.1": newExportRule(1, "any")}, nil)
+
+	assert.NoError(t, driver.Publish(ctx, volConfig, publishInfo))
+
+	// A node that is not known cannot be given access
+	publishInfo.HostName = "node3"
+	assert.Error(t, driver.Publish(ctx, volConfig, publishInfo))
+}
+
+func TestOntapNasStorageDriverVolumeUnpublish_VolumeExportPolicy(t *testing.T) {
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	mockAPI, driver := newMockOntapNASDriverWithVolumeExportPolicies(t)
+	volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+	volConfig.AccessInfo.PublishEnforcement = true
+	publishInfo := &utils.VolumePublishInfo{
+		HostName: "node1",
+		Nodes:    []*utils.Node{{Name: "node2", IPs: []string{"2.2.2.2"}}},
+	}
+
+	// The rule for the unpublished node is removed, leaving those of the nodes the volume remains published to
+	mockAPI.EXPECT().ExportRuleList(ctx, "trident_pvc_1").Return(map[string]api.ExportRule{
+		"1.1.1.1": newExportRule(1, "any"),
+		"2.2.2.2": newExportRule(2, "any"),
+	}, nil)
+	mockAPI.EXPECT().ExportRuleDestroy(ctx, "trident_pvc_1", 1).Return(nil)
+
+	assert.NoError(t, driver.Unpublish(ctx, volConfig, publishInfo))
+}
+
+func TestOntapNasStorageDriverVolumeUnpublish_MovesToVolumeExportPolicy(t *testing.T) {
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	mockAPI, driver := newMockOntapNASDriverWithVolumeExportPolicies(t)
+	volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+
+	// A volume using the backend's export policy stays there while it is published to other nodes
+	publishInfo := &utils.VolumePublishInfo{
+		HostName: "node1",
+		Nodes:    []*utils.Node{{Name: "node2", IPs: []string{"2.2.2.2"}}},
+	}
+	assert.NoError(t, driver.Unpublish(ctx, volConfig, publishInfo))
+	assert.False(t, volConfig.AccessInfo.PublishEnforcement)
+
+	// Once it is published to no node, it is moved to its own export policy
+	publishInfo = &utils.VolumePublishInfo{HostName: "node2"}
+	mockAPI.EXPECT().ExportPolicyCreate(ctx, "trident_pvc_1").Return(nil)
+	mockAPI.EXPECT().VolumeModifyExportPolicy(ctx, "trident_pvc_1", "trident_pvc_1").Return(nil)
+
+	assert.NoError(t, driver.Unpublish(ctx, volConfig, publishInfo))
+	assert.True(t, volConfig.AccessInfo.PublishEnforcement)
+}
+
+func TestOntapNasStorageDriverVolumeDestroy_VolumeExportPolicy(t *testing.T) {
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	mockAPI, driver := newMockOntapNASDriverWithVolumeExportPolicies(t)
+	volConfig := &storage.VolumeConfig{InternalName: "trident_pvc_1"}
+	volConfig.AccessInfo.PublishEnforcement = true
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().VolumeExists(ctx, "trident_pvc_1").Return(true, nil)
+	mockAPI.EXPECT().SnapmirrorDeleteViaDestination(ctx, "trident_pvc_1", "SVM1").Return(nil)
+	mockAPI.EXPECT().VolumeDestroy(ctx, "trident_pvc_1", true).Return(nil)
+	mockAPI.EXPECT().ExportPolicyDestroy(ctx, "trident_pvc_1").Return(nil)
+
+	assert.NoError(t, driver.Destroy(ctx, volConfig))
+}
@@ -4,6 +4,7 @@ package ontap
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -24,7 +25,48 @@ func lunPath(name string) string {
 	return fmt.Sprintf("/vol/%v/lun0", name)
 }
 
-// SANStorageDriver is for iSCSI storage provisioning
+func namespacePath(name string) string {
+	return fmt.Sprintf("/vol/%v/namespace0", name)
+}
+
+// subsystemName returns the name of the NVMe subsystem dedicated to a volume
+func subsystemName(name string) string {
+	return "s_" + name
+}
+
+// getNamespaceComment returns the namespace comment in which the driver context, fstype, and LUKS value are saved
+func getNamespaceComment(fstype, context, luks string) (string, error) {
+	nsComment := map[string]map[string]string{
+		"nsAttribute": {
+			"fstype":        fstype,
+			"driverContext": context,
+			"LUKS":          luks,
+		},
+	}
+	nsCommentJSON, err := json.Marshal(nsComment)
+	if err != nil {
+		return "", err
+	}
+	return string(nsCommentJSON), nil
+}
+
+// getNamespaceFSType returns the fstype saved in a namespace comment, or the default fstype if none was saved
+func getNamespaceFSType(ctx context.Context, ns *api.NVMeNamespace) string {
+	var nsComment map[string]map[string]string
+	if err := json.Unmarshal([]byte(ns.Comment), &nsComment); err == nil {
+		if fstype := nsComment["nsAttribute"]["fstype"]; fstype != "" {
+			return fstype
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"namespace": ns.Name,
+		"fstype":    drivers.DefaultFileSystemType,
+	}).Warn("Namespace attribute fstype not found, using default.")
+	return drivers.DefaultFileSystemType
+}
+
+// SANStorageDriver is for iSCSI, NVMe/TCP and FCP storage provisioning
 type SANStorageDriver struct {
 	initialized bool
 	Config      drivers.OntapStorageDriverConfig
@@ -94,15 +136,39 @@ func (d *SANStorageDriver) Initialize(
 	}
 	d.Config = *config
 
-	d.ips, err = d.API.NetInterfaceGetDataLIFs(ctx, "iscsi")
-	if err != nil {
-		return err
-	}
+	if d.Config.SANType == sa.NVMe {
+		d.ips, err = d.API.NetInterfaceGetDataLIFs(ctx, "nvme_tcp")
+		if err != nil {
+			return err
+		}
 
-	if len(d.ips) == 0 {
-		return fmt.Errorf("no iSCSI data LIFs found on SVM %s", d.API.SVMName())
+		if len(d.ips) == 0 {
+			return fmt.Errorf("no NVMe/TCP data LIFs found on SVM %s", d.API.SVMName())
+		} else {
+			Logc(ctx).WithField("dataLIFs", d.ips).Debug("Found NVMe/TCP LIFs.")
+		}
+	} else if d.Config.SANType == sa.FCP {
+		d.ips, err = d.API.FcpInterfaceGet(ctx, d.API.SVMName())
+		if err != nil {
+			return err
+		}
+
+		if len(d.ips) == 0 {
+			return fmt.Errorf("no FC data LIFs found on SVM %s", d.API.SVMName())
+		} else {
+			Logc(ctx).WithField("dataLIFs", d.ips).Debug("Found FC LIFs.")
+		}
 	} else {
-		Logc(ctx).WithField("dataLIFs", d.ips).Debug("Found iSCSI LIFs.")
+		d.ips, err = d.API.NetInterfaceGetDataLIFs(ctx, "iscsi")
+		if err != nil {
+			return err
+		}
+
+		if len(d.ips) == 0 {
+			return fmt.Errorf("no iSCSI data LIFs found on SVM %s", d.API.SVMName())
+		} else {
+			Logc(ctx).WithField("dataLIFs", d.ips).Debug("Found iSCSI LIFs.")
+		}
 	}
 
 	d.physicalPools, d.virtualPools, err = InitializeStoragePoolsCommon(ctx, d,
@@ -115,7 +181,8 @@ func (d *SANStorageDriver) Initialize(
 
 	// clean up igroup for failed driver
 	if err != nil {
-		if d.Config.DriverContext == tridentconfig.ContextCSI {
+		if d.Config.DriverContext == tridentconfig.ContextCSI && d.Config.SANType != sa.NVMe &&
+			d.Config.SANType != sa.FCP {
 			err := d.API.IgroupDestroy(ctx, d.Config.IgroupName)
 			if err != nil {
 				Logc(ctx).WithError(err).WithField("igroup", d.Config.IgroupName).Warn("Error deleting igroup.")
@@ -143,7 +210,8 @@ func (d *SANStorageDriver) Terminate(ctx context.Context, _ string) {
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Terminate")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Terminate")
 
-	if d.Config.DriverContext == tridentconfig.ContextCSI {
+	if d.Config.DriverContext == tridentconfig.ContextCSI && d.Config.SANType != sa.NVMe &&
+		d.Config.SANType != sa.FCP {
 		// clean up igroup for terminated driver
 		err := d.API.IgroupDestroy(ctx, d.Config.IgroupName)
 		if err != nil {
@@ -353,6 +421,12 @@ func (d *SANStorageDriver) Create(
 			return err
 		}
 
+		// NVMe namespaces have no QoS policy of their own, so QoS is set at the Flexvol layer
+		volumeQosPolicyGroup := api.QosPolicyGroup{}
+		if d.Config.SANType == sa.NVMe {
+			volumeQosPolicyGroup = qosPolicyGroup
+		}
+
 		// Create the volume
 		err = d.API.VolumeCreate(
 			ctx, api.Volume{
@@ -365,7 +439,7 @@ func (d *SANStorageDriver) Create(
 				ExportPolicy:    exportPolicy,
 				JunctionPath:    "",
 				Name:            name,
-				Qos:             api.QosPolicyGroup{},
+				Qos:             volumeQosPolicyGroup,
 				SecurityStyle:   securityStyle,
 				Size:            volumeSize,
 				SnapshotDir:     false,
@@ -394,6 +468,32 @@ func (d *SANStorageDriver) Create(
 			continue
 		}
 
+		// If a DP volume, do not create the namespace, it will be copied over by snapmirror
+		if d.Config.SANType == sa.NVMe {
+			if !volConfig.IsMirrorDestination {
+				if err = d.createNamespace(ctx, name, lunSize, fstype, luksEncryption); err != nil {
+					errMessage := fmt.Sprintf(
+						"ONTAP-SAN pool %s/%s; error creating namespace %s: %v", storagePool.Name(),
+						aggregate, name, err,
+					)
+					Logc(ctx).Error(errMessage)
+					createErrors = append(createErrors, fmt.Errorf(errMessage))
+
+					// Don't leave the new Flexvol around
+					if err := d.API.VolumeDestroy(ctx, name, true); err != nil {
+						Logc(ctx).WithField("volume", name).Errorf("Could not clean up volume; %v", err)
+					} else {
+						Logc(ctx).WithField("volume", name).Debugf("Cleaned up volume after namespace create error.")
+					}
+
+					// Move on to the next pool
+					continue
+				}
+			}
+
+			return nil
+		}
+
 		lunPath := lunPath(name)
 		osType := "linux"
 
@@ -468,6 +568,23 @@ func (d *SANStorageDriver) Create(
 	return drivers.NewBackendIneligibleError(name, createErrors, physicalPoolNames)
 }
 
+// createNamespace creates the NVMe namespace for a new volume, saving the fstype, driver context, and LUKS
+// value in the namespace comment so we know what to do in Attach.
+func (d *SANStorageDriver) createNamespace(ctx context.Context, name, size, fstype, luksEncryption string) error {
+	comment, err := getNamespaceComment(fstype, string(d.Config.DriverContext), luksEncryption)
+	if err != nil {
+		return fmt.Errorf("could not build namespace comment; %v", err)
+	}
+
+	_, err = d.API.NVMeNamespaceCreate(ctx, api.NVMeNamespace{
+		Name:    namespacePath(name),
+		Size:    size,
+		OsType:  "linux",
+		Comment: comment,
+	})
+	return err
+}
+
 // CreateClone creates a volume clone
 func (d *SANStorageDriver) CreateClone(
 	ctx context.Context, _, cloneVolConfig *storage.VolumeConfig, storagePool storage.Pool,
@@ -552,7 +669,11 @@ func (d *SANStorageDriver) CreateClone(
 	}
 
 	if qosPolicyGroup.Kind != api.InvalidQosPolicyGroupKind {
-		err = d.API.LunSetQosPolicyGroup(ctx, lunPath(name), qosPolicyGroup)
+		if d.Config.SANType == sa.NVMe {
+			err = d.API.VolumeSetQosPolicyGroupName(ctx, name, qosPolicyGroup)
+		} else {
+			err = d.API.LunSetQosPolicyGroup(ctx, lunPath(name), qosPolicyGroup)
+		}
 		if err != nil {
 			return fmt.Errorf("error setting QoS policy group: %v", err)
 		}
@@ -591,6 +712,10 @@ func (d *SANStorageDriver) Import(ctx context.Context, volConfig *storage.Volume
 	// Set the volume to LUKS if backend has LUKS true as default
 	volConfig.LUKSEncryption = d.Config.LUKSEncryption
 
+	if d.Config.SANType == sa.NVMe {
+		return d.importNamespace(ctx, volConfig, originalName, flexvol)
+	}
+
 	// Ensure the volume has only one LUN
 	lunInfo, err := d.API.LunGetByName(ctx, "/vol/"+originalName+"/*")
 	if err != nil {
@@ -646,6 +771,56 @@ func (d *SANStorageDriver) Import(ctx context.Context, volConfig *storage.Volume
 	return nil
 }
 
+// importNamespace imports a volume whose data is held in an NVMe namespace
+func (d *SANStorageDriver) importNamespace(
+	ctx context.Context, volConfig *storage.VolumeConfig, originalName string, flexvol *api.Volume,
+) error {
+	ns, err := d.API.NVMeNamespaceGetByName(ctx, "/vol/"+originalName+"/*")
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("namespace not found in volume %s", originalName)
+		}
+		return err
+	}
+	targetPath := "/vol/" + originalName + "/namespace0"
+
+	// The namespace should be online
+	if ns.State != "online" {
+		return fmt.Errorf("namespace %s is not online", ns.Name)
+	}
+
+	// Trident cannot rename namespaces, so the namespace must already have the expected name
+	if ns.Name != targetPath {
+		return fmt.Errorf("could not import volume, namespace is named incorrectly: %s", ns.Name)
+	}
+
+	// Use the namespace size
+	volConfig.Size = ns.Size
+
+	// Rename the volume if Trident will manage its lifecycle
+	if !volConfig.ImportNotManaged {
+		err = d.API.VolumeRename(ctx, originalName, volConfig.InternalName)
+		if err != nil {
+			Logc(ctx).WithField("originalName", originalName).Errorf(
+				"Could not import volume, rename volume failed: %v", err)
+			return fmt.Errorf("volume %s rename failed: %v", originalName, err)
+		}
+		if storage.AllowPoolLabelOverwrite(storage.ProvisioningLabelTag, flexvol.Comment) {
+			err = d.API.VolumeSetComment(ctx, volConfig.InternalName, originalName, "")
+			if err != nil {
+				Logc(ctx).WithField("originalName", originalName).Warnf("Modifying comment failed: %v", err)
+				return fmt.Errorf("volume %s modify failed: %v", originalName, err)
+			}
+		}
+		if err = d.unmapNamespaceFromAllSubsystems(ctx, ns.UUID, false); err != nil {
+			Logc(ctx).WithField("namespace", ns.Name).Warnf("Unmapping of subsystems failed: %v", err)
+			return fmt.Errorf("failed to unmap subsystems for namespace %s: %v", ns.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func (d *SANStorageDriver) Rename(ctx context.Context, name, newName string) error {
 	fields := LogFields{
 		"Method":  "Rename",
@@ -723,6 +898,19 @@ func (d *SANStorageDriver) Destroy(ctx context.Context, volConfig *storage.Volum
 		}
 	}
 
+	// Remove the subsystem that was created to publish the namespace
+	if d.Config.SANType == sa.NVMe {
+		ns, err := d.API.NVMeNamespaceGetByName(ctx, namespacePath(name))
+		if err != nil && !api.IsNotFoundError(err) {
+			return fmt.Errorf("error getting namespace for volume %s: %v", name, err)
+		}
+		if ns != nil {
+			if err = d.unmapNamespaceFromAllSubsystems(ctx, ns.UUID, true); err != nil {
+				return fmt.Errorf("error unmapping namespace for volume %s: %v", name, err)
+			}
+		}
+	}
+
 	// Delete the Flexvol & LUN
 	err = d.API.VolumeDestroy(ctx, name, true)
 	if err != nil {
@@ -757,12 +945,19 @@ func (d *SANStorageDriver) Publish(
 		return fmt.Errorf("volume is not read-write")
 	}
 
+	if d.Config.SANType == sa.NVMe {
+		return d.publishNamespace(ctx, volConfig, publishInfo)
+	}
+	if d.Config.SANType == sa.FCP {
+		return d.publishFCPLUN(ctx, volConfig, publishInfo)
+	}
+
 	lunPath := lunPath(name)
 	igroupName := d.Config.IgroupName
 
 	// Use the node specific igroup if publish enforcement is enabled and this is for CSI.
 	if tridentconfig.CurrentDriverContext == tridentconfig.ContextCSI {
-		igroupName = getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+		igroupName = getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 		err = ensureIGroupExists(ctx, d.GetAPI(), igroupName)
 	}
 
@@ -802,19 +997,156 @@ func (d *SANStorageDriver) Unpublish(
 		return nil
 	}
 
+	if d.Config.SANType == sa.NVMe {
+		return d.unpublishNamespace(ctx, name, publishInfo)
+	}
+
 	// Attempt to unmap the LUN from the per-node igroup.
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
-	lunPath := lunPath(name)
-	if err := LunUnmapIgroup(ctx, d.API, igroupName, lunPath); err != nil {
-		return fmt.Errorf("error unmapping LUN %s from igroup %s; %v", lunPath, igroupName, err)
+	protocol := sa.ISCSI
+	if d.Config.SANType == sa.FCP {
+		protocol = sa.FCP
+	}
+	return unpublishLUNFromNode(ctx, d.API, volConfig, publishInfo, lunPath(name), protocol)
+}
+
+// publishFCPLUN grants the host specified in publishInfo access to a volume's LUN over Fibre Channel.  The LUN
+// is mapped to the per-node igroup, which holds the WWPNs of the node's FC host ports.
+func (d *SANStorageDriver) publishFCPLUN(
+	ctx context.Context, volConfig *storage.VolumeConfig, publishInfo *utils.VolumePublishInfo,
+) error {
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.FCP, publishInfo.BackendUUID)
+
+	// Get target info.
+	fcpNodeName, _, err := GetFCPTargetInfo(ctx, d.API, &d.Config)
+	if err != nil {
+		return err
+	}
+
+	err = PublishFCPLUN(ctx, d.API, &d.Config, publishInfo, lunPath(volConfig.InternalName), igroupName, fcpNodeName)
+	if err != nil {
+		return fmt.Errorf("error publishing %s driver: %v", d.Name(), err)
+	}
+	// Fill in the volume access fields as well.
+	volConfig.AccessInfo = publishInfo.VolumeAccessInfo
+
+	return nil
+}
+
+// publishNamespace grants the host specified in publishInfo access to a volume's namespace.  Each volume has its
+// own subsystem, to which the namespace is mapped and the host NQNs of the nodes using the volume are added.
+func (d *SANStorageDriver) publishNamespace(
+	ctx context.Context, volConfig *storage.VolumeConfig, publishInfo *utils.VolumePublishInfo,
+) error {
+	name := volConfig.InternalName
+
+	if publishInfo.HostNQN == "" {
+		return fmt.Errorf("host NQN of node %s is not known", publishInfo.HostName)
+	}
+
+	ns, err := d.API.NVMeNamespaceGetByName(ctx, namespacePath(name))
+	if err != nil {
+		return fmt.Errorf("error getting namespace for volume %s: %v", name, err)
+	}
+
+	subsystem, err := d.API.NVMeSubsystemCreate(ctx, subsystemName(name))
+	if err != nil {
+		return fmt.Errorf("error getting subsystem for volume %s: %v", name, err)
+	}
+
+	if err = d.API.NVMeAddHostToSubsystem(ctx, publishInfo.HostNQN, subsystem.UUID); err != nil {
+		return fmt.Errorf("error adding host %s to subsystem %s: %v", publishInfo.HostNQN, subsystem.Name, err)
+	}
+
+	if err = d.API.NVMeEnsureNamespaceMapped(ctx, subsystem.UUID, ns.UUID); err != nil {
+		return fmt.Errorf("error mapping namespace %s to subsystem %s: %v", ns.Name, subsystem.Name, err)
+	}
+
+	fstype := getNamespaceFSType(ctx, ns)
+
+	// xfs volumes are always mounted with '-o nouuid' to allow clones to be mounted to the same node as the source
+	if fstype == tridentconfig.FsXfs {
+		publishInfo.MountOptions = drivers.EnsureMountOption(publishInfo.MountOptions, drivers.MountOptionNoUUID)
+	}
+
+	// Add fields needed by Attach
+	publishInfo.SANType = sa.NVMe
+	publishInfo.NVMeSubsystemNQN = subsystem.NQN
+	publishInfo.NVMeSubsystemUUID = subsystem.UUID
+	publishInfo.NVMeNamespaceUUID = ns.UUID
+	publishInfo.NVMeTargetIPs = d.ips
+	publishInfo.FilesystemType = fstype
+	publishInfo.SharedTarget = false
+
+	// Fill in the volume access fields as well.
+	volConfig.AccessInfo = publishInfo.VolumeAccessInfo
+
+	return nil
+}
+
+// unpublishNamespace revokes the access of the host specified in publishInfo to a volume's namespace.  Once no
+// hosts remain, the namespace is unmapped and the volume's subsystem is deleted.
+func (d *SANStorageDriver) unpublishNamespace(
+	ctx context.Context, name string, publishInfo *utils.VolumePublishInfo,
+) error {
+	ns, err := d.API.NVMeNamespaceGetByName(ctx, namespacePath(name))
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return utils.NotFoundError(fmt.Sprintf("namespace for volume %s not found", name))
+		}
+		return fmt.Errorf("error getting namespace for volume %s: %v", name, err)
+	}
+
+	subsystemUUIDs, err := d.API.NVMeNamespaceGetSubsystems(ctx, ns.UUID)
+	if err != nil {
+		return fmt.Errorf("error getting subsystems for namespace %s: %v", ns.Name, err)
+	}
+
+	for _, subsystemUUID := range subsystemUUIDs {
+		if publishInfo.HostNQN != "" {
+			if err = d.API.NVMeRemoveHostFromSubsystem(ctx, publishInfo.HostNQN, subsystemUUID); err != nil {
+				return fmt.Errorf("error removing host %s from subsystem: %v", publishInfo.HostNQN, err)
+			}
+		}
+
+		hostNQNs, err := d.API.NVMeGetHostsOfSubsystem(ctx, subsystemUUID)
+		if err != nil {
+			return fmt.Errorf("error getting hosts of subsystem: %v", err)
+		}
+		if len(hostNQNs) > 0 {
+			continue
+		}
+
+		// Remove the subsystem if no hosts remain.
+		if err = d.API.NVMeEnsureNamespaceUnmapped(ctx, subsystemUUID, ns.UUID); err != nil {
+			return fmt.Errorf("error unmapping namespace %s: %v", ns.Name, err)
+		}
+		if err = d.API.NVMeSubsystemDelete(ctx, subsystemUUID); err != nil {
+			return fmt.Errorf("error removing empty subsystem: %v", err)
+		}
 	}
 
-	// Remove igroup from volume config's iscsi access Info
-	volConfig.AccessInfo.IscsiIgroup = removeIgroupFromIscsiIgroupList(volConfig.AccessInfo.IscsiIgroup, igroupName)
+	return nil
+}
 
-	// Remove igroup if no LUNs are mapped.
-	if err := DestroyUnmappedIgroup(ctx, d.API, igroupName); err != nil {
-		return fmt.Errorf("error removing empty igroup; %v", err)
+// unmapNamespaceFromAllSubsystems unmaps a namespace from every subsystem to which it is mapped, optionally
+// deleting those subsystems as well.
+func (d *SANStorageDriver) unmapNamespaceFromAllSubsystems(
+	ctx context.Context, nsUUID string, deleteSubsystems bool,
+) error {
+	subsystemUUIDs, err := d.API.NVMeNamespaceGetSubsystems(ctx, nsUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, subsystemUUID := range subsystemUUIDs {
+		if err = d.API.NVMeEnsureNamespaceUnmapped(ctx, subsystemUUID, nsUUID); err != nil {
+			return err
+		}
+		if deleteSubsystems {
+			if err = d.API.NVMeSubsystemDelete(ctx, subsystemUUID); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -839,7 +1171,7 @@ func (d *SANStorageDriver) GetSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetSnapshot")
 
-	return getVolumeSnapshot(ctx, snapConfig, &d.Config, d.API, d.API.LunSize)
+	return getVolumeSnapshot(ctx, snapConfig, &d.Config, d.API, d.getLUNSize)
 }
 
 // GetSnapshots returns the list of snapshots associated with the specified volume
@@ -854,7 +1186,20 @@ func (d *SANStorageDriver) GetSnapshots(ctx context.Context, volConfig *storage.
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetSnapshots")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetSnapshots")
 
-	return getVolumeSnapshotList(ctx, volConfig, &d.Config, d.API, d.API.LunSize)
+	return getVolumeSnapshotList(ctx, volConfig, &d.Config, d.API, d.getLUNSize)
+}
+
+// getLUNSize returns the size of the LUN or namespace in a volume's Flexvol.
+func (d *SANStorageDriver) getLUNSize(ctx context.Context, name string) (int, error) {
+	if d.Config.SANType != sa.NVMe {
+		return d.API.LunSize(ctx, name)
+	}
+
+	ns, err := d.API.NVMeNamespaceGetByName(ctx, namespacePath(name))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(ns.Size)
 }
 
 // CreateSnapshot creates a snapshot for the given volume
@@ -873,7 +1218,29 @@ func (d *SANStorageDriver) CreateSnapshot(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateSnapshot")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateSnapshot")
 
-	return createFlexvolSnapshot(ctx, snapConfig, &d.Config, d.API, d.API.LunSize)
+	return createFlexvolSnapshot(ctx, snapConfig, &d.Config, d.API, d.getLUNSize)
+}
+
+// SupportsGroupSnapshots indicates whether this driver can snapshot a group of volumes atomically.  Group
+// snapshots rely on ONTAP consistency groups, which are only available via the REST API.
+func (d *SANStorageDriver) SupportsGroupSnapshots() bool {
+	return d.Config.UseREST
+}
+
+// CreateGroupSnapshot snapshots a group of volumes at a single point in time.
+func (d *SANStorageDriver) CreateGroupSnapshot(
+	ctx context.Context, groupConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	_ []*storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	fields := LogFields{
+		"Method":        "CreateGroupSnapshot",
+		"Type":          "SANStorageDriver",
+		"groupSnapshot": groupConfig.InternalName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	return createFlexvolGroupSnapshot(ctx, groupConfig, snapConfigs, &d.Config, d.API, d.getLUNSize)
 }
 
 // RestoreSnapshot restores a volume (in place) from a snapshot.
@@ -892,6 +1259,11 @@ func (d *SANStorageDriver) RestoreSnapshot(
 	return RestoreSnapshot(ctx, snapConfig, &d.Config, d.API)
 }
 
+// RestoreRequiresNewestSnapshot indicates whether this driver can only restore a volume from its newest snapshot.
+func (d *SANStorageDriver) RestoreRequiresNewestSnapshot() bool {
+	return true
+}
+
 // DeleteSnapshot creates a snapshot of a volume.
 func (d *SANStorageDriver) DeleteSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -947,6 +1319,71 @@ func (d *SANStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) [
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
 }
 
+// GetPoolCapacity returns the space in the aggregates backing a storage pool
+func (d *SANStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, pool, d.physicalPools, d.virtualPools, d.API)
+}
+
+// GetVolumeHealth reports whether a volume's Flexvol is missing or offline, or whether its LUN or namespace is
+// not online
+func (d *SANStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	name := volConfig.InternalName
+
+	exists, err := d.API.VolumeExists(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not check for volume %s; %v", name, err)
+	}
+	if !exists {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("volume %s was not found or is not online", name),
+		}, nil
+	}
+
+	if d.Config.SANType == sa.NVMe {
+		ns, err := d.API.NVMeNamespaceGetByName(ctx, namespacePath(name))
+		if err != nil {
+			return nil, fmt.Errorf("could not get namespace %s; %v", namespacePath(name), err)
+		}
+		if ns.State != "online" {
+			return &storage.VolumeHealth{
+				Abnormal: true,
+				Message:  fmt.Sprintf("namespace %s is %s", namespacePath(name), ns.State),
+			}, nil
+		}
+		return &storage.VolumeHealth{}, nil
+	}
+
+	// A LUN that runs out of space is taken offline by ONTAP, so its state covers the space check
+	lun, err := d.API.LunGetByName(ctx, lunPath(name))
+	if err != nil {
+		return nil, fmt.Errorf("could not get LUN %s; %v", lunPath(name), err)
+	}
+	if lun.State != "online" {
+		return &storage.VolumeHealth{
+			Abnormal: true,
+			Message:  fmt.Sprintf("LUN %s is %s", lunPath(name), lun.State),
+		}, nil
+	}
+
+	return &storage.VolumeHealth{}, nil
+}
+
+// GetVolumeMetrics reports the space used by each volume's LUN and its performance.  An NVMe namespace is the
+// only content of its Flexvol, so the Flexvol's metrics are reported for it.
+func (d *SANStorageDriver) GetVolumeMetrics(
+	ctx context.Context, volConfigs []*storage.VolumeConfig,
+) (map[string]*storage.VolumeMetrics, error) {
+	if d.Config.SANType == sa.NVMe {
+		return getVolumeMetricsCommon(ctx, volConfigs, *d.Config.StoragePrefix+"*", d.API.VolumeListMetrics,
+			flexvolName)
+	}
+	return getVolumeMetricsCommon(ctx, volConfigs, lunPath(*d.Config.StoragePrefix+"*"), d.API.LunListMetrics,
+		lunPath)
+}
+
 func (d *SANStorageDriver) getStoragePoolAttributes(ctx context.Context) map[string]sa.Offer {
 	client := d.GetAPI()
 	mirroring, _ := client.IsSVMDRCapable(ctx)
@@ -1014,13 +1451,22 @@ func (d *SANStorageDriver) GetVolumeExternal(ctx context.Context, name string) (
 		return nil, err
 	}
 
+	if d.Config.SANType == sa.NVMe {
+		ns, err := d.API.NVMeNamespaceGetByName(ctx, fmt.Sprintf("/vol/%v/*", name))
+		if err != nil {
+			return nil, err
+		}
+
+		return d.getVolumeExternal(ns.Size, volumeAttrs), nil
+	}
+
 	lunPath := fmt.Sprintf("/vol/%v/*", name)
 	lunAttrs, err := d.API.LunGetByName(ctx, lunPath)
 	if err != nil {
 		return nil, err
 	}
 
-	return d.getVolumeExternal(lunAttrs, volumeAttrs), nil
+	return d.getVolumeExternal(lunAttrs.Size, volumeAttrs), nil
 }
 
 // GetVolumeExternalWrappers queries the storage backend for all relevant info about
@@ -1038,14 +1484,6 @@ func (d *SANStorageDriver) GetVolumeExternalWrappers(ctx context.Context, channe
 		return
 	}
 
-	// Get all LUNs named 'lun0' in volumes matching the storage prefix
-	lunPathPattern := lunPath(*d.Config.StoragePrefix + "*")
-	luns, err := d.API.LunList(ctx, lunPathPattern)
-	if err != nil {
-		channel <- &storage.VolumeExternalWrapper{Volume: nil, Error: err}
-		return
-	}
-
 	// Make a map of volumes for faster correlation with LUNs
 	volumeMap := make(map[string]api.Volume)
 	if volumes != nil {
@@ -1055,6 +1493,36 @@ func (d *SANStorageDriver) GetVolumeExternalWrappers(ctx context.Context, channe
 		}
 	}
 
+	if d.Config.SANType == sa.NVMe {
+		// Get all namespaces named 'namespace0' in volumes matching the storage prefix
+		namespaces, err := d.API.NVMeNamespaceList(ctx, namespacePath(*d.Config.StoragePrefix+"*"))
+		if err != nil {
+			channel <- &storage.VolumeExternalWrapper{Volume: nil, Error: err}
+			return
+		}
+
+		// Convert all namespaces to VolumeExternal and write them to the channel
+		for idx := range namespaces {
+			ns := &namespaces[idx]
+			volume, ok := volumeMap[ns.VolumeName]
+			if !ok {
+				Logc(ctx).WithField("path", ns.Name).Warning("Flexvol not found for namespace.")
+				continue
+			}
+
+			channel <- &storage.VolumeExternalWrapper{Volume: d.getVolumeExternal(ns.Size, &volume), Error: nil}
+		}
+		return
+	}
+
+	// Get all LUNs named 'lun0' in volumes matching the storage prefix
+	lunPathPattern := lunPath(*d.Config.StoragePrefix + "*")
+	luns, err := d.API.LunList(ctx, lunPathPattern)
+	if err != nil {
+		channel <- &storage.VolumeExternalWrapper{Volume: nil, Error: err}
+		return
+	}
+
 	// Convert all LUNs to VolumeExternal and write them to the channel
 	if luns != nil {
 		for idx := range luns {
@@ -1065,7 +1533,7 @@ func (d *SANStorageDriver) GetVolumeExternalWrappers(ctx context.Context, channe
 				continue
 			}
 
-			channel <- &storage.VolumeExternalWrapper{Volume: d.getVolumeExternal(lun, &volume), Error: nil}
+			channel <- &storage.VolumeExternalWrapper{Volume: d.getVolumeExternal(lun.Size, &volume), Error: nil}
 		}
 	}
 }
@@ -1074,7 +1542,7 @@ func (d *SANStorageDriver) GetVolumeExternalWrappers(ctx context.Context, channe
 // as returned by the storage backend and formats it as a VolumeExternal
 // object.
 func (d *SANStorageDriver) getVolumeExternal(
-	lun *api.Lun, volume *api.Volume,
+	size string, volume *api.Volume,
 ) *storage.VolumeExternal {
 	internalName := volume.Name
 	name := internalName
@@ -1086,7 +1554,7 @@ func (d *SANStorageDriver) getVolumeExternal(
 		Version:         tridentconfig.OrchestratorAPIVersion,
 		Name:            name,
 		InternalName:    internalName,
-		Size:            lun.Size,
+		Size:            size,
 		Protocol:        tridentconfig.Block,
 		SnapshotPolicy:  volume.SnapshotPolicy,
 		ExportPolicy:    "",
@@ -1178,9 +1646,19 @@ func (d *SANStorageDriver) Resize(
 		return fmt.Errorf("error occurred when checking volume size")
 	}
 
-	currentLunSize, err := d.API.LunSize(ctx, name)
-	if err != nil {
-		return fmt.Errorf("error occurred when checking lun size")
+	var ns *api.NVMeNamespace
+	var currentLunSize int
+	if d.Config.SANType == sa.NVMe {
+		if ns, err = d.API.NVMeNamespaceGetByName(ctx, namespacePath(name)); err != nil {
+			return fmt.Errorf("error occurred when checking namespace size")
+		}
+		if currentLunSize, err = strconv.Atoi(ns.Size); err != nil {
+			return fmt.Errorf("error occurred when checking namespace size")
+		}
+	} else {
+		if currentLunSize, err = d.API.LunSize(ctx, name); err != nil {
+			return fmt.Errorf("error occurred when checking lun size")
+		}
 	}
 
 	lunSizeBytes := uint64(currentLunSize)
@@ -1235,8 +1713,18 @@ func (d *SANStorageDriver) Resize(
 		}
 	}
 
-	// Resize LUN0
+	// Resize the namespace
 	returnSize := lunSizeBytes
+	if !sameLUNSize && d.Config.SANType == sa.NVMe {
+		if err = d.API.NVMeNamespaceSetSize(ctx, ns.UUID, int64(requestedSizeBytes)); err != nil {
+			Logc(ctx).WithField("error", err).Error("Namespace resize failed.")
+			return fmt.Errorf("volume resize failed")
+		}
+		volConfig.Size = strconv.FormatUint(requestedSizeBytes, 10)
+		return nil
+	}
+
+	// Resize LUN0
 	if !sameLUNSize {
 		returnSize, err = d.API.LunSetSize(ctx, lunPath(name), strconv.FormatUint(requestedSizeBytes, 10))
 		if err != nil {
@@ -1249,6 +1737,24 @@ func (d *SANStorageDriver) Resize(
 	return nil
 }
 
+// MoveVolume moves a FlexVol to the aggregate of another physical pool with an ONTAP volume move, which does not
+// disrupt its clients.
+func (d *SANStorageDriver) MoveVolume(
+	ctx context.Context, volConfig *storage.VolumeConfig, targetPool storage.Pool, restartFailed bool,
+) (bool, int, error) {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method":     "MoveVolume",
+		"Type":       "SANStorageDriver",
+		"name":       name,
+		"targetPool": targetPool.Name(),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> MoveVolume")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< MoveVolume")
+
+	return moveFlexvol(ctx, name, targetPool, d.physicalPools, d.API, restartFailed)
+}
+
 func (d *SANStorageDriver) ReconcileNodeAccess(ctx context.Context, nodes []*utils.Node,
 	backendUUID, tridentUUID string,
 ) error {
@@ -1267,6 +1773,11 @@ func (d *SANStorageDriver) ReconcileNodeAccess(ctx context.Context, nodes []*uti
 	defer Logd(ctx, d.Config.StorageDriverName,
 		d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< ReconcileNodeAccess")
 
+	// Hosts are removed from the per-volume subsystems as volumes are unpublished, so there is nothing to reconcile
+	if d.Config.SANType == sa.NVMe {
+		return nil
+	}
+
 	return reconcileSANNodeAccess(ctx, d.API, nodeNames, backendUUID, tridentUUID)
 }
 
@@ -1276,7 +1787,15 @@ func (d *SANStorageDriver) GetBackendState(ctx context.Context) (string, *roarin
 	Logc(ctx).Debug(">>>> GetBackendState")
 	defer Logc(ctx).Debugf("<<<< GetBackendState")
 
-	return getSVMState(ctx, d.API, "iscsi", d.GetStorageBackendPhysicalPoolNames(ctx))
+	protocol := "iscsi"
+	switch d.Config.SANType {
+	case sa.NVMe:
+		protocol = "nvme_tcp"
+	case sa.FCP:
+		protocol = sa.FCP
+	}
+
+	return getSVMState(ctx, d.API, protocol, d.GetStorageBackendPhysicalPoolNames(ctx))
 }
 
 // String makes SANStorageDriver satisfy the Stringer interface.
@@ -1385,6 +1904,13 @@ func (d *SANStorageDriver) PromoteMirror(
 		d.GetConfig().ReplicationPolicy, d.API)
 }
 
+// UpdateMirror starts a transfer of a snapshot of the remote volume to a snapmirror destination
+func (d *SANStorageDriver) UpdateMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotName string,
+) error {
+	return updateMirror(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotName, d.API)
+}
+
 // GetMirrorStatus returns the current state of a snapmirror relationship
 func (d *SANStorageDriver) GetMirrorStatus(
 	ctx context.Context, localInternalVolumeName, remoteVolumeHandle string,
@@ -1403,6 +1929,10 @@ func (d *SANStorageDriver) GetReplicationDetails(ctx context.Context, localInter
 }
 
 func (d *SANStorageDriver) GetChapInfo(_ context.Context, _, _ string) (*utils.IscsiChapInfo, error) {
+	if d.Config.SANType == sa.NVMe || d.Config.SANType == sa.FCP {
+		return nil, nil
+	}
+
 	return &utils.IscsiChapInfo{
 		UseCHAP:              d.Config.UseCHAP,
 		IscsiUsername:        d.Config.ChapUsername,
@@ -1414,6 +1944,12 @@ func (d *SANStorageDriver) GetChapInfo(_ context.Context, _, _ string) (*utils.I
 
 // EnablePublishEnforcement prepares a volume for per-node igroup mapping allowing greater access control.
 func (d *SANStorageDriver) EnablePublishEnforcement(ctx context.Context, volume *storage.Volume) error {
+	// NVMe volumes are only ever published to the hosts in their own subsystem
+	if d.Config.SANType == sa.NVMe {
+		volume.Config.AccessInfo.PublishEnforcement = true
+		return nil
+	}
+
 	return EnableSANPublishEnforcement(ctx, d.GetAPI(), volume.Config, lunPath(volume.Config.InternalName))
 }
 
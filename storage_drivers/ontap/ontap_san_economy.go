@@ -259,6 +259,13 @@ func (d *SANEconomyStorageDriver) Initialize(
 	}
 	d.Config = *config
 
+	// This driver packs many LUNs into each Flexvol and relies on LUN clones, renames and igroup mappings, which
+	// Trident does not implement for NVMe namespaces, so it only supports iSCSI
+	if d.Config.SANType == sa.NVMe || d.Config.SANType == sa.FCP {
+		return fmt.Errorf("error initializing %s driver: sanType %s is not supported; use the %s driver",
+			d.Name(), d.Config.SANType, tridentconfig.OntapSANStorageDriverName)
+	}
+
 	// Unit tests mock the API layer, so we only use the real API interface if it doesn't already exist.
 	if d.API == nil {
 		d.API, err = InitializeOntapDriver(ctx, config)
@@ -801,9 +808,12 @@ func (d *SANEconomyStorageDriver) createLUNClone(
 	return d.resizeFlexvol(ctx, flexvol, 0)
 }
 
+// Import brings an existing LUN under Trident's control.  The LUN is named by its path within its Flexvol, in
+// the format <flexvol_name>/<lun_name>.  If Trident will manage its lifecycle, the LUN is renamed to its internal
+// name and its Flexvol is moved into Trident's bucket naming, which requires that the Flexvol holds no other LUN.
 func (d *SANEconomyStorageDriver) Import(
 	ctx context.Context, volConfig *storage.VolumeConfig, originalName string,
-) error {
+) (err error) {
 	fields := LogFields{
 		"Method":       "Import",
 		"Type":         "SANEconomyStorageDriver",
@@ -814,9 +824,141 @@ func (d *SANEconomyStorageDriver) Import(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Import")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Import")
 
-	return errors.New("import is not implemented")
+	// The original name identifies the LUN by its Flexvol, i.e. <flexvol>/<lun>
+	flexvolName, lunName, err := parseLUNImportName(originalName)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the Flexvol exists
+	flexvol, err := d.API.VolumeInfo(ctx, flexvolName)
+	if err != nil {
+		return err
+	} else if flexvol == nil {
+		return fmt.Errorf("volume %s not found", flexvolName)
+	}
+
+	// Validate the Flexvol is what it should be
+	if flexvol.AccessType != "" && flexvol.AccessType != "rw" {
+		Logc(ctx).WithField("originalName", originalName).Error("Could not import volume, type is not rw.")
+		return fmt.Errorf("volume %s type is %s, not rw", flexvolName, flexvol.AccessType)
+	}
+
+	// Ensure the LUN exists
+	originalPath := GetLUNPathEconomy(flexvolName, lunName)
+	lunInfo, err := d.API.LunGetByName(ctx, originalPath)
+	if err != nil {
+		return err
+	} else if lunInfo == nil {
+		return fmt.Errorf("LUN %s not found", originalPath)
+	}
+
+	// The LUN should be online
+	if lunInfo.State != "online" {
+		return fmt.Errorf("LUN %s is not online", originalPath)
+	}
+
+	// Use the LUN size
+	volConfig.Size = lunInfo.Size
+
+	// Set the volume to LUKS if backend has LUKS true as default
+	volConfig.LUKSEncryption = d.Config.LUKSEncryption
+
+	// Use the filesystem type recorded on the LUN, if any
+	if fstype, err := d.API.LunGetFSType(ctx, originalPath); err != nil {
+		Logc(ctx).WithField("LUN", originalPath).WithError(err).Warning("Could not read LUN filesystem type.")
+	} else if fstype != "" {
+		volConfig.FileSystem = fstype
+	}
+
+	// Volume import is not managed by Trident, so the LUN keeps its name and place
+	if volConfig.ImportNotManaged {
+		volConfig.InternalName = originalName
+		return nil
+	}
+
+	if len(volConfig.InternalName) > maxLunNameLength {
+		return fmt.Errorf("volume %s name exceeds the limit of %d characters", volConfig.InternalName,
+			maxLunNameLength)
+	}
+
+	// Move the LUN into a bucket Flexvol by renaming its Flexvol, which is only possible if it holds no other LUN
+	bucketVol := flexvolName
+	if !strings.HasPrefix(flexvolName, d.FlexvolNamePrefix()) {
+		luns, err := d.API.LunList(ctx, fmt.Sprintf("/vol/%s/*", flexvolName))
+		if err != nil {
+			return fmt.Errorf("error enumerating LUNs for volume %v: %v", flexvolName, err)
+		}
+		if len(luns) != 1 {
+			return fmt.Errorf("could not import volume, volume %s holds %d LUNs", flexvolName, len(luns))
+		}
+
+		bucketVol = d.FlexvolNamePrefix() + utils.RandomString(10)
+		if err = d.API.VolumeRename(ctx, flexvolName, bucketVol); err != nil {
+			Logc(ctx).WithField("originalName", originalName).Errorf(
+				"Could not import volume, rename volume failed: %v", err)
+			return fmt.Errorf("volume %s rename failed: %v", flexvolName, err)
+		}
+	}
+
+	// Rename the LUN so Trident can find it
+	targetPath := GetLUNPathEconomy(bucketVol, volConfig.InternalName)
+	currentPath := GetLUNPathEconomy(bucketVol, lunName)
+	lunRenamed := false
+
+	// Restore the original names if any later step fails
+	defer func() {
+		if err == nil {
+			return
+		}
+		if lunRenamed {
+			if renameErr := d.API.LunRename(ctx, targetPath, currentPath); renameErr != nil {
+				Logc(ctx).WithField("path", targetPath).Errorf("Could not restore LUN name: %v", renameErr)
+			}
+		}
+		if bucketVol != flexvolName {
+			if renameErr := d.API.VolumeRename(ctx, bucketVol, flexvolName); renameErr != nil {
+				Logc(ctx).WithField("flexvol", bucketVol).Errorf("Could not restore volume name: %v", renameErr)
+			}
+		}
+	}()
+
+	if currentPath != targetPath {
+		if err = d.API.LunRename(ctx, currentPath, targetPath); err != nil {
+			Logc(ctx).WithField("path", currentPath).Errorf("Could not import volume, rename LUN failed: %v", err)
+			return fmt.Errorf("LUN path %s rename failed: %v", currentPath, err)
+		}
+		lunRenamed = true
+	}
+
+	if err = LunUnmapAllIgroups(ctx, d.GetAPI(), targetPath); err != nil {
+		Logc(ctx).WithField("LUN", targetPath).Warnf("Unmapping of igroups failed: %v", err)
+		return fmt.Errorf("failed to unmap igroups for LUN %s: %v", targetPath, err)
+	}
+
+	return nil
 }
 
+// parseLUNImportName splits the name of a LUN to be imported into the names of its Flexvol and the LUN.
+func parseLUNImportName(originalName string) (flexvol, lun string, err error) {
+	parts := strings.Split(originalName, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("LUN to import must be named <flexvol>/<lun>, not %s", originalName)
+	}
+	return parts[0], parts[1], nil
+}
+
+// lunPathForVolume returns the path of a volume's LUN within the given bucket Flexvol.  LUNs imported without
+// being managed by Trident are named <flexvol>/<lun> and keep their original path.
+func (d *SANEconomyStorageDriver) lunPathForVolume(bucketVol, name string) string {
+	if flexvol, lun, err := parseLUNImportName(name); err == nil {
+		return GetLUNPathEconomy(flexvol, lun)
+	}
+	return d.helper.GetLUNPath(bucketVol, name)
+}
+
+// Rename changes the name of a LUN.  A new name in the format <flexvol_name>/<lun_name> also renames the LUN's
+// bucket Flexvol, which returns a LUN imported as managed to its original location.
 func (d *SANEconomyStorageDriver) Rename(ctx context.Context, name, newName string) error {
 	fields := LogFields{
 		"Method":  "Rename",
@@ -827,7 +969,46 @@ func (d *SANEconomyStorageDriver) Rename(ctx context.Context, name, newName stri
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Rename")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Rename")
 
-	return errors.New("rename is not implemented")
+	exists, bucketVol, err := d.LUNExists(ctx, name, d.FlexvolNamePrefix())
+	if err != nil {
+		return fmt.Errorf("could not find LUN %s: %v", name, err)
+	} else if !exists {
+		return fmt.Errorf("LUN %s not found", name)
+	}
+
+	newFlexvol, newLun := bucketVol, newName
+	if parsedFlexvol, parsedLun, parseErr := parseLUNImportName(newName); parseErr == nil {
+		newFlexvol, newLun = parsedFlexvol, parsedLun
+	}
+
+	lunName := name
+	if _, parsedLun, parseErr := parseLUNImportName(name); parseErr == nil {
+		lunName = parsedLun
+	}
+
+	currentPath := GetLUNPathEconomy(bucketVol, lunName)
+	newPath := GetLUNPathEconomy(bucketVol, newLun)
+	if newPath != currentPath {
+		if err = d.API.LunRename(ctx, currentPath, newPath); err != nil {
+			return fmt.Errorf("LUN path %s rename failed: %v", currentPath, err)
+		}
+	}
+
+	if newFlexvol != bucketVol {
+		// Other volumes' LUNs must stay in their bucket
+		luns, err := d.API.LunList(ctx, fmt.Sprintf("/vol/%s/*", bucketVol))
+		if err != nil {
+			return fmt.Errorf("error enumerating LUNs for volume %v: %v", bucketVol, err)
+		}
+		if len(luns) != 1 {
+			return fmt.Errorf("volume %s holds %d LUNs, so it cannot be renamed", bucketVol, len(luns))
+		}
+		if err = d.API.VolumeRename(ctx, bucketVol, newFlexvol); err != nil {
+			return fmt.Errorf("volume %s rename failed: %v", bucketVol, err)
+		}
+	}
+
+	return nil
 }
 
 // Destroy the LUN
@@ -980,12 +1161,12 @@ func (d *SANEconomyStorageDriver) Publish(
 		return fmt.Errorf("error LUN %v does not exist", name)
 	}
 
-	lunPath := d.helper.GetLUNPath(bucketVol, name)
+	lunPath := d.lunPathForVolume(bucketVol, name)
 	igroupName := d.Config.IgroupName
 
 	// Use the node specific igroup if publish enforcement is enabled and this is for CSI.
 	if volConfig.AccessInfo.PublishEnforcement && tridentconfig.CurrentDriverContext == tridentconfig.ContextCSI {
-		igroupName = getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+		igroupName = getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 		err = ensureIGroupExists(ctx, d.GetAPI(), igroupName)
 	}
 
@@ -1043,21 +1224,7 @@ func (d *SANEconomyStorageDriver) Unpublish(
 	}
 
 	// Attempt to unmap the LUN from the per-node igroup; the lunPath is where the LUN resides within the flexvol.
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
-	lunPath := d.helper.GetLUNPath(bucketVol, name)
-	if err := LunUnmapIgroup(ctx, d.API, igroupName, lunPath); err != nil {
-		return fmt.Errorf("error unmapping LUN %s from igroup %s; %v", lunPath, igroupName, err)
-	}
-
-	// Remove igroup from volume config's access Info
-	volConfig.AccessInfo.IscsiIgroup = removeIgroupFromIscsiIgroupList(volConfig.AccessInfo.IscsiIgroup, igroupName)
-
-	// Remove igroup if no LUNs are mapped.
-	if err := DestroyUnmappedIgroup(ctx, d.API, igroupName); err != nil {
-		return fmt.Errorf("error removing empty igroup; %v", err)
-	}
-
-	return nil
+	return unpublishLUNFromNode(ctx, d.API, volConfig, publishInfo, d.lunPathForVolume(bucketVol, name), sa.ISCSI)
 }
 
 // CanSnapshot determines whether a snapshot as specified in the provided snapshot config may be taken.
@@ -1610,6 +1777,11 @@ func (d *SANEconomyStorageDriver) GetStorageBackendPhysicalPoolNames(context.Con
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
 }
 
+// GetPoolCapacity returns the space in the aggregates backing a storage pool
+func (d *SANEconomyStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, pool, d.physicalPools, d.virtualPools, d.API)
+}
+
 func (d *SANEconomyStorageDriver) getStoragePoolAttributes() map[string]sa.Offer {
 	return map[string]sa.Offer{
 		sa.BackendType:      sa.NewStringOffer(d.Name()),
@@ -1828,6 +2000,10 @@ func (d *SANEconomyStorageDriver) LUNExists(ctx context.Context, name, bucketPre
 	).Debug("Checking if LUN exists.")
 
 	lunPathPattern := fmt.Sprintf("/vol/%s*/%s", bucketPrefix, name)
+	if flexvol, lun, err := parseLUNImportName(name); err == nil {
+		// LUNs imported without being managed by Trident remain in their own Flexvol
+		lunPathPattern = GetLUNPathEconomy(flexvol, lun)
+	}
 	luns, err := d.API.LunList(ctx, lunPathPattern)
 	if err != nil {
 		return false, "", err
@@ -2096,7 +2272,7 @@ func (d *SANEconomyStorageDriver) EnablePublishEnforcement(ctx context.Context,
 	if !exists {
 		return fmt.Errorf("error LUN %v does not exist", internalName)
 	}
-	return EnableSANPublishEnforcement(ctx, d.GetAPI(), volume.Config, GetLUNPathEconomy(flexVol, internalName))
+	return EnableSANPublishEnforcement(ctx, d.GetAPI(), volume.Config, d.lunPathForVolume(flexVol, internalName))
 }
 
 func (d *SANEconomyStorageDriver) CanEnablePublishEnforcement() bool {
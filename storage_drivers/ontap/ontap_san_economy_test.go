@@ -9,6 +9,7 @@ import (
 	"net"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
@@ -1227,24 +1228,178 @@ func TestOntapSanEconomyVolumeClone_BothQosPolicy(t *testing.T) {
 }
 
 func TestOntapSanEconomyVolumeImport(t *testing.T) {
-	_, d := newMockOntapSanEcoDriver(t)
-	volConfig := &storage.VolumeConfig{
-		Size:       "1g",
-		Encryption: "false",
-		FileSystem: "xfs",
-	}
+	expectLUN := func(mockAPI *mockapi.MockOntapAPI, flexvol string) {
+		mockAPI.EXPECT().VolumeInfo(ctx, flexvol).Return(&api.Volume{Name: flexvol, AccessType: "rw"}, nil)
+		mockAPI.EXPECT().LunGetByName(ctx, "/vol/"+flexvol+"/lun1").Return(&api.Lun{
+			Name: "/vol/" + flexvol + "/lun1", State: "online", Size: "1073741824", VolumeName: flexvol,
+		}, nil)
+		mockAPI.EXPECT().LunGetFSType(ctx, "/vol/"+flexvol+"/lun1").Return("ext4", nil)
+	}
+
+	t.Run("Managed", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		d.Config.LUKSEncryption = "true"
+		volConfig := &storage.VolumeConfig{InternalName: "storagePrefix_pvc_1", FileSystem: "xfs"}
+
+		expectLUN(mockAPI, "legacy")
+		mockAPI.EXPECT().LunList(ctx, "/vol/legacy/*").Return(api.Luns{{Name: "/vol/legacy/lun1"}}, nil)
+		mockAPI.EXPECT().VolumeRename(ctx, "legacy", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, originalName, newName string) error {
+				assert.True(t, strings.HasPrefix(newName, d.FlexvolNamePrefix()))
+				mockAPI.EXPECT().LunRename(ctx, "/vol/"+newName+"/lun1", "/vol/"+newName+"/storagePrefix_pvc_1").
+					Return(nil)
+				mockAPI.EXPECT().LunListIgroupsMapped(ctx, "/vol/"+newName+"/storagePrefix_pvc_1").Return(nil, nil)
+				return nil
+			})
+
+		assert.NoError(t, d.Import(ctx, volConfig, "legacy/lun1"))
+		assert.Equal(t, "1073741824", volConfig.Size)
+		assert.Equal(t, "ext4", volConfig.FileSystem)
+		assert.Equal(t, "true", volConfig.LUKSEncryption)
+	})
 
-	err := d.Import(ctx, volConfig, "volInternal")
+	t.Run("Managed in bucket", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		bucket := d.FlexvolNamePrefix() + "bucket1"
+		volConfig := &storage.VolumeConfig{InternalName: "storagePrefix_pvc_1"}
 
-	assert.EqualError(t, err, "import is not implemented")
+		expectLUN(mockAPI, bucket)
+		mockAPI.EXPECT().LunRename(ctx, "/vol/"+bucket+"/lun1", "/vol/"+bucket+"/storagePrefix_pvc_1").Return(nil)
+		mockAPI.EXPECT().LunListIgroupsMapped(ctx, "/vol/"+bucket+"/storagePrefix_pvc_1").Return(nil, nil)
+
+		assert.NoError(t, d.Import(ctx, volConfig, bucket+"/lun1"))
+	})
+
+	t.Run("Managed with other LUNs", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "storagePrefix_pvc_1"}
+
+		expectLUN(mockAPI, "legacy")
+		mockAPI.EXPECT().LunList(ctx, "/vol/legacy/*").Return(api.Luns{
+			{Name: "/vol/legacy/lun1"}, {Name: "/vol/legacy/lun2"},
+		}, nil)
+
+		assert.Error(t, d.Import(ctx, volConfig, "legacy/lun1"))
+	})
+
+	t.Run("Managed rollback", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "storagePrefix_pvc_1"}
+
+		expectLUN(mockAPI, "legacy")
+		mockAPI.EXPECT().LunList(ctx, "/vol/legacy/*").Return(api.Luns{{Name: "/vol/legacy/lun1"}}, nil)
+		mockAPI.EXPECT().VolumeRename(ctx, "legacy", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, originalName, newName string) error {
+				lunPath := "/vol/" + newName + "/lun1"
+				targetPath := "/vol/" + newName + "/storagePrefix_pvc_1"
+				mockAPI.EXPECT().LunRename(ctx, lunPath, targetPath).Return(nil)
+				mockAPI.EXPECT().LunListIgroupsMapped(ctx, targetPath).Return(nil, fmt.Errorf("mapping failed"))
+				mockAPI.EXPECT().LunRename(ctx, targetPath, lunPath).Return(nil)
+				mockAPI.EXPECT().VolumeRename(ctx, newName, "legacy").Return(nil)
+				return nil
+			})
+
+		assert.Error(t, d.Import(ctx, volConfig, "legacy/lun1"))
+	})
+
+	t.Run("NotManaged", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "legacy/lun1", ImportNotManaged: true}
+
+		expectLUN(mockAPI, "legacy")
+
+		assert.NoError(t, d.Import(ctx, volConfig, "legacy/lun1"))
+		assert.Equal(t, "legacy/lun1", volConfig.InternalName)
+		assert.Equal(t, "ext4", volConfig.FileSystem)
+
+		// The LUN is found where it was imported
+		mockAPI.EXPECT().LunList(ctx, "/vol/legacy/lun1").Return(api.Luns{
+			{Name: "/vol/legacy/lun1", VolumeName: "legacy"},
+		}, nil)
+		exists, flexvol, err := d.LUNExists(ctx, volConfig.InternalName, d.FlexvolNamePrefix())
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, "legacy", flexvol)
+		assert.Equal(t, "/vol/legacy/lun1", d.lunPathForVolume(flexvol, volConfig.InternalName))
+	})
+
+	t.Run("Offline", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		volConfig := &storage.VolumeConfig{InternalName: "storagePrefix_pvc_1"}
+
+		mockAPI.EXPECT().VolumeInfo(ctx, "legacy").Return(&api.Volume{Name: "legacy", AccessType: "rw"}, nil)
+		mockAPI.EXPECT().LunGetByName(ctx, "/vol/legacy/lun1").Return(&api.Lun{
+			Name: "/vol/legacy/lun1", State: "offline",
+		}, nil)
+
+		assert.Error(t, d.Import(ctx, volConfig, "legacy/lun1"))
+	})
+
+	t.Run("Invalid name", func(t *testing.T) {
+		_, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		assert.Error(t, d.Import(ctx, &storage.VolumeConfig{}, "legacy"))
+	})
 }
 
 func TestOntapSanEconomyVolumeRename(t *testing.T) {
-	_, d := newMockOntapSanEcoDriver(t)
+	t.Run("LUN", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		bucket := d.FlexvolNamePrefix() + "bucket1"
+
+		mockAPI.EXPECT().LunList(ctx, "/vol/trident_lun_pool_*/volInternal").Return(api.Luns{
+			{Name: "/vol/" + bucket + "/volInternal", VolumeName: bucket},
+		}, nil)
+		mockAPI.EXPECT().LunRename(ctx, "/vol/"+bucket+"/volInternal", "/vol/"+bucket+"/newVolInternal").Return(nil)
 
-	err := d.Rename(ctx, "volInternal", "newVolInternal")
+		assert.NoError(t, d.Rename(ctx, "volInternal", "newVolInternal"))
+	})
+
+	t.Run("Import name", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		bucket := d.FlexvolNamePrefix() + "bucket1"
+
+		mockAPI.EXPECT().LunList(ctx, "/vol/trident_lun_pool_*/volInternal").Return(api.Luns{
+			{Name: "/vol/" + bucket + "/volInternal", VolumeName: bucket},
+		}, nil)
+		mockAPI.EXPECT().LunRename(ctx, "/vol/"+bucket+"/volInternal", "/vol/"+bucket+"/lun1").Return(nil)
+		mockAPI.EXPECT().LunList(ctx, "/vol/"+bucket+"/*").Return(api.Luns{{Name: "/vol/" + bucket + "/lun1"}}, nil)
+		mockAPI.EXPECT().VolumeRename(ctx, bucket, "legacy").Return(nil)
+
+		assert.NoError(t, d.Rename(ctx, "volInternal", "legacy/lun1"))
+	})
+
+	t.Run("Shared bucket", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+		d.flexvolNamePrefix = "trident_lun_pool_"
+		bucket := d.FlexvolNamePrefix() + "bucket1"
 
-	assert.EqualError(t, err, "rename is not implemented")
+		mockAPI.EXPECT().LunList(ctx, "/vol/trident_lun_pool_*/volInternal").Return(api.Luns{
+			{Name: "/vol/" + bucket + "/volInternal", VolumeName: bucket},
+		}, nil)
+		mockAPI.EXPECT().LunRename(ctx, "/vol/"+bucket+"/volInternal", "/vol/"+bucket+"/lun1").Return(nil)
+		mockAPI.EXPECT().LunList(ctx, "/vol/"+bucket+"/*").Return(api.Luns{
+			{Name: "/vol/" + bucket + "/lun1"}, {Name: "/vol/" + bucket + "/lun2"},
+		}, nil)
+
+		assert.Error(t, d.Rename(ctx, "volInternal", "legacy/lun1"))
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockAPI, d := newMockOntapSanEcoDriver(t)
+
+		mockAPI.EXPECT().LunList(ctx, gomock.Any()).Return(api.Luns{}, nil)
+
+		assert.Error(t, d.Rename(ctx, "volInternal", "newVolInternal"))
+	})
 }
 
 func TestOntapSanEconomyVolumeDestroy(t *testing.T) {
@@ -1584,6 +1739,7 @@ func TestOntapSanEconomyVolumePublish(t *testing.T) {
 		HostName:         "bar",
 		HostIQN:          []string{"host_iqn"},
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 		Unmanaged:        false,
 	}
@@ -1616,6 +1772,7 @@ func TestOntapSanEconomyVolumePublishSLMError(t *testing.T) {
 		HostName:         "bar",
 		HostIQN:          []string{"host_iqn"},
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 		Unmanaged:        false,
 	}
@@ -1649,6 +1806,7 @@ func TestOntapSanEconomyVolumePublish_LUNDoesNotExist(t *testing.T) {
 		HostName:         "bar",
 		HostIQN:          []string{"host_iqn"},
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 		Unmanaged:        false,
 	}
@@ -1689,6 +1847,7 @@ func TestOntapSanEconomyVolumePublish_GetISCSITargetInfoFailed(t *testing.T) {
 		HostName:         "bar",
 		HostIQN:          []string{"host_iqn"},
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 		Unmanaged:        false,
 	}
@@ -1719,6 +1878,7 @@ func TestOntapSanEconomyVolumeUnpublish_LegacyVolume(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: false},
 	}
 
@@ -1746,6 +1906,7 @@ func TestOntapSanEconomyVolumeUnpublish_LunListFails(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
@@ -1774,6 +1935,7 @@ func TestOntapSanEconomyVolumeUnpublish_LUNDoesNotExist(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
@@ -1811,10 +1973,11 @@ func TestOntapSanEconomyVolumeUnpublish_LUNMapInfoFails(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 	lunPath := d.helper.GetLUNPath(bucketName, volumeName)
 	lunPathPattern := d.helper.GetLUNPathPattern(volumeName)
 
@@ -1852,10 +2015,11 @@ func TestOntapSanEconomyVolumeUnpublish_IgroupListLUNsMappedFails(t *testing.T)
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 	lunPath := d.helper.GetLUNPath(bucketName, volumeName)
 	lunPathPattern := d.helper.GetLUNPathPattern(volumeName)
 
@@ -1895,10 +2059,11 @@ func TestOntapSanEconomyVolumeUnpublish_IgroupDestroyFails(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 	lunPath := d.helper.GetLUNPath(bucketName, volumeName)
 	lunPathPattern := d.helper.GetLUNPathPattern(volumeName)
 
@@ -1938,10 +2103,11 @@ func TestOntapSanEconomyVolumeUnpublishX(t *testing.T) {
 	publishInfo := &utils.VolumePublishInfo{
 		HostName:         "bar",
 		TridentUUID:      "1234",
+		BackendUUID:      "5678",
 		VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: true},
 	}
 
-	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 	lunPath := d.helper.GetLUNPath(bucketName, volumeName)
 	lunPathPattern := d.helper.GetLUNPathPattern(volumeName)
 
@@ -2056,6 +2222,7 @@ func TestOntapSanEconomyVolumeUnpublish(t *testing.T) {
 			publishInfo := &utils.VolumePublishInfo{
 				HostName:         "bar",
 				TridentUUID:      "1234",
+				BackendUUID:      "5678",
 				VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: tr.args.publishEnforcement},
 			}
 
@@ -2066,7 +2233,7 @@ func TestOntapSanEconomyVolumeUnpublish(t *testing.T) {
 			d.API = mockAPI
 			d.helper = NewTestLUNHelper("", tridentconfig.ContextCSI)
 
-			igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+			igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 			lunPath := d.helper.GetLUNPath(bucketName, volumeName)
 			lunPathPattern := d.helper.GetLUNPathPattern(volumeName)
 
@@ -3487,6 +3654,42 @@ func TestOntapSanEconomyInitialize_InvalidConfig(t *testing.T) {
 	assert.Error(t, result)
 }
 
+func TestOntapSanEconomyInitialize_UnsupportedSANType(t *testing.T) {
+	commonConfig := &drivers.CommonStorageDriverConfig{
+		Version:           1,
+		StorageDriverName: "ontap-san-economy",
+		BackendName:       "myOntapSanEcoBackend",
+		DriverContext:     tridentconfig.ContextCSI,
+		DebugTraceFlags:   debugTraceFlags,
+	}
+	secrets := map[string]string{
+		"clientcertificate": "dummy-certificate",
+	}
+
+	for _, sanType := range []string{sa.NVMe, sa.FCP} {
+		t.Run(sanType, func(t *testing.T) {
+			mockAPI, d := newMockOntapSanEcoDriver(t)
+			commonConfigJSON := fmt.Sprintf(`
+	{
+	    "managementLIF":     "10.0.207.8",
+	    "svm":               "SVM1",
+	    "username":          "admin",
+	    "password":          "password",
+	    "storageDriverName": "ontap-san-economy",
+	    "sanType":           "%s",
+	    "version":1
+	}`, sanType)
+
+			mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+			result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
+
+			assert.ErrorContains(t, result, "sanType "+sanType+" is not supported")
+			assert.False(t, d.Initialized())
+		})
+	}
+}
+
 func TestOntapSanEconomyInitialize_NoDataLIFs(t *testing.T) {
 	mockAPI, d := newMockOntapSanEcoDriver(t)
 	commonConfig := &drivers.CommonStorageDriverConfig{
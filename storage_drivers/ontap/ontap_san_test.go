@@ -376,6 +376,45 @@ func TestGetChapInfo(t *testing.T) {
 	}
 }
 
+func TestOntapSanUnpublish_LegacyIgroup(t *testing.T) {
+	ctx := context.Background()
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	publishInfo := &utils.VolumePublishInfo{HostName: "bar", TridentUUID: "1234", BackendUUID: "5678"}
+	igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
+	legacyIgroupName := getLegacyNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+	volConfig := &storage.VolumeConfig{
+		InternalName: "foo",
+		AccessInfo: utils.VolumeAccessInfo{
+			PublishEnforcement: true,
+			IscsiAccessInfo:    utils.IscsiAccessInfo{IscsiIgroup: legacyIgroupName},
+		},
+	}
+	lunPath := lunPath(volConfig.InternalName)
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+
+	// A LUN published by an earlier release is unmapped from the legacy igroup too
+	mockAPI.EXPECT().LunMapInfo(ctx, igroupName, lunPath).Return(-1, nil)
+	mockAPI.EXPECT().IgroupListLUNsMapped(ctx, igroupName).Return(nil, nil)
+	mockAPI.EXPECT().IgroupDestroy(ctx, igroupName).Return(nil)
+	mockAPI.EXPECT().LunMapInfo(ctx, legacyIgroupName, lunPath).Return(0, nil)
+	mockAPI.EXPECT().LunUnmap(ctx, legacyIgroupName, lunPath).Return(nil)
+	mockAPI.EXPECT().IgroupListLUNsMapped(ctx, legacyIgroupName).Return([]string{"/vol/v/l"}, nil)
+
+	err := d.Unpublish(ctx, volConfig, publishInfo)
+
+	assert.NoError(t, err)
+	assert.Empty(t, volConfig.AccessInfo.IscsiIgroup)
+}
+
 func TestOntapSanUnpublish(t *testing.T) {
 	ctx := context.Background()
 	originalContext := tridentconfig.CurrentDriverContext
@@ -486,10 +525,11 @@ func TestOntapSanUnpublish(t *testing.T) {
 			publishInfo := &utils.VolumePublishInfo{
 				HostName:         "bar",
 				TridentUUID:      "1234",
+				BackendUUID:      "5678",
 				VolumeAccessInfo: utils.VolumeAccessInfo{PublishEnforcement: tr.args.publishEnforcement},
 			}
 
-			igroupName := getNodeSpecificIgroupName(publishInfo.HostName, publishInfo.TridentUUID)
+			igroupName := getNodeSpecificIgroupName(publishInfo.HostName, sa.ISCSI, publishInfo.BackendUUID)
 			lunPath := lunPath(volConfig.InternalName)
 
 			mockCtrl := gomock.NewController(t)
@@ -550,6 +590,322 @@ func TestOntapSanVolumePublishManaged(t *testing.T) {
 	assert.Nil(t, err, "Error is not nil")
 }
 
+func TestOntapSanVolumePublishNVMe(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+	d.Config.SANType = sa.NVMe
+	d.ips = []string{"127.0.0.1"}
+
+	volConfig := &storage.VolumeConfig{
+		InternalName: "volName",
+		Size:         "1g",
+		Encryption:   "false",
+		FileSystem:   "xfs",
+	}
+
+	publishInfo := &utils.VolumePublishInfo{
+		HostName:    "bar",
+		HostNQN:     "host_nqn",
+		TridentUUID: "1234",
+	}
+
+	ns := &api.NVMeNamespace{
+		UUID:    "ns_uuid",
+		Name:    "/vol/volName/namespace0",
+		Comment: `{"nsAttribute":{"fstype":"xfs","driverContext":"csi","LUKS":"false"}}`,
+	}
+	subsystem := &api.NVMeSubsystem{UUID: "subsys_uuid", Name: "s_volName", NQN: "subsys_nqn"}
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "volName").Times(1).Return(&api.Volume{AccessType: VolTypeRW}, nil)
+	mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, "/vol/volName/namespace0").Times(1).Return(ns, nil)
+	mockAPI.EXPECT().NVMeSubsystemCreate(ctx, "s_volName").Times(1).Return(subsystem, nil)
+	mockAPI.EXPECT().NVMeAddHostToSubsystem(ctx, "host_nqn", "subsys_uuid").Times(1).Return(nil)
+	mockAPI.EXPECT().NVMeEnsureNamespaceMapped(ctx, "subsys_uuid", "ns_uuid").Times(1).Return(nil)
+
+	err := d.Publish(ctx, volConfig, publishInfo)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sa.NVMe, publishInfo.SANType)
+	assert.Equal(t, "subsys_nqn", publishInfo.NVMeSubsystemNQN)
+	assert.Equal(t, "subsys_uuid", publishInfo.NVMeSubsystemUUID)
+	assert.Equal(t, "ns_uuid", publishInfo.NVMeNamespaceUUID)
+	assert.Equal(t, []string{"127.0.0.1"}, publishInfo.NVMeTargetIPs)
+	assert.Equal(t, "xfs", publishInfo.FilesystemType)
+	assert.Contains(t, publishInfo.MountOptions, "nouuid")
+	assert.Equal(t, "ns_uuid", volConfig.AccessInfo.NVMeNamespaceUUID)
+}
+
+func TestOntapSanVolumePublishNVMe_NoHostNQN(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+	d.Config.SANType = sa.NVMe
+
+	volConfig := &storage.VolumeConfig{InternalName: "volName"}
+	publishInfo := &utils.VolumePublishInfo{HostName: "bar", TridentUUID: "1234"}
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "volName").Times(1).Return(&api.Volume{AccessType: VolTypeRW}, nil)
+
+	err := d.Publish(ctx, volConfig, publishInfo)
+
+	assert.Error(t, err)
+}
+
+func TestOntapSanVolumePublishFCP(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+	d.Config.SANType = sa.FCP
+
+	volConfig := &storage.VolumeConfig{
+		InternalName: "volName",
+		Size:         "1g",
+		Encryption:   "false",
+		FileSystem:   "xfs",
+	}
+
+	publishInfo := &utils.VolumePublishInfo{
+		HostName:    "bar",
+		HostWWPN:    []string{"10:00:00:90:fa:1b:2c:3d", "10:00:00:90:fa:1b:2c:3e"},
+		TridentUUID: "1234",
+		BackendUUID: "5678",
+	}
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "volName").Times(1).Return(&api.Volume{AccessType: VolTypeRW}, nil)
+	mockAPI.EXPECT().FcpNodeGetNameRequest(ctx).Times(1).Return("20:00:00:50:56:bb:b2:4b", nil)
+	mockAPI.EXPECT().FcpInterfaceGet(ctx, "SVM1").Times(1).Return([]string{"20:01:00:50:56:bb:b2:4b"}, nil)
+	mockAPI.EXPECT().LunGetFSType(ctx, "/vol/volName/lun0").Times(1).Return("xfs", nil)
+	mockAPI.EXPECT().LunGetByName(ctx, "/vol/volName/lun0").Times(1).Return(&api.Lun{SerialNumber: "D1Dev+1X7hSy"},
+		nil)
+	mockAPI.EXPECT().IgroupCreate(ctx, "bar-fcp-5678", "fcp", "linux").Times(1).Return(nil)
+	mockAPI.EXPECT().EnsureIgroupAdded(ctx, "bar-fcp-5678", "10:00:00:90:fa:1b:2c:3d").Times(1).Return(nil)
+	mockAPI.EXPECT().EnsureIgroupAdded(ctx, "bar-fcp-5678", "10:00:00:90:fa:1b:2c:3e").Times(1).Return(nil)
+	mockAPI.EXPECT().EnsureLunMapped(ctx, "bar-fcp-5678", "/vol/volName/lun0").Times(1).Return(5, nil)
+
+	err := d.Publish(ctx, volConfig, publishInfo)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sa.FCP, publishInfo.SANType)
+	assert.Equal(t, "20:00:00:50:56:bb:b2:4b", publishInfo.FCTargetWWNN)
+	assert.Equal(t, int32(5), publishInfo.FCPLunNumber)
+	assert.Equal(t, "D1Dev+1X7hSy", publishInfo.FCPLunSerial)
+	assert.Equal(t, "bar-fcp-5678", publishInfo.FCPIgroup)
+	assert.Equal(t, "xfs", publishInfo.FilesystemType)
+	assert.Contains(t, publishInfo.MountOptions, "nouuid")
+	assert.True(t, publishInfo.SharedTarget)
+	assert.Equal(t, "20:00:00:50:56:bb:b2:4b", volConfig.AccessInfo.FCTargetWWNN)
+}
+
+func TestOntapSanVolumePublishFCP_NoHostWWPN(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+	d.Config.SANType = sa.FCP
+
+	volConfig := &storage.VolumeConfig{InternalName: "volName"}
+	publishInfo := &utils.VolumePublishInfo{HostName: "bar", TridentUUID: "1234"}
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "volName").Times(1).Return(&api.Volume{AccessType: VolTypeRW}, nil)
+	mockAPI.EXPECT().FcpNodeGetNameRequest(ctx).Times(1).Return("20:00:00:50:56:bb:b2:4b", nil)
+	mockAPI.EXPECT().FcpInterfaceGet(ctx, "SVM1").Times(1).Return([]string{"20:01:00:50:56:bb:b2:4b"}, nil)
+
+	err := d.Publish(ctx, volConfig, publishInfo)
+
+	assert.Error(t, err)
+}
+
+func TestOntapSanUnpublishFCP(t *testing.T) {
+	ctx := context.Background()
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+	d.Config.SANType = sa.FCP
+
+	volConfig := &storage.VolumeConfig{InternalName: "volName"}
+	volConfig.AccessInfo.FCPIgroup = "bar-fcp-5678,baz-fcp-5678"
+	publishInfo := &utils.VolumePublishInfo{HostName: "bar", TridentUUID: "1234", BackendUUID: "5678"}
+
+	mockAPI.EXPECT().LunMapInfo(ctx, "bar-fcp-5678", "/vol/volName/lun0").Return(5, nil)
+	mockAPI.EXPECT().LunUnmap(ctx, "bar-fcp-5678", "/vol/volName/lun0").Return(nil)
+	mockAPI.EXPECT().IgroupListLUNsMapped(ctx, "bar-fcp-5678").Return([]string{}, nil)
+	mockAPI.EXPECT().IgroupDestroy(ctx, "bar-fcp-5678").Return(nil)
+
+	err := d.Unpublish(ctx, volConfig, publishInfo)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "baz-fcp-5678", volConfig.AccessInfo.FCPIgroup)
+}
+
+func TestOntapSanUnpublishNVMe(t *testing.T) {
+	ctx := context.Background()
+	originalContext := tridentconfig.CurrentDriverContext
+	tridentconfig.CurrentDriverContext = tridentconfig.ContextCSI
+	defer func() { tridentconfig.CurrentDriverContext = originalContext }()
+
+	nsPath := "/vol/volName/namespace0"
+	ns := &api.NVMeNamespace{UUID: "ns_uuid", Name: nsPath}
+
+	tt := []struct {
+		name    string
+		mocks   func(mockAPI *mockapi.MockOntapAPI)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "LastHostOnSubsystem",
+			mocks: func(mockAPI *mockapi.MockOntapAPI) {
+				mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, nsPath).Return(ns, nil)
+				mockAPI.EXPECT().NVMeNamespaceGetSubsystems(ctx, "ns_uuid").Return([]string{"subsys_uuid"}, nil)
+				mockAPI.EXPECT().NVMeRemoveHostFromSubsystem(ctx, "host_nqn", "subsys_uuid").Return(nil)
+				mockAPI.EXPECT().NVMeGetHostsOfSubsystem(ctx, "subsys_uuid").Return([]string{}, nil)
+				mockAPI.EXPECT().NVMeEnsureNamespaceUnmapped(ctx, "subsys_uuid", "ns_uuid").Return(nil)
+				mockAPI.EXPECT().NVMeSubsystemDelete(ctx, "subsys_uuid").Return(nil) // Subsystem should be deleted
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "NotLastHostOnSubsystem",
+			mocks: func(mockAPI *mockapi.MockOntapAPI) {
+				mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, nsPath).Return(ns, nil)
+				mockAPI.EXPECT().NVMeNamespaceGetSubsystems(ctx, "ns_uuid").Return([]string{"subsys_uuid"}, nil)
+				mockAPI.EXPECT().NVMeRemoveHostFromSubsystem(ctx, "host_nqn", "subsys_uuid").Return(nil)
+				mockAPI.EXPECT().NVMeGetHostsOfSubsystem(ctx, "subsys_uuid").Return([]string{"other_nqn"}, nil)
+				// Subsystem should not be deleted
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "NamespaceNotFound",
+			mocks: func(mockAPI *mockapi.MockOntapAPI) {
+				mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, nsPath).Return(nil, api.NotFoundError("not found"))
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.True(t, utils.IsNotFoundError(err))
+			},
+		},
+		{
+			name: "RemoveHostApiFailure",
+			mocks: func(mockAPI *mockapi.MockOntapAPI) {
+				mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, nsPath).Return(ns, nil)
+				mockAPI.EXPECT().NVMeNamespaceGetSubsystems(ctx, "ns_uuid").Return([]string{"subsys_uuid"}, nil)
+				mockAPI.EXPECT().NVMeRemoveHostFromSubsystem(ctx, "host_nqn", "subsys_uuid").
+					Return(fmt.Errorf("some api error"))
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "SubsystemDeleteApiFailure",
+			mocks: func(mockAPI *mockapi.MockOntapAPI) {
+				mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, nsPath).Return(ns, nil)
+				mockAPI.EXPECT().NVMeNamespaceGetSubsystems(ctx, "ns_uuid").Return([]string{"subsys_uuid"}, nil)
+				mockAPI.EXPECT().NVMeRemoveHostFromSubsystem(ctx, "host_nqn", "subsys_uuid").Return(nil)
+				mockAPI.EXPECT().NVMeGetHostsOfSubsystem(ctx, "subsys_uuid").Return([]string{}, nil)
+				mockAPI.EXPECT().NVMeEnsureNamespaceUnmapped(ctx, "subsys_uuid", "ns_uuid").Return(nil)
+				mockAPI.EXPECT().NVMeSubsystemDelete(ctx, "subsys_uuid").Return(fmt.Errorf("some api error"))
+			},
+			wantErr: assert.Error,
+		},
+	}
+	for _, tr := range tt {
+		t.Run(tr.name, func(t *testing.T) {
+			volConfig := &storage.VolumeConfig{InternalName: "volName"}
+			publishInfo := &utils.VolumePublishInfo{
+				HostName:    "bar",
+				HostNQN:     "host_nqn",
+				TridentUUID: "1234",
+			}
+
+			mockCtrl := gomock.NewController(t)
+			mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+
+			mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+			d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+			d.API = mockAPI
+			d.Config.SANType = sa.NVMe
+
+			tr.mocks(mockAPI)
+
+			err := d.Unpublish(ctx, volConfig, publishInfo)
+			if !tr.wantErr(t, err, "Unexpected Result") {
+				return
+			}
+		})
+	}
+}
+
+func TestOntapSanGetChapInfoNVMe(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.Config.SANType = sa.NVMe
+	d.Config.UseCHAP = true
+
+	chapInfo, err := d.GetChapInfo(context.Background(), "volName", "node1")
+
+	assert.NoError(t, err)
+	assert.Nil(t, chapInfo)
+}
+
+func TestOntapSanGetSnapshotsNVMe(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.Config.SANType = sa.NVMe
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1"}
+
+	// Snapshots are sized by the namespace, as an NVMe volume has no LUN
+	mockAPI.EXPECT().NVMeNamespaceGetByName(ctx, namespacePath("vol1")).Return(
+		&api.NVMeNamespace{Name: namespacePath("vol1"), Size: "1073741824"}, nil)
+	mockAPI.EXPECT().VolumeSnapshotList(ctx, "vol1").Return(api.Snapshots{
+		{Name: "snap1", CreateTime: "2023-05-01T10:00:00Z"},
+	}, nil)
+
+	snapshots, err := d.GetSnapshots(ctx, volConfig)
+
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, int64(1073741824), snapshots[0].SizeBytes)
+}
+
 func TestOntapSanVolumePublishUnmanaged(t *testing.T) {
 	ctx := context.Background()
 
@@ -647,3 +1003,90 @@ func TestSANStorageDriverGetBackendState(t *testing.T) {
 	assert.Equal(t, reason, StateReasonSVMUnreachable, "should be 'SVM is not reachable'")
 	assert.NotNil(t, changeMap, "should not be nil")
 }
+
+func TestOntapSanGetVolumeMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+
+	volConfigs := []*storage.VolumeConfig{
+		{Name: "vol1", InternalName: "test_vol1"},
+		{Name: "vol2", InternalName: "test_vol2"},
+	}
+
+	// LUN metrics are matched to volumes by LUN path
+	mockAPI.EXPECT().LunListMetrics(ctx, "/vol/test_*/lun0").Return(map[string]*api.Metrics{
+		"/vol/test_vol1/lun0":  {Name: "/vol/test_vol1/lun0", UsedBytes: 100, TotalBytes: 1000, ReadIOPS: 5},
+		"/vol/test_other/lun0": {Name: "/vol/test_other/lun0", UsedBytes: 200},
+	}, nil)
+
+	metrics, err := d.GetVolumeMetrics(ctx, volConfigs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*storage.VolumeMetrics{
+		"vol1": {UsedBytes: 100, TotalBytes: 1000, ReadIOPS: 5},
+	}, metrics)
+
+	// NVMe namespaces report the metrics of their Flexvols
+	d.Config.SANType = sa.NVMe
+	mockAPI.EXPECT().VolumeListMetrics(ctx, "test_*").Return(map[string]*api.Metrics{
+		"test_vol2": {Name: "test_vol2", UsedBytes: 300, WriteLatency: 250},
+	}, nil)
+
+	metrics, err = d.GetVolumeMetrics(ctx, volConfigs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*storage.VolumeMetrics{"vol2": {UsedBytes: 300, WriteLatency: 250}}, metrics)
+
+	mockAPI.EXPECT().VolumeListMetrics(ctx, "test_*").Return(nil, fmt.Errorf("API failed"))
+
+	_, err = d.GetVolumeMetrics(ctx, volConfigs)
+	assert.Error(t, err)
+}
+
+func TestOntapSanGetVolumeHealth(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	mockAPI := mockapi.NewMockOntapAPI(mockCtrl)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+
+	d := newTestOntapSANDriver(ONTAPTEST_LOCALHOST, "0", ONTAPTEST_VSERVER_AGGR_NAME, true, mockAPI)
+	d.API = mockAPI
+
+	volConfig := &storage.VolumeConfig{InternalName: "lunName"}
+
+	// Healthy LUN
+	mockAPI.EXPECT().VolumeExists(ctx, "lunName").Return(true, nil)
+	mockAPI.EXPECT().LunGetByName(ctx, "/vol/lunName/lun0").Return(&api.Lun{State: "online"}, nil)
+
+	health, err := d.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.False(t, health.Abnormal)
+
+	// LUN out of space
+	mockAPI.EXPECT().VolumeExists(ctx, "lunName").Return(true, nil)
+	mockAPI.EXPECT().LunGetByName(ctx, "/vol/lunName/lun0").Return(&api.Lun{State: "space_error"}, nil)
+
+	health, err = d.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+	assert.Contains(t, health.Message, "space_error")
+
+	// Volume deleted out of band
+	mockAPI.EXPECT().VolumeExists(ctx, "lunName").Return(false, nil)
+
+	health, err = d.GetVolumeHealth(ctx, volConfig)
+	assert.NoError(t, err)
+	assert.True(t, health.Abnormal)
+
+	// LUN lookup failure
+	mockAPI.EXPECT().VolumeExists(ctx, "lunName").Return(true, nil)
+	mockAPI.EXPECT().LunGetByName(ctx, "/vol/lunName/lun0").Return(nil, fmt.Errorf("API failed"))
+
+	_, err = d.GetVolumeHealth(ctx, volConfig)
+	assert.Error(t, err)
+}
@@ -14,11 +14,28 @@ import (
 	"github.com/netapp/trident/utils"
 )
 
+// volumeInfoFunc returns the local volume of a snapmirror relationship, which is a FlexVol or a FlexGroup
+// depending on the driver.
+type volumeInfoFunc func(ctx context.Context, name string) (*api.Volume, error)
+
+// snapshotListFunc returns the snapshots of the local volume of a snapmirror relationship.
+type snapshotListFunc func(ctx context.Context, name string) (api.Snapshots, error)
+
 // establishMirror will create a new snapmirror relationship between a RW and a DP volume that have not previously
 // had a relationship
 func establishMirror(
 	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy,
 	replicationSchedule string, d api.OntapAPI,
+) error {
+	return establishMirrorWithVolumeInfo(ctx, localInternalVolumeName, remoteVolumeHandle, replicationPolicy,
+		replicationSchedule, d.VolumeInfo, d)
+}
+
+// establishMirrorWithVolumeInfo will create a new snapmirror relationship between a RW and a DP volume that have
+// not previously had a relationship, looking up the DP volume with the given function
+func establishMirrorWithVolumeInfo(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, replicationPolicy,
+	replicationSchedule string, volumeInfo volumeInfoFunc, d api.OntapAPI,
 ) error {
 	if localInternalVolumeName == "" {
 		return fmt.Errorf("invalid volume name")
@@ -30,7 +47,7 @@ func establishMirror(
 	}
 
 	// Ensure the destination is a DP volume
-	volume, err := d.VolumeInfo(ctx, localInternalVolumeName)
+	volume, err := volumeInfo(ctx, localInternalVolumeName)
 	if err != nil {
 		return err
 	}
@@ -152,6 +169,16 @@ func reestablishMirror(
 // optionally after a given snapshot has synced
 func promoteMirror(
 	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotHandle, replicationPolicy string, d api.OntapAPI,
+) (bool, error) {
+	return promoteMirrorWithSnapshotList(ctx, localInternalVolumeName, remoteVolumeHandle, snapshotHandle,
+		replicationPolicy, d.VolumeSnapshotList, d)
+}
+
+// promoteMirrorWithSnapshotList will break the snapmirror and make the destination volume RW, optionally after a
+// given snapshot has synced, listing the snapshots of the destination volume with the given function
+func promoteMirrorWithSnapshotList(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotHandle, replicationPolicy string,
+	snapshotList snapshotListFunc, d api.OntapAPI,
 ) (bool, error) {
 	if remoteVolumeHandle == "" {
 		return false, nil
@@ -182,7 +209,7 @@ func promoteMirror(
 
 		// Check for snapshot
 		if snapshotHandle != "" {
-			foundSnapshot, err := isSnapshotPresent(ctx, snapshotHandle, localInternalVolumeName, d)
+			foundSnapshot, err := isSnapshotPresent(ctx, snapshotHandle, localInternalVolumeName, snapshotList)
 			if err != nil {
 				return false, err
 			}
@@ -247,7 +274,9 @@ func promoteMirror(
 }
 
 // isSnapshotPresent returns whether the given snapshot is found on the snapmirror snapshot list
-func isSnapshotPresent(ctx context.Context, snapshotHandle, localInternalVolumeName string, d api.OntapAPI) (bool, error) {
+func isSnapshotPresent(
+	ctx context.Context, snapshotHandle, localInternalVolumeName string, snapshotList snapshotListFunc,
+) (bool, error) {
 	found := false
 
 	_, snapshotName, err := storage.ParseSnapshotID(snapshotHandle)
@@ -255,7 +284,7 @@ func isSnapshotPresent(ctx context.Context, snapshotHandle, localInternalVolumeN
 		return found, err
 	}
 
-	snapshots, err := d.VolumeSnapshotList(ctx, localInternalVolumeName)
+	snapshots, err := snapshotList(ctx, localInternalVolumeName)
 	if err != nil {
 		return found, err
 	}
@@ -401,6 +430,29 @@ func validateReplicationConfig(
 	return nil
 }
 
+// updateMirror starts a transfer to a snapmirror destination, optionally of a given snapshot of the remote volume
+func updateMirror(
+	ctx context.Context, localInternalVolumeName, remoteVolumeHandle, snapshotHandle string, d api.OntapAPI,
+) error {
+	if localInternalVolumeName == "" {
+		return fmt.Errorf("invalid volume name")
+	}
+	remoteSVMName, remoteFlexvolName, err := parseVolumeHandle(remoteVolumeHandle)
+	if err != nil {
+		return fmt.Errorf("could not parse remoteVolumeHandle '%v'; %v", remoteVolumeHandle, err)
+	}
+
+	snapshotName := ""
+	if snapshotHandle != "" {
+		if _, snapshotName, err = storage.ParseSnapshotID(snapshotHandle); err != nil {
+			return err
+		}
+	}
+
+	return d.SnapmirrorUpdate(ctx, localInternalVolumeName, d.SVMName(), remoteFlexvolName, remoteSVMName,
+		snapshotName)
+}
+
 // releaseMirror will release the snapmirror relationship data of the source volume
 func releaseMirror(ctx context.Context, localInternalVolumeName string, d api.OntapAPI) error {
 	// release any previous snapmirror relationship
@@ -1570,6 +1570,34 @@ func (d *SANStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) [
 	return []string{}
 }
 
+// GetPoolCapacity returns the space that may still be provisioned on the cluster.  SolidFire volumes are thin
+// provisioned, and the cluster refuses new volumes once their total size would exceed its over-provisioning
+// limit.  All of a backend's pools draw from the same cluster, so they are reported as one physical pool.
+func (d *SANStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	fields := LogFields{"Method": "GetPoolCapacity", "Type": "SANStorageDriver", "pool": pool.Name()}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetPoolCapacity")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetPoolCapacity")
+
+	if _, ok := d.virtualPools[pool.Name()]; !ok {
+		return nil, utils.NotFoundError(fmt.Sprintf("pool %s not found", pool.Name()))
+	}
+
+	clusterCapacity, err := d.Client.GetClusterCapacity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cluster capacity; %v", err)
+	}
+
+	capacity := &storage.PoolCapacity{
+		TotalBytes:    uint64(clusterCapacity.MaxOverProvisionableSpace),
+		PhysicalPools: []string{d.Config.SVIP},
+	}
+	if freeBytes := clusterCapacity.MaxOverProvisionableSpace - clusterCapacity.ProvisionedSpace; freeBytes > 0 {
+		capacity.FreeBytes = uint64(freeBytes)
+	}
+
+	return capacity, nil
+}
+
 func (d *SANStorageDriver) GetInternalVolumeName(ctx context.Context, name string) string {
 	if tridentconfig.UsingPassthroughStore {
 		// With a passthrough store, the name mapping must remain reversible
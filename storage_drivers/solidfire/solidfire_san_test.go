@@ -4,12 +4,16 @@ package solidfire
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/netapp/trident/storage"
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/storage_drivers/solidfire/api"
+	"github.com/netapp/trident/utils"
 )
 
 const (
@@ -188,3 +192,26 @@ func TestValidateStoragePrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPoolCapacity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 1, "result": {"clusterCapacity": {
+			"maxOverProvisionableSpace": 5000, "maxProvisionedSpace": 1000, "provisionedSpace": 3000}}}`))
+	}))
+	defer server.Close()
+
+	d := newTestSolidfireSANDriver()
+	d.Client.Endpoint = server.URL
+	pool := storage.NewStoragePool(nil, "pool1")
+	d.virtualPools = map[string]storage.Pool{pool.Name(): pool}
+
+	capacity, err := d.GetPoolCapacity(context.Background(), pool)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5000), capacity.TotalBytes)
+	assert.Equal(t, uint64(2000), capacity.FreeBytes)
+	assert.Equal(t, []string{d.Config.SVIP}, capacity.PhysicalPools)
+
+	_, err = d.GetPoolCapacity(context.Background(), storage.NewStoragePool(nil, "pool2"))
+	assert.True(t, utils.IsNotFoundError(err))
+}
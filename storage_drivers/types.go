@@ -118,13 +118,16 @@ type OntapStorageDriverConfig struct {
 	LUNsPerFlexvol                   string   `json:"lunsPerFlexvol"`                   // default to 100
 	EmptyFlexvolDeferredDeletePeriod string   `json:"emptyFlexvolDeferredDeletePeriod"` // in seconds, default to 28800
 	NfsMountOptions                  string   `json:"nfsMountOptions"`
+	NfsSecurity                      string   `json:"nfsSecurity"` // sys, krb5, krb5i or krb5p, default to sys
 	LimitAggregateUsage              string   `json:"limitAggregateUsage"`
 	AutoExportPolicy                 bool     `json:"autoExportPolicy"`
 	AutoExportCIDRs                  []string `json:"autoExportCIDRs"`
+	PerVolumeExportPolicy            bool     `json:"perVolumeExportPolicy"` // requires autoExportPolicy
 	OntapStorageDriverPool
 	Storage                   []OntapStorageDriverPool `json:"storage"`
 	UseCHAP                   bool                     `json:"useCHAP"`
 	UseREST                   bool                     `json:"useREST"`
+	SANType                   string                   `json:"sanType"`
 	ChapUsername              string                   `json:"chapUsername"`
 	ChapInitiatorSecret       string                   `json:"chapInitiatorSecret"`
 	ChapTargetUsername        string                   `json:"chapTargetUsername"`
@@ -135,6 +138,7 @@ type OntapStorageDriverConfig struct {
 	ReplicationPolicy         string                   `json:"replicationPolicy"`
 	ReplicationSchedule       string                   `json:"replicationSchedule"`
 	FlexGroupAggregateList    []string                 `json:"flexgroupAggregateList"`
+	FlexGroupConstituentCount string                   `json:"flexgroupConstituentCount"`
 }
 
 type OntapStorageDriverPool struct {
@@ -407,6 +411,7 @@ type AzureNASStorageDriverConfig struct {
 	VolumeCreateTimeout string `json:"volumeCreateTimeout"`
 	SDKTimeout          string `json:"sdkTimeout"`
 	MaxCacheAge         string `json:"maxCacheAge"`
+	ReplicationSchedule string `json:"replicationSchedule"`
 	AzureNASStorageDriverPool
 	Storage []AzureNASStorageDriverPool `json:"storage"`
 }
@@ -648,6 +653,8 @@ type FakeStorageDriverConfig struct {
 	Password     string                  `json:"password"`
 	// Dummy field for unit tests
 	VolumeAccess string `json:"volumeAccess"`
+	// RestoreNewestSnapshotOnly models backends that may only restore a volume from its newest snapshot
+	RestoreNewestSnapshotOnly bool `json:"restoreNewestSnapshotOnly,omitempty"`
 	FakeStorageDriverPool
 }
 
@@ -827,7 +834,9 @@ func getCredentialNameAndType(credentials map[string]string) (string, string, er
 		secretStore = string(CredentialStoreK8sSecret)
 	}
 
-	if secretStore != string(CredentialStoreK8sSecret) {
+	switch CredentialStore(secretStore) {
+	case CredentialStoreK8sSecret, CredentialStoreFile, CredentialStoreHTTP:
+	default:
 		return "", "", fmt.Errorf("credentials field does not support type '%s'", secretStore)
 	}
 
@@ -1515,6 +1515,18 @@ func TestGetCredentialNameAndType(t *testing.T) {
 			"",
 			fmt.Errorf("credentials field is missing 'name' attribute"),
 		},
+		{
+			map[string]string{"name": "secret1", "type": "file"},
+			"secret1",
+			"file",
+			nil,
+		},
+		{
+			map[string]string{"name": "secret1", "type": "http"},
+			"secret1",
+			"http",
+			nil,
+		},
 		{
 			map[string]string{"name": "", "type": "KMIP"},
 			"",
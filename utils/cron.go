@@ -0,0 +1,185 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMaxSearchYears bounds the search for the next activation of a schedule that can never fire, such as
+// one for February 30.
+const cronMaxSearchYears = 5
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	cronDayNames = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+// CronSchedule is a parsed five-field cron expression (minute, hour, day of month, month, day of week).
+// Each field is held as a bitset of the values it matches.
+type CronSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// If both day fields are restricted, a day matches when either of them does, as in the standard cron.
+	dayOfMonthAny bool
+	dayOfWeekAny  bool
+}
+
+// ParseCronSchedule parses a standard five-field cron expression or one of the @hourly, @daily, @weekly,
+// @monthly or @yearly macros.  Fields accept '*', single values, ranges, lists and steps, and the month
+// and day of week fields also accept three-letter English names.
+func ParseCronSchedule(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron schedule '%s' must have 5 fields", spec)
+	}
+
+	var err error
+	s := &CronSchedule{}
+	if s.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("invalid minute in cron schedule '%s'; %v", spec, err)
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("invalid hour in cron schedule '%s'; %v", spec, err)
+	}
+	if s.dayOfMonth, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("invalid day of month in cron schedule '%s'; %v", spec, err)
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("invalid month in cron schedule '%s'; %v", spec, err)
+	}
+	if s.dayOfWeek, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, fmt.Errorf("invalid day of week in cron schedule '%s'; %v", spec, err)
+	}
+
+	// Sunday may be written as either 0 or 7
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek = s.dayOfWeek&^(1<<7) | 1
+	}
+	s.dayOfMonthAny = strings.HasPrefix(fields[2], "*")
+	s.dayOfWeekAny = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// parseCronField parses one comma-separated cron field into a bitset of the values it matches.
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in '%s'", part)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*":
+			low, high = min, max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = parseCronValue(bounds[0], names); err != nil {
+				return 0, err
+			}
+			if high, err = parseCronValue(bounds[1], names); err != nil {
+				return 0, err
+			}
+		default:
+			var err error
+			if low, err = parseCronValue(rangePart, names); err != nil {
+				return 0, err
+			}
+			high = low
+			// A step after a single value means "from this value to the end of the range"
+			if strings.Contains(part, "/") {
+				high = max
+			}
+		}
+
+		if low < min || high > max || low > high {
+			return 0, fmt.Errorf("'%s' is outside the range %d-%d", part, min, max)
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(value string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a valid value", value)
+	}
+	return v, nil
+}
+
+// Next returns the first activation time of the schedule strictly after t, in t's location, or the zero
+// time if the schedule never fires.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + cronMaxSearchYears
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *CronSchedule) matchesDay(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@fortnightly",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseCronSchedule(spec)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	start := time.Date(2023, 5, 1, 10, 7, 30, 0, time.UTC) // a Monday
+
+	tests := map[string]struct {
+		spec     string
+		expected time.Time
+	}{
+		"Every minute":           {"* * * * *", time.Date(2023, 5, 1, 10, 8, 0, 0, time.UTC)},
+		"Every 15 minutes":       {"*/15 * * * *", time.Date(2023, 5, 1, 10, 15, 0, 0, time.UTC)},
+		"Step from value":        {"5/20 * * * *", time.Date(2023, 5, 1, 10, 25, 0, 0, time.UTC)},
+		"Hourly macro":           {"@hourly", time.Date(2023, 5, 1, 11, 0, 0, 0, time.UTC)},
+		"Daily at 2am":           {"0 2 * * *", time.Date(2023, 5, 2, 2, 0, 0, 0, time.UTC)},
+		"List of hours":          {"30 6,18 * * *", time.Date(2023, 5, 1, 18, 30, 0, 0, time.UTC)},
+		"Weekend days":           {"0 9 * * sat,sun", time.Date(2023, 5, 6, 9, 0, 0, 0, time.UTC)},
+		"Sunday as 7":            {"0 0 * * 7", time.Date(2023, 5, 7, 0, 0, 0, 0, time.UTC)},
+		"Monthly macro":          {"@monthly", time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)},
+		"Named month":            {"0 0 1 jan *", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		"Day of month or week":   {"0 0 15 * fri", time.Date(2023, 5, 5, 0, 0, 0, 0, time.UTC)},
+		"Leap day":               {"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		"Never fires":            {"0 0 30 2 *", time.Time{}},
+		"Same minute is skipped": {"7 10 * * *", time.Date(2023, 5, 2, 10, 7, 0, 0, time.UTC)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(test.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, schedule.Next(start))
+		})
+	}
+}
@@ -152,6 +152,12 @@ func ISCSIRescanDevices(ctx context.Context, targetIQN string, lunID int32, minS
 		return fmt.Errorf("could not get iSCSI device information for LUN: %d", lunID)
 	}
 
+	return rescanSCSIDevices(ctx, deviceInfo, minSize)
+}
+
+// rescanSCSIDevices rescans each of the disks behind a SCSI LUN, as well as any multipath device built on
+// them, until they reflect at least the requested size.
+func rescanSCSIDevices(ctx context.Context, deviceInfo *ScsiDeviceInfo, minSize int64) error {
 	allLargeEnough := true
 	for _, diskDevice := range deviceInfo.Devices {
 		size, err := getISCSIDiskSize(ctx, "/dev/"+diskDevice)
@@ -192,7 +198,7 @@ func ISCSIRescanDevices(ctx context.Context, targetIQN string, lunID int32, minS
 			return err
 		}
 
-		fields = LogFields{"size": size, "minSize": minSize}
+		fields := LogFields{"size": size, "minSize": minSize}
 		if size < minSize {
 			Logc(ctx).WithFields(fields).Debug("Reloading the multipath device.")
 			err := reloadMultipathDevice(ctx, multipathDevice)
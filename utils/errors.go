@@ -201,6 +201,28 @@ func IsVolumeStateError(err error) bool {
 	return ok
 }
 
+// ///////////////////////////////////////////////////////////////////////////
+// volumeMoveFailedError
+// ///////////////////////////////////////////////////////////////////////////
+
+type volumeMoveFailedError struct {
+	message string
+}
+
+func (e *volumeMoveFailedError) Error() string { return e.message }
+
+func VolumeMoveFailedError(message string) error {
+	return &volumeMoveFailedError{message}
+}
+
+func IsVolumeMoveFailedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(*volumeMoveFailedError)
+	return ok
+}
+
 // ///////////////////////////////////////////////////////////////////////////
 // timeoutError
 // ///////////////////////////////////////////////////////////////////////////
@@ -633,3 +655,25 @@ func HasResourceExhaustedError(err error) (bool, *resourceExhaustedError) {
 	ok := errors.As(err, &resourceExhaustedErrorPtr)
 	return ok, resourceExhaustedErrorPtr
 }
+
+// ///////////////////////////////////////////////////////////////////////////
+// resumeTokenExpiredError
+// ///////////////////////////////////////////////////////////////////////////
+
+type resumeTokenExpiredError struct {
+	message string
+}
+
+func (e *resumeTokenExpiredError) Error() string { return e.message }
+
+func ResumeTokenExpiredError(message string) error {
+	return &resumeTokenExpiredError{message}
+}
+
+func IsResumeTokenExpiredError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(*resumeTokenExpiredError)
+	return ok
+}
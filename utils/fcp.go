@@ -0,0 +1,430 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	. "github.com/netapp/trident/logging"
+)
+
+const (
+	fcpSysfsHostPath         = "class/fc_host"
+	fcpSysfsRemotePortPath   = "class/fc_remote_ports"
+	fcpSysfsSCSIDevicePath   = "class/scsi_device"
+	fcpSysfsSCSIHostPath     = "class/scsi_host"
+	fcpRemotePortStateOnline = "Online"
+)
+
+var (
+	fcpSysfsRoot = "/sys"
+
+	fcpRemotePortRegex = regexp.MustCompile(`^rport-(?P<host>\d+):(?P<channel>\d+)-\d+$`)
+)
+
+// fcpTarget is the SCSI address (host:channel:target) at which an FC remote port appears on this host.
+type fcpTarget struct {
+	Host    int
+	Channel int
+	Target  int
+}
+
+// GetFCPHostPortWWPNs returns the world wide port names of the Fibre Channel host ports on this host.
+func GetFCPHostPortWWPNs(ctx context.Context) ([]string, error) {
+	Logc(ctx).Debug(">>>> fcp.GetFCPHostPortWWPNs")
+	defer Logc(ctx).Debug("<<<< fcp.GetFCPHostPortWWPNs")
+
+	return getFCPHostPortWWPNs(ctx, chrootPathPrefix+fcpSysfsRoot)
+}
+
+// AttachFCPVolumeRetry attaches an FCP volume with retry by invoking AttachFCPVolume with backoff.
+func AttachFCPVolumeRetry(
+	ctx context.Context, name, mountpoint string, publishInfo *VolumePublishInfo, secrets map[string]string,
+	timeout time.Duration,
+) error {
+	Logc(ctx).Debug(">>>> fcp.AttachFCPVolumeRetry")
+	defer Logc(ctx).Debug("<<<< fcp.AttachFCPVolumeRetry")
+
+	checkAttachFCPVolume := func() error {
+		return AttachFCPVolume(ctx, name, mountpoint, publishInfo, secrets)
+	}
+
+	attachNotify := func(err error, duration time.Duration) {
+		Logc(ctx).WithFields(LogFields{
+			"increment": duration,
+			"error":     err,
+		}).Debug("Attach FCP volume is not complete, waiting.")
+	}
+
+	attachBackoff := backoff.NewExponentialBackOff()
+	attachBackoff.InitialInterval = 1 * time.Second
+	attachBackoff.Multiplier = 1.414 // approx sqrt(2)
+	attachBackoff.RandomizationFactor = 0.1
+	attachBackoff.MaxElapsedTime = timeout
+
+	return backoff.RetryNotify(checkAttachFCPVolume, attachBackoff, attachNotify)
+}
+
+// AttachFCPVolume attaches the volume to the local host.  It scans the FC remote ports of the volume's target
+// for the LUN, waits for the multipath device to appear, and then formats and optionally mounts it.  The device
+// path is set on the in-out publishInfo parameter so that it may be mounted later instead.
+func AttachFCPVolume(
+	ctx context.Context, name, mountpoint string, publishInfo *VolumePublishInfo, secrets map[string]string,
+) error {
+	Logc(ctx).Debug(">>>> fcp.AttachFCPVolume")
+	defer Logc(ctx).Debug("<<<< fcp.AttachFCPVolume")
+
+	lunID := int(publishInfo.FCPLunNumber)
+	sysfsRoot := chrootPathPrefix + fcpSysfsRoot
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":     name,
+		"mountpoint": mountpoint,
+		"lunID":      lunID,
+		"targetWWNN": publishInfo.FCTargetWWNN,
+		"fstype":     publishInfo.FilesystemType,
+	}).Debug("Attaching FCP volume.")
+
+	if publishInfo.FCTargetWWNN == "" {
+		return fmt.Errorf("no FC target WWNN provided for volume %s", name)
+	}
+
+	targets, err := getFCPTargets(ctx, sysfsRoot, publishInfo.FCTargetWWNN)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no FC remote ports found for target %s", publishInfo.FCTargetWWNN)
+	}
+
+	// Scan the target and look for the device(s)
+	if err = fcpScanTargetLUN(ctx, sysfsRoot, lunID, targets); err != nil {
+		Logc(ctx).WithField("scanError", err).Error("Could not scan for new LUN.")
+	}
+
+	paths := getFCPSysfsDirsForLUN(sysfsRoot, lunID, targets)
+	devices, err := IscsiUtils.GetDevicesForLUN(paths)
+	if err != nil {
+		return err
+	} else if len(devices) == 0 {
+		return fmt.Errorf("scan not completed for LUN %d on target %s", lunID, publishInfo.FCTargetWWNN)
+	}
+
+	// Purge any paths with a stale serial number so they are scanned again on the next attempt
+	purgeHandler := func(ctx context.Context, path string) error {
+		if err := purgeOneLun(ctx, path); err != nil {
+			return err
+		}
+		return fmt.Errorf("LUN serial number mismatch, kernel has stale cached data")
+	}
+	if err = handleInvalidFCPSerials(ctx, paths, publishInfo.FCPLunSerial, purgeHandler); err != nil {
+		return err
+	}
+
+	multipathDevice, err := waitForMultipathDeviceForDevices(ctx, devices)
+	if err != nil {
+		return err
+	}
+
+	devicePath := "/dev/" + multipathDevice
+	if err = waitForDevice(ctx, devicePath); err != nil {
+		return fmt.Errorf("could not find device %v; %s", devicePath, err)
+	}
+
+	return formatAndMountDevice(ctx, name, mountpoint, devicePath, publishInfo, secrets)
+}
+
+// PrepareFCPDeviceForRemoval informs Linux that the device(s) of an FCP volume will be removed.  The name of
+// the multipath device is returned if its mapping could not be removed yet.
+func PrepareFCPDeviceForRemoval(
+	ctx context.Context, publishInfo *VolumePublishInfo, ignoreErrors, force bool,
+) (string, error) {
+	GenerateRequestContextForLayer(ctx, LogLayerUtils)
+
+	lunID := int(publishInfo.FCPLunNumber)
+	fields := LogFields{
+		"lunID":      lunID,
+		"targetWWNN": publishInfo.FCTargetWWNN,
+	}
+	Logc(ctx).WithFields(fields).Debug(">>>> fcp.PrepareFCPDeviceForRemoval")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< fcp.PrepareFCPDeviceForRemoval")
+
+	var multipathDevice string
+
+	deviceInfo, err := getFCPDeviceInfoForLUN(ctx, chrootPathPrefix+fcpSysfsRoot, lunID, publishInfo.FCTargetWWNN)
+	if err != nil {
+		Logc(ctx).WithFields(fields).WithError(err).Warn(
+			"Could not get device info for removal, skipping host removal steps.")
+		return multipathDevice, err
+	}
+
+	if deviceInfo == nil {
+		Logc(ctx).WithFields(fields).Debug("No device found for removal, skipping host removal steps.")
+		return multipathDevice, nil
+	}
+
+	performDeferredDeviceRemoval, err := removeSCSIDevice(ctx, deviceInfo, ignoreErrors, force)
+	if performDeferredDeviceRemoval && deviceInfo.MultipathDevice != "" {
+		multipathDevice = "/dev/" + deviceInfo.MultipathDevice
+	}
+
+	return multipathDevice, err
+}
+
+// FCPRescanDevices rescans the device(s) of an FCP volume until they reflect at least the requested size.
+func FCPRescanDevices(ctx context.Context, targetWWNN string, lunID int32, minSize int64) error {
+	GenerateRequestContextForLayer(ctx, LogLayerUtils)
+
+	fields := LogFields{"targetWWNN": targetWWNN, "lunID": lunID}
+	Logc(ctx).WithFields(fields).Debug(">>>> fcp.FCPRescanDevices")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< fcp.FCPRescanDevices")
+
+	deviceInfo, err := getFCPDeviceInfoForLUN(ctx, chrootPathPrefix+fcpSysfsRoot, int(lunID), targetWWNN)
+	if err != nil {
+		return fmt.Errorf("error getting FCP device information: %s", err)
+	} else if deviceInfo == nil {
+		return fmt.Errorf("could not get FCP device information for LUN: %d", lunID)
+	}
+
+	return rescanSCSIDevices(ctx, deviceInfo, minSize)
+}
+
+// ReconcileFCPVolumeInfo returns true if any device of a tracked FCP volume is still present on the host.
+func ReconcileFCPVolumeInfo(ctx context.Context, trackingInfo *VolumeTrackingInfo) (bool, error) {
+	deviceInfo, err := getFCPDeviceInfoForLUN(ctx, chrootPathPrefix+fcpSysfsRoot,
+		int(trackingInfo.FCPLunNumber), trackingInfo.FCTargetWWNN)
+	if err != nil {
+		return false, err
+	}
+
+	return deviceInfo != nil, nil
+}
+
+// getFCPHostPortWWPNs reads the port names of the FC host adapters from sysfs.
+func getFCPHostPortWWPNs(ctx context.Context, sysfsRoot string) ([]string, error) {
+	hostsPath := filepath.Join(sysfsRoot, fcpSysfsHostPath)
+	hostDirs, err := os.ReadDir(hostsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	wwpns := make([]string, 0)
+	for _, hostDir := range hostDirs {
+		portName, err := os.ReadFile(filepath.Join(hostsPath, hostDir.Name(), "port_name"))
+		if err != nil {
+			Logc(ctx).WithField("host", hostDir.Name()).WithError(err).Debug("Could not read FC port name.")
+			continue
+		}
+		if wwpn := formatWWN(string(portName)); wwpn != "" {
+			wwpns = append(wwpns, wwpn)
+		}
+	}
+
+	return wwpns, nil
+}
+
+// getFCPTargets returns the SCSI addresses of the online FC remote ports that belong to the target with the
+// specified world wide node name.
+func getFCPTargets(ctx context.Context, sysfsRoot, targetWWNN string) ([]fcpTarget, error) {
+	if targetWWNN = formatWWN(targetWWNN); targetWWNN == "" {
+		return nil, fmt.Errorf("invalid FC target WWNN")
+	}
+
+	portsPath := filepath.Join(sysfsRoot, fcpSysfsRemotePortPath)
+	portDirs, err := os.ReadDir(portsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	targets := make([]fcpTarget, 0)
+	for _, portDir := range portDirs {
+		match := fcpRemotePortRegex.FindStringSubmatch(portDir.Name())
+		if match == nil {
+			continue
+		}
+		portPath := filepath.Join(portsPath, portDir.Name())
+
+		nodeName, err := os.ReadFile(filepath.Join(portPath, "node_name"))
+		if err != nil || formatWWN(string(nodeName)) != targetWWNN {
+			continue
+		}
+
+		if state, err := os.ReadFile(filepath.Join(portPath, "port_state")); err != nil ||
+			strings.TrimSpace(string(state)) != fcpRemotePortStateOnline {
+			Logc(ctx).WithField("remotePort", portDir.Name()).Debug("FC remote port is not online.")
+			continue
+		}
+
+		// Remote ports that are not SCSI targets report a target ID of -1
+		targetID, err := os.ReadFile(filepath.Join(portPath, "scsi_target_id"))
+		if err != nil {
+			continue
+		}
+		target, err := strconv.Atoi(strings.TrimSpace(string(targetID)))
+		if err != nil || target < 0 {
+			continue
+		}
+
+		host, _ := strconv.Atoi(match[1])
+		channel, _ := strconv.Atoi(match[2])
+		targets = append(targets, fcpTarget{Host: host, Channel: channel, Target: target})
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Host != targets[j].Host {
+			return targets[i].Host < targets[j].Host
+		}
+		return targets[i].Target < targets[j].Target
+	})
+
+	return targets, nil
+}
+
+// getFCPSysfsDirsForLUN returns the sysfs SCSI device directories at which the LUN appears, or will appear
+// once scanned, behind each of the specified FC targets.
+func getFCPSysfsDirsForLUN(sysfsRoot string, lunID int, targets []fcpTarget) []string {
+	paths := make([]string, 0, len(targets))
+	for _, t := range targets {
+		paths = append(paths, filepath.Join(sysfsRoot, fcpSysfsSCSIDevicePath,
+			fmt.Sprintf("%d:%d:%d:%d", t.Host, t.Channel, t.Target, lunID), "device"))
+	}
+	return paths
+}
+
+// fcpScanTargetLUN asks the SCSI host of each FC target to scan for the specified LUN.
+func fcpScanTargetLUN(ctx context.Context, sysfsRoot string, lunID int, targets []fcpTarget) error {
+	fields := LogFields{"targets": targets, "lunID": lunID}
+	Logc(ctx).WithFields(fields).Debug(">>>> fcp.fcpScanTargetLUN")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< fcp.fcpScanTargetLUN")
+
+	for _, t := range targets {
+		filename := filepath.Join(sysfsRoot, fcpSysfsSCSIHostPath, fmt.Sprintf("host%d", t.Host), "scan")
+		scanCmd := fmt.Sprintf("%d %d %d", t.Channel, t.Target, lunID)
+
+		f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o200)
+		if err != nil {
+			Logc(ctx).WithField("file", filename).Warning("Could not open file for writing.")
+			return err
+		}
+
+		written, err := f.WriteString(scanCmd)
+		f.Close()
+		if err != nil {
+			Logc(ctx).WithFields(LogFields{"file": filename, "error": err}).Warning("Could not write to file.")
+			return err
+		} else if written == 0 {
+			Logc(ctx).WithField("file", filename).Warning("No data written to file.")
+			return fmt.Errorf("no data written to %s", filename)
+		}
+
+		Logc(ctx).WithFields(LogFields{
+			"scanCmd":  scanCmd,
+			"scanFile": filename,
+		}).Debug("Invoked single-LUN scan.")
+	}
+
+	return nil
+}
+
+// getFCPDeviceInfoForLUN finds the devices of an FCP LUN.  If the LUN is not present on this host, nil is returned.
+func getFCPDeviceInfoForLUN(
+	ctx context.Context, sysfsRoot string, lunID int, targetWWNN string,
+) (*ScsiDeviceInfo, error) {
+	targets, err := getFCPTargets(ctx, sysfsRoot, targetWWNN)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := IscsiUtils.GetDevicesForLUN(getFCPSysfsDirsForLUN(sysfsRoot, lunID, targets))
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		return nil, nil
+	}
+
+	multipathDevice := ""
+	for _, device := range devices {
+		if multipathDevice = findMultipathDeviceForDevice(ctx, device); multipathDevice != "" {
+			break
+		}
+	}
+
+	return &ScsiDeviceInfo{
+		LUN:             strconv.Itoa(lunID),
+		Devices:         devices,
+		MultipathDevice: multipathDevice,
+	}, nil
+}
+
+// handleInvalidFCPSerials checks the LUN serial number at each sysfs path of an FCP LUN, and if it doesn't
+// match the expected value, runs a handler function.
+func handleInvalidFCPSerials(
+	ctx context.Context, paths []string, expectedSerial string, handler func(ctx context.Context, path string) error,
+) error {
+	if expectedSerial == "" {
+		// Empty string means don't care
+		return nil
+	}
+
+	for _, path := range paths {
+		serial, err := getLunSerial(ctx, path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The LUN isn't scanned at this path, or the kernel doesn't support VPD page 80 in sysfs
+				continue
+			}
+			return err
+		}
+
+		if serial != expectedSerial {
+			Logc(ctx).WithFields(LogFields{
+				"expected": expectedSerial,
+				"actual":   serial,
+				"path":     path,
+			}).Warn("LUN serial check failed")
+			if err = handler(ctx, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatWWN converts a world wide name, as found in sysfs (0x10000090fa1b2c3d) or as reported by ONTAP
+// (10:00:00:90:fa:1b:2c:3d), to the colon-separated lower-case form used by ONTAP igroups.
+func formatWWN(wwn string) string {
+	wwn = strings.ToLower(strings.TrimSpace(wwn))
+	wwn = strings.TrimPrefix(wwn, "0x")
+	wwn = strings.ReplaceAll(wwn, ":", "")
+
+	if len(wwn) != 16 {
+		return ""
+	}
+	if _, err := strconv.ParseUint(wwn, 16, 64); err != nil {
+		return ""
+	}
+
+	pairs := make([]string, 0, 8)
+	for i := 0; i < len(wwn); i += 2 {
+		pairs = append(pairs, wwn[i:i+2])
+	}
+	return strings.Join(pairs, ":")
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatWWN(t *testing.T) {
+	tests := map[string]struct {
+		wwn      string
+		expected string
+	}{
+		"Sysfs format":    {wwn: "0x10000090FA1B2C3D\n", expected: "10:00:00:90:fa:1b:2c:3d"},
+		"ONTAP format":    {wwn: "20:00:00:50:56:bb:b2:4b", expected: "20:00:00:50:56:bb:b2:4b"},
+		"Too short":       {wwn: "0x1000", expected: ""},
+		"Not hexadecimal": {wwn: "0x10000090fa1b2cxz", expected: ""},
+		"Empty":           {wwn: "", expected: ""},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatWWN(test.wwn))
+		})
+	}
+}
+
+// writeFCPRemotePort adds an FC remote port to a fake sysfs tree.
+func writeFCPRemotePort(t *testing.T, sysfsRoot, name, nodeName, state, targetID string) {
+	portPath := filepath.Join(sysfsRoot, fcpSysfsRemotePortPath, name)
+	writeSysfsFile(t, filepath.Join(portPath, "node_name"), nodeName+"\n")
+	writeSysfsFile(t, filepath.Join(portPath, "port_state"), state+"\n")
+	writeSysfsFile(t, filepath.Join(portPath, "scsi_target_id"), targetID+"\n")
+}
+
+func TestFCPSysfsLookups(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+	targetWWNN := "20:00:00:50:56:bb:b2:4b"
+
+	writeSysfsFile(t, filepath.Join(sysfsRoot, fcpSysfsHostPath, "host3", "port_name"), "0x10000090fa1b2c3d\n")
+	writeSysfsFile(t, filepath.Join(sysfsRoot, fcpSysfsHostPath, "host4", "port_name"), "0x10000090fa1b2c3e\n")
+
+	writeFCPRemotePort(t, sysfsRoot, "rport-3:0-1", "0x2000005056bbb24b", "Online", "0")
+	writeFCPRemotePort(t, sysfsRoot, "rport-4:0-2", "0x2000005056bbb24b", "Online", "1")
+	writeFCPRemotePort(t, sysfsRoot, "rport-4:0-3", "0x2000005056bbb24b", "Blocked", "2")
+	writeFCPRemotePort(t, sysfsRoot, "rport-3:0-4", "0x2000005056bbb24b", "Online", "-1")
+	writeFCPRemotePort(t, sysfsRoot, "rport-3:0-5", "0x20000050560000ff", "Online", "3")
+
+	writeSysfsFile(t, filepath.Join(sysfsRoot, fcpSysfsSCSIDevicePath, "3:0:0:5", "device", "block", "sdc",
+		"size"), "0\n")
+	writeSysfsFile(t, filepath.Join(sysfsRoot, fcpSysfsSCSIDevicePath, "4:0:1:5", "device", "block", "sdd",
+		"size"), "0\n")
+
+	wwpns, err := getFCPHostPortWWPNs(ctx, sysfsRoot)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"10:00:00:90:fa:1b:2c:3d", "10:00:00:90:fa:1b:2c:3e"}, wwpns)
+
+	targets, err := getFCPTargets(ctx, sysfsRoot, targetWWNN)
+	assert.NoError(t, err)
+	assert.Equal(t, []fcpTarget{{Host: 3, Channel: 0, Target: 0}, {Host: 4, Channel: 0, Target: 1}}, targets)
+
+	paths := getFCPSysfsDirsForLUN(sysfsRoot, 5, targets)
+	assert.Equal(t, []string{
+		filepath.Join(sysfsRoot, fcpSysfsSCSIDevicePath, "3:0:0:5", "device"),
+		filepath.Join(sysfsRoot, fcpSysfsSCSIDevicePath, "4:0:1:5", "device"),
+	}, paths)
+
+	deviceInfo, err := getFCPDeviceInfoForLUN(ctx, sysfsRoot, 5, targetWWNN)
+	assert.NoError(t, err)
+	assert.NotNil(t, deviceInfo)
+	assert.Equal(t, "5", deviceInfo.LUN)
+	assert.ElementsMatch(t, []string{"sdc", "sdd"}, deviceInfo.Devices)
+
+	deviceInfo, err = getFCPDeviceInfoForLUN(ctx, sysfsRoot, 6, targetWWNN)
+	assert.NoError(t, err)
+	assert.Nil(t, deviceInfo)
+
+	_, err = getFCPTargets(ctx, sysfsRoot, "")
+	assert.Error(t, err)
+}
+
+func TestFCPSysfsLookups_NoFCHosts(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+
+	wwpns, err := getFCPHostPortWWPNs(ctx, sysfsRoot)
+	assert.NoError(t, err)
+	assert.Empty(t, wwpns)
+
+	targets, err := getFCPTargets(ctx, sysfsRoot, "20:00:00:50:56:bb:b2:4b")
+	assert.NoError(t, err)
+	assert.Empty(t, targets)
+
+	deviceInfo, err := getFCPDeviceInfoForLUN(ctx, sysfsRoot, 0, "20:00:00:50:56:bb:b2:4b")
+	assert.NoError(t, err)
+	assert.Nil(t, deviceInfo)
+}
+
+func TestFCPScanTargetLUN(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+
+	scanFile := filepath.Join(sysfsRoot, fcpSysfsSCSIHostPath, "host4", "scan")
+	writeSysfsFile(t, scanFile, "")
+
+	err := fcpScanTargetLUN(ctx, sysfsRoot, 5, []fcpTarget{{Host: 4, Channel: 0, Target: 1}})
+	assert.NoError(t, err)
+
+	contents, err := os.ReadFile(scanFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "0 1 5", string(contents))
+
+	err = fcpScanTargetLUN(ctx, sysfsRoot, 5, []fcpTarget{{Host: 7, Channel: 0, Target: 1}})
+	assert.Error(t, err)
+}
+
+func TestHandleInvalidFCPSerials(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+
+	goodPath := filepath.Join(sysfsRoot, "3:0:0:5", "device")
+	badPath := filepath.Join(sysfsRoot, "4:0:1:5", "device")
+	missingPath := filepath.Join(sysfsRoot, "4:0:2:5", "device")
+	writeSysfsFile(t, filepath.Join(goodPath, "vpd_pg80"), "\x00\x80\x00\x0cD1Dev+1X7hSy")
+	writeSysfsFile(t, filepath.Join(badPath, "vpd_pg80"), "\x00\x80\x00\x0cD1Dev+1X7xxx")
+
+	handled := make([]string, 0)
+	handler := func(ctx context.Context, path string) error {
+		handled = append(handled, path)
+		return nil
+	}
+
+	err := handleInvalidFCPSerials(ctx, []string{goodPath, badPath, missingPath}, "D1Dev+1X7hSy", handler)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{badPath}, handled)
+
+	handled = make([]string, 0)
+	err = handleInvalidFCPSerials(ctx, []string{goodPath, badPath}, "", handler)
+	assert.NoError(t, err)
+	assert.Empty(t, handled)
+}
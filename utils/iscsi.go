@@ -206,6 +206,19 @@ func AttachISCSIVolume(ctx context.Context, name, mountpoint string, publishInfo
 		return fmt.Errorf("could not find device %v; %s", devicePath, err)
 	}
 
+	return formatAndMountDevice(ctx, name, mountpoint, devicePath, publishInfo, secrets)
+}
+
+// formatAndMountDevice completes the attachment of a block device that is present on the host.  It opens the
+// LUKS layer if the volume is encrypted, formats the device if it is new, repairs any filesystem inconsistencies,
+// and optionally mounts the device.  The device path is set on the in-out publishInfo parameter.
+func formatAndMountDevice(
+	ctx context.Context, name, mountpoint, rawDevicePath string, publishInfo *VolumePublishInfo,
+	secrets map[string]string,
+) error {
+	var err error
+	devicePath := rawDevicePath
+
 	var isLUKSDevice, luksFormatted bool
 	if publishInfo.LUKSEncryption != "" {
 		isLUKSDevice, err = strconv.ParseBool(publishInfo.LUKSEncryption)
@@ -253,24 +266,24 @@ func AttachISCSIVolume(ctx context.Context, name, mountpoint string, publishInfo
 			}
 		}
 
-		Logc(ctx).WithFields(LogFields{"volume": name, "fstype": publishInfo.FilesystemType}).Debug("Formatting LUN.")
+		Logc(ctx).WithFields(LogFields{"volume": name, "fstype": publishInfo.FilesystemType}).Debug("Formatting volume.")
 		err := formatVolume(ctx, devicePath, publishInfo.FilesystemType)
 		if err != nil {
-			return fmt.Errorf("error formatting LUN %s, device %s: %v", name, deviceToUse, err)
+			return fmt.Errorf("error formatting volume %s, device %s: %v", name, rawDevicePath, err)
 		}
 	} else if existingFstype != unknownFstype && existingFstype != publishInfo.FilesystemType {
 		Logc(ctx).WithFields(LogFields{
 			"volume":          name,
 			"existingFstype":  existingFstype,
 			"requestedFstype": publishInfo.FilesystemType,
-		}).Error("LUN already formatted with a different file system type.")
-		return fmt.Errorf("LUN %s, device %s already formatted with other filesystem: %s",
-			name, deviceToUse, existingFstype)
+		}).Error("Volume already formatted with a different file system type.")
+		return fmt.Errorf("volume %s, device %s already formatted with other filesystem: %s",
+			name, rawDevicePath, existingFstype)
 	} else {
 		Logc(ctx).WithFields(LogFields{
 			"volume": name,
-			"fstype": deviceInfo.Filesystem,
-		}).Debug("LUN already formatted.")
+			"fstype": existingFstype,
+		}).Debug("Volume already formatted.")
 	}
 
 	// Attempt to resolve any filesystem inconsistencies that might be due to dirty node shutdowns, cloning
@@ -288,8 +301,8 @@ func AttachISCSIVolume(ctx context.Context, name, mountpoint string, publishInfo
 	// Optionally mount the device
 	if mountpoint != "" {
 		if err := MountDevice(ctx, devicePath, mountpoint, publishInfo.MountOptions, false); err != nil {
-			return fmt.Errorf("error mounting LUN %v, device %v, mountpoint %v; %s",
-				name, deviceToUse, mountpoint, err)
+			return fmt.Errorf("error mounting volume %v, device %v, mountpoint %v; %s",
+				name, rawDevicePath, mountpoint, err)
 		}
 	}
 
@@ -5,10 +5,22 @@ package utils
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	. "github.com/netapp/trident/logging"
 )
 
+// NFS security flavors
+const (
+	NFSSecuritySys   = "sys"
+	NFSSecurityKrb5  = "krb5"
+	NFSSecurityKrb5i = "krb5i"
+	NFSSecurityKrb5p = "krb5p"
+
+	nfsSecurityMountOption = "sec="
+	krb5KeytabPath         = "/etc/krb5.keytab"
+)
+
 // AttachNFSVolume attaches the volume to the local host.
 // This method must be able to accomplish its task using only the data passed in.
 // It may be assumed that this method always runs on the host to which the volume will be attached.
@@ -26,5 +38,95 @@ func AttachNFSVolume(ctx context.Context, name, mountpoint string, publishInfo *
 		"options":    options,
 	}).Debug("Publishing NFS volume.")
 
+	if flavor := GetNFSSecurityFlavor(options); IsKerberosNFSSecurity(flavor) {
+		if err := ensureKerberosClientReady(ctx); err != nil {
+			return fmt.Errorf("cannot mount NFS volume %s with sec=%s; %v", name, flavor, err)
+		}
+	}
+
 	return mountNFSPath(ctx, exportPath, mountpoint, options)
 }
+
+// IsValidNFSSecurity returns whether a security flavor is one that Trident can mount NFS volumes with.
+func IsValidNFSSecurity(flavor string) bool {
+	switch flavor {
+	case NFSSecuritySys, NFSSecurityKrb5, NFSSecurityKrb5i, NFSSecurityKrb5p:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsKerberosNFSSecurity returns whether a security flavor requires Kerberos.
+func IsKerberosNFSSecurity(flavor string) bool {
+	switch flavor {
+	case NFSSecurityKrb5, NFSSecurityKrb5i, NFSSecurityKrb5p:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetNFSSecurityFlavor returns the security flavor set by the sec= option in a set of NFS mount options, or an
+// empty string if there is none.  If the option is repeated, the last one wins, as it does for mount.
+func GetNFSSecurityFlavor(mountOptions string) string {
+	flavor := ""
+	for _, option := range strings.Split(strings.TrimPrefix(mountOptions, "-o "), ",") {
+		option = strings.TrimSpace(option)
+		if strings.HasPrefix(option, nfsSecurityMountOption) {
+			flavor = strings.TrimPrefix(option, nfsSecurityMountOption)
+		}
+	}
+	return flavor
+}
+
+// SetNFSSecurityMountOption returns a set of NFS mount options in which the sec= option, if any, is replaced by
+// one for the given security flavor.  A leading "-o " is preserved.
+func SetNFSSecurityMountOption(mountOptions, flavor string) string {
+	prefix := ""
+	if strings.HasPrefix(mountOptions, "-o ") {
+		prefix = "-o "
+	}
+
+	options := make([]string, 0)
+	for _, option := range strings.Split(strings.TrimPrefix(mountOptions, "-o "), ",") {
+		option = strings.TrimSpace(option)
+		if option == "" || strings.HasPrefix(option, nfsSecurityMountOption) {
+			continue
+		}
+		options = append(options, option)
+	}
+	options = append(options, nfsSecurityMountOption+flavor)
+
+	return prefix + strings.Join(options, ",")
+}
+
+// ensureKerberosClientReady checks that this host can mount NFS volumes with Kerberos, which requires a keytab
+// holding the host's credentials and a running rpc.gssd to present them.
+func ensureKerberosClientReady(ctx context.Context) error {
+	Logc(ctx).Debug(">>>> nfs.ensureKerberosClientReady")
+	defer Logc(ctx).Debug("<<<< nfs.ensureKerberosClientReady")
+
+	// In CSI mode the keytab is on the host rather than in this container, so it is found through chwrap
+	// the same way rpc.gssd is, while the Docker plugin sees the host's filesystem under its chroot path prefix
+	if chrootPathPrefix == "" {
+		if _, err := execCommand(ctx, "stat", krb5KeytabPath); err != nil {
+			return fmt.Errorf("keytab %s not found; %v", krb5KeytabPath, err)
+		}
+	} else {
+		keytabPath := chrootPathPrefix + krb5KeytabPath
+		if _, err := osFs.Stat(keytabPath); err != nil {
+			return fmt.Errorf("keytab %s not found; %v", keytabPath, err)
+		}
+	}
+
+	out, err := execCommand(ctx, "pgrep", "rpc.gssd")
+	pids := strings.Fields(string(out))
+	if err != nil || len(pids) == 0 || !pidRegex.MatchString(pids[0]) {
+		Logc(ctx).WithField("error", err).Debug("rpc.gssd is not running.")
+		return fmt.Errorf("rpc.gssd is not running")
+	}
+	Logc(ctx).WithField("pid", pids[0]).Debug("rpc.gssd is running.")
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+//go:build linux
+
+package utils
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNFSSecurityFlavor(t *testing.T) {
+	tests := map[string]string{
+		"":                               "",
+		"-o nfsvers=3":                   "",
+		"nfsvers=4.1,sec=krb5p":          NFSSecurityKrb5p,
+		"-o sec=krb5i,nfsvers=4.1":       NFSSecurityKrb5i,
+		"sec=sys, nfsvers=4.1, sec=krb5": NFSSecurityKrb5,
+	}
+	for mountOptions, expected := range tests {
+		assert.Equal(t, expected, GetNFSSecurityFlavor(mountOptions), "wrong flavor for %q", mountOptions)
+	}
+}
+
+func TestSetNFSSecurityMountOption(t *testing.T) {
+	assert.Equal(t, "sec=krb5p", SetNFSSecurityMountOption("", NFSSecurityKrb5p))
+	assert.Equal(t, "-o nfsvers=4.1,sec=krb5p", SetNFSSecurityMountOption("-o nfsvers=4.1", NFSSecurityKrb5p))
+	assert.Equal(t, "nfsvers=4.1,hard,sec=krb5",
+		SetNFSSecurityMountOption("nfsvers=4.1,sec=sys,hard", NFSSecurityKrb5))
+}
+
+func TestIsKerberosNFSSecurity(t *testing.T) {
+	for _, flavor := range []string{NFSSecurityKrb5, NFSSecurityKrb5i, NFSSecurityKrb5p} {
+		assert.True(t, IsValidNFSSecurity(flavor))
+		assert.True(t, IsKerberosNFSSecurity(flavor))
+	}
+	assert.True(t, IsValidNFSSecurity(NFSSecuritySys))
+	assert.False(t, IsKerberosNFSSecurity(NFSSecuritySys))
+	assert.False(t, IsValidNFSSecurity("krb6"))
+	assert.False(t, IsKerberosNFSSecurity(""))
+}
+
+func TestEnsureKerberosClientReady(t *testing.T) {
+	ctx := context.Background()
+	keytabFound := true
+	execCmd = func(ctx context.Context, command string, args ...string) *exec.Cmd {
+		if command == "stat" && !keytabFound {
+			return fakeExecCommandExitError(ctx, command, args...)
+		}
+		return fakeExecCommand(ctx, command, args...)
+	}
+	osFs = afero.NewMemMapFs()
+	// Reset exec command and filesystem after tests
+	defer func() {
+		execCmd = exec.CommandContext
+		osFs = afero.NewOsFs()
+	}()
+
+	// No keytab on the host, even though there is one in this container
+	_, err := osFs.Create(krb5KeytabPath)
+	assert.NoError(t, err)
+	keytabFound = false
+	execReturnValue = "1234"
+	execReturnCode = 0
+	assert.ErrorContains(t, ensureKerberosClientReady(ctx), "keytab")
+
+	// Keytab, but no rpc.gssd
+	keytabFound = true
+	execReturnValue = ""
+	execReturnCode = 1
+	assert.Error(t, ensureKerberosClientReady(ctx))
+
+	// Keytab and rpc.gssd
+	execReturnValue = "1234\n5678"
+	execReturnCode = 0
+	assert.NoError(t, ensureKerberosClientReady(ctx))
+}
+
+func TestEnsureKerberosClientReady_DockerPlugin(t *testing.T) {
+	ctx := context.Background()
+	execCmd = fakeExecCommand
+	osFs = afero.NewMemMapFs()
+	SetChrootPathPrefix("/host")
+	// Reset exec command, filesystem and chroot path prefix after tests
+	defer func() {
+		execCmd = exec.CommandContext
+		osFs = afero.NewOsFs()
+		SetChrootPathPrefix("")
+	}()
+
+	// No keytab under the chroot path prefix
+	execReturnValue = "1234"
+	execReturnCode = 0
+	assert.ErrorContains(t, ensureKerberosClientReady(ctx), "/host"+krb5KeytabPath)
+
+	// Keytab and rpc.gssd
+	_, err := osFs.Create("/host" + krb5KeytabPath)
+	assert.NoError(t, err)
+	assert.NoError(t, ensureKerberosClientReady(ctx))
+}
+
+func TestAttachNFSVolume_KerberosNotReady(t *testing.T) {
+	ctx := context.Background()
+	execCmd = fakeExecCommand
+	osFs = afero.NewMemMapFs()
+	// Reset exec command and filesystem after tests
+	defer func() {
+		execCmd = exec.CommandContext
+		osFs = afero.NewOsFs()
+	}()
+	execReturnValue = ""
+	execReturnCode = 0
+
+	volumePublishInfo := &VolumePublishInfo{
+		VolumeAccessInfo: VolumeAccessInfo{
+			NfsAccessInfo: NfsAccessInfo{
+				NfsServerIP: "1.1.1.1",
+				NfsPath:     "/test/nfs/path",
+			},
+			MountOptions: "nfsvers=4.1,sec=krb5p",
+		},
+	}
+
+	// The volume is not mounted without a keytab
+	err := AttachNFSVolume(ctx, "test-vol", "/pods", volumePublishInfo)
+	assert.ErrorContains(t, err, "sec=krb5p")
+}
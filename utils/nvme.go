@@ -0,0 +1,435 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	. "github.com/netapp/trident/logging"
+)
+
+const (
+	nvmeHostNQNFile        = "/etc/nvme/hostnqn"
+	nvmeTransportTCP       = "tcp"
+	nvmeDefaultDataPort    = "4420"
+	nvmeDiscoveryPort      = "8009"
+	nvmeSubsystemTypeNVM   = "nvme subsystem"
+	nvmeSysfsSubsystemPath = "class/nvme-subsystem"
+	nvmeSysfsBlockPath     = "block"
+)
+
+var (
+	nvmeSysfsRoot = "/sys"
+
+	nvmeNamespaceDeviceRegex  = regexp.MustCompile(`^nvme\d+n\d+$`)
+	nvmeControllerDeviceRegex = regexp.MustCompile(`^nvme\d+$`)
+	nvmeAddressRegex          = regexp.MustCompile(`traddr=(?P<traddr>[^,\s]+)`)
+)
+
+// NVMeDiscoveryRecord is a single entry in the discovery log page returned by "nvme discover".
+type NVMeDiscoveryRecord struct {
+	TransportType    string `json:"trtype"`
+	SubsystemType    string `json:"subtype"`
+	TransportAddress string `json:"traddr"`
+	TransportService string `json:"trsvcid"`
+	SubsystemNQN     string `json:"subnqn"`
+}
+
+type nvmeDiscoveryLog struct {
+	Records []NVMeDiscoveryRecord `json:"records"`
+}
+
+// GetHostNqn returns the NVMe qualified name of this host, as found in /etc/nvme/hostnqn.
+func GetHostNqn(ctx context.Context) (string, error) {
+	Logc(ctx).Debug(">>>> nvme.GetHostNqn")
+	defer Logc(ctx).Debug("<<<< nvme.GetHostNqn")
+
+	out, err := execCommand(ctx, "cat", nvmeHostNQNFile)
+	if err != nil {
+		Logc(ctx).WithField("Error", err).Debug("Could not read hostnqn; perhaps nvme-cli is not installed?")
+		return "", err
+	}
+
+	return parseHostNQN(string(out)), nil
+}
+
+// parseHostNQN accepts the contents of /etc/nvme/hostnqn and returns the host NQN.
+func parseHostNQN(contents string) string {
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "nqn.") {
+			return line
+		}
+	}
+	return ""
+}
+
+// NVMeDiscover queries the discovery controller at the specified address and returns the NVM subsystems
+// it reports.
+func NVMeDiscover(ctx context.Context, address string) ([]NVMeDiscoveryRecord, error) {
+	fields := LogFields{"address": address}
+	Logc(ctx).WithFields(fields).Debug(">>>> nvme.NVMeDiscover")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< nvme.NVMeDiscover")
+
+	out, err := execCommandWithTimeout(ctx, "nvme", 10*time.Second, true, "discover", "-t", nvmeTransportTCP,
+		"-a", address, "-s", nvmeDiscoveryPort, "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("NVMe discovery at %s failed; %v; %s", address, err, string(out))
+	}
+
+	return parseNVMeDiscoveryLog(out)
+}
+
+// parseNVMeDiscoveryLog accepts the JSON output of "nvme discover" and returns the NVM subsystem records,
+// omitting any referrals to other discovery controllers.
+func parseNVMeDiscoveryLog(out []byte) ([]NVMeDiscoveryRecord, error) {
+	var discoveryLog nvmeDiscoveryLog
+	if err := json.Unmarshal(out, &discoveryLog); err != nil {
+		return nil, fmt.Errorf("could not parse NVMe discovery log; %v", err)
+	}
+
+	records := make([]NVMeDiscoveryRecord, 0)
+	for _, record := range discoveryLog.Records {
+		if record.SubsystemType == nvmeSubsystemTypeNVM {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// NVMeConnectSubsystem connects this host to the specified subsystem over every path the target advertises,
+// skipping any paths that are already connected.
+func NVMeConnectSubsystem(ctx context.Context, subsystemNQN string, targetIPs []string) error {
+	fields := LogFields{"subsystemNQN": subsystemNQN, "targetIPs": targetIPs}
+	Logc(ctx).WithFields(fields).Debug(">>>> nvme.NVMeConnectSubsystem")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< nvme.NVMeConnectSubsystem")
+
+	if len(targetIPs) == 0 {
+		return fmt.Errorf("no target IPs provided for NVMe subsystem %s", subsystemNQN)
+	}
+
+	connectedAddresses, err := getNVMeSubsystemAddresses(ctx, nvmeSysfsRoot, subsystemNQN)
+	if err != nil {
+		return err
+	}
+
+	connectedPaths := 0
+	for _, targetIP := range targetIPs {
+		if _, ok := connectedAddresses[targetIP]; ok {
+			Logc(ctx).WithField("address", targetIP).Debug("NVMe path already connected.")
+			connectedPaths++
+			continue
+		}
+
+		service := nvmeDefaultDataPort
+
+		// Prefer the data port the target reports for this subsystem, if it reports one.
+		if records, err := NVMeDiscover(ctx, targetIP); err != nil {
+			Logc(ctx).WithField("address", targetIP).WithError(err).Warn("NVMe discovery failed.")
+		} else {
+			for _, record := range records {
+				if record.SubsystemNQN == subsystemNQN && record.TransportAddress == targetIP {
+					service = record.TransportService
+					break
+				}
+			}
+		}
+
+		out, err := execCommandWithTimeout(ctx, "nvme", 10*time.Second, true, "connect", "-t", nvmeTransportTCP,
+			"-a", targetIP, "-s", service, "-n", subsystemNQN)
+		if err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"address": targetIP,
+				"output":  string(out),
+			}).WithError(err).Warn("Could not connect NVMe path.")
+			continue
+		}
+		connectedPaths++
+	}
+
+	if connectedPaths == 0 {
+		return fmt.Errorf("could not connect to NVMe subsystem %s on any path", subsystemNQN)
+	}
+
+	return nil
+}
+
+// NVMeDisconnectSubsystem disconnects all paths from this host to the specified subsystem.
+func NVMeDisconnectSubsystem(ctx context.Context, subsystemNQN string) error {
+	fields := LogFields{"subsystemNQN": subsystemNQN}
+	Logc(ctx).WithFields(fields).Debug(">>>> nvme.NVMeDisconnectSubsystem")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< nvme.NVMeDisconnectSubsystem")
+
+	subsystemPath, err := getNVMeSubsystemPath(ctx, nvmeSysfsRoot, subsystemNQN)
+	if err != nil {
+		return err
+	}
+	if subsystemPath == "" {
+		Logc(ctx).WithFields(fields).Debug("NVMe subsystem not connected.")
+		return nil
+	}
+
+	out, err := execCommandWithTimeout(ctx, "nvme", 10*time.Second, true, "disconnect", "-n", subsystemNQN)
+	if err != nil {
+		return fmt.Errorf("could not disconnect NVMe subsystem %s; %v; %s", subsystemNQN, err, string(out))
+	}
+
+	return nil
+}
+
+// NVMeRescanNamespace asks every controller of the specified subsystem to rescan its namespaces and
+// verifies that the namespace device reflects at least the requested size.
+func NVMeRescanNamespace(ctx context.Context, subsystemNQN, namespaceUUID string, minSize int64) error {
+	fields := LogFields{"subsystemNQN": subsystemNQN, "namespaceUUID": namespaceUUID}
+	Logc(ctx).WithFields(fields).Debug(">>>> nvme.NVMeRescanNamespace")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< nvme.NVMeRescanNamespace")
+
+	devicePath, err := findNVMeNamespaceDevice(ctx, nvmeSysfsRoot, namespaceUUID)
+	if err != nil {
+		return err
+	}
+	if devicePath == "" {
+		return fmt.Errorf("could not find device for NVMe namespace %s", namespaceUUID)
+	}
+
+	size, err := getISCSIDiskSize(ctx, devicePath)
+	if err != nil {
+		return err
+	}
+	if size >= minSize {
+		return nil
+	}
+
+	controllers, err := getNVMeSubsystemControllers(ctx, nvmeSysfsRoot, subsystemNQN)
+	if err != nil {
+		return err
+	}
+	for _, controller := range controllers {
+		if out, err := execCommandWithTimeout(ctx, "nvme", deviceOperationsTimeout, true, "ns-rescan",
+			"/dev/"+controller); err != nil {
+			return fmt.Errorf("failed to rescan NVMe controller %s; %v; %s", controller, err, string(out))
+		}
+	}
+
+	time.Sleep(time.Second)
+	if size, err = getISCSIDiskSize(ctx, devicePath); err != nil {
+		return err
+	}
+	if size < minSize {
+		Logc(ctx).Error("Namespace size not large enough after resize.")
+		return fmt.Errorf("namespace size not large enough after resize: %d, %d", size, minSize)
+	}
+
+	return nil
+}
+
+// AttachNVMeVolumeRetry attaches an NVMe volume with retry logic.
+func AttachNVMeVolumeRetry(
+	ctx context.Context, name, mountpoint string, publishInfo *VolumePublishInfo, secrets map[string]string,
+	timeout time.Duration,
+) error {
+	Logc(ctx).Debug(">>>> nvme.AttachNVMeVolumeRetry")
+	defer Logc(ctx).Debug("<<<< nvme.AttachNVMeVolumeRetry")
+
+	checkAttachNVMeVolume := func() error {
+		return AttachNVMeVolume(ctx, name, mountpoint, publishInfo, secrets)
+	}
+
+	attachNotify := func(err error, duration time.Duration) {
+		Logc(ctx).WithFields(LogFields{
+			"increment": duration,
+			"error":     err,
+		}).Debug("Attach NVMe volume is not complete, waiting.")
+	}
+
+	attachBackoff := backoff.NewExponentialBackOff()
+	attachBackoff.InitialInterval = 1 * time.Second
+	attachBackoff.Multiplier = 1.414 // approx sqrt(2)
+	attachBackoff.RandomizationFactor = 0.1
+	attachBackoff.MaxElapsedTime = timeout
+
+	return backoff.RetryNotify(checkAttachNVMeVolume, attachBackoff, attachNotify)
+}
+
+// AttachNVMeVolume attaches the volume to the local host.  It connects to the volume's subsystem, waits for
+// the namespace device to appear, and then formats and optionally mounts it.  The device path is set on the
+// in-out publishInfo parameter so that it may be mounted later instead.
+func AttachNVMeVolume(
+	ctx context.Context, name, mountpoint string, publishInfo *VolumePublishInfo, secrets map[string]string,
+) error {
+	Logc(ctx).Debug(">>>> nvme.AttachNVMeVolume")
+	defer Logc(ctx).Debug("<<<< nvme.AttachNVMeVolume")
+
+	if publishInfo.NVMeSubsystemNQN == "" {
+		return fmt.Errorf("no NVMe subsystem NQN provided for volume %s", name)
+	}
+	if publishInfo.NVMeNamespaceUUID == "" {
+		return fmt.Errorf("no NVMe namespace UUID provided for volume %s", name)
+	}
+
+	if err := NVMeConnectSubsystem(ctx, publishInfo.NVMeSubsystemNQN, publishInfo.NVMeTargetIPs); err != nil {
+		return err
+	}
+
+	devicePath, err := findNVMeNamespaceDevice(ctx, nvmeSysfsRoot, publishInfo.NVMeNamespaceUUID)
+	if err != nil {
+		return err
+	}
+	if devicePath == "" {
+		return fmt.Errorf("could not find device for NVMe namespace %s", publishInfo.NVMeNamespaceUUID)
+	}
+	if err = waitForDevice(ctx, devicePath); err != nil {
+		return fmt.Errorf("could not find device %v; %s", devicePath, err)
+	}
+
+	return formatAndMountDevice(ctx, name, mountpoint, devicePath, publishInfo, secrets)
+}
+
+// DetachNVMeVolume flushes the namespace device of an NVMe volume and disconnects its subsystem.  Each
+// volume has its own subsystem, so no other volume is affected.
+func DetachNVMeVolume(ctx context.Context, publishInfo *VolumePublishInfo) error {
+	Logc(ctx).Debug(">>>> nvme.DetachNVMeVolume")
+	defer Logc(ctx).Debug("<<<< nvme.DetachNVMeVolume")
+
+	devicePath, err := findNVMeNamespaceDevice(ctx, nvmeSysfsRoot, publishInfo.NVMeNamespaceUUID)
+	if err != nil {
+		return err
+	}
+	if devicePath != "" {
+		if err = flushOneDevice(ctx, devicePath); err != nil {
+			return err
+		}
+	}
+
+	return NVMeDisconnectSubsystem(ctx, publishInfo.NVMeSubsystemNQN)
+}
+
+// ReconcileNVMeVolumeInfo returns true if the namespace device of a tracked NVMe volume is still present on
+// the host.
+func ReconcileNVMeVolumeInfo(ctx context.Context, trackingInfo *VolumeTrackingInfo) (bool, error) {
+	devicePath, err := findNVMeNamespaceDevice(ctx, nvmeSysfsRoot, trackingInfo.NVMeNamespaceUUID)
+	if err != nil {
+		return false, err
+	}
+
+	return devicePath != "", nil
+}
+
+// getNVMeSubsystemPath returns the sysfs directory of the connected subsystem with the specified NQN, or an
+// empty string if this host is not connected to it.
+func getNVMeSubsystemPath(ctx context.Context, sysfsRoot, subsystemNQN string) (string, error) {
+	subsystemsPath := filepath.Join(sysfsRoot, nvmeSysfsSubsystemPath)
+	entries, err := os.ReadDir(subsystemsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("could not read %s; %v", subsystemsPath, err)
+	}
+
+	for _, entry := range entries {
+		subsystemPath := filepath.Join(subsystemsPath, entry.Name())
+		nqn, err := os.ReadFile(filepath.Join(subsystemPath, "subsysnqn"))
+		if err != nil {
+			Logc(ctx).WithField("subsystem", entry.Name()).WithError(err).Debug("Could not read subsystem NQN.")
+			continue
+		}
+		if strings.TrimSpace(string(nqn)) == subsystemNQN {
+			return subsystemPath, nil
+		}
+	}
+
+	return "", nil
+}
+
+// getNVMeSubsystemControllers returns the names of the controllers through which this host is connected
+// to the specified subsystem.
+func getNVMeSubsystemControllers(ctx context.Context, sysfsRoot, subsystemNQN string) ([]string, error) {
+	subsystemPath, err := getNVMeSubsystemPath(ctx, sysfsRoot, subsystemNQN)
+	if err != nil || subsystemPath == "" {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(subsystemPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s; %v", subsystemPath, err)
+	}
+
+	controllers := make([]string, 0)
+	for _, entry := range entries {
+		if nvmeControllerDeviceRegex.MatchString(entry.Name()) {
+			controllers = append(controllers, entry.Name())
+		}
+	}
+	return controllers, nil
+}
+
+// getNVMeSubsystemAddresses returns the set of target addresses through which this host is connected to
+// the specified subsystem.
+func getNVMeSubsystemAddresses(ctx context.Context, sysfsRoot, subsystemNQN string) (map[string]struct{}, error) {
+	addresses := make(map[string]struct{})
+
+	controllers, err := getNVMeSubsystemControllers(ctx, sysfsRoot, subsystemNQN)
+	if err != nil {
+		return nil, err
+	}
+	if len(controllers) == 0 {
+		return addresses, nil
+	}
+
+	subsystemPath, err := getNVMeSubsystemPath(ctx, sysfsRoot, subsystemNQN)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, controller := range controllers {
+		controllerPath := filepath.Join(subsystemPath, controller)
+		state, err := os.ReadFile(filepath.Join(controllerPath, "state"))
+		if err == nil && strings.TrimSpace(string(state)) != "live" {
+			continue
+		}
+		address, err := os.ReadFile(filepath.Join(controllerPath, "address"))
+		if err != nil {
+			continue
+		}
+		if match := nvmeAddressRegex.FindStringSubmatch(string(address)); match != nil {
+			addresses[match[1]] = struct{}{}
+		}
+	}
+	return addresses, nil
+}
+
+// findNVMeNamespaceDevice returns the path of the block device for the namespace with the specified UUID,
+// or an empty string if no such device is present.
+func findNVMeNamespaceDevice(ctx context.Context, sysfsRoot, namespaceUUID string) (string, error) {
+	blockPath := filepath.Join(sysfsRoot, nvmeSysfsBlockPath)
+	entries, err := os.ReadDir(blockPath)
+	if err != nil {
+		return "", fmt.Errorf("could not read %s; %v", blockPath, err)
+	}
+
+	for _, entry := range entries {
+		if !nvmeNamespaceDeviceRegex.MatchString(entry.Name()) {
+			continue
+		}
+		uuid, err := os.ReadFile(filepath.Join(blockPath, entry.Name(), "uuid"))
+		if err != nil {
+			Logc(ctx).WithField("device", entry.Name()).WithError(err).Debug("Could not read namespace UUID.")
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(string(uuid)), namespaceUUID) {
+			return "/dev/" + entry.Name(), nil
+		}
+	}
+
+	return "", nil
+}
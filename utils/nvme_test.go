@@ -0,0 +1,152 @@
+// Copyright 2023 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHostNQN(t *testing.T) {
+	tests := map[string]struct {
+		contents string
+		expected string
+	}{
+		"Single line": {
+			contents: "nqn.2014-08.org.nvmexpress:uuid:f3b1d1a4-8b31-4e6b-a1c8-7a4d8f0c1e22\n",
+			expected: "nqn.2014-08.org.nvmexpress:uuid:f3b1d1a4-8b31-4e6b-a1c8-7a4d8f0c1e22",
+		},
+		"Surrounding whitespace": {
+			contents: "\n  nqn.2014-08.org.nvmexpress:uuid:f3b1d1a4-8b31-4e6b-a1c8-7a4d8f0c1e22  \n",
+			expected: "nqn.2014-08.org.nvmexpress:uuid:f3b1d1a4-8b31-4e6b-a1c8-7a4d8f0c1e22",
+		},
+		"Empty": {
+			contents: "",
+			expected: "",
+		},
+		"Not an NQN": {
+			contents: "InitiatorName=iqn.2005-03.org.open-iscsi:123abc456de\n",
+			expected: "",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseHostNQN(test.contents))
+		})
+	}
+}
+
+func TestParseNVMeDiscoveryLog(t *testing.T) {
+	out := []byte(`{
+  "device": "nvme0",
+  "genctr": 4,
+  "records": [
+    {
+      "trtype": "tcp",
+      "adrfam": "ipv4",
+      "subtype": "current discovery subsystem",
+      "treq": "not specified",
+      "portid": 0,
+      "trsvcid": "8009",
+      "subnqn": "nqn.2014-08.org.nvmexpress.discovery",
+      "traddr": "10.0.0.1"
+    },
+    {
+      "trtype": "tcp",
+      "adrfam": "ipv4",
+      "subtype": "nvme subsystem",
+      "treq": "not specified",
+      "portid": 0,
+      "trsvcid": "4420",
+      "subnqn": "nqn.1992-08.com.netapp:sn.abc:subsystem.s_trident_pvc_1",
+      "traddr": "10.0.0.1"
+    }
+  ]
+}`)
+
+	records, err := parseNVMeDiscoveryLog(out)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []NVMeDiscoveryRecord{{
+		TransportType:    "tcp",
+		SubsystemType:    "nvme subsystem",
+		TransportAddress: "10.0.0.1",
+		TransportService: "4420",
+		SubsystemNQN:     "nqn.1992-08.com.netapp:sn.abc:subsystem.s_trident_pvc_1",
+	}}, records)
+
+	_, err = parseNVMeDiscoveryLog([]byte("Failed to write to /dev/nvme-fabrics"))
+	assert.Error(t, err)
+}
+
+func writeSysfsFile(t *testing.T, path, contents string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+}
+
+func TestNVMeSysfsLookups(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+	subsystemNQN := "nqn.1992-08.com.netapp:sn.abc:subsystem.s_trident_pvc_1"
+
+	subsystemPath := filepath.Join(sysfsRoot, nvmeSysfsSubsystemPath, "nvme-subsys0")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "subsysnqn"), subsystemNQN+"\n")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "nvme0", "address"), "traddr=10.0.0.1,trsvcid=4420\n")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "nvme0", "state"), "live\n")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "nvme1", "address"), "traddr=10.0.0.2,trsvcid=4420\n")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "nvme1", "state"), "connecting\n")
+	writeSysfsFile(t, filepath.Join(subsystemPath, "nvme0n1", "uuid"), "ignored")
+
+	otherPath := filepath.Join(sysfsRoot, nvmeSysfsSubsystemPath, "nvme-subsys1")
+	writeSysfsFile(t, filepath.Join(otherPath, "subsysnqn"), "nqn.1992-08.com.netapp:sn.abc:subsystem.other\n")
+
+	writeSysfsFile(t, filepath.Join(sysfsRoot, nvmeSysfsBlockPath, "nvme0n1", "uuid"),
+		"5f6e7d8c-1234-4abc-9def-0123456789ab\n")
+	writeSysfsFile(t, filepath.Join(sysfsRoot, nvmeSysfsBlockPath, "nvme0c0n1", "uuid"),
+		"5f6e7d8c-1234-4abc-9def-0123456789ab\n")
+	writeSysfsFile(t, filepath.Join(sysfsRoot, nvmeSysfsBlockPath, "sda", "size"), "0\n")
+
+	path, err := getNVMeSubsystemPath(ctx, sysfsRoot, subsystemNQN)
+	assert.NoError(t, err)
+	assert.Equal(t, subsystemPath, path)
+
+	path, err = getNVMeSubsystemPath(ctx, sysfsRoot, "nqn.1992-08.com.netapp:sn.abc:subsystem.missing")
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+
+	controllers, err := getNVMeSubsystemControllers(ctx, sysfsRoot, subsystemNQN)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"nvme0", "nvme1"}, controllers)
+
+	addresses, err := getNVMeSubsystemAddresses(ctx, sysfsRoot, subsystemNQN)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"10.0.0.1": {}}, addresses)
+
+	device, err := findNVMeNamespaceDevice(ctx, sysfsRoot, "5F6E7D8C-1234-4ABC-9DEF-0123456789AB")
+	assert.NoError(t, err)
+	assert.Equal(t, "/dev/nvme0n1", device)
+
+	device, err = findNVMeNamespaceDevice(ctx, sysfsRoot, "00000000-0000-0000-0000-000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, "", device)
+}
+
+func TestNVMeSysfsLookups_NoSubsystems(t *testing.T) {
+	ctx := context.Background()
+	sysfsRoot := t.TempDir()
+
+	path, err := getNVMeSubsystemPath(ctx, sysfsRoot, "nqn.1992-08.com.netapp:sn.abc:subsystem.s_trident_pvc_1")
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+
+	addresses, err := getNVMeSubsystemAddresses(ctx, sysfsRoot, "nqn.1992-08.com.netapp:sn.abc:subsystem.s_trident_pvc_1")
+	assert.NoError(t, err)
+	assert.Empty(t, addresses)
+
+	_, err = findNVMeNamespaceDevice(ctx, sysfsRoot, "5f6e7d8c-1234-4abc-9def-0123456789ab")
+	assert.Error(t, err)
+}
@@ -16,6 +16,8 @@ import (
 
 type VolumeAccessInfo struct {
 	IscsiAccessInfo
+	NVMeAccessInfo
+	FCPAccessInfo
 	NfsAccessInfo
 	SMBAccessInfo
 	NfsBlockAccessInfo
@@ -53,6 +55,20 @@ type IscsiAccessInfo struct {
 	IscsiChapInfo
 }
 
+type NVMeAccessInfo struct {
+	NVMeTargetIPs     []string `json:"nvmeTargetIPs,omitempty"`
+	NVMeSubsystemNQN  string   `json:"nvmeSubsystemNqn,omitempty"`
+	NVMeSubsystemUUID string   `json:"nvmeSubsystemUUID,omitempty"`
+	NVMeNamespaceUUID string   `json:"nvmeNamespaceUUID,omitempty"`
+}
+
+type FCPAccessInfo struct {
+	FCTargetWWNN string `json:"fcTargetWWNN,omitempty"`
+	FCPLunNumber int32  `json:"fcpLunNumber,omitempty"`
+	FCPIgroup    string `json:"fcpIgroup,omitempty"`
+	FCPLunSerial string `json:"fcpLunSerial,omitempty"`
+}
+
 type NfsAccessInfo struct {
 	NfsServerIP string `json:"nfsServerIp,omitempty"`
 	NfsPath     string `json:"nfsPath,omitempty"`
@@ -73,10 +89,13 @@ type NfsBlockAccessInfo struct {
 type VolumePublishInfo struct {
 	Localhost         bool     `json:"localhost,omitempty"`
 	HostIQN           []string `json:"hostIQN,omitempty"`
+	HostNQN           string   `json:"hostNQN,omitempty"`
+	HostWWPN          []string `json:"hostWWPN,omitempty"`
 	HostIP            []string `json:"hostIP,omitempty"`
 	BackendUUID       string   `json:"backendUUID,omitempty"`
 	Nodes             []*Node  `json:"nodes,omitempty"`
 	HostName          string   `json:"hostName,omitempty"`
+	AutogrowEnabled   bool     `json:"autogrowEnabled,omitempty"`
 	FilesystemType    string   `json:"fstype,omitempty"`
 	SharedTarget      bool     `json:"sharedTarget,omitempty"`
 	DevicePath        string   `json:"rawDevicePath,omitempty"`
@@ -84,6 +103,7 @@ type VolumePublishInfo struct {
 	StagingMountpoint string   `json:"stagingMountpoint,omitempty"` // NOTE: Added in 22.04 release
 	TridentUUID       string   `json:"tridentUUID,omitempty"`       // NOTE: Added in 22.07 release
 	LUKSEncryption    string   `json:"LUKSEncryption,omitempty"`
+	SANType           string   `json:"SANType,omitempty"`
 	VolumeAccessInfo
 }
 
@@ -143,6 +163,8 @@ func (v *VolumePublication) ConstructExternal() *VolumePublicationExternal {
 type Node struct {
 	Name             string               `json:"name"`
 	IQN              string               `json:"iqn,omitempty"`
+	NQN              string               `json:"nqn,omitempty"`
+	WWPNs            []string             `json:"wwpns,omitempty"`
 	IPs              []string             `json:"ips,omitempty"`
 	TopologyLabels   map[string]string    `json:"topologyLabels,omitempty"`
 	NodePrep         *NodePrep            `json:"nodePrep,omitempty"`
@@ -154,6 +176,8 @@ type Node struct {
 type NodeExternal struct {
 	Name             string               `json:"name"`
 	IQN              string               `json:"iqn,omitempty"`
+	NQN              string               `json:"nqn,omitempty"`
+	WWPNs            []string             `json:"wwpns,omitempty"`
 	IPs              []string             `json:"ips,omitempty"`
 	TopologyLabels   map[string]string    `json:"topologyLabels,omitempty"`
 	NodePrep         *NodePrep            `json:"nodePrep,omitempty"`
@@ -182,6 +206,8 @@ func (n *Node) ConstructExternal() *NodeExternal {
 	return &NodeExternal{
 		Name:             node.Name,
 		IQN:              node.IQN,
+		NQN:              node.NQN,
+		WWPNs:            node.WWPNs,
 		IPs:              node.IPs,
 		TopologyLabels:   node.TopologyLabels,
 		NodePrep:         node.NodePrep,
@@ -53,6 +53,10 @@ const (
 	// NAS protocols
 	SMB = "smb"
 
+	// SAN protocols
+	NVMe = "nvme"
+	FCP  = "fcp"
+
 	// Path separator
 	WindowsPathSeparator = `\`
 	UnixPathSeparator    = "/"